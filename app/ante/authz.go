@@ -7,11 +7,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/mage-coven/fury/x/flash"
 )
 
 var _ sdk.AnteDecorator = AuthzLimiterDecorator{}
 
-// AuthzLimiterDecorator blocks certain msg types from being granted or executed within authz.
+// AuthzLimiterDecorator blocks certain msg types from being granted or executed within authz, flash mints or flash loans.
 type AuthzLimiterDecorator struct {
 	// disabledMsgTypes is the type urls of the msgs to block.
 	disabledMsgTypes []string
@@ -37,7 +39,8 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
 // Otherwise any msg matching the disabled types are blocked, regardless of being in an authz msg or not.
 //
-// This method is recursive as MsgExec's can wrap other MsgExecs.
+// This method is recursive as MsgExec's can wrap other MsgExecs. Flash mints and flash loans execute the msgs they
+// wrap in the same way, so the msgs of any flash.MsgExecutor are always searched.
 func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyInAuthzMsgs bool) error {
 	for _, msg := range msgs {
 		typeURL := sdk.MsgTypeURL(msg)
@@ -59,10 +62,11 @@ func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyI
 				return fmt.Errorf("found disabled msg type in MsgGrant: %s", authorization.MsgTypeURL())
 			}
 
-		case typeURL == sdk.MsgTypeURL(&authz.MsgExec{}):
-			m, ok := msg.(*authz.MsgExec)
+		default:
+			// authz execs, flash mints and flash loans all execute the msgs they wrap
+			m, ok := msg.(flash.MsgExecutor)
 			if !ok {
-				panic("unexpected msg type")
+				continue
 			}
 			innerMsgs, err := m.GetMessages()
 			if err != nil {
@@ -16,6 +16,8 @@ import (
 
 	"github.com/mage-coven/fury/app"
 	"github.com/mage-coven/fury/app/ante"
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
 )
 
 func newMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a authz.Authorization, expiration time.Time) *authz.MsgGrant {
@@ -31,6 +33,22 @@ func newMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) *authz.MsgExec {
 	return &msg
 }
 
+func newMsgFlashMint(sender sdk.AccAddress, msgs []sdk.Msg) *cdptypes.MsgFlashMint {
+	msg, err := cdptypes.NewMsgFlashMint(sender, sdk.NewInt64Coin("usdx", 100e6), msgs)
+	if err != nil {
+		panic(err)
+	}
+	return &msg
+}
+
+func newMsgFlashLoan(borrower sdk.AccAddress, msgs []sdk.Msg) *hardtypes.MsgFlashLoan {
+	msg, err := hardtypes.NewMsgFlashLoan(borrower, sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6)), msgs)
+	if err != nil {
+		panic(err)
+	}
+	return &msg
+}
+
 func TestAuthzLimiterDecorator(t *testing.T) {
 	testPrivKeys, testAddresses := app.GeneratePrivKeyAddressPairs(5)
 	distantFuture := time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -206,6 +224,53 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 			checkTx:     false,
 			expectedErr: sdkerrors.ErrUnauthorized,
 		},
+		{
+			name: "a flash mint containing a blocked msg is blocked",
+			msgs: []sdk.Msg{
+				newMsgFlashMint(
+					testAddresses[0],
+					[]sdk.Msg{
+						&evmtypes.MsgEthereumTx{},
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "a flash loan containing a MsgExec with a blocked msg is blocked",
+			msgs: []sdk.Msg{
+				newMsgFlashLoan(
+					testAddresses[0],
+					[]sdk.Msg{
+						newMsgExec(
+							testAddresses[0],
+							[]sdk.Msg{
+								&evmtypes.MsgEthereumTx{},
+							},
+						),
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "a flash mint containing a non blocked msg passes",
+			msgs: []sdk.Msg{
+				newMsgFlashMint(
+					testAddresses[0],
+					[]sdk.Msg{
+						banktypes.NewMsgSend(
+							testAddresses[0],
+							testAddresses[0],
+							sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6)),
+						),
+					},
+				),
+			},
+			checkTx: false,
+		},
 	}
 
 	txConfig := app.MakeEncodingConfig().TxConfig
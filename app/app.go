@@ -237,10 +237,10 @@ var (
 		swaptypes.ModuleName:            nil,
 		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
+		hardtypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
 		savingstypes.ModuleAccountName:  nil,
 		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
+		earntypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
 		furydisttypes.FundModuleAccount: nil,
 		minttypes.ModuleName:            {authtypes.Minter},
 		communitytypes.ModuleName:       nil,
@@ -434,13 +434,16 @@ func NewApp(
 		mAccPerms,
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		bankSubspace,
 		app.loadBlockedMaccAddrs(),
 	)
+	// Transfers of tokenized earn shares sync the earn rewards of the holders
+	tokenizedSharesBankKeeper := earnkeeper.NewTokenizedSharesBankKeeper(baseBankKeeper)
+	app.bankKeeper = tokenizedSharesBankKeeper
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -594,6 +597,7 @@ func NewApp(
 		app.bankKeeper,
 		app.accountKeeper,
 		mAccPerms,
+		app.MsgServiceRouter(),
 	)
 	hardKeeper := hardkeeper.NewKeeper(
 		appCodec,
@@ -603,6 +607,7 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		app.MsgServiceRouter(),
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
@@ -624,10 +629,12 @@ func NewApp(
 		keys[earntypes.StoreKey],
 		earnSubspace,
 		app.accountKeeper,
-		app.bankKeeper,
+		baseBankKeeper,
 		&app.liquidKeeper,
 		&hardKeeper,
 		&savingsKeeper,
+		&swapKeeper,
+		app.pricefeedKeeper,
 		&app.distrKeeper,
 	)
 
@@ -711,10 +718,11 @@ func NewApp(
 		)))
 
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
-	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
+	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks())).SetSavingsKeeper(&savingsKeeper)
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
-	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
-	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	app.savingsKeeper = *savingsKeeper.SetHooks(savingstypes.NewMultiSavingsHooks(app.incentiveKeeper.Hooks()))
+	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks()).SetIncentiveKeeper(app.incentiveKeeper)
+	tokenizedSharesBankKeeper.SetEarnKeeper(&app.earnKeeper)
 
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
@@ -755,7 +763,7 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, nil),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		newBankAppModule(bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper), app.bankKeeper, baseBankKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
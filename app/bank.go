@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankAppModule wraps the bank module so its msgs are handled by a wrapped
+// bank keeper, such as the keeper that syncs the earn rewards of tokenized
+// shares. The bank AppModule asserts its keeper is a BaseKeeper to register
+// the bank migrations, which panics for a wrapped keeper, so the base keeper
+// is kept separately for the migrations.
+type bankAppModule struct {
+	bank.AppModule
+
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+// newBankAppModule returns a new bankAppModule.
+func newBankAppModule(module bank.AppModule, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper) bankAppModule {
+	return bankAppModule{
+		AppModule:  module,
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers the bank services with the wrapped keeper and
+// the bank migrations with the base keeper.
+func (am bankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
@@ -1,3 +1,113 @@
 package app
 
-func (app App) RegisterUpgradeHandlers() {}
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
+)
+
+// UpgradeName is the name of the upgrade that introduces the new module params
+const UpgradeName = "v0.24.0"
+
+// RegisterUpgradeHandlers registers the handlers of on-chain upgrades
+func (app App) RegisterUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info(fmt.Sprintf("running %s upgrade handler", UpgradeName))
+
+			app.setNewParamDefaults(ctx)
+			app.indexHardBorrowers(ctx)
+			app.initializeSavingsClaims(ctx)
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
+
+// setNewParamDefaults stores the default value of every param added since the
+// last upgrade. Params that already have a value are left unchanged.
+func (app App) setNewParamDefaults(ctx sdk.Context) {
+	cdpSubspace := app.mustGetSubspace(cdptypes.ModuleName)
+	setParamIfMissing(ctx, cdpSubspace, cdptypes.KeyStabilityFeeControl, cdptypes.DefaultStabilityFeeControllers)
+	setParamIfMissing(ctx, cdpSubspace, cdptypes.KeyFlashMintCap, cdptypes.DefaultFlashMintCap)
+	setParamIfMissing(ctx, cdpSubspace, cdptypes.KeyFlashMintFee, cdptypes.DefaultFlashMintFee)
+
+	hardSubspace := app.mustGetSubspace(hardtypes.ModuleName)
+	setParamIfMissing(ctx, hardSubspace, hardtypes.KeyCheckLtvIndexCount, hardtypes.DefaultCheckLtvIndexCount)
+	setParamIfMissing(ctx, hardSubspace, hardtypes.KeyEfficiencyCategories, hardtypes.DefaultEfficiencyCategories)
+	setParamIfMissing(ctx, hardSubspace, hardtypes.KeyFlashLoanFee, hardtypes.DefaultFlashLoanFee)
+	setParamIfMissing(ctx, hardSubspace, hardtypes.KeyCloseFactor, hardtypes.DefaultCloseFactor)
+
+	// Money markets stored before the upgrade are missing their supply limit, isolation mode and liquidation bonus
+	var moneyMarkets hardtypes.MoneyMarkets
+	hardSubspace.Get(ctx, hardtypes.KeyMoneyMarkets, &moneyMarkets)
+	for i, mm := range moneyMarkets {
+		if mm.SupplyLimit.MaximumLimit.IsNil() {
+			moneyMarkets[i].SupplyLimit = hardtypes.NewSupplyLimit(false, sdk.ZeroDec())
+		}
+		if mm.IsolationMode.DebtCeiling.IsNil() {
+			moneyMarkets[i].IsolationMode = hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false)
+		}
+		if mm.LiquidationBonus.IsNil() {
+			moneyMarkets[i].LiquidationBonus = hardtypes.DefaultLiquidationBonus
+		}
+	}
+	hardSubspace.Set(ctx, hardtypes.KeyMoneyMarkets, moneyMarkets)
+
+	savingsSubspace := app.mustGetSubspace(savingstypes.ModuleName)
+	setParamIfMissing(ctx, savingsSubspace, savingstypes.KeySavingsRate, savingstypes.DefaultSavingsRate)
+	setParamIfMissing(ctx, savingsSubspace, savingstypes.KeyLockupTiers, savingstypes.DefaultLockupTiers)
+}
+
+// indexHardBorrowers adds every existing hard borrower to the LTV index used for automatic liquidations
+func (app App) indexHardBorrowers(ctx sdk.Context) {
+	var borrowers []sdk.AccAddress
+	app.hardKeeper.IterateBorrows(ctx, func(borrow hardtypes.Borrow) (stop bool) {
+		borrowers = append(borrowers, borrow.Borrower)
+		return false
+	})
+	for _, borrower := range borrowers {
+		app.hardKeeper.UpdateLtvIndex(ctx, borrower)
+	}
+}
+
+// initializeSavingsClaims creates a savings reward claim for every existing savings deposit, as the savings
+// incentive hooks that create claims were not called before the upgrade
+func (app App) initializeSavingsClaims(ctx sdk.Context) {
+	var deposits []savingstypes.Deposit
+	app.savingsKeeper.IterateDeposits(ctx, func(deposit savingstypes.Deposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	for _, deposit := range deposits {
+		if _, found := app.incentiveKeeper.GetSavingsClaim(ctx, deposit.Depositor); found {
+			continue
+		}
+		app.incentiveKeeper.InitializeSavingsReward(ctx, deposit)
+	}
+}
+
+// mustGetSubspace returns the params subspace of a module, panicking if it is not registered
+func (app App) mustGetSubspace(moduleName string) paramstypes.Subspace {
+	subspace, found := app.paramsKeeper.GetSubspace(moduleName)
+	if !found {
+		panic(fmt.Sprintf("params subspace for module %s not found", moduleName))
+	}
+	return subspace
+}
+
+// setParamIfMissing sets a param to a value if the param is not yet in the store
+func setParamIfMissing(ctx sdk.Context, subspace paramstypes.Subspace, key []byte, value interface{}) {
+	if subspace.Has(ctx, key) {
+		return
+	}
+	subspace.Set(ctx, key, value)
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
+	incentivetypes "github.com/mage-coven/fury/x/incentive/types"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
+)
+
+func TestSetNewParamDefaults(t *testing.T) {
+	tApp := NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+
+	hardParams := hardtypes.DefaultParams()
+	hardParams.MoneyMarkets = hardtypes.MoneyMarkets{
+		hardtypes.NewMoneyMarket(
+			"usdx",
+			hardtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+			"usdx:usd",
+			sdk.NewInt(1e6),
+			hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.ZeroDec(),
+			hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+			hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+			hardtypes.DefaultLiquidationBonus,
+		),
+	}
+	tApp.GetHardKeeper().SetParams(ctx, hardParams)
+
+	// Remove the params added since the last upgrade to recreate the store of an upgrading chain
+	paramsStore := ctx.KVStore(tApp.keys[paramstypes.StoreKey])
+	deleteParams := func(moduleName string, keys ...[]byte) {
+		store := prefix.NewStore(paramsStore, append([]byte(moduleName), '/'))
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	deleteParams(cdptypes.ModuleName, cdptypes.KeyStabilityFeeControl, cdptypes.KeyFlashMintCap, cdptypes.KeyFlashMintFee)
+	deleteParams(hardtypes.ModuleName, hardtypes.KeyCheckLtvIndexCount, hardtypes.KeyEfficiencyCategories, hardtypes.KeyFlashLoanFee, hardtypes.KeyCloseFactor)
+	deleteParams(savingstypes.ModuleName, savingstypes.KeySavingsRate, savingstypes.KeyLockupTiers)
+
+	hardStore := prefix.NewStore(paramsStore, append([]byte(hardtypes.ModuleName), '/'))
+	var moneyMarkets []map[string]interface{}
+	require.NoError(t, json.Unmarshal(hardStore.Get(hardtypes.KeyMoneyMarkets), &moneyMarkets))
+	for _, mm := range moneyMarkets {
+		delete(mm, "supply_limit")
+		delete(mm, "isolation_mode")
+		delete(mm, "liquidation_bonus")
+	}
+	bz, err := json.Marshal(moneyMarkets)
+	require.NoError(t, err)
+	hardStore.Set(hardtypes.KeyMoneyMarkets, bz)
+
+	require.Panics(t, func() { tApp.GetCDPKeeper().GetParams(ctx) })
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+	require.Panics(t, func() { tApp.GetSavingsKeeper().GetParams(ctx) })
+
+	tApp.setNewParamDefaults(ctx)
+
+	cdpParams := tApp.GetCDPKeeper().GetParams(ctx)
+	require.Equal(t, cdptypes.DefaultStabilityFeeControllers, cdpParams.StabilityFeeControllers)
+	require.Equal(t, cdptypes.DefaultFlashMintCap, cdpParams.FlashMintCap)
+	require.Equal(t, cdptypes.DefaultFlashMintFee, cdpParams.FlashMintFee)
+	require.NoError(t, cdpParams.Validate())
+
+	migratedHardParams := tApp.GetHardKeeper().GetParams(ctx)
+	require.Equal(t, hardtypes.DefaultCheckLtvIndexCount, migratedHardParams.CheckLtvIndexCount)
+	require.Equal(t, hardtypes.DefaultFlashLoanFee, migratedHardParams.FlashLoanFee)
+	require.Equal(t, hardtypes.DefaultCloseFactor, migratedHardParams.CloseFactor)
+	require.Len(t, migratedHardParams.MoneyMarkets, 1)
+	require.Equal(t, hardtypes.DefaultLiquidationBonus, migratedHardParams.MoneyMarkets[0].LiquidationBonus)
+	require.NoError(t, migratedHardParams.Validate())
+
+	savingsParams := tApp.GetSavingsKeeper().GetParams(ctx)
+	require.Equal(t, savingstypes.DefaultSavingsRate, savingsParams.SavingsRate)
+	require.Empty(t, savingsParams.LockupTiers)
+	require.NoError(t, savingsParams.Validate())
+
+	// Params that already exist are not overwritten
+	cdpParams.FlashMintCap = sdk.NewInt(1e12)
+	tApp.GetCDPKeeper().SetParams(ctx, cdpParams)
+	tApp.setNewParamDefaults(ctx)
+	require.Equal(t, sdk.NewInt(1e12), tApp.GetCDPKeeper().GetParams(ctx).FlashMintCap)
+}
+
+func TestIndexHardBorrowers(t *testing.T) {
+	tApp := NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+	hardKeeper := tApp.GetHardKeeper()
+
+	hardParams := hardtypes.DefaultParams()
+	hardParams.MoneyMarkets = hardtypes.MoneyMarkets{
+		hardtypes.NewMoneyMarket(
+			"usdx",
+			hardtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+			"usdx:usd",
+			sdk.NewInt(1e6),
+			hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.ZeroDec(),
+			hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+			hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+			hardtypes.DefaultLiquidationBonus,
+		),
+	}
+	hardKeeper.SetParams(ctx, hardParams)
+	hardKeeper.SetMoneyMarket(ctx, "usdx", hardParams.MoneyMarkets[0])
+
+	// a borrow stored before the upgrade is not in the index
+	borrower := sdk.AccAddress("borrower")
+	hardKeeper.SetDeposit(ctx, hardtypes.NewDeposit(borrower, sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6)), hardtypes.SupplyInterestFactors{}))
+	hardKeeper.SetBorrow(ctx, hardtypes.NewBorrow(borrower, sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)), hardtypes.BorrowInterestFactors{}))
+
+	tApp.indexHardBorrowers(ctx)
+
+	var scores []sdk.Dec
+	hardKeeper.IterateBorrowerLtvScores(ctx, borrower, func(depositDenom, borrowDenom string, score sdk.Dec) bool {
+		require.Equal(t, "usdx", depositDenom)
+		require.Equal(t, "usdx", borrowDenom)
+		scores = append(scores, score)
+		return false
+	})
+	require.Equal(t, []sdk.Dec{sdk.MustNewDecFromStr("1.6")}, scores)
+}
+
+func TestInitializeSavingsClaims(t *testing.T) {
+	tApp := NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+	incentiveKeeper := tApp.GetIncentiveKeeper()
+
+	indexes := incentivetypes.RewardIndexes{incentivetypes.NewRewardIndex("hard", sdk.MustNewDecFromStr("0.1"))}
+	incentiveKeeper.SetSavingsRewardIndexes(ctx, "usdx", indexes)
+
+	// a deposit stored before the upgrade has no claim
+	depositor := sdk.AccAddress("depositor")
+	tApp.GetSavingsKeeper().SetDeposit(ctx, savingstypes.NewDeposit(depositor, sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6))))
+
+	tApp.initializeSavingsClaims(ctx)
+
+	claim, found := incentiveKeeper.GetSavingsClaim(ctx, depositor)
+	require.True(t, found)
+	require.True(t, claim.Reward.IsZero())
+	require.Equal(t, incentivetypes.MultiRewardIndexes{incentivetypes.NewMultiRewardIndex("usdx", indexes)}, claim.RewardIndexes)
+}
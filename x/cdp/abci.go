@@ -42,7 +42,17 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		}
 	}
 
-	err := k.RunSurplusAndDebtAuctions(ctx)
+	err := k.UpdateStabilityFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.DistributeSavingsRate(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.RunSurplusAndDebtAuctions(ctx)
 	if err != nil {
 		panic(err)
 	}
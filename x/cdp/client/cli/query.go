@@ -22,6 +22,9 @@ const (
 	flagOwner          = "owner"
 	flagID             = "id"
 	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagDeposit        = "deposit"
+	flagDraw           = "draw"
+	flagPriceChange    = "price-change"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -38,6 +41,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryStabilityFeeAdjustmentsCmd(),
+		QuerySimulateCdpCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -270,3 +275,116 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryStabilityFeeAdjustmentsCmd returns the command handler for querying stability fee controller adjustments
+func QueryStabilityFeeAdjustmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stability-fee-adjustments",
+		Short: "get the history of stability fees set by the stability fee controller",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the paginated history of stability fees set by the stability fee controller, optionally filtered by collateral type.
+
+Example:
+$ %s query %s stability-fee-adjustments
+$ %s query %s stability-fee-adjustments --collateral-type=bnb-a
+$ %s query %s stability-fee-adjustments --collateral-type=bnb-a --reverse --limit=1
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StabilityFeeAdjustments(context.Background(), &types.QueryStabilityFeeAdjustmentsRequest{
+				CollateralType: collateralType,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by a collateral type")
+	flags.AddPaginationFlagsToCmd(cmd, "stability-fee-adjustments")
+
+	return cmd
+}
+
+// QuerySimulateCdpCmd returns the command handler for simulating an action on a cdp
+func QuerySimulateCdpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [collateral-type]",
+		Short: "simulate a deposit, draw or price change on a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the state of a CDP after a hypothetical deposit and draw or collateral price change.
+Amounts are in the base denom of the collateral and principal. The price change is relative, e.g. -0.1 for a 10%% drop.
+Opening a new CDP is simulated if no owner is given or the owner has no CDP for the collateral type.
+
+Example:
+$ %s query %s simulate atom-a --owner=fury15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw --draw=1000000
+$ %s query %s simulate atom-a --deposit=100000000 --draw=10000000 --price-change=-0.2
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			deposit, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+			draw, err := cmd.Flags().GetString(flagDraw)
+			if err != nil {
+				return err
+			}
+			priceChange, err := cmd.Flags().GetString(flagPriceChange)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateCdp(context.Background(), &types.QuerySimulateCdpRequest{
+				CollateralType: args[0],
+				Owner:          owner,
+				Deposit:        deposit,
+				Draw:           draw,
+				PriceChange:    priceChange,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "(optional) owner of an existing cdp")
+	cmd.Flags().String(flagDeposit, "", "(optional) collateral amount to deposit")
+	cmd.Flags().String(flagDraw, "", "(optional) principal amount to draw")
+	cmd.Flags().String(flagPriceChange, "", "(optional) relative change of the collateral price")
+
+	return cmd
+}
@@ -63,6 +63,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, gtp := range gs.TotalPrincipals {
 		k.SetTotalPrincipal(ctx, gtp.CollateralType, types.DefaultStableDenom, gtp.TotalPrincipal)
 	}
+
+	for _, adjustment := range gs.StabilityFeeAdjustments {
+		k.SetStabilityFeeAdjustment(ctx, adjustment)
+	}
+	for _, gsfut := range gs.PreviousStabilityFeeUpdateTimes {
+		k.SetPreviousStabilityFeeUpdateTime(ctx, gsfut.CollateralType, gsfut.PreviousUpdateTime)
+	}
 	// add cdps
 	for _, cdp := range gs.CDPs {
 		if cdp.ID == gs.StartingCdpID {
@@ -129,5 +136,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	var stabilityFeeAdjustments types.StabilityFeeAdjustments
+	k.IterateStabilityFeeAdjustments(ctx, func(adjustment types.StabilityFeeAdjustment) (stop bool) {
+		stabilityFeeAdjustments = append(stabilityFeeAdjustments, adjustment)
+		return false
+	})
+
+	var prevStabilityFeeUpdateTimes types.GenesisStabilityFeeUpdateTimes
+	for _, sfc := range params.StabilityFeeControllers {
+		prevUpdateTime, found := k.GetPreviousStabilityFeeUpdateTime(ctx, sfc.CollateralType)
+		if !found {
+			continue
+		}
+		prevStabilityFeeUpdateTimes = append(
+			prevStabilityFeeUpdateTimes,
+			types.NewGenesisStabilityFeeUpdateTime(sfc.CollateralType, prevUpdateTime),
+		)
+	}
+
+	return types.NewGenesisState(
+		params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals,
+		stabilityFeeAdjustments, prevStabilityFeeUpdateTimes,
+	)
 }
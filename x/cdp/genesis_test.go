@@ -52,6 +52,7 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 		govDenom           string
 		genAccumTimes      types.GenesisAccumulationTimes
 		genTotalPrincipals types.GenesisTotalPrincipals
+		genFeeAdjustments  types.StabilityFeeAdjustments
 	}
 	type errArgs struct {
 		expectPass bool
@@ -127,11 +128,31 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 				contains:   "total principal should be positive",
 			},
 		},
+		{
+			name: "stability fee adjustment below one",
+			args: args{
+				params:             types.DefaultParams(),
+				cdps:               types.CDPs{},
+				deposits:           types.Deposits{},
+				debtDenom:          types.DefaultDebtDenom,
+				govDenom:           types.DefaultGovDenom,
+				genAccumTimes:      types.DefaultGenesisState().PreviousAccumulationTimes,
+				genTotalPrincipals: types.DefaultGenesisState().TotalPrincipals,
+				genFeeAdjustments: types.StabilityFeeAdjustments{
+					types.NewStabilityFeeAdjustment("bnb-a", sdk.MustNewDecFromStr("0.9"), sdk.OneDec(), sdk.OneDec(), time.Time{}),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "stability fee must be ≥ 1.0",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals,
+				tc.args.genFeeAdjustments, types.GenesisStabilityFeeUpdateTimes{})
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -210,6 +231,9 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
+			StabilityFeeControllers: types.DefaultStabilityFeeControllers,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
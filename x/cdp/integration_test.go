@@ -47,6 +47,8 @@ func NewCDPGenState(cdc codec.JSONCodec, asset string, liquidationRatio sdk.Dec)
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            asset,
@@ -118,6 +120,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
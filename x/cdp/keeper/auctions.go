@@ -140,6 +140,45 @@ func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdkmath.Int {
 	return k.bankKeeper.GetBalance(ctx, acc.GetAddress(), k.GetDebtDenom(ctx)).Amount
 }
 
+// DistributeSavingsRate pays the savings rate on savings deposits of the debt
+// param denom from the accrued stability fees, limited to the surplus that
+// remains after netting debt. Other surplus, such as liquidation proceeds, is
+// left to be auctioned.
+func (k Keeper) DistributeSavingsRate(ctx sdk.Context) error {
+	if k.savingsKeeper == nil {
+		return nil
+	}
+
+	dp := k.GetParams(ctx).DebtParam
+	available := sdk.MinInt(k.GetAccruedStabilityFees(ctx), k.getNetSurplus(ctx))
+
+	liquidatorAcc := k.accountKeeper.GetModuleAccount(ctx, types.LiquidatorMacc)
+	balanceBefore := k.bankKeeper.GetBalance(ctx, liquidatorAcc.GetAddress(), dp.Denom).Amount
+	if err := k.savingsKeeper.AccrueSavingsRate(ctx, types.LiquidatorMacc, sdk.NewCoin(dp.Denom, available)); err != nil {
+		return err
+	}
+	paid := balanceBefore.Sub(k.bankKeeper.GetBalance(ctx, liquidatorAcc.GetAddress(), dp.Denom).Amount)
+
+	k.SetAccruedStabilityFees(ctx, available.Sub(paid))
+	return nil
+}
+
+// getNetSurplus returns the surplus held by the liquidator module account in excess of its debt
+func (k Keeper) getNetSurplus(ctx sdk.Context) sdkmath.Int {
+	surplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
+	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
+	if surplus.GT(debt) {
+		return surplus.Sub(debt)
+	}
+	return sdkmath.ZeroInt()
+}
+
+// capAccruedStabilityFees limits the accrued stability fees to the net surplus, as fees that were
+// netted against debt or auctioned are no longer available for the savings rate
+func (k Keeper) capAccruedStabilityFees(ctx sdk.Context) {
+	k.SetAccruedStabilityFees(ctx, sdk.MinInt(k.GetAccruedStabilityFees(ctx), k.getNetSurplus(ctx)))
+}
+
 // RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter
 func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 	if err := k.NetSurplusAndDebt(ctx); err != nil {
@@ -162,10 +201,15 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 	liquidatorAcc := k.accountKeeper.GetModuleAccount(ctx, types.LiquidatorMacc)
 	surplus := k.bankKeeper.GetBalance(ctx, liquidatorAcc.GetAddress(), params.DebtParam.Denom).Amount
 	if !surplus.GTE(params.SurplusAuctionThreshold) {
+		k.capAccruedStabilityFees(ctx)
 		return nil
 	}
 
 	surplusLot := sdk.NewCoin(params.DebtParam.Denom, sdk.MinInt(params.SurplusAuctionLot, surplus))
-	_, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, surplusLot, k.GetGovDenom(ctx))
-	return err
+	if _, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, surplusLot, k.GetGovDenom(ctx)); err != nil {
+		return err
+	}
+
+	k.capAccruedStabilityFees(ctx)
+	return nil
 }
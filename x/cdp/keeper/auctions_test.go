@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +11,7 @@ import (
 	auctiontypes "github.com/mage-coven/fury/x/auction/types"
 	"github.com/mage-coven/fury/x/cdp/keeper"
 	"github.com/mage-coven/fury/x/cdp/types"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
 
 	"github.com/stretchr/testify/suite"
 
@@ -163,6 +165,70 @@ func (suite *AuctionTestSuite) TestGetTotalDebt() {
 	suite.Require().Equal(sdkmath.NewInt(250e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
 }
 
+func (suite *AuctionTestSuite) TestDistributeSavingsRate() {
+	bk := suite.app.GetBankKeeper()
+	sk := suite.app.GetSavingsKeeper()
+	sk.SetParams(suite.ctx, savingstypes.NewParams([]string{"usdx"}, sdk.MustNewDecFromStr("0.1"), savingstypes.LockupTiers{}))
+
+	err := sk.Deposit(suite.ctx, suite.addrs[0], cs(c("usdx", 1000000000)))
+	suite.Require().NoError(err)
+
+	// Surplus that is not from stability fees, such as liquidation proceeds, is not paid out
+	err = bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 300000000), c("debt", 250000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.DistributeSavingsRate(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.DistributeSavingsRate(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Equal(i(300000000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Equal(i(1000000000), suite.app.GetModuleAccountBalance(suite.ctx, savingstypes.ModuleAccountName, "usdx"))
+
+	// Accrued stability fees are paid out, up to the savings rate
+	err = bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 30000000)))
+	suite.Require().NoError(err)
+	suite.keeper.SetAccruedStabilityFees(suite.ctx, i(30000000))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.DistributeSavingsRate(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Equal(i(300000000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Equal(i(1030000000), suite.app.GetModuleAccountBalance(suite.ctx, savingstypes.ModuleAccountName, "usdx"))
+	suite.True(suite.keeper.GetAccruedStabilityFees(suite.ctx).IsZero())
+
+	deposit, found := sk.GetSyncedDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 1030000000)), deposit.Amount)
+}
+
+func (suite *AuctionTestSuite) TestDistributeSavingsRate_FeesLimitedByNetSurplus() {
+	bk := suite.app.GetBankKeeper()
+	sk := suite.app.GetSavingsKeeper()
+	sk.SetParams(suite.ctx, savingstypes.NewParams([]string{"usdx"}, sdk.MustNewDecFromStr("0.1"), savingstypes.LockupTiers{}))
+
+	err := sk.Deposit(suite.ctx, suite.addrs[0], cs(c("usdx", 1000000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.DistributeSavingsRate(suite.ctx)
+	suite.Require().NoError(err)
+
+	// Fees that are needed to cover debt are not paid out
+	err = bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 60000000), c("debt", 40000000)))
+	suite.Require().NoError(err)
+	suite.keeper.SetAccruedStabilityFees(suite.ctx, i(60000000))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.DistributeSavingsRate(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Equal(i(1020000000), suite.app.GetModuleAccountBalance(suite.ctx, savingstypes.ModuleAccountName, "usdx"))
+	suite.True(suite.keeper.GetAccruedStabilityFees(suite.ctx).IsZero())
+}
+
 func TestAuctionTestSuite(t *testing.T) {
 	suite.Run(t, new(AuctionTestSuite))
 }
@@ -461,9 +461,14 @@ func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.C
 	if err != nil {
 		return err
 	}
+	return k.validateCollateralizationRatio(ctx, collateral.Denom, collateralType, collateralizationRatio)
+}
+
+// validateCollateralizationRatio validates that the input collateralization ratio is not below the liquidation ratio
+func (k Keeper) validateCollateralizationRatio(ctx sdk.Context, denom string, collateralType string, collateralizationRatio sdk.Dec) error {
 	liquidationRatio := k.getLiquidationRatio(ctx, collateralType)
 	if collateralizationRatio.LT(liquidationRatio) {
-		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ratio %s", collateral.Denom, collateralizationRatio, liquidationRatio)
+		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ratio %s", denom, collateralizationRatio, liquidationRatio)
 	}
 	return nil
 }
@@ -577,16 +582,20 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	if err != nil {
 		return sdk.Dec{}, err
 	}
+	return k.calculateCollateralizationRatioAtPrice(ctx, collateral, collateralType, principal, fees, price.Price), nil
+}
+
+// calculateCollateralizationRatioAtPrice returns the collateralization ratio of the input collateral to the input debt plus fees at the input collateral price
+func (k Keeper) calculateCollateralizationRatioAtPrice(ctx sdk.Context, collateral sdk.Coin, collateralType string, principal sdk.Coin, fees sdk.Coin, price sdk.Dec) sdk.Dec {
 	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType)
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralValue := collateralBaseUnits.Mul(price)
 
 	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
 	principalTotal := prinicpalBaseUnits
 	feeBaseUnits := k.convertDebtToBaseUnits(ctx, fees)
 	principalTotal = principalTotal.Add(feeBaseUnits)
 
-	collateralRatio := collateralValue.Quo(principalTotal)
-	return collateralRatio, nil
+	return collateralValue.Quo(principalTotal)
 }
 
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/cdp/types"
+	"github.com/mage-coven/fury/x/flash"
+)
+
+// FlashMint mints debt to the sender, executes the input messages and then burns the minted amount from the sender.
+// A fee of the minted amount times the flash mint fee param is sent from the sender to the liquidator module account,
+// where it counts towards the surplus. The whole message fails if the sender cannot repay the amount plus fee.
+func (k Keeper) FlashMint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) ([][]byte, error) {
+	params := k.GetParams(ctx)
+	if amount.Denom != params.DebtParam.Denom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDebtRequest, "proposed %s, expected %s", amount.Denom, params.DebtParam.Denom)
+	}
+	// the cap applies to all flash minted debt that has not been repaid, not just this flash mint
+	outstanding := k.GetOutstandingFlashMint(ctx).Add(amount.Amount)
+	if outstanding.GT(params.FlashMintCap) {
+		return nil, errorsmod.Wrapf(types.ErrExceedsFlashMintCap, "%s > %s", sdk.NewCoin(amount.Denom, outstanding), sdk.NewCoin(amount.Denom, params.FlashMintCap))
+	}
+	k.SetOutstandingFlashMint(ctx, outstanding)
+	fee := sdk.NewCoin(amount.Denom, sdk.NewDecFromInt(amount.Amount).Mul(params.FlashMintFee).Ceil().TruncateInt())
+
+	// mint the debt and the corresponding debt coins, then send the debt to the sender
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, err
+	}
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), amount)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := flash.DispatchMsgs(ctx, k.router, sender, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// take back the debt and fee, then burn the debt and the corresponding debt coins
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrFlashMintNotRepaid, err.Error())
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.LiquidatorMacc, sdk.NewCoins(fee))
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrFlashMintNotRepaid, err.Error())
+		}
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, err
+	}
+	err = k.BurnDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), amount)
+	if err != nil {
+		return nil, err
+	}
+	k.SetOutstandingFlashMint(ctx, k.GetOutstandingFlashMint(ctx).Sub(amount.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFlashMint,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return results, nil
+}
+
+// GetOutstandingFlashMint returns the amount of flash minted debt that has not been repaid
+func (k Keeper) GetOutstandingFlashMint(ctx sdk.Context) sdkmath.Int {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OutstandingFlashMintKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var outstanding sdkmath.Int
+	if err := outstanding.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return outstanding
+}
+
+// SetOutstandingFlashMint sets the amount of flash minted debt that has not been repaid
+func (k Keeper) SetOutstandingFlashMint(ctx sdk.Context, outstanding sdkmath.Int) {
+	store := ctx.KVStore(k.key)
+	if outstanding.IsZero() {
+		store.Delete(types.OutstandingFlashMintKey)
+		return
+	}
+	bz, err := outstanding.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.OutstandingFlashMintKey, bz)
+}
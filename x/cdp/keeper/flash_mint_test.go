@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mage-coven/fury/app"
+	"github.com/mage-coven/fury/x/cdp/keeper"
+	"github.com/mage-coven/fury/x/cdp/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
+)
+
+type FlashMintTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *FlashMintTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("usdx", 1000000)),
+		cs(c("usdx", 1000000)),
+	}
+
+	tApp.InitializeFromGenesisStates(
+		app.NewFundedGenStateWithCoins(cdc, coins, addrs),
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	keeper := tApp.GetCDPKeeper()
+	params := keeper.GetParams(ctx)
+	params.FlashMintCap = i(100000000000)
+	params.FlashMintFee = d("0.001")
+	keeper.SetParams(ctx, params)
+
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint() {
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+	liquidator := ak.GetModuleAddress(types.LiquidatorMacc)
+	supplyBefore := bk.GetSupply(suite.ctx, "usdx")
+
+	amount := c("usdx", 500000000)
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[0], cs(amount)),
+	}
+	results, err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], amount, msgs)
+	suite.Require().NoError(err)
+	suite.Len(results, 1)
+
+	// the minted amount is burned and the fee is paid to the liquidator
+	suite.Equal(cs(c("usdx", 500000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+	suite.Equal(cs(c("usdx", 500000)), bk.GetAllBalances(suite.ctx, liquidator))
+	suite.Equal(supplyBefore, bk.GetSupply(suite.ctx, "usdx"))
+	suite.True(bk.GetAllBalances(suite.ctx, ak.GetModuleAddress(types.ModuleName)).IsZero())
+	suite.True(suite.keeper.GetOutstandingFlashMint(suite.ctx).IsZero())
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFlashMint {
+			found = true
+		}
+	}
+	suite.True(found)
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint_FeeRoundsUp() {
+	bk := suite.app.GetBankKeeper()
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[0], cs(c("usdx", 1))),
+	}
+	_, err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1500), msgs)
+	suite.Require().NoError(err)
+
+	suite.Equal(cs(c("usdx", 999998)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint_NotRepaid() {
+	amount := c("usdx", 500000000)
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], cs(amount)),
+	}
+	ctx, _ := suite.ctx.CacheContext()
+	_, err := suite.keeper.FlashMint(ctx, suite.addrs[0], amount, msgs)
+	suite.Require().ErrorIs(err, types.ErrFlashMintNotRepaid)
+
+	// the fee alone cannot be paid either
+	msgs = []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], cs(c("usdx", 1000000))),
+	}
+	ctx, _ = suite.ctx.CacheContext()
+	_, err = suite.keeper.FlashMint(ctx, suite.addrs[0], amount, msgs)
+	suite.Require().ErrorIs(err, types.ErrFlashMintNotRepaid)
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint_Invalid() {
+	selfSend := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[0], cs(c("usdx", 1))),
+	}
+
+	_, err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 100000000001), selfSend)
+	suite.Require().ErrorIs(err, types.ErrExceedsFlashMintCap)
+
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("xrp", 1000), selfSend)
+	suite.Require().ErrorIs(err, types.ErrInvalidDebtRequest)
+
+	otherSigner := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[1], suite.addrs[0], cs(c("usdx", 1))),
+	}
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), otherSigner)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	nested, err := types.NewMsgFlashMint(suite.addrs[0], c("usdx", 1000), selfSend)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), []sdk.Msg{&nested})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	flashLoan, err := hardtypes.NewMsgFlashLoan(suite.addrs[0], cs(c("usdx", 1000)), []sdk.Msg{&nested})
+	suite.Require().NoError(err)
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), []sdk.Msg{&flashLoan})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	exec := authz.NewMsgExec(suite.addrs[0], selfSend)
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), []sdk.Msg{&exec})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	notAllowed := []sdk.Msg{
+		banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(suite.addrs[0], cs(c("usdx", 1)))},
+			[]banktypes.Output{banktypes.NewOutput(suite.addrs[0], cs(c("usdx", 1)))},
+		),
+	}
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), notAllowed)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint_OutstandingCap() {
+	selfSend := []sdk.Msg{
+		banktypes.NewMsgSend(suite.addrs[0], suite.addrs[0], cs(c("usdx", 1))),
+	}
+
+	// flash minted debt that is not yet repaid counts towards the cap
+	suite.keeper.SetOutstandingFlashMint(suite.ctx, i(99999999000))
+	_, err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1001), selfSend)
+	suite.Require().ErrorIs(err, types.ErrExceedsFlashMintCap)
+
+	_, err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000), selfSend)
+	suite.Require().NoError(err)
+	suite.Equal(i(99999999000), suite.keeper.GetOutstandingFlashMint(suite.ctx))
+}
+
+func TestFlashMintTestSuite(t *testing.T) {
+	suite.Run(t, new(FlashMintTestSuite))
+}
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -238,6 +241,107 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 	}, nil
 }
 
+// StabilityFeeAdjustments queries the history of stability fees set by the stability fee controller.
+func (s QueryServer) StabilityFeeAdjustments(c context.Context, req *types.QueryStabilityFeeAdjustmentsRequest) (*types.QueryStabilityFeeAdjustmentsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.StabilityFeeAdjustmentPrefix)
+	if req.CollateralType != "" {
+		if _, found := s.keeper.GetCollateral(ctx, req.CollateralType); !found {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid collateral type")
+		}
+		store = prefix.NewStore(store, types.DenomIterKey(req.CollateralType))
+	}
+
+	var adjustments types.StabilityFeeAdjustments
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var adjustment types.StabilityFeeAdjustment
+		if err := s.keeper.cdc.Unmarshal(value, &adjustment); err != nil {
+			return err
+		}
+		adjustments = append(adjustments, adjustment)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStabilityFeeAdjustmentsResponse{
+		StabilityFeeAdjustments: adjustments,
+		Pagination:              pageRes,
+	}, nil
+}
+
+// SimulateCdp queries the state a CDP would be in after a hypothetical deposit and draw or collateral price change.
+func (s QueryServer) SimulateCdp(c context.Context, req *types.QuerySimulateCdpRequest) (*types.QuerySimulateCdpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+		}
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	deposit, err := parseSimulationAmount(req.Deposit)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deposit: %s", err)
+	}
+	draw, err := parseSimulationAmount(req.Draw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid draw: %s", err)
+	}
+	priceChange := sdk.ZeroDec()
+	if req.PriceChange != "" {
+		priceChange, err = sdk.NewDecFromStr(req.PriceChange)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid price change: %s", err)
+		}
+		if priceChange.LTE(sdk.OneDec().Neg()) {
+			return nil, status.Errorf(codes.InvalidArgument, "price change must be greater than -1, got %s", priceChange)
+		}
+	}
+
+	simulation, err := s.keeper.SimulateCDP(ctx, owner, req.CollateralType, deposit, draw, priceChange)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateCdpResponse{
+		Simulation: simulation,
+	}, nil
+}
+
+// parseSimulationAmount parses a non-negative integer amount, an empty string is treated as zero
+func parseSimulationAmount(amount string) (sdkmath.Int, error) {
+	if amount == "" {
+		return sdk.ZeroInt(), nil
+	}
+	parsed, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("%s is not an integer", amount)
+	}
+	if parsed.IsNegative() {
+		return sdkmath.Int{}, fmt.Errorf("%s is negative", amount)
+	}
+	return parsed, nil
+}
+
 // FilterCDPs queries the store for all CDPs that match query req
 func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (types.CDPResponses, error) {
 	// TODO: Ideally use query.Paginate() here over existing FilterCDPs. However
@@ -279,6 +279,300 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryDeposits() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryStabilityFeeAdjustments() {
+	adjustments := types.StabilityFeeAdjustments{
+		types.NewStabilityFeeAdjustment("btc-a", d("1.000000000782997609"), d("1.000000000782997610"), d("1.1"), suite.now),
+		types.NewStabilityFeeAdjustment("xrp-a", d("1.000000001647125958"), d("1.000000001547125958"), d("0.95"), suite.now),
+		types.NewStabilityFeeAdjustment("xrp-a", d("1.000000001747125958"), d("1.000000001647125958"), d("0.95"), suite.now.Add(time.Hour)),
+		types.NewStabilityFeeAdjustment("xrp-a", d("1.000000001647125958"), d("1.000000001747125958"), d("1.05"), suite.now.Add(2*time.Hour)),
+	}
+	for _, adjustment := range adjustments {
+		suite.keeper.SetStabilityFeeAdjustment(suite.ctx, adjustment)
+	}
+
+	res, err := suite.queryServer.StabilityFeeAdjustments(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeAdjustmentsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(adjustments, res.StabilityFeeAdjustments)
+
+	res, err = suite.queryServer.StabilityFeeAdjustments(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeAdjustmentsRequest{
+		CollateralType: "xrp-a",
+		Pagination:     &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(adjustments[1:3], res.StabilityFeeAdjustments)
+	suite.NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryServer.StabilityFeeAdjustments(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeAdjustmentsRequest{
+		CollateralType: "xrp-a",
+		Pagination:     &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(adjustments[3:], res.StabilityFeeAdjustments)
+
+	// the latest adjustment is the one applied
+	res, err = suite.queryServer.StabilityFeeAdjustments(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeAdjustmentsRequest{
+		CollateralType: "xrp-a",
+		Pagination:     &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(adjustments[3:], res.StabilityFeeAdjustments)
+	latest, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(adjustments[3], latest)
+
+	_, err = suite.queryServer.StabilityFeeAdjustments(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeAdjustmentsRequest{
+		CollateralType: "fury-a",
+	})
+	suite.Require().Error(err)
+	suite.Require().Equal("rpc error: code = InvalidArgument desc = invalid collateral type", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateCdp() {
+	suite.addCdp()
+
+	tests := []struct {
+		giveName        string
+		giveRequest     *types.QuerySimulateCdpRequest
+		wantSimulation  types.CDPSimulationResponse
+		wantValidateErr string
+		wantErr         string
+	}{
+		{
+			"existing cdp without action",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          suite.addrs[0].String(),
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 100000000),
+				Principal:              c("usdx", 10000000),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.250000000000000000",
+				CollateralizationRatio: "2.500000000000000000",
+				LiquidationPrice:       "0.200000000000000000",
+				MaxDraw:                c("usdx", 2500000),
+				MaxWithdraw:            c("xrp", 20000000),
+			},
+			"",
+			"",
+		},
+		{
+			"draw up to the liquidation ratio",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          suite.addrs[0].String(),
+				Draw:           "2500000",
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 100000000),
+				Principal:              c("usdx", 12500000),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.250000000000000000",
+				CollateralizationRatio: "2.000000000000000000",
+				LiquidationPrice:       "0.250000000000000000",
+				MaxDraw:                c("usdx", 0),
+				MaxWithdraw:            c("xrp", 0),
+			},
+			"",
+			"",
+		},
+		{
+			"draw below the liquidation ratio",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          suite.addrs[0].String(),
+				Draw:           "2500001",
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 100000000),
+				Principal:              c("usdx", 12500001),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.250000000000000000",
+				CollateralizationRatio: "1.999999840000012800",
+				LiquidationPrice:       "0.250000020000000000",
+				MaxDraw:                c("usdx", 0),
+				MaxWithdraw:            c("xrp", 0),
+			},
+			"proposed collateral ratio is below liquidation ratio",
+			"",
+		},
+		{
+			"deposit and draw with price drop",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          suite.addrs[0].String(),
+				Deposit:        "20000000",
+				Draw:           "1000000",
+				PriceChange:    "-0.2",
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 120000000),
+				Principal:              c("usdx", 11000000),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.200000000000000000",
+				CollateralizationRatio: "2.181818181818181818",
+				LiquidationPrice:       "0.183333333333333333",
+				MaxDraw:                c("usdx", 1000000),
+				MaxWithdraw:            c("xrp", 10000000),
+			},
+			"",
+			"",
+		},
+		{
+			"new cdp",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Deposit:        "100000000",
+				Draw:           "10000000",
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 100000000),
+				Principal:              c("usdx", 10000000),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.250000000000000000",
+				CollateralizationRatio: "2.500000000000000000",
+				LiquidationPrice:       "0.200000000000000000",
+				MaxDraw:                c("usdx", 2500000),
+				MaxWithdraw:            c("xrp", 20000000),
+			},
+			"",
+			"",
+		},
+		{
+			"new cdp below debt floor",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          suite.addrs[1].String(),
+				Deposit:        "100000000",
+				Draw:           "1000000",
+			},
+			types.CDPSimulationResponse{
+				Type:                   "xrp-a",
+				Collateral:             c("xrp", 100000000),
+				Principal:              c("usdx", 1000000),
+				AccumulatedFees:        c("usdx", 0),
+				CollateralPrice:        "0.250000000000000000",
+				CollateralizationRatio: "25.000000000000000000",
+				LiquidationPrice:       "0.020000000000000000",
+				MaxDraw:                c("usdx", 11500000),
+				MaxWithdraw:            c("xrp", 92000000),
+			},
+			"proposed cdp debt is below minimum",
+			"",
+		},
+		{
+			"new cdp that cannot reach the debt floor",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Deposit:        "40000000",
+			},
+			types.CDPSimulationResponse{
+				Type:             "xrp-a",
+				Collateral:       c("xrp", 40000000),
+				Principal:        c("usdx", 0),
+				AccumulatedFees:  c("usdx", 0),
+				CollateralPrice:  "0.250000000000000000",
+				LiquidationPrice: "0.000000000000000000",
+				MaxDraw:          c("usdx", 0),
+				MaxWithdraw:      c("xrp", 40000000),
+			},
+			"proposed cdp debt is below minimum",
+			"",
+		},
+		{
+			"invalid collateral type",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "fury-a",
+			},
+			types.CDPSimulationResponse{},
+			"",
+			"fury-a: invalid collateral for input collateral type",
+		},
+		{
+			"invalid owner",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Owner:          "invalid addr",
+			},
+			types.CDPSimulationResponse{},
+			"",
+			"rpc error: code = InvalidArgument desc = invalid address",
+		},
+		{
+			"negative draw",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				Draw:           "-1",
+			},
+			types.CDPSimulationResponse{},
+			"",
+			"rpc error: code = InvalidArgument desc = invalid draw: -1 is negative",
+		},
+		{
+			"price change of -100%",
+			&types.QuerySimulateCdpRequest{
+				CollateralType: "xrp-a",
+				PriceChange:    "-1",
+			},
+			types.CDPSimulationResponse{},
+			"",
+			"rpc error: code = InvalidArgument desc = price change must be greater than -1, got -1.000000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.SimulateCdp(sdk.WrapSDKContext(suite.ctx), tt.giveRequest)
+
+			if tt.wantErr != "" {
+				suite.Require().Error(err)
+				suite.Require().Equal(tt.wantErr, err.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			if tt.wantValidateErr != "" {
+				suite.Require().Contains(res.Simulation.ValidationError, tt.wantValidateErr)
+			} else {
+				suite.Require().Empty(res.Simulation.ValidationError)
+			}
+			res.Simulation.ValidationError = ""
+			suite.Require().Equal(tt.wantSimulation, res.Simulation)
+		})
+	}
+
+	// simulations do not modify state
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateCdp_ZeroDebt() {
+	suite.addCdp()
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParam.DebtFloor = sdk.ZeroInt()
+	suite.keeper.SetParams(suite.ctx, params)
+
+	res, err := suite.queryServer.SimulateCdp(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulateCdpRequest{
+		CollateralType: "xrp-a",
+		Deposit:        "0",
+		Draw:           "0",
+	})
+	suite.Require().NoError(err)
+	suite.Contains(res.Simulation.ValidationError, "principal amount 0usdx")
+	suite.Equal(c("usdx", 0), res.Simulation.Principal)
+	suite.Equal(c("xrp", 0), res.Simulation.Collateral)
+	suite.Empty(res.Simulation.CollateralizationRatio)
+	suite.Empty(res.Simulation.LiquidationPrice)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
@@ -47,6 +47,8 @@ func NewCDPGenState(cdc codec.JSONCodec, asset string, liquidationRatio sdk.Dec)
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            asset,
@@ -160,6 +162,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
@@ -253,6 +257,8 @@ func NewCDPGenStateHighDebtLimit(cdc codec.JSONCodec) app.GenesisState {
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintCap:            types.DefaultFlashMintCap,
+			FlashMintFee:            types.DefaultFlashMintFee,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
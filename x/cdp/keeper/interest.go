@@ -71,6 +71,7 @@ func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
 		if err != nil {
 			return err
 		}
+		k.SetAccruedStabilityFees(ctx, k.GetAccruedStabilityFees(ctx).Add(newFeesSurplus))
 	}
 
 	interestFactorNew := interestFactorPrior.Mul(interestFactor)
@@ -247,6 +247,7 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 			suite.keeper.SetTotalPrincipal(suite.ctx, tc.args.ctype, types.DefaultStableDenom, tc.args.totalPrincipal)
 			suite.keeper.SetPreviousAccrualTime(suite.ctx, tc.args.ctype, suite.ctx.BlockTime())
 			suite.keeper.SetInterestFactor(suite.ctx, tc.args.ctype, sdk.OneDec())
+			suite.keeper.SetAccruedStabilityFees(suite.ctx, sdk.ZeroInt())
 
 			updatedBlockTime := suite.ctx.BlockTime().Add(time.Duration(int(time.Second) * tc.args.timeElapsed))
 			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
@@ -257,6 +258,9 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 			suite.Require().Equal(tc.args.expectedTotalPrincipal, actualTotalPrincipal)
 			actualAccrualTime, _ := suite.keeper.GetPreviousAccrualTime(suite.ctx, tc.args.ctype)
 			suite.Require().Equal(tc.args.expectedLastAccrualTime, actualAccrualTime)
+			// the interest is tracked as stability fees available for the savings rate
+			expectedFees := tc.args.expectedTotalPrincipal.Sub(tc.args.totalPrincipal)
+			suite.Require().True(expectedFees.Equal(suite.keeper.GetAccruedStabilityFees(suite.ctx)))
 		})
 	}
 }
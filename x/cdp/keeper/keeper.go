@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -24,12 +25,15 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	accountKeeper   types.AccountKeeper
 	hooks           types.CDPHooks
+	savingsKeeper   types.SavingsKeeper
 	maccPerms       map[string][]string
+	router          *baseapp.MsgServiceRouter
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, pfk types.PricefeedKeeper,
 	ak types.AuctionKeeper, bk types.BankKeeper, ack types.AccountKeeper, maccs map[string][]string,
+	router *baseapp.MsgServiceRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -45,6 +49,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		accountKeeper:   ack,
 		hooks:           nil,
 		maccPerms:       maccs,
+		router:          router,
 	}
 }
 
@@ -57,6 +62,15 @@ func (k *Keeper) SetHooks(hooks types.CDPHooks) *Keeper {
 	return k
 }
 
+// SetSavingsKeeper sets the savings keeper that is paid the savings rate.
+func (k *Keeper) SetSavingsKeeper(savingsKeeper types.SavingsKeeper) *Keeper {
+	if k.savingsKeeper != nil {
+		panic("cannot set savings keeper twice")
+	}
+	k.savingsKeeper = savingsKeeper
+	return k
+}
+
 // CdpDenomIndexIterator returns an sdk.Iterator for all cdps with matching collateral denom
 func (k Keeper) CdpDenomIndexIterator(ctx sdk.Context, collateralType string) sdk.Iterator {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -221,3 +235,32 @@ func (k Keeper) SetTotalPrincipal(ctx sdk.Context, collateralType, principalDeno
 	}
 	store.Set([]byte(collateralType+principalDenom), bz)
 }
+
+// GetAccruedStabilityFees returns the stability fees that have been minted to
+// the liquidator module account and not yet paid out as the savings rate
+func (k Keeper) GetAccruedStabilityFees(ctx sdk.Context) sdkmath.Int {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.AccruedStabilityFeesKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var fees sdkmath.Int
+	if err := fees.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return fees
+}
+
+// SetAccruedStabilityFees sets the stability fees that have not yet been paid out as the savings rate
+func (k Keeper) SetAccruedStabilityFees(ctx sdk.Context, fees sdkmath.Int) {
+	store := ctx.KVStore(k.key)
+	if !fees.IsPositive() {
+		store.Delete(types.AccruedStabilityFeesKey)
+		return
+	}
+	bz, err := fees.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.AccruedStabilityFeesKey, bz)
+}
@@ -13,6 +13,10 @@ import (
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var p types.Params
 	k.paramSubspace.GetParamSet(ctx, &p)
+	// the params store decodes an empty list of controllers as nil
+	if p.StabilityFeeControllers == nil {
+		p.StabilityFeeControllers = types.DefaultStabilityFeeControllers
+	}
 	return p
 }
 
@@ -93,9 +97,20 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, collateralType string) sdkmath.I
 
 // GetFeeRate returns the per second fee rate for the input denom
 func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec) {
-	collalateralParam, found := k.GetCollateral(ctx, collateralType)
+	params := k.GetParams(ctx)
+	collalateralParam, found := params.CollateralParams.Get(collateralType)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+
+	// collateral types managed by a stability fee controller use the most recently adjusted fee, the collateral param
+	// stability fee is only the base rate used until the first adjustment
+	controller, controlled := params.StabilityFeeControllers.Get(collateralType)
+	if !controlled {
+		return collalateralParam.StabilityFee
+	}
+	if adjustment, found := k.GetStabilityFeeAdjustment(ctx, collateralType); found {
+		return controller.ClampFee(adjustment.StabilityFee)
+	}
+	return controller.ClampFee(collalateralParam.StabilityFee)
 }
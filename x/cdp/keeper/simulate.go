@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mage-coven/fury/x/cdp/types"
+)
+
+// SimulateCDP returns the state of the owner's cdp after depositing and drawing the input amounts with the collateral
+// price changed by the input relative amount. If the owner has no cdp for the collateral type, opening a new cdp is simulated.
+// Interest is accumulated up to the current block time before the action is applied, and the action is checked with the
+// same validation used by deposits, draws and cdp creation. A failed check is reported in the response rather than as an error.
+// Account balances are not checked.
+func (k Keeper) SimulateCDP(ctx sdk.Context, owner sdk.AccAddress, collateralType string, deposit, draw sdkmath.Int, priceChange sdk.Dec) (types.CDPSimulationResponse, error) {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return types.CDPSimulationResponse{}, errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+	if err != nil {
+		return types.CDPSimulationResponse{}, err
+	}
+	collateralPrice := price.Price.Mul(sdk.OneDec().Add(priceChange))
+
+	// simulate on a cache so interest accumulation is never written to state
+	ctx, _ = ctx.CacheContext()
+	if err := k.AccumulateInterest(ctx, collateralType); err != nil {
+		return types.CDPSimulationResponse{}, err
+	}
+
+	debtParam := k.GetParams(ctx).DebtParam
+	depositCoin := sdk.NewCoin(cp.Denom, deposit)
+	drawCoin := sdk.NewCoin(debtParam.Denom, draw)
+
+	var validationErr error
+	var cdp types.CDP
+	var hasCdp bool
+	if !owner.Empty() {
+		cdp, hasCdp = k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	}
+	if hasCdp {
+		cdp = k.SynchronizeInterest(ctx, cdp)
+		validationErr = k.validateSimulatedDraw(ctx, cdp, depositCoin, drawCoin, collateralPrice)
+	} else {
+		cdp = types.NewCDP(0, owner, sdk.NewCoin(cp.Denom, sdk.ZeroInt()), collateralType, sdk.NewCoin(debtParam.Denom, sdk.ZeroInt()), ctx.BlockTime(), sdk.OneDec())
+		validationErr = k.validateSimulatedCdp(ctx, depositCoin, drawCoin, collateralType, collateralPrice)
+	}
+	cdp.Collateral = cdp.Collateral.Add(depositCoin)
+	cdp.Principal = cdp.Principal.Add(drawCoin)
+
+	simulation := types.CDPSimulationResponse{
+		Type:            collateralType,
+		Collateral:      cdp.Collateral,
+		Principal:       cdp.Principal,
+		AccumulatedFees: cdp.AccumulatedFees,
+		CollateralPrice: collateralPrice.String(),
+		MaxDraw:         k.calculateMaxDraw(ctx, cdp, drawCoin, collateralPrice),
+		MaxWithdraw:     k.calculateMaxWithdraw(ctx, cdp, collateralPrice),
+	}
+
+	debtBaseUnits := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, collateralType)
+	if debtBaseUnits.IsPositive() {
+		simulation.CollateralizationRatio = k.calculateCollateralizationRatioAtPrice(ctx, cdp.Collateral, collateralType, cdp.Principal, cdp.AccumulatedFees, collateralPrice).String()
+	}
+	if collateralBaseUnits.IsPositive() {
+		simulation.LiquidationPrice = cp.LiquidationRatio.Mul(debtBaseUnits).Quo(collateralBaseUnits).String()
+	}
+	if validationErr != nil {
+		simulation.ValidationError = validationErr.Error()
+	}
+	return simulation, nil
+}
+
+// validateSimulatedDraw applies the validation of DepositCollateral and AddPrincipal to an existing cdp
+func (k Keeper) validateSimulatedDraw(ctx sdk.Context, cdp types.CDP, deposit, draw sdk.Coin, collateralPrice sdk.Dec) error {
+	if deposit.IsPositive() {
+		if err := k.ValidateCollateral(ctx, deposit, cdp.Type); err != nil {
+			return err
+		}
+	}
+	if !draw.IsPositive() {
+		return nil
+	}
+	if err := k.ValidatePrincipalDraw(ctx, draw, cdp.Principal.Denom); err != nil {
+		return err
+	}
+	if err := k.ValidateDebtLimit(ctx, cdp.Type, draw); err != nil {
+		return err
+	}
+	collateral := cdp.Collateral.Add(deposit)
+	ratio := k.calculateCollateralizationRatioAtPrice(ctx, collateral, cdp.Type, cdp.Principal.Add(draw), cdp.AccumulatedFees, collateralPrice)
+	return k.validateCollateralizationRatio(ctx, collateral.Denom, cdp.Type, ratio)
+}
+
+// validateSimulatedCdp applies the validation of AddCdp to a new cdp
+func (k Keeper) validateSimulatedCdp(ctx sdk.Context, collateral, principal sdk.Coin, collateralType string, collateralPrice sdk.Dec) error {
+	if err := k.ValidateCollateral(ctx, collateral, collateralType); err != nil {
+		return err
+	}
+	if err := k.ValidatePrincipalAdd(ctx, principal); err != nil {
+		return err
+	}
+	if err := k.ValidateDebtLimit(ctx, collateralType, principal); err != nil {
+		return err
+	}
+	// a cdp without debt has no collateralization ratio, it is rejected like an empty principal in MsgCreateCDP
+	if !principal.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", principal)
+	}
+	ratio := k.calculateCollateralizationRatioAtPrice(ctx, collateral, collateralType, principal, sdk.NewCoin(principal.Denom, sdk.ZeroInt()), collateralPrice)
+	return k.validateCollateralizationRatio(ctx, collateral.Denom, collateralType, ratio)
+}
+
+// calculateMaxDraw returns the largest amount of principal that can be added to the cdp without falling below the
+// liquidation ratio, exceeding the debt limits or leaving the principal below the debt floor
+func (k Keeper) calculateMaxDraw(ctx sdk.Context, cdp types.CDP, draw sdk.Coin, collateralPrice sdk.Dec) sdk.Coin {
+	liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
+	collateralValue := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type).Mul(collateralPrice)
+	drawableBaseUnits := collateralValue.Quo(liquidationRatio).Sub(k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal()))
+	maxDraw := k.convertBaseUnitsToDebt(ctx, drawableBaseUnits, cdp.Principal.Denom).TruncateInt()
+
+	// the simulated draw is not yet included in the total principal
+	cp, _ := k.GetCollateral(ctx, cdp.Type)
+	debtLimit := sdk.MinInt(cp.DebtLimit.Amount, k.GetParams(ctx).GlobalDebtLimit.Amount)
+	totalPrincipal := k.GetTotalPrincipal(ctx, cdp.Type, cdp.Principal.Denom).Add(draw.Amount)
+	maxDraw = sdk.MinInt(maxDraw, debtLimit.Sub(totalPrincipal))
+
+	// a draw that leaves the cdp's principal below the debt floor is rejected
+	dp, _ := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if cdp.Principal.Amount.Add(maxDraw).LT(dp.DebtFloor) {
+		return sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(cdp.Principal.Denom, sdk.MaxInt(maxDraw, sdk.ZeroInt()))
+}
+
+// calculateMaxWithdraw returns the largest amount of collateral that can be removed from the cdp without falling below
+// the liquidation ratio
+func (k Keeper) calculateMaxWithdraw(ctx sdk.Context, cdp types.CDP, collateralPrice sdk.Dec) sdk.Coin {
+	debtBaseUnits := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	if !debtBaseUnits.IsPositive() {
+		return cdp.Collateral
+	}
+	if !collateralPrice.IsPositive() {
+		return sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt())
+	}
+	liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
+	requiredBaseUnits := debtBaseUnits.Mul(liquidationRatio).Quo(collateralPrice)
+	required := k.convertBaseUnitsToCollateral(ctx, requiredBaseUnits, cdp.Type).Ceil().TruncateInt()
+	maxWithdraw := cdp.Collateral.Amount.Sub(required)
+
+	return sdk.NewCoin(cdp.Collateral.Denom, sdk.MaxInt(maxWithdraw, sdk.ZeroInt()))
+}
+
+// converts the input base units to collateral (ie divides the input by 10^(-ConversionFactor))
+func (k Keeper) convertBaseUnitsToCollateral(ctx sdk.Context, baseUnits sdk.Dec, collateralType string) sdk.Dec {
+	cp, _ := k.GetCollateral(ctx, collateralType)
+	return baseUnits.Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64()))
+}
+
+// converts the input base units to debt (ie divides the input by 10^(-ConversionFactor))
+func (k Keeper) convertBaseUnitsToDebt(ctx sdk.Context, baseUnits sdk.Dec, denom string) sdk.Dec {
+	dp, _ := k.GetDebtParam(ctx, denom)
+	return baseUnits.Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()))
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/cdp/types"
+	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
+)
+
+// UpdateStabilityFees nudges the stability fee of each collateral type managed by a stability fee controller.
+// When the debt asset trades below its target price fees are raised to discourage minting, when it trades above
+// fees are lowered. Adjustments happen at most once per adjustment interval and never leave the governance set bounds.
+// Fees are left unchanged while the debt asset has no valid price.
+func (k Keeper) UpdateStabilityFees(ctx sdk.Context) error {
+	for _, controller := range k.GetParams(ctx).StabilityFeeControllers {
+		err := k.updateStabilityFee(ctx, controller)
+		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) updateStabilityFee(ctx sdk.Context, controller types.StabilityFeeController) error {
+	previousUpdateTime, found := k.GetPreviousStabilityFeeUpdateTime(ctx, controller.CollateralType)
+	if !found {
+		k.SetPreviousStabilityFeeUpdateTime(ctx, controller.CollateralType, ctx.BlockTime())
+		return nil
+	}
+	if ctx.BlockTime().Before(previousUpdateTime.Add(controller.AdjustmentInterval)) {
+		return nil
+	}
+
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, controller.MarketID)
+	if err != nil {
+		return err
+	}
+	k.SetPreviousStabilityFeeUpdateTime(ctx, controller.CollateralType, ctx.BlockTime())
+
+	deviation := price.Price.Sub(controller.TargetPrice)
+	if deviation.Abs().LTE(controller.Tolerance) {
+		return nil
+	}
+
+	// accrue interest at the current fee before it changes
+	if err := k.AccumulateInterest(ctx, controller.CollateralType); err != nil {
+		return err
+	}
+
+	previousFee := k.getFeeRate(ctx, controller.CollateralType)
+	fee := previousFee.Add(controller.AdjustmentStep)
+	if deviation.IsPositive() {
+		fee = previousFee.Sub(controller.AdjustmentStep)
+	}
+	fee = controller.ClampFee(fee)
+	if fee.Equal(previousFee) {
+		return nil
+	}
+
+	k.SetStabilityFeeAdjustment(ctx, types.NewStabilityFeeAdjustment(controller.CollateralType, fee, previousFee, price.Price, ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStabilityFeeAdjustment,
+			sdk.NewAttribute(types.AttributeKeyCollateralType, controller.CollateralType),
+			sdk.NewAttribute(types.AttributeKeyPreviousStabilityFee, previousFee.String()),
+			sdk.NewAttribute(types.AttributeKeyStabilityFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyReferencePrice, price.Price.String()),
+		),
+	)
+	return nil
+}
+
+// GetStabilityFeeAdjustment returns the most recent stability fee adjustment for a collateral type
+func (k Keeper) GetStabilityFeeAdjustment(ctx sdk.Context, collateralType string) (types.StabilityFeeAdjustment, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StabilityFeeAdjustmentPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.DenomIterKey(collateralType))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.StabilityFeeAdjustment{}, false
+	}
+	var adjustment types.StabilityFeeAdjustment
+	k.cdc.MustUnmarshal(iterator.Value(), &adjustment)
+	return adjustment, true
+}
+
+// SetStabilityFeeAdjustment stores a stability fee adjustment, previous adjustments of the collateral type are kept
+func (k Keeper) SetStabilityFeeAdjustment(ctx sdk.Context, adjustment types.StabilityFeeAdjustment) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StabilityFeeAdjustmentPrefix)
+	bz := k.cdc.MustMarshal(&adjustment)
+	store.Set(types.StabilityFeeAdjustmentKey(adjustment.CollateralType, adjustment.AdjustmentTime), bz)
+}
+
+// IterateStabilityFeeAdjustments iterates over all stability fee adjustments, ordered by collateral type and time, and performs a callback function
+func (k Keeper) IterateStabilityFeeAdjustments(ctx sdk.Context, cb func(adjustment types.StabilityFeeAdjustment) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StabilityFeeAdjustmentPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var adjustment types.StabilityFeeAdjustment
+		k.cdc.MustUnmarshal(iterator.Value(), &adjustment)
+		if cb(adjustment) {
+			break
+		}
+	}
+}
+
+// GetAllStabilityFeeAdjustments returns all stability fee adjustments from the store
+func (k Keeper) GetAllStabilityFeeAdjustments(ctx sdk.Context) types.StabilityFeeAdjustments {
+	adjustments := types.StabilityFeeAdjustments{}
+	k.IterateStabilityFeeAdjustments(ctx, func(adjustment types.StabilityFeeAdjustment) (stop bool) {
+		adjustments = append(adjustments, adjustment)
+		return false
+	})
+	return adjustments
+}
+
+// GetPreviousStabilityFeeUpdateTime returns the last time the stability fee controller ran for a collateral type
+func (k Keeper) GetPreviousStabilityFeeUpdateTime(ctx sdk.Context, ctype string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousStabilityFeeUpdateTimePrefix)
+	bz := store.Get([]byte(ctype))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var previousUpdateTime time.Time
+	if err := previousUpdateTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return previousUpdateTime, true
+}
+
+// SetPreviousStabilityFeeUpdateTime sets the last time the stability fee controller ran for a collateral type
+func (k Keeper) SetPreviousStabilityFeeUpdateTime(ctx sdk.Context, ctype string, previousUpdateTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousStabilityFeeUpdateTimePrefix)
+	bz, err := previousUpdateTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(ctype), bz)
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mage-coven/fury/app"
+	"github.com/mage-coven/fury/x/cdp/keeper"
+	"github.com/mage-coven/fury/x/cdp/types"
+	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
+)
+
+const usdxMarketID = "usdx:usd"
+
+type StabilityFeeTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+}
+
+func (suite *StabilityFeeTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+
+	pfKeeper := tApp.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeedtypes.Market{
+		MarketID: usdxMarketID, BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+	})
+	pfKeeper.SetParams(ctx, pfParams)
+
+	cdpKeeper := tApp.GetCDPKeeper()
+	params := cdpKeeper.GetParams(ctx)
+	params.StabilityFeeControllers = types.StabilityFeeControllers{
+		types.NewStabilityFeeController(
+			"xrp-a",
+			usdxMarketID,
+			sdk.OneDec(),
+			d("0.01"),
+			d("0.000000000100000000"),
+			time.Hour,
+			d("1.000000001447125958"),
+			d("1.000000001747125958"),
+		),
+	}
+	cdpKeeper.SetParams(ctx, params)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = cdpKeeper
+}
+
+func (suite *StabilityFeeTestSuite) setUsdxPrice(price sdk.Dec) {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, usdxMarketID, price, suite.ctx.BlockTime().Add(24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, usdxMarketID))
+}
+
+func (suite *StabilityFeeTestSuite) advanceTime(duration time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(duration))
+}
+
+func (suite *StabilityFeeTestSuite) TestUpdateStabilityFees_BelowPeg() {
+	suite.setUsdxPrice(d("0.95"))
+
+	// the first run only records the update time
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	_, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().False(found)
+
+	// no adjustment before the interval has passed
+	suite.advanceTime(30 * time.Minute)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	_, found = suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().False(found)
+
+	suite.advanceTime(30 * time.Minute)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	adjustment, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(d("1.000000001647125958"), adjustment.StabilityFee)
+	suite.Equal(d("1.000000001547125958"), adjustment.PreviousStabilityFee)
+	suite.Equal(d("0.95"), adjustment.ReferencePrice)
+	suite.Equal(suite.ctx.BlockTime(), adjustment.AdjustmentTime)
+
+	suite.Require().True(suite.hasAdjustmentEvent("xrp-a"))
+
+	// uncontrolled collateral types are unaffected
+	_, found = suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "btc-a")
+	suite.Require().False(found)
+
+	// fees are capped at the max bound
+	for i := 0; i < 3; i++ {
+		suite.advanceTime(time.Hour)
+		suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	}
+	adjustment, _ = suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Equal(d("1.000000001747125958"), adjustment.StabilityFee)
+
+	// earlier adjustments are kept in order
+	adjustments := suite.keeper.GetAllStabilityFeeAdjustments(suite.ctx)
+	suite.Require().Len(adjustments, 2)
+	suite.Equal(d("1.000000001647125958"), adjustments[0].StabilityFee)
+	suite.Equal(d("1.000000001747125958"), adjustments[1].StabilityFee)
+	suite.Equal(d("1.000000001647125958"), adjustments[1].PreviousStabilityFee)
+}
+
+func (suite *StabilityFeeTestSuite) TestUpdateStabilityFees_AbovePeg() {
+	suite.setUsdxPrice(d("1.05"))
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+
+	suite.advanceTime(time.Hour)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	adjustment, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(d("1.000000001447125958"), adjustment.StabilityFee)
+
+	// fees are floored at the min bound
+	suite.advanceTime(time.Hour)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	adjustment, _ = suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Equal(d("1.000000001447125958"), adjustment.StabilityFee)
+}
+
+func (suite *StabilityFeeTestSuite) TestUpdateStabilityFees_WithinTolerance() {
+	suite.setUsdxPrice(d("0.995"))
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+
+	suite.advanceTime(time.Hour)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	_, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().False(found)
+
+	updateTime, found := suite.keeper.GetPreviousStabilityFeeUpdateTime(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(suite.ctx.BlockTime(), updateTime)
+}
+
+func (suite *StabilityFeeTestSuite) TestUpdateStabilityFees_NoPrice() {
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	startTime := suite.ctx.BlockTime()
+
+	suite.advanceTime(time.Hour)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	_, found := suite.keeper.GetStabilityFeeAdjustment(suite.ctx, "xrp-a")
+	suite.Require().False(found)
+
+	// the controller runs again as soon as a price is available
+	updateTime, _ := suite.keeper.GetPreviousStabilityFeeUpdateTime(suite.ctx, "xrp-a")
+	suite.Equal(startTime, updateTime)
+}
+
+func (suite *StabilityFeeTestSuite) TestUpdateStabilityFees_Disabled() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.StabilityFeeControllers = nil
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setUsdxPrice(d("0.5"))
+
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+	suite.advanceTime(time.Hour)
+	suite.Require().NoError(suite.keeper.UpdateStabilityFees(suite.ctx))
+
+	_, found := suite.keeper.GetPreviousStabilityFeeUpdateTime(suite.ctx, "xrp-a")
+	suite.Require().False(found)
+	suite.Empty(suite.keeper.GetAllStabilityFeeAdjustments(suite.ctx))
+}
+
+func (suite *StabilityFeeTestSuite) hasAdjustmentEvent(collateralType string) bool {
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeStabilityFeeAdjustment {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyCollateralType && string(attr.Value) == collateralType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestStabilityFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(StabilityFeeTestSuite))
+}
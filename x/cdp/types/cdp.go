@@ -203,3 +203,50 @@ func NewTotalCollateral(collateralType string, amount sdk.Coin) TotalCollateral
 		Amount:         amount,
 	}
 }
+
+// NewStabilityFeeAdjustment returns a new StabilityFeeAdjustment
+func NewStabilityFeeAdjustment(collateralType string, fee, previousFee, referencePrice sdk.Dec, adjustmentTime time.Time) StabilityFeeAdjustment {
+	return StabilityFeeAdjustment{
+		CollateralType:       collateralType,
+		StabilityFee:         fee,
+		PreviousStabilityFee: previousFee,
+		ReferencePrice:       referencePrice,
+		AdjustmentTime:       adjustmentTime,
+	}
+}
+
+// Validate performs a basic validation of the stability fee adjustment fields.
+func (sfa StabilityFeeAdjustment) Validate() error {
+	if strings.TrimSpace(sfa.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if sfa.StabilityFee.IsNil() || sfa.StabilityFee.LT(sdk.OneDec()) || sfa.StabilityFee.GT(stabilityFeeMax) {
+		return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, sfa.StabilityFee, sfa.CollateralType)
+	}
+	if sfa.PreviousStabilityFee.IsNil() || sfa.PreviousStabilityFee.LT(sdk.OneDec()) {
+		return fmt.Errorf("previous stability fee must be ≥ 1.0, is %s for %s", sfa.PreviousStabilityFee, sfa.CollateralType)
+	}
+	if sfa.ReferencePrice.IsNil() || sfa.ReferencePrice.IsNegative() {
+		return fmt.Errorf("reference price cannot be negative for %s", sfa.CollateralType)
+	}
+	return nil
+}
+
+// StabilityFeeAdjustments a collection of StabilityFeeAdjustment objects
+type StabilityFeeAdjustments []StabilityFeeAdjustment
+
+// Validate validates each adjustment and checks for duplicate adjustments of a collateral type at the same time
+func (sfas StabilityFeeAdjustments) Validate() error {
+	dupMap := make(map[string]bool)
+	for _, sfa := range sfas {
+		if err := sfa.Validate(); err != nil {
+			return err
+		}
+		key := string(StabilityFeeAdjustmentKey(sfa.CollateralType, sfa.AdjustmentTime))
+		if dupMap[key] {
+			return fmt.Errorf("duplicate stability fee adjustment for collateral type %s at %s", sfa.CollateralType, sfa.AdjustmentTime)
+		}
+		dupMap[key] = true
+	}
+	return nil
+}
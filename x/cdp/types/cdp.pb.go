@@ -46,7 +46,7 @@ func (m *CDP) Reset()         { *m = CDP{} }
 func (m *CDP) String() string { return proto.CompactTextString(m) }
 func (*CDP) ProtoMessage()    {}
 func (*CDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{0}
+	return fileDescriptor_ace3339a6b997db3, []int{0}
 }
 func (m *CDP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -86,7 +86,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{1}
+	return fileDescriptor_ace3339a6b997db3, []int{1}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +125,7 @@ func (m *TotalPrincipal) Reset()         { *m = TotalPrincipal{} }
 func (m *TotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*TotalPrincipal) ProtoMessage()    {}
 func (*TotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{2}
+	return fileDescriptor_ace3339a6b997db3, []int{2}
 }
 func (m *TotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +164,7 @@ func (m *TotalCollateral) Reset()         { *m = TotalCollateral{} }
 func (m *TotalCollateral) String() string { return proto.CompactTextString(m) }
 func (*TotalCollateral) ProtoMessage()    {}
 func (*TotalCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{3}
+	return fileDescriptor_ace3339a6b997db3, []int{3}
 }
 func (m *TotalCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +202,7 @@ func (m *OwnerCDPIndex) Reset()         { *m = OwnerCDPIndex{} }
 func (m *OwnerCDPIndex) String() string { return proto.CompactTextString(m) }
 func (*OwnerCDPIndex) ProtoMessage()    {}
 func (*OwnerCDPIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{4}
+	return fileDescriptor_ace3339a6b997db3, []int{4}
 }
 func (m *OwnerCDPIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,57 +231,107 @@ func (m *OwnerCDPIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OwnerCDPIndex proto.InternalMessageInfo
 
+// StabilityFeeAdjustment records a stability fee set by the stability fee controller for a collateral type
+type StabilityFeeAdjustment struct {
+	CollateralType       string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	StabilityFee         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=stability_fee,json=stabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stability_fee"`
+	PreviousStabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=previous_stability_fee,json=previousStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_stability_fee"`
+	// reference_price is the debt asset market price that triggered the adjustment
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price"`
+	AdjustmentTime time.Time                              `protobuf:"bytes,5,opt,name=adjustment_time,json=adjustmentTime,proto3,stdtime" json:"adjustment_time"`
+}
+
+func (m *StabilityFeeAdjustment) Reset()         { *m = StabilityFeeAdjustment{} }
+func (m *StabilityFeeAdjustment) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeAdjustment) ProtoMessage()    {}
+func (*StabilityFeeAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ace3339a6b997db3, []int{5}
+}
+func (m *StabilityFeeAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeAdjustment.Merge(m, src)
+}
+func (m *StabilityFeeAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeAdjustment proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CDP)(nil), "fury.cdp.v1beta1.CDP")
 	proto.RegisterType((*Deposit)(nil), "fury.cdp.v1beta1.Deposit")
 	proto.RegisterType((*TotalPrincipal)(nil), "fury.cdp.v1beta1.TotalPrincipal")
 	proto.RegisterType((*TotalCollateral)(nil), "fury.cdp.v1beta1.TotalCollateral")
 	proto.RegisterType((*OwnerCDPIndex)(nil), "fury.cdp.v1beta1.OwnerCDPIndex")
+	proto.RegisterType((*StabilityFeeAdjustment)(nil), "fury.cdp.v1beta1.StabilityFeeAdjustment")
 }
 
-func init() { proto.RegisterFile("fury/cdp/v1beta1/cdp.proto", fileDescriptor_68a9ab097fb7be40) }
+func init() { proto.RegisterFile("fury/cdp/v1beta1/cdp.proto", fileDescriptor_ace3339a6b997db3) }
 
-var fileDescriptor_68a9ab097fb7be40 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0xbb, 0x36, 0x5b, 0xbd, 0xb1, 0x4e, 0x06, 0xa1, 0xac, 0x87, 0xa4, 0x1a, 0x02, 0x7a,
-	0x69, 0xa2, 0x01, 0x12, 0x17, 0x10, 0x5a, 0x1a, 0x0d, 0xca, 0x85, 0x29, 0x1a, 0x17, 0x0e, 0x54,
-	0xae, 0xed, 0x96, 0x68, 0x69, 0x1c, 0xc5, 0xee, 0xd8, 0x1e, 0x02, 0x69, 0x0f, 0xb3, 0x87, 0xd8,
-	0x81, 0xc3, 0xb4, 0x13, 0xe2, 0x10, 0x20, 0x7b, 0x0b, 0x4e, 0xc8, 0x4e, 0xba, 0xec, 0x58, 0x24,
-	0x38, 0xf5, 0xf7, 0xc7, 0xdf, 0xf7, 0x73, 0x7f, 0xdf, 0x17, 0xc3, 0xce, 0x11, 0x3e, 0xc6, 0x2e,
-	0xa1, 0x89, 0x7b, 0xbc, 0x3b, 0x66, 0x12, 0xef, 0xaa, 0xd8, 0x49, 0x52, 0x2e, 0x39, 0xda, 0x52,
-	0x3d, 0x47, 0xe5, 0x65, 0xaf, 0x63, 0x11, 0x2e, 0x66, 0x5c, 0xb8, 0x63, 0x2c, 0x58, 0x05, 0xe0,
-	0x61, 0x5c, 0x20, 0x3a, 0xdb, 0x45, 0x7f, 0xa4, 0x33, 0xb7, 0x48, 0xca, 0xd6, 0xbd, 0x29, 0x9f,
-	0xf2, 0xa2, 0xae, 0xa2, 0xb2, 0x6a, 0x4f, 0x39, 0x9f, 0x46, 0xcc, 0xd5, 0xd9, 0x78, 0x3e, 0x71,
-	0x65, 0x38, 0x63, 0x42, 0xe2, 0x59, 0x79, 0x87, 0x9d, 0x2f, 0x0d, 0xb8, 0x32, 0xf0, 0x0f, 0xd0,
-	0x7d, 0x58, 0x0f, 0xa9, 0x09, 0xba, 0xa0, 0xd7, 0xf0, 0x8c, 0x3c, 0xb3, 0xeb, 0x43, 0x3f, 0xa8,
-	0x87, 0x14, 0x7d, 0x84, 0x4d, 0xfe, 0x39, 0x66, 0xa9, 0x59, 0xef, 0x82, 0xde, 0x86, 0xf7, 0xe6,
-	0x77, 0x66, 0xf7, 0xa7, 0xa1, 0xfc, 0x34, 0x1f, 0x3b, 0x84, 0xcf, 0xca, 0x2b, 0x94, 0x3f, 0x7d,
-	0x41, 0x8f, 0x5c, 0x79, 0x9a, 0x30, 0xe1, 0xec, 0x11, 0xb2, 0x47, 0x69, 0xca, 0x84, 0xb8, 0x3a,
-	0xef, 0xdf, 0x2d, 0x2f, 0x5a, 0x56, 0xbc, 0x53, 0xc9, 0x44, 0x50, 0xd0, 0x22, 0x04, 0x1b, 0x0a,
-	0x61, 0xae, 0x74, 0x41, 0xaf, 0x15, 0xe8, 0x18, 0xbd, 0x82, 0x90, 0xf0, 0x28, 0xc2, 0x92, 0xa5,
-	0x38, 0x32, 0x1b, 0x5d, 0xd0, 0x5b, 0x7f, 0xb2, 0xed, 0x94, 0x24, 0x6a, 0x35, 0x8b, 0x7d, 0x39,
-	0x03, 0x1e, 0xc6, 0x5e, 0xe3, 0x22, 0xb3, 0x6b, 0xc1, 0x2d, 0x08, 0x7a, 0x09, 0x5b, 0x49, 0x1a,
-	0xc6, 0x24, 0x4c, 0x70, 0x64, 0x36, 0x97, 0xc3, 0x57, 0x08, 0xf4, 0x16, 0x6e, 0x61, 0x42, 0xe6,
-	0xb3, 0xb9, 0xe2, 0xa3, 0xa3, 0x09, 0x63, 0xc2, 0x34, 0x96, 0x63, 0x69, 0xdf, 0x02, 0xee, 0x33,
-	0x26, 0xd0, 0x6b, 0xb8, 0xa1, 0xf0, 0xa3, 0x79, 0x42, 0x55, 0xcd, 0x5c, 0xd5, 0x3c, 0x1d, 0xa7,
-	0xd0, 0xc5, 0x59, 0xe8, 0xe2, 0x1c, 0x2e, 0x74, 0xf1, 0xd6, 0x14, 0xd1, 0xd9, 0x0f, 0x1b, 0x04,
-	0xeb, 0x0a, 0xf9, 0xbe, 0x00, 0x22, 0x06, 0xdb, 0x61, 0x2c, 0x59, 0xca, 0x84, 0x1c, 0x4d, 0x30,
-	0x91, 0x3c, 0x35, 0xd7, 0xd4, 0xce, 0xbc, 0x17, 0xea, 0xfc, 0xf7, 0xcc, 0x7e, 0xb4, 0x84, 0x2c,
-	0x3e, 0x23, 0x57, 0xe7, 0x7d, 0x58, 0xfe, 0x09, 0x9f, 0x91, 0x60, 0x73, 0x41, 0xba, 0xaf, 0x39,
-	0x77, 0xbe, 0x02, 0xb8, 0xea, 0xb3, 0x84, 0x8b, 0x50, 0xa2, 0x2e, 0x34, 0x08, 0x4d, 0x46, 0x37,
-	0xbe, 0x68, 0xe5, 0x99, 0xdd, 0x1c, 0xd0, 0x64, 0xe8, 0x07, 0x4d, 0x42, 0x93, 0x21, 0x45, 0x13,
-	0xd8, 0xa2, 0xc5, 0x61, 0x5e, 0x38, 0xa4, 0xf5, 0x0f, 0x1d, 0x52, 0x51, 0xa3, 0xe7, 0xd0, 0xc0,
-	0x33, 0x3e, 0x8f, 0xa5, 0xf6, 0xc9, 0x12, 0x3a, 0x94, 0xc7, 0x77, 0x52, 0xb8, 0x79, 0xc8, 0x25,
-	0x8e, 0x0e, 0x6e, 0xc4, 0x7d, 0x0c, 0xdb, 0x95, 0x53, 0x46, 0xda, 0x7b, 0x40, 0x7b, 0x6f, 0xb3,
-	0x2a, 0x1f, 0x2a, 0x17, 0x56, 0x33, 0xeb, 0x7f, 0x37, 0x53, 0xc0, 0xb6, 0x9e, 0x39, 0xa8, 0x0c,
-	0xf9, 0xff, 0x87, 0x3e, 0x83, 0x77, 0xde, 0xa9, 0x0f, 0x6a, 0xe0, 0x1f, 0x0c, 0x63, 0xca, 0x4e,
-	0xd0, 0x03, 0xb8, 0x5a, 0x88, 0x27, 0x4c, 0xd0, 0x5d, 0xe9, 0x35, 0x3c, 0x98, 0x67, 0xb6, 0xa1,
-	0xd5, 0x13, 0x81, 0xa1, 0xe5, 0x13, 0xde, 0xe0, 0xe2, 0x97, 0x55, 0xbb, 0xc8, 0x2d, 0x70, 0x99,
-	0x5b, 0xe0, 0x67, 0x6e, 0x81, 0xb3, 0x6b, 0xab, 0x76, 0x79, 0x6d, 0xd5, 0xbe, 0x5d, 0x5b, 0xb5,
-	0x0f, 0x0f, 0x6f, 0xc9, 0xa8, 0x9e, 0xaa, 0x7e, 0x84, 0xc7, 0x42, 0x47, 0xee, 0x89, 0x7e, 0xd2,
-	0xb4, 0x92, 0x63, 0x43, 0x9b, 0xf8, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe3, 0x65,
-	0xc4, 0xeb, 0x04, 0x00, 0x00,
+var fileDescriptor_ace3339a6b997db3 = []byte{
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xf3, 0xd7, 0x66, 0xda, 0x26, 0xd5, 0xdc, 0xaa, 0x72, 0xb3, 0xb0, 0xa3, 0x5c, 0xe9,
+	0xde, 0x6c, 0x62, 0xab, 0xf7, 0x5e, 0xe9, 0x6e, 0x40, 0xa8, 0x8e, 0x55, 0x08, 0x12, 0x22, 0x32,
+	0x65, 0xc3, 0x02, 0xcb, 0x19, 0x9f, 0x04, 0x43, 0xec, 0xb1, 0x3c, 0xe3, 0xd2, 0x3c, 0x04, 0x52,
+	0x1f, 0xa6, 0x0f, 0xd1, 0x05, 0x42, 0x55, 0x57, 0x88, 0x45, 0x80, 0xf4, 0x2d, 0x58, 0xa1, 0xb1,
+	0x9d, 0x38, 0xec, 0x52, 0x29, 0xac, 0x32, 0x73, 0xce, 0x7c, 0xdf, 0x39, 0x39, 0xdf, 0x37, 0x1e,
+	0xd4, 0x1c, 0xc5, 0xd1, 0x54, 0x27, 0x6e, 0xa8, 0x9f, 0x1f, 0x0f, 0x81, 0x3b, 0xc7, 0x62, 0xad,
+	0x85, 0x11, 0xe5, 0x14, 0xef, 0x8b, 0x9c, 0x26, 0xf6, 0x59, 0xae, 0xa9, 0x10, 0xca, 0x7c, 0xca,
+	0xf4, 0xa1, 0xc3, 0x20, 0x07, 0x50, 0x2f, 0x48, 0x11, 0xcd, 0xa3, 0x34, 0x6f, 0x27, 0x3b, 0x3d,
+	0xdd, 0x64, 0xa9, 0x83, 0x31, 0x1d, 0xd3, 0x34, 0x2e, 0x56, 0x59, 0x54, 0x1d, 0x53, 0x3a, 0x9e,
+	0x80, 0x9e, 0xec, 0x86, 0xf1, 0x48, 0xe7, 0x9e, 0x0f, 0x8c, 0x3b, 0x7e, 0xd6, 0x43, 0xfb, 0x43,
+	0x19, 0x95, 0x7a, 0xe6, 0x00, 0x1f, 0xa2, 0xa2, 0xe7, 0xca, 0x52, 0x4b, 0xea, 0x94, 0x8d, 0xea,
+	0x7c, 0xa6, 0x16, 0xfb, 0xa6, 0x55, 0xf4, 0x5c, 0xfc, 0x1a, 0x55, 0xe8, 0xfb, 0x00, 0x22, 0xb9,
+	0xd8, 0x92, 0x3a, 0xbb, 0xc6, 0x93, 0x1f, 0x33, 0xb5, 0x3b, 0xf6, 0xf8, 0x9b, 0x78, 0xa8, 0x11,
+	0xea, 0x67, 0x2d, 0x64, 0x3f, 0x5d, 0xe6, 0xbe, 0xd3, 0xf9, 0x34, 0x04, 0xa6, 0x9d, 0x10, 0x72,
+	0xe2, 0xba, 0x11, 0x30, 0x76, 0x7b, 0xd5, 0xfd, 0x23, 0x6b, 0x34, 0x8b, 0x18, 0x53, 0x0e, 0xcc,
+	0x4a, 0x69, 0x31, 0x46, 0x65, 0x81, 0x90, 0x4b, 0x2d, 0xa9, 0x53, 0xb3, 0x92, 0x35, 0x7e, 0x84,
+	0x10, 0xa1, 0x93, 0x89, 0xc3, 0x21, 0x72, 0x26, 0x72, 0xb9, 0x25, 0x75, 0x76, 0xfe, 0x39, 0xd2,
+	0x32, 0x12, 0x31, 0x9a, 0xc5, 0xbc, 0xb4, 0x1e, 0xf5, 0x02, 0xa3, 0x7c, 0x3d, 0x53, 0x0b, 0xd6,
+	0x0a, 0x04, 0x3f, 0x44, 0xb5, 0x30, 0xf2, 0x02, 0xe2, 0x85, 0xce, 0x44, 0xae, 0xac, 0x87, 0xcf,
+	0x11, 0xf8, 0x29, 0xda, 0x77, 0x08, 0x89, 0xfd, 0x58, 0xf0, 0xb9, 0xf6, 0x08, 0x80, 0xc9, 0xd5,
+	0xf5, 0x58, 0x1a, 0x2b, 0xc0, 0x53, 0x00, 0x86, 0x1f, 0xa3, 0x5d, 0x81, 0xb7, 0xe3, 0xd0, 0x15,
+	0x31, 0x79, 0x2b, 0xe1, 0x69, 0x6a, 0xa9, 0x2e, 0xda, 0x42, 0x17, 0xed, 0x6c, 0xa1, 0x8b, 0xb1,
+	0x2d, 0x88, 0x2e, 0xbf, 0xaa, 0x92, 0xb5, 0x23, 0x90, 0x2f, 0x53, 0x20, 0x06, 0xd4, 0xf0, 0x02,
+	0x0e, 0x11, 0x30, 0x6e, 0x8f, 0x1c, 0xc2, 0x69, 0x24, 0x6f, 0x8b, 0x99, 0x19, 0x0f, 0xc4, 0xf9,
+	0x2f, 0x33, 0xf5, 0xaf, 0x35, 0x64, 0x31, 0x81, 0xdc, 0x5e, 0x75, 0x51, 0xf6, 0x27, 0x4c, 0x20,
+	0x56, 0x7d, 0x41, 0x7a, 0x9a, 0x70, 0xb6, 0x3f, 0x4a, 0x68, 0xcb, 0x84, 0x90, 0x32, 0x8f, 0xe3,
+	0x16, 0xaa, 0x12, 0x37, 0xb4, 0x97, 0xbe, 0xa8, 0xcd, 0x67, 0x6a, 0xa5, 0xe7, 0x86, 0x7d, 0xd3,
+	0xaa, 0x10, 0x37, 0xec, 0xbb, 0x78, 0x84, 0x6a, 0x6e, 0x7a, 0x98, 0xa6, 0x0e, 0xa9, 0x6d, 0xd0,
+	0x21, 0x39, 0x35, 0xfe, 0x1f, 0x55, 0x1d, 0x9f, 0xc6, 0x01, 0x97, 0x4b, 0xeb, 0xe9, 0x90, 0x1d,
+	0x6f, 0x47, 0xa8, 0x7e, 0x46, 0xb9, 0x33, 0x19, 0x2c, 0xc5, 0xfd, 0x1b, 0x35, 0x72, 0xa7, 0xd8,
+	0x89, 0xf7, 0xa4, 0xc4, 0x7b, 0xf5, 0x3c, 0x7c, 0x26, 0x5c, 0x98, 0xd7, 0x2c, 0xde, 0xaf, 0x26,
+	0x43, 0x8d, 0xa4, 0x66, 0x2f, 0x37, 0xe4, 0xef, 0x2f, 0xfa, 0x1f, 0xda, 0x7b, 0x2e, 0x2e, 0x54,
+	0xcf, 0x1c, 0xf4, 0x03, 0x17, 0x2e, 0xf0, 0x9f, 0x68, 0x2b, 0x15, 0x8f, 0xc9, 0x52, 0xab, 0xd4,
+	0x29, 0x1b, 0x68, 0x3e, 0x53, 0xab, 0x89, 0x7a, 0xcc, 0xaa, 0x26, 0xf2, 0xb1, 0xf6, 0xa7, 0x12,
+	0x3a, 0x7c, 0xc1, 0x9d, 0xa1, 0x37, 0xf1, 0xf8, 0xf4, 0x14, 0xe0, 0xc4, 0x7d, 0x1b, 0x33, 0xee,
+	0x43, 0xc0, 0xd7, 0x6f, 0xd9, 0x41, 0x7b, 0x6c, 0x41, 0x21, 0xee, 0x8a, 0x5c, 0xdc, 0x80, 0x2d,
+	0x77, 0xd9, 0x4a, 0x57, 0x38, 0x42, 0x87, 0x61, 0x04, 0xe7, 0x1e, 0x8d, 0x99, 0xfd, 0x6b, 0xad,
+	0xd2, 0x06, 0x6a, 0x1d, 0x2c, 0xb8, 0x57, 0x27, 0x21, 0xee, 0x5b, 0x04, 0x23, 0x88, 0x20, 0x20,
+	0x60, 0x87, 0x91, 0x47, 0x40, 0x2e, 0x6f, 0xa0, 0x58, 0x7d, 0x49, 0x3a, 0x10, 0x9c, 0xf8, 0x19,
+	0x6a, 0x38, 0xcb, 0xa1, 0xdb, 0xe2, 0xeb, 0x2c, 0x57, 0xee, 0xf1, 0x89, 0xa8, 0xe7, 0x60, 0x91,
+	0x36, 0xcc, 0xeb, 0xef, 0x4a, 0xe1, 0x7a, 0xae, 0x48, 0x37, 0x73, 0x45, 0xfa, 0x36, 0x57, 0xa4,
+	0xcb, 0x3b, 0xa5, 0x70, 0x73, 0xa7, 0x14, 0x3e, 0xdf, 0x29, 0x85, 0x57, 0xab, 0x2d, 0xfb, 0xce,
+	0x18, 0xba, 0x84, 0x9e, 0x43, 0xa0, 0x27, 0x4f, 0xd4, 0x45, 0xf2, 0x48, 0x25, 0x6d, 0x0f, 0xab,
+	0x49, 0xcd, 0x7f, 0x7f, 0x0e, 0x00, 0x0a, 0xf3, 0xd1, 0x97, 0xbd, 0x06, 0x00, 0x00,
 }
 
 func (m *CDP) Marshal() (dAtA []byte, err error) {
@@ -540,6 +590,74 @@ func (m *OwnerCDPIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StabilityFeeAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AdjustmentTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AdjustmentTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintCdp(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousStabilityFee.Size()
+		i -= size
+		if _, err := m.PreviousStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StabilityFee.Size()
+		i -= size
+		if _, err := m.StabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCdp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCdp(v)
 	base := offset
@@ -645,6 +763,27 @@ func (m *OwnerCDPIndex) Size() (n int) {
 	return n
 }
 
+func (m *StabilityFeeAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.StabilityFee.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.PreviousStabilityFee.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AdjustmentTime)
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
 func sovCdp(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1442,6 +1581,223 @@ func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StabilityFeeAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AdjustmentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCdp(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
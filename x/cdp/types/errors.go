@@ -49,4 +49,8 @@ var (
 	ErrInsufficientBalance = errorsmod.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = errorsmod.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrExceedsFlashMintCap error for when a flash mint is larger than the flash mint cap
+	ErrExceedsFlashMintCap = errorsmod.Register(ModuleName, 24, "flash mint amount exceeds cap")
+	// ErrFlashMintNotRepaid error for when a flash mint and its fee cannot be repaid
+	ErrFlashMintNotRepaid = errorsmod.Register(ModuleName, 25, "flash mint not repaid")
 )
@@ -2,17 +2,24 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp              = "create_cdp"
+	EventTypeCdpDeposit             = "cdp_deposit"
+	EventTypeCdpDraw                = "cdp_draw"
+	EventTypeCdpRepay               = "cdp_repayment"
+	EventTypeCdpClose               = "cdp_close"
+	EventTypeCdpWithdrawal          = "cdp_withdrawal"
+	EventTypeCdpLiquidation         = "cdp_liquidation"
+	EventTypeBeginBlockerFatal      = "cdp_begin_block_error"
+	EventTypeStabilityFeeAdjustment = "stability_fee_adjustment"
+	EventTypeFlashMint              = "cdp_flash_mint"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID                = "cdp_id"
+	AttributeKeyDeposit              = "deposit"
+	AttributeValueCategory           = "cdp"
+	AttributeKeyError                = "error_message"
+	AttributeKeyCollateralType       = "collateral_type"
+	AttributeKeyStabilityFee         = "stability_fee"
+	AttributeKeyPreviousStabilityFee = "previous_stability_fee"
+	AttributeKeyReferencePrice       = "reference_price"
+	AttributeKeyFee                  = "fee"
 )
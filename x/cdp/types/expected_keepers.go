@@ -55,6 +55,11 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
+// SavingsKeeper expected interface for the savings keeper
+type SavingsKeeper interface {
+	AccrueSavingsRate(ctx sdk.Context, fundingModule string, available sdk.Coin) error
+}
+
 // CDPHooks event hooks for other keepers to run code in response to CDP modifications
 type CDPHooks interface {
 	AfterCDPCreated(ctx sdk.Context, cdp CDP)
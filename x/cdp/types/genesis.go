@@ -12,7 +12,8 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
 	debtDenom, govDenom string, prevAccumTimes GenesisAccumulationTimes,
-	totalPrincipals GenesisTotalPrincipals,
+	totalPrincipals GenesisTotalPrincipals, stabilityFeeAdjustments StabilityFeeAdjustments,
+	prevStabilityFeeUpdateTimes GenesisStabilityFeeUpdateTimes,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -23,6 +24,9 @@ func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID
 		GovDenom:                  govDenom,
 		PreviousAccumulationTimes: prevAccumTimes,
 		TotalPrincipals:           totalPrincipals,
+		StabilityFeeAdjustments:   stabilityFeeAdjustments,
+
+		PreviousStabilityFeeUpdateTimes: prevStabilityFeeUpdateTimes,
 	}
 }
 
@@ -37,6 +41,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		GenesisAccumulationTimes{},
 		GenesisTotalPrincipals{},
+		StabilityFeeAdjustments{},
+		GenesisStabilityFeeUpdateTimes{},
 	)
 }
 
@@ -63,6 +69,14 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.StabilityFeeAdjustments.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PreviousStabilityFeeUpdateTimes.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -136,3 +150,32 @@ func (gats GenesisAccumulationTimes) Validate() error {
 	}
 	return nil
 }
+
+// NewGenesisStabilityFeeUpdateTime returns a new GenesisStabilityFeeUpdateTime
+func NewGenesisStabilityFeeUpdateTime(ctype string, prevTime time.Time) GenesisStabilityFeeUpdateTime {
+	return GenesisStabilityFeeUpdateTime{
+		CollateralType:     ctype,
+		PreviousUpdateTime: prevTime,
+	}
+}
+
+// Validate performs validation of GenesisStabilityFeeUpdateTime
+func (gsfut GenesisStabilityFeeUpdateTime) Validate() error {
+	if strings.TrimSpace(gsfut.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GenesisStabilityFeeUpdateTimes slice of GenesisStabilityFeeUpdateTime
+type GenesisStabilityFeeUpdateTimes []GenesisStabilityFeeUpdateTime
+
+// Validate performs validation of GenesisStabilityFeeUpdateTimes
+func (gsfuts GenesisStabilityFeeUpdateTimes) Validate() error {
+	for _, gsfut := range gsfuts {
+		if err := gsfut.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
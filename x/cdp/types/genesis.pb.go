@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,21 +34,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the cdp module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params                    Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	CDPs                      CDPs                     `protobuf:"bytes,2,rep,name=cdps,proto3,castrepeated=CDPs" json:"cdps"`
-	Deposits                  Deposits                 `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
-	StartingCdpID             uint64                   `protobuf:"varint,4,opt,name=starting_cdp_id,json=startingCdpId,proto3" json:"starting_cdp_id,omitempty"`
-	DebtDenom                 string                   `protobuf:"bytes,5,opt,name=debt_denom,json=debtDenom,proto3" json:"debt_denom,omitempty"`
-	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
-	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
-	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	Params                          Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	CDPs                            CDPs                           `protobuf:"bytes,2,rep,name=cdps,proto3,castrepeated=CDPs" json:"cdps"`
+	Deposits                        Deposits                       `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	StartingCdpID                   uint64                         `protobuf:"varint,4,opt,name=starting_cdp_id,json=startingCdpId,proto3" json:"starting_cdp_id,omitempty"`
+	DebtDenom                       string                         `protobuf:"bytes,5,opt,name=debt_denom,json=debtDenom,proto3" json:"debt_denom,omitempty"`
+	GovDenom                        string                         `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
+	PreviousAccumulationTimes       GenesisAccumulationTimes       `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
+	TotalPrincipals                 GenesisTotalPrincipals         `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	StabilityFeeAdjustments         StabilityFeeAdjustments        `protobuf:"bytes,9,rep,name=stability_fee_adjustments,json=stabilityFeeAdjustments,proto3,castrepeated=StabilityFeeAdjustments" json:"stability_fee_adjustments"`
+	PreviousStabilityFeeUpdateTimes GenesisStabilityFeeUpdateTimes `protobuf:"bytes,10,rep,name=previous_stability_fee_update_times,json=previousStabilityFeeUpdateTimes,proto3,castrepeated=GenesisStabilityFeeUpdateTimes" json:"previous_stability_fee_update_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{0}
+	return fileDescriptor_3ca565c97afff7e5, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,6 +135,20 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetStabilityFeeAdjustments() StabilityFeeAdjustments {
+	if m != nil {
+		return m.StabilityFeeAdjustments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousStabilityFeeUpdateTimes() GenesisStabilityFeeUpdateTimes {
+	if m != nil {
+		return m.PreviousStabilityFeeUpdateTimes
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams        CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -142,13 +159,19 @@ type Params struct {
 	DebtAuctionThreshold    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=debt_auction_threshold,json=debtAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_threshold"`
 	DebtAuctionLot          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker          bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// stability_fee_controllers automatically adjust the stability fees of the listed collateral types
+	StabilityFeeControllers StabilityFeeControllers `protobuf:"bytes,9,rep,name=stability_fee_controllers,json=stabilityFeeControllers,proto3,castrepeated=StabilityFeeControllers" json:"stability_fee_controllers"`
+	// flash_mint_cap is the maximum amount of debt that can be flash minted in a single message
+	FlashMintCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=flash_mint_cap,json=flashMintCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"flash_mint_cap"`
+	// flash_mint_fee is the fraction of the flash minted amount paid to the liquidator module account
+	FlashMintFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=flash_mint_fee,json=flashMintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_mint_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{1}
+	return fileDescriptor_3ca565c97afff7e5, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,6 +228,13 @@ func (m *Params) GetCircuitBreaker() bool {
 	return false
 }
 
+func (m *Params) GetStabilityFeeControllers() StabilityFeeControllers {
+	if m != nil {
+		return m.StabilityFeeControllers
+	}
+	return nil
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -217,7 +247,7 @@ func (m *DebtParam) Reset()         { *m = DebtParam{} }
 func (m *DebtParam) String() string { return proto.CompactTextString(m) }
 func (*DebtParam) ProtoMessage()    {}
 func (*DebtParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{2}
+	return fileDescriptor_3ca565c97afff7e5, []int{2}
 }
 func (m *DebtParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,10 +292,12 @@ func (m *DebtParam) GetReferenceAsset() string {
 
 // CollateralParam defines governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
-	Denom                            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Type                             string                                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	LiquidationRatio                 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidation_ratio,json=liquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_ratio"`
-	DebtLimit                        types.Coin                             `protobuf:"bytes,4,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
+	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Type             string                                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	LiquidationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidation_ratio,json=liquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_ratio"`
+	DebtLimit        types.Coin                             `protobuf:"bytes,4,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
+	// stability_fee is the per second fee charged on debt. For collateral types managed by a stability fee controller it
+	// is the base rate, the fee charged is the latest controller adjustment or the base rate clamped to the controller bounds.
 	StabilityFee                     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=stability_fee,json=stabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stability_fee"`
 	AuctionSize                      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=auction_size,json=auctionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"auction_size"`
 	LiquidationPenalty               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidation_penalty,json=liquidationPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_penalty"`
@@ -280,7 +312,7 @@ func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
 func (m *CollateralParam) String() string { return proto.CompactTextString(m) }
 func (*CollateralParam) ProtoMessage()    {}
 func (*CollateralParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{3}
+	return fileDescriptor_3ca565c97afff7e5, []int{3}
 }
 func (m *CollateralParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +376,77 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+// StabilityFeeController defines governance parameters for automatically adjusting a collateral type's stability
+// fee based on the market price of the debt asset
+type StabilityFeeController struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// market_id is the pricefeed market used to price the debt asset, eg. usdx:usd
+	MarketID string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// target_price is the peg the controller steers the debt asset towards
+	TargetPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price"`
+	// tolerance is the absolute deviation from the target price within which the fee is left unchanged
+	Tolerance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tolerance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tolerance"`
+	// adjustment_step is the change to the per second stability fee applied each interval
+	AdjustmentStep     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=adjustment_step,json=adjustmentStep,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_step"`
+	AdjustmentInterval time.Duration                          `protobuf:"bytes,6,opt,name=adjustment_interval,json=adjustmentInterval,proto3,stdduration" json:"adjustment_interval"`
+	MinStabilityFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_stability_fee,json=minStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_fee"`
+	MaxStabilityFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_stability_fee,json=maxStabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_stability_fee"`
+}
+
+func (m *StabilityFeeController) Reset()         { *m = StabilityFeeController{} }
+func (m *StabilityFeeController) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeController) ProtoMessage()    {}
+func (*StabilityFeeController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca565c97afff7e5, []int{4}
+}
+func (m *StabilityFeeController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeController.Merge(m, src)
+}
+func (m *StabilityFeeController) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeController) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeController proto.InternalMessageInfo
+
+func (m *StabilityFeeController) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *StabilityFeeController) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *StabilityFeeController) GetAdjustmentInterval() time.Duration {
+	if m != nil {
+		return m.AdjustmentInterval
+	}
+	return 0
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -355,7 +458,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{4}
+	return fileDescriptor_3ca565c97afff7e5, []int{5}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +511,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{5}
+	return fileDescriptor_3ca565c97afff7e5, []int{6}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,93 +547,169 @@ func (m *GenesisTotalPrincipal) GetCollateralType() string {
 	return ""
 }
 
+// GenesisStabilityFeeUpdateTime defines the last time the stability fee controller ran for a collateral type
+type GenesisStabilityFeeUpdateTime struct {
+	CollateralType     string    `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	PreviousUpdateTime time.Time `protobuf:"bytes,2,opt,name=previous_update_time,json=previousUpdateTime,proto3,stdtime" json:"previous_update_time"`
+}
+
+func (m *GenesisStabilityFeeUpdateTime) Reset()         { *m = GenesisStabilityFeeUpdateTime{} }
+func (m *GenesisStabilityFeeUpdateTime) String() string { return proto.CompactTextString(m) }
+func (*GenesisStabilityFeeUpdateTime) ProtoMessage()    {}
+func (*GenesisStabilityFeeUpdateTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca565c97afff7e5, []int{7}
+}
+func (m *GenesisStabilityFeeUpdateTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisStabilityFeeUpdateTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisStabilityFeeUpdateTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisStabilityFeeUpdateTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisStabilityFeeUpdateTime.Merge(m, src)
+}
+func (m *GenesisStabilityFeeUpdateTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisStabilityFeeUpdateTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisStabilityFeeUpdateTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisStabilityFeeUpdateTime proto.InternalMessageInfo
+
+func (m *GenesisStabilityFeeUpdateTime) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *GenesisStabilityFeeUpdateTime) GetPreviousUpdateTime() time.Time {
+	if m != nil {
+		return m.PreviousUpdateTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.cdp.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "fury.cdp.v1beta1.Params")
 	proto.RegisterType((*DebtParam)(nil), "fury.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "fury.cdp.v1beta1.CollateralParam")
+	proto.RegisterType((*StabilityFeeController)(nil), "fury.cdp.v1beta1.StabilityFeeController")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "fury.cdp.v1beta1.GenesisAccumulationTime")
 	proto.RegisterType((*GenesisTotalPrincipal)(nil), "fury.cdp.v1beta1.GenesisTotalPrincipal")
-}
-
-func init() { proto.RegisterFile("fury/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
-
-var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x3a, 0x8a, 0x23, 0xd1, 0x8a, 0x24, 0x33, 0x4e, 0xb2, 0x76, 0x50, 0x49, 0x75, 0xd1,
-	0xc6, 0x3d, 0x58, 0x42, 0x52, 0x20, 0x40, 0x81, 0xa2, 0xa9, 0x65, 0xc1, 0x81, 0x11, 0x17, 0x10,
-	0xd6, 0x3e, 0xb5, 0x87, 0x05, 0x97, 0x4b, 0xc9, 0x84, 0x56, 0xcb, 0x2d, 0x49, 0xa9, 0xb6, 0x5f,
-	0xa1, 0x28, 0x1a, 0xf4, 0x25, 0x0a, 0xe4, 0xdc, 0x87, 0x48, 0x6f, 0x41, 0x4f, 0x45, 0x0f, 0x72,
-	0x21, 0xdf, 0xfb, 0x0c, 0x05, 0x7f, 0xf4, 0x63, 0xc9, 0x02, 0x02, 0x5b, 0xbd, 0x58, 0xcb, 0x19,
-	0xce, 0xf7, 0xcd, 0x90, 0x33, 0xc3, 0x31, 0x28, 0xb6, 0x51, 0x0f, 0x55, 0x71, 0x98, 0x54, 0x7b,
-	0xcf, 0x02, 0x22, 0xd1, 0xb3, 0x6a, 0x8b, 0xc4, 0x44, 0x50, 0x51, 0x49, 0x38, 0x93, 0x0c, 0x16,
-	0x94, 0xbe, 0x82, 0xc3, 0xa4, 0x62, 0xf5, 0x9b, 0x45, 0xcc, 0x44, 0x87, 0x89, 0x6a, 0x80, 0x04,
-	0x19, 0x19, 0x61, 0x46, 0x63, 0x63, 0xb1, 0xb9, 0x61, 0xf4, 0xbe, 0x5e, 0x55, 0xcd, 0xc2, 0xaa,
-	0xd6, 0x5b, 0xac, 0xc5, 0x8c, 0x5c, 0x7d, 0x59, 0x69, 0xa9, 0xc5, 0x58, 0x2b, 0x22, 0x55, 0xbd,
-	0x0a, 0xba, 0xcd, 0xaa, 0xa4, 0x1d, 0x22, 0x24, 0xea, 0x24, 0x76, 0xc3, 0xe6, 0x8c, 0x8f, 0xca,
-	0x1f, 0xad, 0xdb, 0xfa, 0x23, 0x05, 0xb2, 0xaf, 0x8c, 0xc7, 0x47, 0x12, 0x49, 0x02, 0x5f, 0x80,
-	0x95, 0x04, 0x71, 0xd4, 0x11, 0xae, 0x53, 0x76, 0xb6, 0x57, 0x9f, 0xbb, 0x95, 0xe9, 0x08, 0x2a,
-	0x0d, 0xad, 0xaf, 0xa5, 0xde, 0xf5, 0x4b, 0x4b, 0x9e, 0xdd, 0x0d, 0x5f, 0x82, 0x14, 0x0e, 0x13,
-	0xe1, 0x2e, 0x97, 0xef, 0x6c, 0xaf, 0x3e, 0x7f, 0x38, 0x6b, 0xb5, 0x57, 0x6f, 0xd4, 0xd6, 0x95,
-	0xc9, 0xa0, 0x5f, 0x4a, 0xed, 0xd5, 0x1b, 0xe2, 0xed, 0x85, 0xf9, 0xf5, 0xb4, 0x21, 0x7c, 0x05,
-	0xd2, 0x21, 0x49, 0x98, 0xa0, 0x52, 0xb8, 0x77, 0x34, 0xc8, 0xc6, 0x2c, 0x48, 0xdd, 0xec, 0xa8,
-	0x15, 0x14, 0xd0, 0xdb, 0x8b, 0x52, 0xda, 0x0a, 0x84, 0x37, 0x32, 0x86, 0x5f, 0x82, 0xbc, 0x90,
-	0x88, 0x4b, 0x1a, 0xb7, 0x7c, 0x1c, 0x26, 0x3e, 0x0d, 0xdd, 0x54, 0xd9, 0xd9, 0x4e, 0xd5, 0xd6,
-	0x06, 0xfd, 0xd2, 0xfd, 0x23, 0xab, 0xda, 0x0b, 0x93, 0x83, 0xba, 0x77, 0x5f, 0x4c, 0x2c, 0x43,
-	0xf8, 0x11, 0x00, 0x21, 0x09, 0xa4, 0x1f, 0x92, 0x98, 0x75, 0xdc, 0xbb, 0x65, 0x67, 0x3b, 0xe3,
-	0x65, 0x94, 0xa4, 0xae, 0x04, 0xf0, 0x09, 0xc8, 0xb4, 0x58, 0xcf, 0x6a, 0x57, 0xb4, 0x36, 0xdd,
-	0x62, 0x3d, 0xa3, 0xfc, 0xc9, 0x01, 0x4f, 0x12, 0x4e, 0x7a, 0x94, 0x75, 0x85, 0x8f, 0x30, 0xee,
-	0x76, 0xba, 0x11, 0x92, 0x94, 0xc5, 0xbe, 0xbe, 0x0f, 0xf7, 0x9e, 0x8e, 0xe9, 0xf3, 0xd9, 0x98,
-	0xec, 0xf1, 0xef, 0x4e, 0x98, 0x1c, 0xd3, 0x0e, 0xa9, 0x95, 0x6d, 0x8c, 0xee, 0x9c, 0x0d, 0xc2,
-	0xdb, 0x18, 0xf2, 0xcd, 0xa8, 0x20, 0x07, 0x05, 0xc9, 0x24, 0x8a, 0xfc, 0x84, 0xd3, 0x18, 0xd3,
-	0x04, 0x45, 0xc2, 0x4d, 0x6b, 0x0f, 0x9e, 0xce, 0xf5, 0xe0, 0x58, 0x19, 0x34, 0x86, 0xfb, 0x6b,
-	0x45, 0xcb, 0xff, 0xe8, 0x5a, 0xb5, 0xf0, 0xf2, 0xf2, 0xaa, 0x60, 0xeb, 0xdf, 0xbb, 0x60, 0xc5,
-	0xe4, 0x06, 0x3c, 0x01, 0x6b, 0x98, 0x45, 0x11, 0x92, 0x84, 0x2b, 0x1f, 0x86, 0x09, 0xa5, 0xf8,
-	0x3f, 0xbe, 0x26, 0x35, 0x46, 0x5b, 0xb5, 0x79, 0xcd, 0xb5, 0xcc, 0x85, 0x29, 0x85, 0xf0, 0x0a,
-	0x78, 0x4a, 0x02, 0xbf, 0xb1, 0x57, 0xa6, 0x39, 0xdc, 0x65, 0x9d, 0xb3, 0x4f, 0xae, 0x4b, 0x9c,
-	0x40, 0x1a, 0x70, 0x93, 0xb6, 0xfa, 0x56, 0xb5, 0x00, 0xbe, 0x06, 0x6b, 0xad, 0x88, 0x05, 0x28,
-	0xf2, 0x35, 0x50, 0x44, 0x3b, 0x54, 0xba, 0x77, 0x34, 0xd0, 0x46, 0xc5, 0xd6, 0x9f, 0x2a, 0xd6,
-	0x09, 0x77, 0x69, 0x6c, 0x61, 0xf2, 0xc6, 0x52, 0xa1, 0x1f, 0x2a, 0x3b, 0x78, 0x0a, 0x36, 0x44,
-	0x97, 0x27, 0x91, 0xca, 0x81, 0x2e, 0x36, 0xd7, 0x7f, 0xc2, 0x89, 0x38, 0x61, 0x91, 0x49, 0xc3,
-	0x4c, 0xed, 0x2b, 0x65, 0xf9, 0x77, 0xbf, 0xf4, 0x59, 0x8b, 0xca, 0x93, 0x6e, 0x50, 0xc1, 0xac,
-	0x63, 0xcb, 0xdc, 0xfe, 0xec, 0x88, 0xb0, 0x5d, 0x95, 0x67, 0x09, 0x11, 0x95, 0x83, 0x58, 0xfe,
-	0xf9, 0xfb, 0x0e, 0xb0, 0x5e, 0x1c, 0xc4, 0xd2, 0x7b, 0x6c, 0xe1, 0x77, 0x0d, 0xfa, 0xf1, 0x10,
-	0x1c, 0x46, 0xe0, 0xc1, 0x34, 0x73, 0xc4, 0xa4, 0x49, 0xe2, 0x5b, 0x72, 0xae, 0x5d, 0xe5, 0x3c,
-	0x64, 0x12, 0x72, 0xf0, 0x48, 0x9f, 0xd6, 0x6c, 0x90, 0x2b, 0x0b, 0x20, 0x5c, 0x57, 0xd8, 0x33,
-	0x11, 0x36, 0x41, 0xe1, 0x0a, 0xa7, 0x0a, 0xef, 0xde, 0x02, 0xd8, 0x72, 0x13, 0x6c, 0x2a, 0xb6,
-	0xa7, 0x20, 0x8f, 0x29, 0xc7, 0x5d, 0x2a, 0xfd, 0x80, 0x13, 0xd4, 0x26, 0xdc, 0x4d, 0x97, 0x9d,
-	0xed, 0xb4, 0x97, 0xb3, 0xe2, 0x9a, 0x91, 0x6e, 0xfd, 0xba, 0x0c, 0x32, 0xa3, 0xc4, 0x82, 0xeb,
-	0xe0, 0xae, 0xe9, 0x0c, 0x8e, 0xee, 0x0c, 0x66, 0xa1, 0xc0, 0x38, 0x69, 0x12, 0x4e, 0x62, 0x4c,
-	0x7c, 0x24, 0x04, 0x91, 0x3a, 0x49, 0x33, 0x5e, 0x6e, 0x24, 0xde, 0x55, 0x52, 0x48, 0x55, 0xc9,
-	0xc4, 0x3d, 0xc2, 0x85, 0x8a, 0xad, 0x89, 0xb0, 0x64, 0x5c, 0xa7, 0xe1, 0x6d, 0xc3, 0x2b, 0x8c,
-	0x61, 0xf7, 0x35, 0x2a, 0xfc, 0xde, 0xd6, 0x4c, 0x33, 0x62, 0x8c, 0x2f, 0x24, 0x2b, 0x75, 0x39,
-	0xed, 0x2b, 0xb8, 0xad, 0x5f, 0xd2, 0x20, 0x3f, 0x55, 0xb7, 0x73, 0x8e, 0x06, 0x82, 0x94, 0xc2,
-	0xb3, 0xe7, 0xa1, 0xbf, 0xd5, 0x29, 0x44, 0xf4, 0x87, 0x2e, 0x0d, 0x4d, 0xeb, 0xe4, 0xea, 0xe7,
-	0x06, 0xa7, 0x50, 0x27, 0x78, 0xc2, 0xc3, 0x3a, 0xc1, 0x5e, 0x61, 0x02, 0xd6, 0x53, 0x7f, 0xe1,
-	0xd7, 0xf6, 0x14, 0x4c, 0xc1, 0xa7, 0x3e, 0xac, 0xe0, 0x75, 0xa0, 0xa6, 0xd4, 0x11, 0x50, 0xaf,
-	0x47, 0x40, 0x23, 0x2a, 0xcf, 0xfc, 0x26, 0x21, 0x37, 0x28, 0xb5, 0x59, 0x37, 0xb3, 0x23, 0xc8,
-	0x7d, 0x42, 0xa0, 0x0f, 0xb2, 0xc3, 0x64, 0x17, 0xf4, 0x9c, 0x2c, 0xa4, 0xb6, 0x56, 0x2d, 0xe2,
-	0x11, 0x3d, 0x27, 0xb0, 0x03, 0x1e, 0x4c, 0x1e, 0x77, 0x42, 0x62, 0x14, 0xc9, 0xb3, 0x1b, 0x54,
-	0xd5, 0x6c, 0x24, 0x70, 0x02, 0xb8, 0x61, 0x70, 0xe1, 0x0b, 0x90, 0x13, 0x09, 0x93, 0x7e, 0x07,
-	0xf1, 0x36, 0x91, 0xea, 0x65, 0x4e, 0x6b, 0xa6, 0xc2, 0xa0, 0x5f, 0xca, 0x1e, 0x25, 0x4c, 0x7e,
-	0xab, 0x15, 0x07, 0x75, 0x2f, 0x2b, 0xc6, 0xab, 0x10, 0xbe, 0x06, 0x0f, 0x27, 0xdd, 0x1c, 0x9b,
-	0x67, 0xb4, 0xf9, 0xe3, 0x41, 0xbf, 0xf4, 0xe0, 0x70, 0xbc, 0x61, 0x84, 0x32, 0x19, 0xdc, 0x08,
-	0xac, 0x07, 0xdc, 0x36, 0x21, 0x09, 0xe1, 0x3e, 0x27, 0x3f, 0x22, 0x1e, 0xfa, 0x09, 0xe1, 0x98,
-	0xc4, 0x12, 0xb5, 0x88, 0x0b, 0x16, 0x10, 0xf8, 0x23, 0x83, 0xee, 0x69, 0xf0, 0xc6, 0x08, 0x5b,
-	0x0d, 0x08, 0x9f, 0xe0, 0x13, 0x82, 0xdb, 0xfe, 0xf8, 0x11, 0xa3, 0xe7, 0x26, 0x22, 0x1a, 0x87,
-	0xe4, 0xd4, 0xc7, 0xac, 0x1b, 0x4b, 0x77, 0x75, 0x01, 0x97, 0x5c, 0xd6, 0x44, 0x7b, 0xd3, 0x3c,
-	0x07, 0x8a, 0x66, 0x4f, 0xb1, 0x5c, 0xdf, 0x6e, 0xb2, 0xff, 0x47, 0xbb, 0xd9, 0xfa, 0x79, 0x19,
-	0x3c, 0x9e, 0x33, 0xc3, 0xe8, 0x5e, 0x3b, 0x1e, 0x14, 0x74, 0x3b, 0x30, 0x3d, 0x22, 0x37, 0x16,
-	0x1f, 0xab, 0xc6, 0x10, 0x80, 0xcd, 0xf9, 0xd3, 0x95, 0x7d, 0xf7, 0x37, 0x2b, 0x66, 0x14, 0xae,
-	0x0c, 0x47, 0xe1, 0xca, 0xf1, 0x70, 0x14, 0xae, 0xa5, 0x55, 0x50, 0x6f, 0x2e, 0x4a, 0x8e, 0xe7,
-	0xce, 0x9b, 0x9a, 0x20, 0x01, 0x79, 0x1a, 0x4b, 0xc2, 0x89, 0x90, 0x37, 0x6f, 0xc0, 0xb3, 0x09,
-	0x91, 0x1b, 0x82, 0xda, 0xf3, 0xf8, 0xcd, 0x01, 0x0f, 0xaf, 0x9d, 0xa9, 0x3e, 0xfc, 0x34, 0x08,
-	0xc8, 0x4f, 0x8d, 0x77, 0xa6, 0x8b, 0xde, 0xf6, 0x25, 0xbc, 0x3a, 0xd2, 0xd5, 0x5e, 0xbe, 0x1b,
-	0x14, 0x9d, 0xf7, 0x83, 0xa2, 0xf3, 0xcf, 0xa0, 0xe8, 0xbc, 0xb9, 0x2c, 0x2e, 0xbd, 0xbf, 0x2c,
-	0x2e, 0xfd, 0x75, 0x59, 0x5c, 0xfa, 0xee, 0xd3, 0x09, 0x7c, 0x35, 0x6c, 0xed, 0x44, 0x28, 0x10,
-	0xfa, 0xab, 0x7a, 0xaa, 0xff, 0xd5, 0xd0, 0x14, 0xc1, 0x8a, 0xbe, 0x89, 0x2f, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x31, 0x9b, 0x4e, 0x27, 0x0d, 0x00, 0x00,
+	proto.RegisterType((*GenesisStabilityFeeUpdateTime)(nil), "fury.cdp.v1beta1.GenesisStabilityFeeUpdateTime")
+}
+
+func init() { proto.RegisterFile("fury/cdp/v1beta1/genesis.proto", fileDescriptor_3ca565c97afff7e5) }
+
+var fileDescriptor_3ca565c97afff7e5 = []byte{
+	// 1511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xbf, 0x6f, 0x1b, 0x47,
+	0x16, 0x16, 0x25, 0x4a, 0x26, 0x47, 0xb4, 0x48, 0x8d, 0x64, 0x69, 0x25, 0xc3, 0xa4, 0x4e, 0x06,
+	0x6c, 0xb9, 0x30, 0x09, 0xfb, 0x00, 0x03, 0x07, 0x1c, 0xee, 0x2c, 0x8a, 0x90, 0x21, 0xd8, 0x06,
+	0x84, 0x95, 0xee, 0x0a, 0x5f, 0xb1, 0x18, 0xee, 0x3e, 0x52, 0x73, 0xda, 0xdd, 0xd9, 0x9b, 0x19,
+	0xf2, 0x24, 0xf7, 0xa9, 0x82, 0x20, 0x46, 0xaa, 0x94, 0x69, 0x82, 0x00, 0xae, 0x53, 0xa5, 0x48,
+	0xed, 0xd2, 0x48, 0x15, 0xa4, 0x90, 0x03, 0xfa, 0xaf, 0x48, 0x17, 0xcc, 0xec, 0x90, 0x5c, 0xf1,
+	0x87, 0x21, 0x05, 0x9b, 0x46, 0xe2, 0xbe, 0x37, 0xef, 0xfb, 0xde, 0xbc, 0x37, 0xef, 0xed, 0xbc,
+	0x45, 0xe5, 0x56, 0x87, 0x9f, 0xd7, 0x5c, 0x2f, 0xaa, 0x75, 0x1f, 0x35, 0x41, 0x92, 0x47, 0xb5,
+	0x36, 0x84, 0x20, 0xa8, 0xa8, 0x46, 0x9c, 0x49, 0x86, 0x4b, 0x4a, 0x5f, 0x75, 0xbd, 0xa8, 0x6a,
+	0xf4, 0x9b, 0x65, 0x97, 0x89, 0x80, 0x89, 0x5a, 0x93, 0x08, 0x18, 0x18, 0xb9, 0x8c, 0x86, 0xb1,
+	0xc5, 0xe6, 0x46, 0xac, 0x77, 0xf4, 0x53, 0x2d, 0x7e, 0x30, 0xaa, 0xd5, 0x36, 0x6b, 0xb3, 0x58,
+	0xae, 0x7e, 0x19, 0x69, 0xb9, 0xcd, 0x58, 0xdb, 0x87, 0x9a, 0x7e, 0x6a, 0x76, 0x5a, 0x35, 0xaf,
+	0xc3, 0x89, 0xa4, 0xac, 0x0f, 0x58, 0x19, 0xd5, 0x4b, 0x1a, 0x80, 0x90, 0x24, 0x88, 0xcc, 0x82,
+	0xcd, 0xb1, 0x3d, 0xb8, 0x9e, 0xd1, 0x6d, 0xff, 0xb6, 0x80, 0x0a, 0xcf, 0xe2, 0x1d, 0x1d, 0x49,
+	0x22, 0x01, 0x3f, 0x41, 0x0b, 0x11, 0xe1, 0x24, 0x10, 0x56, 0x66, 0x2b, 0xb3, 0xb3, 0xf8, 0xd8,
+	0xaa, 0x8e, 0xee, 0xb0, 0x7a, 0xa8, 0xf5, 0xf5, 0xec, 0xbb, 0x8b, 0xca, 0x8c, 0x6d, 0x56, 0xe3,
+	0x7f, 0xa2, 0xac, 0xeb, 0x45, 0xc2, 0x9a, 0xdd, 0x9a, 0xdb, 0x59, 0x7c, 0x7c, 0x6b, 0xdc, 0x6a,
+	0xaf, 0x71, 0x58, 0x5f, 0x55, 0x26, 0xbd, 0x8b, 0x4a, 0x76, 0xaf, 0x71, 0x28, 0xde, 0x7e, 0x88,
+	0xff, 0xdb, 0xda, 0x10, 0x3f, 0x43, 0x39, 0x0f, 0x22, 0x26, 0xa8, 0x14, 0xd6, 0x9c, 0x06, 0xd9,
+	0x18, 0x07, 0x69, 0xc4, 0x2b, 0xea, 0x25, 0x05, 0xf4, 0xf6, 0x43, 0x25, 0x67, 0x04, 0xc2, 0x1e,
+	0x18, 0xe3, 0xbf, 0xa1, 0xa2, 0x90, 0x84, 0x4b, 0x1a, 0xb6, 0x1d, 0xd7, 0x8b, 0x1c, 0xea, 0x59,
+	0xd9, 0xad, 0xcc, 0x4e, 0xb6, 0xbe, 0xdc, 0xbb, 0xa8, 0xdc, 0x3c, 0x32, 0xaa, 0x3d, 0x2f, 0x3a,
+	0x68, 0xd8, 0x37, 0x45, 0xe2, 0xd1, 0xc3, 0x77, 0x10, 0xf2, 0xa0, 0x29, 0x1d, 0x0f, 0x42, 0x16,
+	0x58, 0xf3, 0x5b, 0x99, 0x9d, 0xbc, 0x9d, 0x57, 0x92, 0x86, 0x12, 0xe0, 0xdb, 0x28, 0xdf, 0x66,
+	0x5d, 0xa3, 0x5d, 0xd0, 0xda, 0x5c, 0x9b, 0x75, 0x63, 0xe5, 0xe7, 0x19, 0x74, 0x3b, 0xe2, 0xd0,
+	0xa5, 0xac, 0x23, 0x1c, 0xe2, 0xba, 0x9d, 0xa0, 0xe3, 0xeb, 0x34, 0x39, 0x3a, 0x1f, 0xd6, 0x0d,
+	0xbd, 0xa7, 0x07, 0xe3, 0x7b, 0x32, 0xe1, 0xdf, 0x4d, 0x98, 0x1c, 0xd3, 0x00, 0xea, 0x5b, 0x66,
+	0x8f, 0xd6, 0x94, 0x05, 0xc2, 0xde, 0xe8, 0xf3, 0x8d, 0xa9, 0x30, 0x47, 0x25, 0xc9, 0x24, 0xf1,
+	0x9d, 0x88, 0xd3, 0xd0, 0xa5, 0x11, 0xf1, 0x85, 0x95, 0xd3, 0x1e, 0xdc, 0x9f, 0xea, 0xc1, 0xb1,
+	0x32, 0x38, 0xec, 0xaf, 0xaf, 0x97, 0x0d, 0xff, 0xda, 0x44, 0xb5, 0xb0, 0x8b, 0xf2, 0xb2, 0x00,
+	0x7f, 0x96, 0x41, 0x1b, 0x42, 0x92, 0x26, 0xf5, 0xa9, 0x3c, 0x77, 0x5a, 0x00, 0x0e, 0xf1, 0xfe,
+	0xdb, 0x11, 0x32, 0x80, 0x50, 0x0a, 0x2b, 0xaf, 0xd9, 0x77, 0xc6, 0xd9, 0x8f, 0xfa, 0x26, 0xfb,
+	0x00, 0xbb, 0x03, 0x83, 0x7a, 0xc5, 0xd0, 0xaf, 0x4f, 0xd6, 0x0b, 0x7b, 0x5d, 0x4c, 0x56, 0xe0,
+	0x6f, 0x33, 0xe8, 0xee, 0x20, 0x13, 0x97, 0x1d, 0xea, 0x44, 0x1e, 0x91, 0x60, 0x32, 0x82, 0xb4,
+	0x47, 0xb5, 0xa9, 0xf1, 0x48, 0x12, 0xff, 0x4b, 0x1b, 0xea, 0xbc, 0xdc, 0x33, 0x8e, 0x95, 0x3f,
+	0xb9, 0x4c, 0xd8, 0x95, 0xbe, 0x0f, 0x53, 0x16, 0x6c, 0xff, 0x98, 0x43, 0x0b, 0x71, 0x2d, 0xe1,
+	0x13, 0xb4, 0xec, 0x32, 0xdf, 0x27, 0x12, 0xb8, 0xca, 0x59, 0xbf, 0x00, 0x95, 0x7f, 0x7f, 0x99,
+	0x50, 0x4a, 0x83, 0xa5, 0xda, 0xbc, 0x6e, 0x19, 0x8f, 0x4a, 0x23, 0x0a, 0x61, 0x97, 0xdc, 0x11,
+	0x09, 0x7e, 0x6a, 0x8e, 0xb8, 0xe6, 0xb0, 0x66, 0x75, 0x8d, 0xdf, 0x9e, 0x54, 0x68, 0x4d, 0x19,
+	0x83, 0xc7, 0x65, 0x9e, 0xf7, 0xfa, 0x02, 0xfc, 0x1c, 0x2d, 0xb7, 0x7d, 0xd6, 0x24, 0xbe, 0xa3,
+	0x81, 0x7c, 0x1a, 0x50, 0x69, 0xcd, 0x69, 0xa0, 0x8d, 0xaa, 0xe9, 0x67, 0xaa, 0xf9, 0x25, 0xdc,
+	0xa5, 0xa1, 0x81, 0x29, 0xc6, 0x96, 0x0a, 0xfd, 0x85, 0xb2, 0xc3, 0x67, 0x68, 0x43, 0x74, 0x78,
+	0xe4, 0xab, 0x9a, 0xe9, 0xb8, 0x71, 0xb9, 0x9c, 0x70, 0x10, 0x27, 0xcc, 0x8f, 0xcb, 0x36, 0x5f,
+	0xff, 0xbb, 0xb2, 0xfc, 0xe5, 0xa2, 0x72, 0xaf, 0x4d, 0xe5, 0x49, 0xa7, 0x59, 0x75, 0x59, 0x60,
+	0xda, 0xa6, 0xf9, 0xf7, 0x50, 0x78, 0xa7, 0x35, 0x79, 0x1e, 0x81, 0xa8, 0x1e, 0x84, 0xf2, 0xa7,
+	0xef, 0x1f, 0x22, 0xe3, 0xc5, 0x41, 0x28, 0xed, 0x75, 0x03, 0xbf, 0x1b, 0xa3, 0x1f, 0xf7, 0xc1,
+	0xb1, 0x8f, 0x56, 0x46, 0x99, 0x7d, 0x26, 0xad, 0xf9, 0x14, 0x38, 0x97, 0x2f, 0x73, 0xbe, 0x60,
+	0x12, 0x73, 0xb4, 0xa6, 0xa3, 0x35, 0xbe, 0xc9, 0x85, 0x14, 0x08, 0x57, 0x15, 0xf6, 0xd8, 0x0e,
+	0x5b, 0xa8, 0x74, 0x89, 0x53, 0x6d, 0xef, 0x46, 0x0a, 0x6c, 0x4b, 0x09, 0x36, 0xb5, 0xb7, 0xfb,
+	0xa8, 0xe8, 0x52, 0xee, 0x76, 0xa8, 0x74, 0x9a, 0x1c, 0xc8, 0x29, 0x70, 0x2b, 0xb7, 0x95, 0xd9,
+	0xc9, 0xd9, 0x4b, 0x46, 0x5c, 0x8f, 0xa5, 0x13, 0x1a, 0x84, 0xcb, 0x42, 0xc9, 0x99, 0xef, 0x03,
+	0xbf, 0x62, 0x83, 0xd8, 0x1b, 0x18, 0x4c, 0x6e, 0x10, 0x43, 0xfd, 0x48, 0x83, 0x48, 0x28, 0x70,
+	0x13, 0x2d, 0xb5, 0x7c, 0x22, 0x4e, 0x9c, 0x80, 0x86, 0xd2, 0x71, 0x49, 0x64, 0xa1, 0x14, 0xc2,
+	0x52, 0xd0, 0x98, 0x2f, 0x69, 0x28, 0xf7, 0x48, 0x34, 0xc2, 0xd1, 0x02, 0xb0, 0x16, 0xaf, 0xcd,
+	0xd1, 0x00, 0x37, 0xc1, 0xd1, 0x00, 0x37, 0xc1, 0xb1, 0x0f, 0xb0, 0xfd, 0xd5, 0x2c, 0xca, 0x0f,
+	0x0a, 0x15, 0xaf, 0xa2, 0xf9, 0xf8, 0xcd, 0x94, 0xd1, 0x6f, 0xa6, 0xf8, 0x41, 0x25, 0x87, 0x43,
+	0x0b, 0x38, 0x84, 0x2e, 0x38, 0x44, 0x08, 0x90, 0xba, 0xe8, 0xf3, 0xf6, 0xd2, 0x40, 0xbc, 0xab,
+	0xa4, 0x98, 0xaa, 0x16, 0x14, 0x76, 0x81, 0x0b, 0x75, 0x56, 0x5a, 0xc4, 0x95, 0x8c, 0x5b, 0x73,
+	0xd7, 0xf6, 0x79, 0x3c, 0x2e, 0xa5, 0x21, 0xec, 0xbe, 0x46, 0xc5, 0xff, 0x31, 0x3d, 0xa8, 0xe5,
+	0x33, 0xc6, 0x53, 0xa9, 0x72, 0xdd, 0x9e, 0xf6, 0x15, 0xdc, 0xf6, 0x97, 0x39, 0x54, 0x1c, 0xe9,
+	0x83, 0x53, 0x42, 0x83, 0x51, 0x56, 0xe1, 0x99, 0x78, 0xe8, 0xdf, 0x2a, 0x0a, 0x3e, 0xfd, 0x5f,
+	0x87, 0x7a, 0xf1, 0xab, 0x5b, 0x5f, 0xb4, 0xac, 0xb9, 0x14, 0x32, 0x57, 0x4a, 0xc0, 0xda, 0xea,
+	0x2f, 0xfe, 0x07, 0x42, 0x89, 0x06, 0x9a, 0xbd, 0x5a, 0x03, 0xcd, 0x7b, 0x83, 0xd6, 0x49, 0xd0,
+	0xcd, 0x4b, 0xc5, 0x64, 0xcd, 0xa7, 0xe0, 0x66, 0x21, 0x59, 0x33, 0xd8, 0x41, 0x85, 0x7e, 0xf3,
+	0x10, 0xf4, 0x35, 0xa4, 0xd2, 0xab, 0x16, 0x0d, 0xe2, 0x11, 0x7d, 0x0d, 0x38, 0x40, 0x2b, 0xc9,
+	0x70, 0x47, 0x10, 0x12, 0x5f, 0x9e, 0x5b, 0x37, 0x52, 0xd8, 0x09, 0x4e, 0x00, 0x1f, 0xc6, 0xb8,
+	0xf8, 0x09, 0x5a, 0x12, 0x11, 0x93, 0x4e, 0x40, 0xf8, 0x29, 0x48, 0x75, 0x33, 0xcc, 0x69, 0xa6,
+	0x52, 0xef, 0xa2, 0x52, 0x38, 0x8a, 0x98, 0x7c, 0xa9, 0x15, 0x07, 0x0d, 0xbb, 0x20, 0x86, 0x4f,
+	0x1e, 0x7e, 0x8e, 0x6e, 0x25, 0xdd, 0x1c, 0x9a, 0xe7, 0xb5, 0xf9, 0x7a, 0xef, 0xa2, 0xb2, 0xf2,
+	0x62, 0xb8, 0x60, 0x80, 0xb2, 0xe2, 0x8f, 0x09, 0x3d, 0xdc, 0x45, 0xd6, 0x29, 0x40, 0x04, 0xdc,
+	0xe1, 0xf0, 0x7f, 0xc2, 0x3d, 0x27, 0x02, 0xee, 0x42, 0x28, 0x49, 0x1b, 0x2c, 0x94, 0xc2, 0xc6,
+	0xd7, 0x62, 0x74, 0x5b, 0x83, 0x1f, 0x0e, 0xb0, 0xd5, 0x05, 0xf5, 0xae, 0x7b, 0x02, 0xee, 0xa9,
+	0x33, 0xbc, 0x14, 0xd0, 0xd7, 0xf1, 0x8e, 0x68, 0xe8, 0xc1, 0x99, 0xe3, 0xb2, 0x4e, 0x28, 0xad,
+	0xc5, 0x14, 0x92, 0xbc, 0xa5, 0x89, 0xf6, 0x46, 0x79, 0x0e, 0x14, 0xcd, 0x9e, 0x62, 0x99, 0xdc,
+	0x6e, 0x0a, 0x7f, 0x46, 0xbb, 0xd9, 0xfe, 0x61, 0x1e, 0xad, 0x4d, 0x7e, 0x47, 0xe8, 0x57, 0xd7,
+	0xf0, 0xde, 0xa5, 0xbb, 0x41, 0xdc, 0x22, 0x96, 0x86, 0xe2, 0x63, 0xd5, 0x17, 0x1e, 0xa0, 0xfc,
+	0x30, 0xeb, 0xba, 0x61, 0xd4, 0x0b, 0xbd, 0x8b, 0x4a, 0x6e, 0x90, 0xea, 0x5c, 0xd0, 0xcf, 0xaf,
+	0x83, 0x0a, 0x92, 0xf0, 0x36, 0x48, 0x75, 0xf7, 0x76, 0x21, 0x95, 0xee, 0xb1, 0x18, 0x23, 0x1e,
+	0x2a, 0x40, 0xfc, 0x0a, 0xe5, 0x25, 0xf3, 0x81, 0x93, 0xd0, 0x05, 0x2b, 0x9b, 0x02, 0xfa, 0x10,
+	0x0e, 0x03, 0x2a, 0x0e, 0x2f, 0xed, 0x8e, 0x90, 0x10, 0xa5, 0xd2, 0x56, 0x96, 0x86, 0xa0, 0x47,
+	0x12, 0x22, 0x7c, 0x8c, 0x56, 0x12, 0x34, 0x34, 0x94, 0xc0, 0xbb, 0xc4, 0xb7, 0x16, 0x4c, 0x13,
+	0x8c, 0x27, 0xda, 0x6a, 0x7f, 0xa2, 0xad, 0x36, 0xcc, 0xc4, 0x5b, 0xcf, 0x29, 0x2f, 0xbe, 0xfe,
+	0x50, 0xc9, 0xd8, 0x78, 0x68, 0x7f, 0x60, 0xcc, 0xd5, 0x2d, 0x3a, 0xa0, 0xe1, 0xe5, 0x2b, 0x7f,
+	0x2a, 0xbd, 0xa4, 0x18, 0xd0, 0x30, 0x79, 0x82, 0x34, 0x13, 0x39, 0x1b, 0x61, 0xca, 0xa5, 0xc2,
+	0x44, 0xce, 0x92, 0x4c, 0xdb, 0x5f, 0xcc, 0xa2, 0xf5, 0x29, 0x03, 0xe0, 0xd5, 0x4f, 0x6f, 0x13,
+	0x6d, 0x4e, 0x1f, 0x4d, 0xcd, 0x10, 0xb0, 0x39, 0x16, 0xf5, 0xe3, 0xfe, 0x77, 0x84, 0x38, 0xec,
+	0x6f, 0x54, 0xd8, 0xad, 0x69, 0x23, 0xa7, 0x3a, 0x39, 0x3a, 0x8f, 0x20, 0xe4, 0x1f, 0xbf, 0x3d,
+	0x4c, 0x38, 0x39, 0x7d, 0x50, 0x53, 0xcc, 0xdf, 0x65, 0xd0, 0xad, 0x89, 0x03, 0xe9, 0xd5, 0xa3,
+	0x01, 0xa8, 0x38, 0x32, 0x1b, 0x5b, 0xb3, 0xd7, 0xf6, 0x74, 0xc2, 0xb5, 0xf8, 0xf2, 0x3c, 0xbc,
+	0xfd, 0x4d, 0x06, 0xdd, 0xf9, 0xe4, 0x88, 0x78, 0x75, 0x8f, 0xff, 0x8d, 0x56, 0x07, 0xf9, 0x4b,
+	0x8c, 0xb0, 0xd7, 0xca, 0x1c, 0xee, 0x23, 0x24, 0x46, 0xd9, 0xa7, 0xef, 0x7a, 0xe5, 0xcc, 0xfb,
+	0x5e, 0x39, 0xf3, 0x6b, 0xaf, 0x9c, 0x79, 0xf3, 0xb1, 0x3c, 0xf3, 0xfe, 0x63, 0x79, 0xe6, 0xe7,
+	0x8f, 0xe5, 0x99, 0x57, 0xc9, 0x10, 0x04, 0xa4, 0x0d, 0x0f, 0x5d, 0xd6, 0x85, 0xb0, 0xa6, 0xbf,
+	0x24, 0x9d, 0xe9, 0x6f, 0x49, 0x3a, 0x0c, 0xcd, 0x05, 0xcd, 0xf9, 0xd7, 0xdf, 0x07, 0x00, 0xf0,
+	0xe7, 0xd9, 0xf2, 0x28, 0x13, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -553,6 +732,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreviousStabilityFeeUpdateTimes) > 0 {
+		for iNdEx := len(m.PreviousStabilityFeeUpdateTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousStabilityFeeUpdateTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for iNdEx := len(m.StabilityFeeAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -661,6 +868,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FlashMintFee.Size()
+		i -= size
+		if _, err := m.FlashMintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FlashMintCap.Size()
+		i -= size
+		if _, err := m.FlashMintCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.StabilityFeeControllers) > 0 {
+		for iNdEx := len(m.StabilityFeeControllers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeControllers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CircuitBreaker {
 		i--
 		if m.CircuitBreaker {
@@ -936,6 +1177,101 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StabilityFeeController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxStabilityFee.Size()
+		i -= size
+		if _, err := m.MaxStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinStabilityFee.Size()
+		i -= size
+		if _, err := m.MinStabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AdjustmentInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdjustmentInterval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AdjustmentStep.Size()
+		i -= size
+		if _, err := m.AdjustmentStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Tolerance.Size()
+		i -= size
+		if _, err := m.Tolerance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetPrice.Size()
+		i -= size
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -966,12 +1302,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -1024,6 +1360,44 @@ func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisStabilityFeeUpdateTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisStabilityFeeUpdateTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStabilityFeeUpdateTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousUpdateTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -1078,6 +1452,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for _, e := range m.StabilityFeeAdjustments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousStabilityFeeUpdateTimes) > 0 {
+		for _, e := range m.PreviousStabilityFeeUpdateTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1108,6 +1494,16 @@ func (m *Params) Size() (n int) {
 	if m.CircuitBreaker {
 		n += 2
 	}
+	if len(m.StabilityFeeControllers) > 0 {
+		for _, e := range m.StabilityFeeControllers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FlashMintCap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FlashMintFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1173,7 +1569,7 @@ func (m *CollateralParam) Size() (n int) {
 	return n
 }
 
-func (m *GenesisAccumulationTime) Size() (n int) {
+func (m *StabilityFeeController) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1183,7 +1579,36 @@ func (m *GenesisAccumulationTime) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime)
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Tolerance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AdjustmentStep.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdjustmentInterval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxStabilityFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisAccumulationTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime)
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.InterestFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -1205,6 +1630,21 @@ func (m *GenesisTotalPrincipal) Size() (n int) {
 	return n
 }
 
+func (m *GenesisStabilityFeeUpdateTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousUpdateTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1492,6 +1932,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeAdjustments = append(m.StabilityFeeAdjustments, StabilityFeeAdjustment{})
+			if err := m.StabilityFeeAdjustments[len(m.StabilityFeeAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStabilityFeeUpdateTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStabilityFeeUpdateTimes = append(m.PreviousStabilityFeeUpdateTimes, GenesisStabilityFeeUpdateTime{})
+			if err := m.PreviousStabilityFeeUpdateTimes[len(m.PreviousStabilityFeeUpdateTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1798,6 +2306,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CircuitBreaker = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeControllers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeControllers = append(m.StabilityFeeControllers, StabilityFeeController{})
+			if err := m.StabilityFeeControllers[len(m.StabilityFeeControllers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashMintCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashMintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2450,7 +3060,7 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
+func (m *StabilityFeeController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2473,10 +3083,10 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisAccumulationTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: StabilityFeeController: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisAccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StabilityFeeController: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2513,9 +3123,9 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2525,28 +3135,27 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2574,36 +3183,354 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+			if err := m.Tolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AdjustmentInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccumulationTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2715,6 +3642,121 @@ func (m *GenesisTotalPrincipal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisStabilityFeeUpdateTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisStabilityFeeUpdateTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisStabilityFeeUpdateTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -48,17 +49,21 @@ var sep = []byte(":")
 
 // KVStore key prefixes
 var (
-	CdpIDKeyPrefix             = []byte{0x01}
-	CdpKeyPrefix               = []byte{0x02}
-	CollateralRatioIndexPrefix = []byte{0x03}
-	CdpIDKey                   = []byte{0x04}
-	DebtDenomKey               = []byte{0x05}
-	GovDenomKey                = []byte{0x06}
-	DepositKeyPrefix           = []byte{0x07}
-	PrincipalKeyPrefix         = []byte{0x08}
-	PricefeedStatusKeyPrefix   = []byte{0x10}
-	PreviousAccrualTimePrefix  = []byte{0x12}
-	InterestFactorPrefix       = []byte{0x13}
+	CdpIDKeyPrefix                       = []byte{0x01}
+	CdpKeyPrefix                         = []byte{0x02}
+	CollateralRatioIndexPrefix           = []byte{0x03}
+	CdpIDKey                             = []byte{0x04}
+	DebtDenomKey                         = []byte{0x05}
+	GovDenomKey                          = []byte{0x06}
+	DepositKeyPrefix                     = []byte{0x07}
+	PrincipalKeyPrefix                   = []byte{0x08}
+	PricefeedStatusKeyPrefix             = []byte{0x10}
+	PreviousAccrualTimePrefix            = []byte{0x12}
+	InterestFactorPrefix                 = []byte{0x13}
+	StabilityFeeAdjustmentPrefix         = []byte{0x14}
+	PreviousStabilityFeeUpdateTimePrefix = []byte{0x15}
+	OutstandingFlashMintKey              = []byte{0x16}
+	AccruedStabilityFeesKey              = []byte{0x17}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -95,6 +100,11 @@ func SplitDenomIterKey(key []byte) string {
 	return string(split[0])
 }
 
+// StabilityFeeAdjustmentKey returns the key of a stability fee adjustment, adjustments of a collateral type are ordered by time
+func StabilityFeeAdjustmentKey(collateralType string, adjustmentTime time.Time) []byte {
+	return createKey(DenomIterKey(collateralType), sdk.FormatTimeBytes(adjustmentTime))
+}
+
 // DepositKey key of a specific deposit in the store
 func DepositKey(cdpID uint64, depositor sdk.AccAddress) []byte {
 	return createKey(GetCdpIDBytes(cdpID), sep, depositor)
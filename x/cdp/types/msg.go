@@ -6,8 +6,12 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/mage-coven/fury/x/flash"
 )
 
 // ensure Msg interface compliance at compile time
@@ -18,6 +22,9 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgFlashMint{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgFlashMint{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -310,3 +317,76 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgFlashMint returns a new MsgFlashMint
+func NewMsgFlashMint(sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) (MsgFlashMint, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgFlashMint{}, err
+	}
+	return MsgFlashMint{
+		Sender: sender.String(),
+		Amount: amount,
+		Msgs:   anys,
+	}, nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashMint) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashMint) Type() string { return "flash_mint" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashMint) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash mint amount %s", msg.Amount)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "flash mint must contain at least one message")
+	}
+	for _, m := range msgs {
+		if err := flash.ValidateMsg(m, sender); err != nil {
+			return err
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgFlashMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetMessages returns the cache values from the MsgFlashMint.Msgs if present.
+func (msg MsgFlashMint) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "fury.cdp.v1beta1.MsgFlashMint")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashMint) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var (
@@ -167,3 +169,38 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgFlashMint(t *testing.T) {
+	execFlashMint := authz.NewMsgExec(addrs[0], []sdk.Msg{&MsgFlashMint{Sender: addrs[0].String(), Amount: coinsSingle}})
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		msgs        []sdk.Msg
+		expectPass  bool
+	}{
+		{"flash mint", addrs[0], coinsSingle, []sdk.Msg{&MsgRepayDebt{Sender: addrs[0].String(), CollateralType: "type-a", Payment: coinsSingle}}, true},
+		{"flash mint no amount", addrs[0], coinsZero, []sdk.Msg{&MsgRepayDebt{Sender: addrs[0].String(), CollateralType: "type-a", Payment: coinsSingle}}, false},
+		{"flash mint empty sender", sdk.AccAddress{}, coinsSingle, []sdk.Msg{&MsgRepayDebt{Sender: addrs[0].String(), CollateralType: "type-a", Payment: coinsSingle}}, false},
+		{"flash mint no msgs", addrs[0], coinsSingle, []sdk.Msg{}, false},
+		{"flash mint other signer", addrs[0], coinsSingle, []sdk.Msg{&MsgRepayDebt{Sender: addrs[1].String(), CollateralType: "type-a", Payment: coinsSingle}}, false},
+		{"flash mint invalid msg", addrs[0], coinsSingle, []sdk.Msg{&MsgRepayDebt{Sender: addrs[0].String(), CollateralType: "type-a", Payment: coinsZero}}, false},
+		{"flash mint nested", addrs[0], coinsSingle, []sdk.Msg{&MsgFlashMint{Sender: addrs[0].String(), Amount: coinsSingle}}, false},
+		{"flash mint in authz exec", addrs[0], coinsSingle, []sdk.Msg{&execFlashMint}, false},
+		{"flash mint msg type not allowed", addrs[0], coinsSingle, []sdk.Msg{&banktypes.MsgMultiSend{Inputs: []banktypes.Input{banktypes.NewInput(addrs[0], sdk.NewCoins(coinsSingle))}}}, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := NewMsgFlashMint(
+			tc.sender,
+			tc.amount,
+			tc.msgs,
+		)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
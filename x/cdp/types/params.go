@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -13,18 +14,22 @@ import (
 
 // Parameter keys
 var (
-	KeyGlobalDebtLimit      = []byte("GlobalDebtLimit")
-	KeyCollateralParams     = []byte("CollateralParams")
-	KeyDebtParam            = []byte("DebtParam")
-	KeyCircuitBreaker       = []byte("CircuitBreaker")
-	KeyDebtThreshold        = []byte("DebtThreshold")
-	KeyDebtLot              = []byte("DebtLot")
-	KeySurplusThreshold     = []byte("SurplusThreshold")
-	KeySurplusLot           = []byte("SurplusLot")
-	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
-	DefaultCircuitBreaker   = false
-	DefaultCollateralParams = CollateralParams{}
-	DefaultDebtParam        = DebtParam{
+	KeyGlobalDebtLimit             = []byte("GlobalDebtLimit")
+	KeyCollateralParams            = []byte("CollateralParams")
+	KeyDebtParam                   = []byte("DebtParam")
+	KeyCircuitBreaker              = []byte("CircuitBreaker")
+	KeyDebtThreshold               = []byte("DebtThreshold")
+	KeyDebtLot                     = []byte("DebtLot")
+	KeySurplusThreshold            = []byte("SurplusThreshold")
+	KeySurplusLot                  = []byte("SurplusLot")
+	KeyStabilityFeeControl         = []byte("StabilityFeeControllers")
+	KeyFlashMintCap                = []byte("FlashMintCap")
+	KeyFlashMintFee                = []byte("FlashMintFee")
+	DefaultGlobalDebt              = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
+	DefaultCircuitBreaker          = false
+	DefaultCollateralParams        = CollateralParams{}
+	DefaultStabilityFeeControllers = StabilityFeeControllers{}
+	DefaultDebtParam               = DebtParam{
 		Denom:            "usdx",
 		ReferenceAsset:   "usd",
 		ConversionFactor: sdkmath.NewInt(6),
@@ -38,13 +43,15 @@ var (
 	DefaultDebtThreshold    = sdkmath.NewInt(100000000000)
 	DefaultSurplusLot       = sdkmath.NewInt(10000000000)
 	DefaultDebtLot          = sdkmath.NewInt(10000000000)
+	DefaultFlashMintCap     = sdkmath.ZeroInt()
+	DefaultFlashMintFee     = sdk.MustNewDecFromStr("0.001")
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 )
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool, flashMintCap sdkmath.Int, flashMintFee sdk.Dec,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -55,6 +62,9 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		StabilityFeeControllers: DefaultStabilityFeeControllers,
+		FlashMintCap:            flashMintCap,
+		FlashMintFee:            flashMintFee,
 	}
 }
 
@@ -63,7 +73,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultFlashMintCap, DefaultFlashMintFee,
 	)
 }
 
@@ -91,6 +101,89 @@ func NewCollateralParam(
 // CollateralParams array of CollateralParam
 type CollateralParams []CollateralParam
 
+// Get returns the collateral param with matching collateral type
+func (cps CollateralParams) Get(collateralType string) (CollateralParam, bool) {
+	for _, cp := range cps {
+		if cp.Type == collateralType {
+			return cp, true
+		}
+	}
+	return CollateralParam{}, false
+}
+
+// NewStabilityFeeController returns a new StabilityFeeController
+func NewStabilityFeeController(
+	collateralType, marketID string, targetPrice, tolerance, adjustmentStep sdk.Dec, adjustmentInterval time.Duration,
+	minFee, maxFee sdk.Dec,
+) StabilityFeeController {
+	return StabilityFeeController{
+		CollateralType:     collateralType,
+		MarketID:           marketID,
+		TargetPrice:        targetPrice,
+		Tolerance:          tolerance,
+		AdjustmentStep:     adjustmentStep,
+		AdjustmentInterval: adjustmentInterval,
+		MinStabilityFee:    minFee,
+		MaxStabilityFee:    maxFee,
+	}
+}
+
+// ClampFee limits the input stability fee to the controller's bounds
+func (sfc StabilityFeeController) ClampFee(fee sdk.Dec) sdk.Dec {
+	if fee.LT(sfc.MinStabilityFee) {
+		return sfc.MinStabilityFee
+	}
+	if fee.GT(sfc.MaxStabilityFee) {
+		return sfc.MaxStabilityFee
+	}
+	return fee
+}
+
+// Validate performs basic validation of the stability fee controller
+func (sfc StabilityFeeController) Validate() error {
+	if strings.TrimSpace(sfc.CollateralType) == "" {
+		return fmt.Errorf("stability fee controller collateral type cannot be blank")
+	}
+	if strings.TrimSpace(sfc.MarketID) == "" {
+		return fmt.Errorf("stability fee controller market id cannot be blank for %s", sfc.CollateralType)
+	}
+	if sfc.TargetPrice.IsNil() || !sfc.TargetPrice.IsPositive() {
+		return fmt.Errorf("stability fee controller target price must be positive for %s", sfc.CollateralType)
+	}
+	if sfc.Tolerance.IsNil() || sfc.Tolerance.IsNegative() {
+		return fmt.Errorf("stability fee controller tolerance cannot be negative for %s", sfc.CollateralType)
+	}
+	if sfc.AdjustmentStep.IsNil() || !sfc.AdjustmentStep.IsPositive() {
+		return fmt.Errorf("stability fee controller adjustment step must be positive for %s", sfc.CollateralType)
+	}
+	if sfc.AdjustmentInterval <= 0 {
+		return fmt.Errorf("stability fee controller adjustment interval must be positive, is %s for %s", sfc.AdjustmentInterval, sfc.CollateralType)
+	}
+	if sfc.MinStabilityFee.IsNil() || sfc.MinStabilityFee.LT(sdk.OneDec()) {
+		return fmt.Errorf("min stability fee must be ≥ 1.0, is %s for %s", sfc.MinStabilityFee, sfc.CollateralType)
+	}
+	if sfc.MaxStabilityFee.IsNil() || sfc.MaxStabilityFee.GT(stabilityFeeMax) {
+		return fmt.Errorf("max stability fee must be ≤ %s, is %s for %s", stabilityFeeMax, sfc.MaxStabilityFee, sfc.CollateralType)
+	}
+	if sfc.MinStabilityFee.GT(sfc.MaxStabilityFee) {
+		return fmt.Errorf("min stability fee %s cannot exceed max stability fee %s for %s", sfc.MinStabilityFee, sfc.MaxStabilityFee, sfc.CollateralType)
+	}
+	return nil
+}
+
+// StabilityFeeControllers array of StabilityFeeController
+type StabilityFeeControllers []StabilityFeeController
+
+// Get returns the stability fee controller for the input collateral type
+func (sfcs StabilityFeeControllers) Get(collateralType string) (StabilityFeeController, bool) {
+	for _, sfc := range sfcs {
+		if sfc.CollateralType == collateralType {
+			return sfc, true
+		}
+	}
+	return StabilityFeeController{}, false
+}
+
 // NewDebtParam returns a new DebtParam
 func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdkmath.Int) DebtParam {
 	return DebtParam{
@@ -122,6 +215,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		paramtypes.NewParamSetPair(KeyStabilityFeeControl, &p.StabilityFeeControllers, validateStabilityFeeControllersParam),
+		paramtypes.NewParamSetPair(KeyFlashMintCap, &p.FlashMintCap, validateFlashMintCapParam),
+		paramtypes.NewParamSetPair(KeyFlashMintFee, &p.FlashMintFee, validateFlashMintFeeParam),
 	}
 }
 
@@ -159,6 +255,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStabilityFeeControllersParam(p.StabilityFeeControllers); err != nil {
+		return err
+	}
+
+	if err := validateFlashMintCapParam(p.FlashMintCap); err != nil {
+		return err
+	}
+
+	if err := validateFlashMintFeeParam(p.FlashMintFee); err != nil {
+		return err
+	}
+
+	for _, sfc := range p.StabilityFeeControllers {
+		if _, found := p.CollateralParams.Get(sfc.CollateralType); !found {
+			return fmt.Errorf("stability fee controller set for unknown collateral type %s", sfc.CollateralType)
+		}
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -295,6 +409,26 @@ func validateCircuitBreakerParam(i interface{}) error {
 	return nil
 }
 
+func validateStabilityFeeControllersParam(i interface{}) error {
+	controllers, ok := i.(StabilityFeeControllers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	typeDupMap := make(map[string]bool)
+	for _, sfc := range controllers {
+		if err := sfc.Validate(); err != nil {
+			return err
+		}
+		if typeDupMap[sfc.CollateralType] {
+			return fmt.Errorf("duplicate stability fee controller for collateral type %s", sfc.CollateralType)
+		}
+		typeDupMap[sfc.CollateralType] = true
+	}
+
+	return nil
+}
+
 func validateSurplusAuctionThresholdParam(i interface{}) error {
 	sat, ok := i.(sdkmath.Int)
 	if !ok {
@@ -346,3 +480,37 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validateFlashMintCapParam(i interface{}) error {
+	fmc, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fmc.IsNil() {
+		return fmt.Errorf("flash mint cap cannot be nil")
+	}
+
+	if fmc.IsNegative() {
+		return fmt.Errorf("flash mint cap should not be negative: %s", fmc)
+	}
+
+	return nil
+}
+
+func validateFlashMintFeeParam(i interface{}) error {
+	fmf, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fmf.IsNil() {
+		return fmt.Errorf("flash mint fee cannot be nil")
+	}
+
+	if fmf.IsNegative() || fmf.GTE(sdk.OneDec()) {
+		return fmt.Errorf("flash mint fee should be between 0 and 1: %s", fmf)
+	}
+
+	return nil
+}
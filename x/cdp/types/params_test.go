@@ -28,6 +28,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		debtThreshold    sdkmath.Int
 		debtLot          sdkmath.Int
 		breaker          bool
+		flashMintCap     sdkmath.Int
+		flashMintFee     sdk.Dec
 	}
 	type errArgs struct {
 		expectPass bool
@@ -50,6 +52,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -87,6 +91,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -124,6 +130,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -161,6 +169,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -212,6 +222,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -263,6 +275,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -314,6 +328,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -350,6 +366,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -387,6 +405,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -438,6 +458,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -489,6 +511,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -526,6 +550,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -563,6 +589,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -600,6 +628,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -637,6 +667,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -669,6 +701,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -706,6 +740,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -723,6 +759,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -740,6 +778,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -757,6 +797,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    sdk.ZeroInt(),
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -774,6 +816,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          types.DefaultDebtLot,
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -791,16 +835,56 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtThreshold:    types.DefaultDebtThreshold,
 				debtLot:          sdk.ZeroInt(),
 				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     types.DefaultFlashMintFee,
 			},
 			errArgs: errArgs{
 				expectPass: false,
 				contains:   "debt auction lot should be positive",
 			},
 		},
+		{
+			name: "negative flash mint cap",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     sdkmath.NewInt(-1),
+				flashMintFee:     types.DefaultFlashMintFee,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "flash mint cap should not be negative",
+			},
+		},
+		{
+			name: "flash mint fee of one",
+			args: args{
+				globalDebtLimit:  types.DefaultGlobalDebt,
+				collateralParams: types.DefaultCollateralParams,
+				debtParam:        types.DefaultDebtParam,
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+				flashMintCap:     types.DefaultFlashMintCap,
+				flashMintFee:     sdk.OneDec(),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "flash mint fee should be between 0 and 1",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.flashMintCap, tc.args.flashMintFee)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
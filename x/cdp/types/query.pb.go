@@ -45,7 +45,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{0}
+	return fileDescriptor_f8caaf4da7412dac, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +83,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{1}
+	return fileDescriptor_f8caaf4da7412dac, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +120,7 @@ func (m *QueryAccountsRequest) Reset()         { *m = QueryAccountsRequest{} }
 func (m *QueryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsRequest) ProtoMessage()    {}
 func (*QueryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{2}
+	return fileDescriptor_f8caaf4da7412dac, []int{2}
 }
 func (m *QueryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +158,7 @@ func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
 func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsResponse) ProtoMessage()    {}
 func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{3}
+	return fileDescriptor_f8caaf4da7412dac, []int{3}
 }
 func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +204,7 @@ func (m *QueryCdpRequest) Reset()         { *m = QueryCdpRequest{} }
 func (m *QueryCdpRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpRequest) ProtoMessage()    {}
 func (*QueryCdpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{4}
+	return fileDescriptor_f8caaf4da7412dac, []int{4}
 }
 func (m *QueryCdpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +256,7 @@ func (m *QueryCdpResponse) Reset()         { *m = QueryCdpResponse{} }
 func (m *QueryCdpResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpResponse) ProtoMessage()    {}
 func (*QueryCdpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{5}
+	return fileDescriptor_f8caaf4da7412dac, []int{5}
 }
 func (m *QueryCdpResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +306,7 @@ func (m *QueryCdpsRequest) Reset()         { *m = QueryCdpsRequest{} }
 func (m *QueryCdpsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpsRequest) ProtoMessage()    {}
 func (*QueryCdpsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{6}
+	return fileDescriptor_f8caaf4da7412dac, []int{6}
 }
 func (m *QueryCdpsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +380,7 @@ func (m *QueryCdpsResponse) Reset()         { *m = QueryCdpsResponse{} }
 func (m *QueryCdpsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpsResponse) ProtoMessage()    {}
 func (*QueryCdpsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{7}
+	return fileDescriptor_f8caaf4da7412dac, []int{7}
 }
 func (m *QueryCdpsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +433,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{8}
+	return fileDescriptor_f8caaf4da7412dac, []int{8}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +485,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{9}
+	return fileDescriptor_f8caaf4da7412dac, []int{9}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +530,7 @@ func (m *QueryTotalPrincipalRequest) Reset()         { *m = QueryTotalPrincipalR
 func (m *QueryTotalPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalRequest) ProtoMessage()    {}
 func (*QueryTotalPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{10}
+	return fileDescriptor_f8caaf4da7412dac, []int{10}
 }
 func (m *QueryTotalPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +575,7 @@ func (m *QueryTotalPrincipalResponse) Reset()         { *m = QueryTotalPrincipal
 func (m *QueryTotalPrincipalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalResponse) ProtoMessage()    {}
 func (*QueryTotalPrincipalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{11}
+	return fileDescriptor_f8caaf4da7412dac, []int{11}
 }
 func (m *QueryTotalPrincipalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +620,7 @@ func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollatera
 func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralRequest) ProtoMessage()    {}
 func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{12}
+	return fileDescriptor_f8caaf4da7412dac, []int{12}
 }
 func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +665,7 @@ func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollater
 func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralResponse) ProtoMessage()    {}
 func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{13}
+	return fileDescriptor_f8caaf4da7412dac, []int{13}
 }
 func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,6 +701,239 @@ func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollaterals {
 	return nil
 }
 
+// QueryStabilityFeeAdjustmentsRequest defines the request type for the Query/StabilityFeeAdjustments RPC method.
+type QueryStabilityFeeAdjustmentsRequest struct {
+	// collateral_type optionally restricts the adjustments to a single collateral type
+	CollateralType string             `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) Reset()         { *m = QueryStabilityFeeAdjustmentsRequest{} }
+func (m *QueryStabilityFeeAdjustmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeAdjustmentsRequest) ProtoMessage()    {}
+func (*QueryStabilityFeeAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{14}
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.Merge(m, src)
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest proto.InternalMessageInfo
+
+func (m *QueryStabilityFeeAdjustmentsRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStabilityFeeAdjustmentsResponse defines the response type for the Query/StabilityFeeAdjustments RPC method.
+type QueryStabilityFeeAdjustmentsResponse struct {
+	StabilityFeeAdjustments StabilityFeeAdjustments `protobuf:"bytes,1,rep,name=stability_fee_adjustments,json=stabilityFeeAdjustments,proto3,castrepeated=StabilityFeeAdjustments" json:"stability_fee_adjustments"`
+	Pagination              *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) Reset()         { *m = QueryStabilityFeeAdjustmentsResponse{} }
+func (m *QueryStabilityFeeAdjustmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeAdjustmentsResponse) ProtoMessage()    {}
+func (*QueryStabilityFeeAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{15}
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.Merge(m, src)
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse proto.InternalMessageInfo
+
+func (m *QueryStabilityFeeAdjustmentsResponse) GetStabilityFeeAdjustments() StabilityFeeAdjustments {
+	if m != nil {
+		return m.StabilityFeeAdjustments
+	}
+	return nil
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySimulateCdpRequest defines the request type for the Query/SimulateCdp RPC method.
+type QuerySimulateCdpRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// owner of an existing CDP, a new CDP is simulated when empty or the owner has no CDP
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// collateral amount to deposit, sdk.Int as a string
+	Deposit string `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// principal amount to draw, sdk.Int as a string
+	Draw string `protobuf:"bytes,4,opt,name=draw,proto3" json:"draw,omitempty"`
+	// relative change of the collateral price, sdk.Dec as a string (e.g. "-0.1" for a 10% drop)
+	PriceChange string `protobuf:"bytes,5,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+}
+
+func (m *QuerySimulateCdpRequest) Reset()         { *m = QuerySimulateCdpRequest{} }
+func (m *QuerySimulateCdpRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCdpRequest) ProtoMessage()    {}
+func (*QuerySimulateCdpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{16}
+}
+func (m *QuerySimulateCdpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCdpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCdpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCdpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCdpRequest.Merge(m, src)
+}
+func (m *QuerySimulateCdpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCdpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCdpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCdpRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateCdpRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *QuerySimulateCdpRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySimulateCdpRequest) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
+func (m *QuerySimulateCdpRequest) GetDraw() string {
+	if m != nil {
+		return m.Draw
+	}
+	return ""
+}
+
+func (m *QuerySimulateCdpRequest) GetPriceChange() string {
+	if m != nil {
+		return m.PriceChange
+	}
+	return ""
+}
+
+// QuerySimulateCdpResponse defines the response type for the Query/SimulateCdp RPC method.
+type QuerySimulateCdpResponse struct {
+	Simulation CDPSimulationResponse `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation"`
+}
+
+func (m *QuerySimulateCdpResponse) Reset()         { *m = QuerySimulateCdpResponse{} }
+func (m *QuerySimulateCdpResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCdpResponse) ProtoMessage()    {}
+func (*QuerySimulateCdpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{17}
+}
+func (m *QuerySimulateCdpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCdpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCdpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCdpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCdpResponse.Merge(m, src)
+}
+func (m *QuerySimulateCdpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCdpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCdpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCdpResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateCdpResponse) GetSimulation() CDPSimulationResponse {
+	if m != nil {
+		return m.Simulation
+	}
+	return CDPSimulationResponse{}
+}
+
 // CDPResponse defines the state of a single collateralized debt position.
 type CDPResponse struct {
 	ID                     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -719,7 +952,7 @@ func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
 func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
 func (*CDPResponse) ProtoMessage()    {}
 func (*CDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{14}
+	return fileDescriptor_f8caaf4da7412dac, []int{18}
 }
 func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,6 +1051,128 @@ func (m *CDPResponse) GetCollateralizationRatio() string {
 	return ""
 }
 
+// CDPSimulationResponse defines the state of a collateralized debt position after a simulated action.
+type CDPSimulationResponse struct {
+	Type            string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Collateral      types1.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+	Principal       types1.Coin `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees types1.Coin `protobuf:"bytes,4,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	// spot price of the collateral after the price change
+	CollateralPrice        string `protobuf:"bytes,5,opt,name=collateral_price,json=collateralPrice,proto3" json:"collateral_price,omitempty"`
+	CollateralizationRatio string `protobuf:"bytes,6,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+	// collateral price at which the CDP falls below the liquidation ratio
+	LiquidationPrice string `protobuf:"bytes,7,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	// additional principal that can be drawn
+	MaxDraw types1.Coin `protobuf:"bytes,8,opt,name=max_draw,json=maxDraw,proto3" json:"max_draw"`
+	// collateral that can be withdrawn
+	MaxWithdraw types1.Coin `protobuf:"bytes,9,opt,name=max_withdraw,json=maxWithdraw,proto3" json:"max_withdraw"`
+	// reason the simulated action would be rejected, empty if it is valid
+	ValidationError string `protobuf:"bytes,10,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+}
+
+func (m *CDPSimulationResponse) Reset()         { *m = CDPSimulationResponse{} }
+func (m *CDPSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*CDPSimulationResponse) ProtoMessage()    {}
+func (*CDPSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8caaf4da7412dac, []int{19}
+}
+func (m *CDPSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CDPSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CDPSimulationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CDPSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CDPSimulationResponse.Merge(m, src)
+}
+func (m *CDPSimulationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CDPSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CDPSimulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CDPSimulationResponse proto.InternalMessageInfo
+
+func (m *CDPSimulationResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CDPSimulationResponse) GetCollateral() types1.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types1.Coin{}
+}
+
+func (m *CDPSimulationResponse) GetPrincipal() types1.Coin {
+	if m != nil {
+		return m.Principal
+	}
+	return types1.Coin{}
+}
+
+func (m *CDPSimulationResponse) GetAccumulatedFees() types1.Coin {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return types1.Coin{}
+}
+
+func (m *CDPSimulationResponse) GetCollateralPrice() string {
+	if m != nil {
+		return m.CollateralPrice
+	}
+	return ""
+}
+
+func (m *CDPSimulationResponse) GetCollateralizationRatio() string {
+	if m != nil {
+		return m.CollateralizationRatio
+	}
+	return ""
+}
+
+func (m *CDPSimulationResponse) GetLiquidationPrice() string {
+	if m != nil {
+		return m.LiquidationPrice
+	}
+	return ""
+}
+
+func (m *CDPSimulationResponse) GetMaxDraw() types1.Coin {
+	if m != nil {
+		return m.MaxDraw
+	}
+	return types1.Coin{}
+}
+
+func (m *CDPSimulationResponse) GetMaxWithdraw() types1.Coin {
+	if m != nil {
+		return m.MaxWithdraw
+	}
+	return types1.Coin{}
+}
+
+func (m *CDPSimulationResponse) GetValidationError() string {
+	if m != nil {
+		return m.ValidationError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "fury.cdp.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "fury.cdp.v1beta1.QueryParamsResponse")
@@ -833,85 +1188,112 @@ func init() {
 	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "fury.cdp.v1beta1.QueryTotalPrincipalResponse")
 	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "fury.cdp.v1beta1.QueryTotalCollateralRequest")
 	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "fury.cdp.v1beta1.QueryTotalCollateralResponse")
+	proto.RegisterType((*QueryStabilityFeeAdjustmentsRequest)(nil), "fury.cdp.v1beta1.QueryStabilityFeeAdjustmentsRequest")
+	proto.RegisterType((*QueryStabilityFeeAdjustmentsResponse)(nil), "fury.cdp.v1beta1.QueryStabilityFeeAdjustmentsResponse")
+	proto.RegisterType((*QuerySimulateCdpRequest)(nil), "fury.cdp.v1beta1.QuerySimulateCdpRequest")
+	proto.RegisterType((*QuerySimulateCdpResponse)(nil), "fury.cdp.v1beta1.QuerySimulateCdpResponse")
 	proto.RegisterType((*CDPResponse)(nil), "fury.cdp.v1beta1.CDPResponse")
-}
-
-func init() { proto.RegisterFile("fury/cdp/v1beta1/query.proto", fileDescriptor_fd68799328aaf74a) }
-
-var fileDescriptor_fd68799328aaf74a = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0x8f, 0xd3, 0xb4, 0x4b, 0x5f, 0xa7, 0x26, 0x3c, 0x42, 0xe7, 0x9a, 0x92, 0xa4, 0x1e, 0x5b,
-	0x0b, 0xa2, 0x36, 0x2b, 0xe2, 0xb7, 0xd0, 0xd4, 0xb4, 0x74, 0x1a, 0x12, 0x52, 0x31, 0x05, 0x24,
-	0x24, 0x14, 0x5e, 0xec, 0xd7, 0xcc, 0x90, 0xf8, 0x79, 0x7e, 0x76, 0x47, 0x99, 0x26, 0x04, 0x87,
-	0x89, 0xe3, 0x04, 0x07, 0x0e, 0x48, 0x68, 0x17, 0x2e, 0x9c, 0xf9, 0x23, 0x76, 0x9c, 0x80, 0x03,
-	0xa7, 0x0d, 0x5a, 0x0e, 0xfc, 0x19, 0xd3, 0x7b, 0xfe, 0x3a, 0x76, 0xe2, 0xa4, 0xed, 0x0e, 0xbb,
-	0x44, 0xf1, 0xf7, 0xd7, 0xe7, 0xf3, 0xfd, 0xfa, 0xfb, 0xc3, 0x68, 0xe9, 0x4b, 0xb2, 0x4f, 0x4c,
-	0xdb, 0xf1, 0xcd, 0xfd, 0x4b, 0x1d, 0x1a, 0x92, 0x4b, 0xe6, 0xf5, 0x88, 0x06, 0x07, 0x86, 0x1f,
-	0xb0, 0x90, 0xe1, 0xaa, 0xd0, 0x1a, 0xb6, 0xe3, 0x1b, 0xa0, 0xd5, 0xea, 0x36, 0xe3, 0x7d, 0xc6,
-	0x4d, 0x12, 0x85, 0xd7, 0x06, 0x2e, 0xe2, 0x21, 0xf6, 0xd0, 0x5e, 0x04, 0x7d, 0x87, 0x70, 0x1a,
-	0x87, 0x1a, 0x58, 0xf9, 0xa4, 0xeb, 0x7a, 0x24, 0x74, 0x99, 0x07, 0xb6, 0xf5, 0xac, 0x6d, 0x62,
-	0x65, 0x33, 0x37, 0xd1, 0x2f, 0xc6, 0xfa, 0xb6, 0x7c, 0x32, 0xe3, 0x07, 0x50, 0xd5, 0xba, 0xac,
-	0xcb, 0x62, 0xb9, 0xf8, 0x07, 0xd2, 0xa5, 0x2e, 0x63, 0xdd, 0x1e, 0x35, 0x89, 0xef, 0x9a, 0xc4,
-	0xf3, 0x58, 0x28, 0xd1, 0x12, 0x9f, 0x06, 0x68, 0xe5, 0x53, 0x27, 0xda, 0x33, 0x43, 0xb7, 0x4f,
-	0x79, 0x48, 0xfa, 0x3e, 0x18, 0x68, 0xb9, 0x5a, 0x88, 0xcc, 0x81, 0x6b, 0x4e, 0xd7, 0xa5, 0x1e,
-	0xe5, 0x2e, 0x04, 0xd7, 0x6b, 0x08, 0x7f, 0x20, 0xb2, 0xdd, 0x21, 0x01, 0xe9, 0x73, 0x8b, 0x5e,
-	0x8f, 0x28, 0x0f, 0xf5, 0x4f, 0xd0, 0xd3, 0x43, 0x52, 0xee, 0x33, 0x8f, 0x53, 0xfc, 0x1a, 0x9a,
-	0xf1, 0xa5, 0x44, 0x55, 0x9a, 0xca, 0xea, 0xdc, 0xba, 0x6a, 0x8c, 0xd6, 0xd9, 0x88, 0x3d, 0x5a,
-	0xa5, 0x7b, 0x0f, 0x1a, 0x05, 0x0b, 0xac, 0xdf, 0x2a, 0x7f, 0x7f, 0xb7, 0x51, 0xf8, 0xff, 0x6e,
-	0xa3, 0xa0, 0x2f, 0xa0, 0x9a, 0x0c, 0xbc, 0x61, 0xdb, 0x2c, 0xf2, 0xc2, 0x01, 0xe0, 0x67, 0xe8,
-	0x99, 0x11, 0x39, 0x40, 0x6e, 0xa1, 0x32, 0x01, 0x99, 0xaa, 0x34, 0xa7, 0x56, 0xe7, 0xd6, 0x75,
-	0x03, 0x2a, 0x2a, 0xdf, 0x5e, 0x82, 0xfb, 0x3e, 0x73, 0xa2, 0x1e, 0x05, 0x77, 0x80, 0x1f, 0x78,
-	0xea, 0x5f, 0xa0, 0x8a, 0x0c, 0xbf, 0xe9, 0xf8, 0x80, 0x88, 0x57, 0x50, 0xc5, 0x66, 0xbd, 0x1e,
-	0x09, 0x69, 0x40, 0x7a, 0xed, 0xf0, 0xc0, 0xa7, 0x32, 0xa9, 0x59, 0x6b, 0x3e, 0x15, 0xef, 0x1e,
-	0xf8, 0x14, 0x1b, 0x68, 0x9a, 0xdd, 0xf0, 0x68, 0xa0, 0x16, 0x85, 0xba, 0xa5, 0xfe, 0xf1, 0xfb,
-	0x5a, 0x0d, 0x18, 0x6c, 0x38, 0x4e, 0x40, 0x39, 0xff, 0x30, 0x0c, 0x5c, 0xaf, 0x6b, 0xc5, 0x66,
-	0xfa, 0x55, 0x54, 0x4d, 0xb1, 0x20, 0x8b, 0x57, 0xd1, 0x94, 0xed, 0xf8, 0x50, 0xb5, 0xe7, 0xf2,
-	0x55, 0xdb, 0xdc, 0xda, 0x49, 0x6c, 0x81, 0xbb, 0xb0, 0xd7, 0xff, 0x55, 0xd2, 0x58, 0xfc, 0x49,
-	0x13, 0xc7, 0x0b, 0xa8, 0xe8, 0x3a, 0xea, 0x54, 0x53, 0x59, 0x2d, 0xb5, 0x66, 0x0e, 0x1f, 0x34,
-	0x8a, 0x57, 0xb7, 0xac, 0xa2, 0xeb, 0xe0, 0x1a, 0x9a, 0x0e, 0x44, 0x43, 0xaa, 0x25, 0x09, 0x13,
-	0x3f, 0xe0, 0x6d, 0x84, 0xd2, 0xc1, 0x50, 0xa7, 0x65, 0x66, 0x17, 0x93, 0x57, 0x23, 0x26, 0xc3,
-	0x88, 0x07, 0x32, 0x6d, 0x8c, 0x2e, 0x85, 0x14, 0xac, 0x8c, 0xa7, 0xfe, 0xab, 0x82, 0x9e, 0xca,
-	0xe4, 0x08, 0x05, 0xbb, 0x82, 0x4a, 0xb6, 0xe3, 0x27, 0xaf, 0xfc, 0x84, 0x8a, 0xd5, 0x44, 0xc5,
-	0x7e, 0x7b, 0xd8, 0x38, 0x9b, 0x11, 0x72, 0x4b, 0x06, 0xc0, 0x57, 0x86, 0x68, 0x16, 0x25, 0xcd,
-	0x95, 0x13, 0x69, 0xc6, 0x31, 0x86, 0x78, 0x32, 0xe8, 0xdc, 0x2d, 0xea, 0x33, 0xee, 0x86, 0x4f,
-	0xfc, 0x75, 0xe8, 0x9f, 0xc3, 0x48, 0xa4, 0x80, 0x83, 0xda, 0x94, 0x1d, 0x90, 0x41, 0x7d, 0x16,
-	0xf3, 0xf5, 0x01, 0xaf, 0x56, 0x15, 0x6a, 0x53, 0x1e, 0x84, 0x19, 0x38, 0xeb, 0xef, 0x22, 0x4d,
-	0x22, 0xec, 0xb2, 0x90, 0xf4, 0x76, 0x02, 0xd7, 0xb3, 0x5d, 0x9f, 0xf4, 0x1e, 0x37, 0x31, 0xfd,
-	0x5b, 0x05, 0x3d, 0x3b, 0x36, 0x0e, 0xf0, 0xed, 0xa0, 0x4a, 0x28, 0x34, 0x6d, 0x3f, 0x51, 0x01,
-	0xed, 0x66, 0x9e, 0xf6, 0x70, 0x88, 0xd6, 0x39, 0x60, 0x5f, 0x19, 0x96, 0x73, 0x6b, 0x3e, 0x1c,
-	0x12, 0xe8, 0xdb, 0x59, 0x0a, 0x9b, 0x03, 0x7e, 0x8f, 0x9d, 0xcb, 0x6d, 0x05, 0x2d, 0x8d, 0x0f,
-	0x04, 0xc9, 0xec, 0xa1, 0x6a, 0x9c, 0x4c, 0xea, 0x08, 0xd9, 0x2c, 0x4f, 0xc8, 0x26, 0x0d, 0xd2,
-	0x52, 0x21, 0x9d, 0xea, 0x88, 0x82, 0x5b, 0x71, 0x85, 0x52, 0x89, 0xfe, 0x43, 0x09, 0xcd, 0x65,
-	0xda, 0x19, 0x86, 0x53, 0x19, 0x37, 0x9c, 0x99, 0xae, 0x4a, 0x46, 0x19, 0xa3, 0x92, 0x4c, 0x72,
-	0x4a, 0x0a, 0xe5, 0x7f, 0x7c, 0x19, 0xa1, 0x0c, 0xe7, 0x92, 0x9c, 0x84, 0xc5, 0xa1, 0x49, 0x18,
-	0xcc, 0x16, 0x73, 0x3d, 0x58, 0x43, 0x19, 0x17, 0xfc, 0x0e, 0x9a, 0x4d, 0xdf, 0xe0, 0xf4, 0xe9,
-	0xfc, 0x53, 0x0f, 0xfc, 0x1e, 0xaa, 0x12, 0xdb, 0x8e, 0xfa, 0x91, 0x88, 0xe7, 0xb4, 0xf7, 0x28,
-	0xe5, 0xea, 0xcc, 0xe9, 0xa2, 0x54, 0x32, 0x8e, 0xdb, 0x94, 0x8a, 0xa9, 0x3e, 0x2b, 0xfc, 0xdb,
-	0x91, 0xef, 0x08, 0x99, 0x7a, 0x46, 0xc6, 0xd1, 0x8c, 0xf8, 0x52, 0x1a, 0xc9, 0xa5, 0x34, 0x76,
-	0x93, 0x4b, 0xd9, 0x2a, 0x8b, 0x40, 0x77, 0x1e, 0x36, 0x14, 0x6b, 0x4e, 0x78, 0x7e, 0x14, 0x3b,
-	0x8a, 0xc6, 0x70, 0xbd, 0x90, 0x06, 0x94, 0x87, 0xed, 0x3d, 0x62, 0x87, 0x2c, 0x50, 0xcb, 0x71,
-	0x63, 0x24, 0xe2, 0x6d, 0x29, 0x15, 0xec, 0x33, 0x1d, 0xb4, 0x4f, 0x7a, 0x11, 0x55, 0x67, 0x4f,
-	0xc9, 0x3e, 0x75, 0xfc, 0x58, 0xf8, 0xe1, 0xd7, 0xd1, 0xb9, 0x54, 0xe4, 0x7e, 0x2d, 0xf7, 0x4b,
-	0x3b, 0x5e, 0xb1, 0x48, 0x82, 0x2f, 0xe4, 0xd4, 0x96, 0xf8, 0x5d, 0xff, 0xeb, 0x0c, 0x9a, 0x96,
-	0xdd, 0x89, 0x6f, 0xa0, 0x99, 0xf8, 0xd2, 0xe2, 0xe7, 0xf3, 0x6d, 0x97, 0x3f, 0xe8, 0xda, 0x85,
-	0x13, 0xac, 0xe2, 0x2e, 0xd3, 0x9b, 0xdf, 0xfd, 0xf9, 0xdf, 0x8f, 0x45, 0x0d, 0xab, 0x66, 0xee,
-	0xb3, 0x21, 0x3e, 0xe5, 0xf8, 0x1b, 0x54, 0x4e, 0x6e, 0x34, 0xbe, 0x38, 0x21, 0xe8, 0xc8, 0x71,
-	0xd7, 0x56, 0x4e, 0xb4, 0x03, 0x78, 0x5d, 0xc2, 0x2f, 0x61, 0x2d, 0x0f, 0x9f, 0x9c, 0x72, 0xfc,
-	0x93, 0x82, 0xe6, 0x87, 0xb7, 0x01, 0x7e, 0x69, 0x42, 0xfc, 0xb1, 0x7b, 0x4d, 0x5b, 0x3b, 0xa5,
-	0x35, 0x70, 0x5a, 0x95, 0x9c, 0x74, 0xdc, 0xcc, 0x73, 0x1a, 0xde, 0x41, 0xf8, 0x67, 0x05, 0x55,
-	0x46, 0x06, 0x1b, 0x1f, 0x0b, 0x96, 0xdb, 0x53, 0x9a, 0x71, 0x5a, 0x73, 0x20, 0xf7, 0x82, 0x24,
-	0x77, 0x1e, 0x2f, 0x4f, 0x20, 0x97, 0x61, 0xc2, 0x50, 0x49, 0x5c, 0x58, 0xac, 0x4f, 0x80, 0xc8,
-	0x7c, 0x62, 0x68, 0xe7, 0x8f, 0xb5, 0x01, 0xec, 0xba, 0xc4, 0x56, 0xf1, 0x82, 0x39, 0xee, 0xf3,
-	0x93, 0xe3, 0xdb, 0x0a, 0x9a, 0xda, 0x74, 0x7c, 0xbc, 0x3c, 0x39, 0x58, 0x82, 0xa7, 0x1f, 0x67,
-	0x02, 0x70, 0x6f, 0x48, 0xb8, 0x75, 0xfc, 0xf2, 0x78, 0x38, 0xf3, 0xa6, 0xdc, 0x7c, 0xb7, 0xcc,
-	0x9b, 0x23, 0x8b, 0xfe, 0x16, 0xfe, 0x45, 0x41, 0x83, 0xeb, 0x37, 0xb1, 0x67, 0x47, 0xce, 0xfa,
-	0xc4, 0x9e, 0x1d, 0xbd, 0xc6, 0xfa, 0x86, 0xe4, 0xf5, 0x36, 0x7e, 0x73, 0x02, 0xaf, 0xe4, 0xda,
-	0x4e, 0x26, 0xd8, 0xba, 0x7c, 0xef, 0xb0, 0xae, 0xdc, 0x3f, 0xac, 0x2b, 0xff, 0x1c, 0xd6, 0x95,
-	0x3b, 0x47, 0xf5, 0xc2, 0xfd, 0xa3, 0x7a, 0xe1, 0xef, 0xa3, 0x7a, 0xe1, 0xd3, 0x0b, 0x5d, 0x37,
-	0xbc, 0x16, 0x75, 0x0c, 0x9b, 0xf5, 0x65, 0xf8, 0xb5, 0x1e, 0xe9, 0xf0, 0x18, 0xe8, 0x2b, 0x09,
-	0x25, 0x02, 0xf0, 0xce, 0x8c, 0x5c, 0x78, 0xaf, 0x3c, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x73,
-	0xf8, 0x07, 0x15, 0x0d, 0x00, 0x00,
+	proto.RegisterType((*CDPSimulationResponse)(nil), "fury.cdp.v1beta1.CDPSimulationResponse")
+}
+
+func init() { proto.RegisterFile("fury/cdp/v1beta1/query.proto", fileDescriptor_f8caaf4da7412dac) }
+
+var fileDescriptor_f8caaf4da7412dac = []byte{
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0x3a, 0x4e, 0xe2, 0x3c, 0x8e, 0x6a, 0x77, 0xde, 0x34, 0xd9, 0xec, 0x9b, 0xd7, 0x4e,
+	0xb6, 0x7d, 0x9b, 0xa4, 0x7d, 0xeb, 0x7d, 0x13, 0xd4, 0x16, 0x8a, 0x10, 0xc4, 0x49, 0x53, 0x15,
+	0xa9, 0x52, 0xd8, 0x16, 0x2a, 0x21, 0x21, 0x33, 0xde, 0x9d, 0x38, 0x5b, 0xd9, 0xbb, 0xdb, 0xfd,
+	0xc8, 0x07, 0x55, 0x85, 0x40, 0xa2, 0xe2, 0x58, 0x81, 0x04, 0x07, 0x04, 0xea, 0x85, 0x0b, 0x47,
+	0xe0, 0xc6, 0x91, 0x4b, 0x8f, 0x15, 0x5c, 0x38, 0xb5, 0x90, 0x72, 0xe0, 0x3f, 0xe0, 0x8a, 0x66,
+	0x76, 0xd6, 0xbb, 0xf6, 0x7a, 0x1d, 0xb7, 0xb4, 0x5c, 0x2c, 0xcf, 0xf3, 0xf9, 0x7b, 0x7e, 0xf3,
+	0xcc, 0xec, 0x33, 0x30, 0xbb, 0xe5, 0x3b, 0xfb, 0x8a, 0xa6, 0xdb, 0xca, 0xce, 0x72, 0x9d, 0x78,
+	0x78, 0x59, 0xb9, 0xe9, 0x13, 0x67, 0xbf, 0x62, 0x3b, 0x96, 0x67, 0xa1, 0x22, 0xd5, 0x56, 0x34,
+	0xdd, 0xae, 0x70, 0xad, 0x54, 0xd2, 0x2c, 0xb7, 0x65, 0xb9, 0x0a, 0xf6, 0xbd, 0xed, 0xb6, 0x0b,
+	0x5d, 0x04, 0x1e, 0xd2, 0x29, 0xae, 0xaf, 0x63, 0x97, 0x04, 0xa1, 0xda, 0x56, 0x36, 0x6e, 0x18,
+	0x26, 0xf6, 0x0c, 0xcb, 0xe4, 0xb6, 0xa5, 0xb8, 0x6d, 0x68, 0xa5, 0x59, 0x46, 0xa8, 0x9f, 0x09,
+	0xf4, 0x35, 0xb6, 0x52, 0x82, 0x05, 0x57, 0x4d, 0x36, 0xac, 0x86, 0x15, 0xc8, 0xe9, 0x3f, 0x2e,
+	0x9d, 0x6d, 0x58, 0x56, 0xa3, 0x49, 0x14, 0x6c, 0x1b, 0x0a, 0x36, 0x4d, 0xcb, 0x63, 0xd9, 0x42,
+	0x9f, 0x32, 0xd7, 0xb2, 0x55, 0xdd, 0xdf, 0x52, 0x3c, 0xa3, 0x45, 0x5c, 0x0f, 0xb7, 0x6c, 0x6e,
+	0x20, 0x25, 0xb8, 0xd0, 0xf4, 0x50, 0x57, 0x4a, 0xe8, 0x1a, 0xc4, 0x24, 0xae, 0xc1, 0x83, 0xcb,
+	0x93, 0x80, 0xde, 0xa0, 0xd5, 0x6e, 0x62, 0x07, 0xb7, 0x5c, 0x95, 0xdc, 0xf4, 0x89, 0xeb, 0xc9,
+	0xd7, 0xe1, 0x5f, 0x1d, 0x52, 0xd7, 0xb6, 0x4c, 0x97, 0xa0, 0x73, 0x30, 0x6a, 0x33, 0x89, 0x28,
+	0xcc, 0x09, 0x8b, 0xf9, 0x15, 0xb1, 0xd2, 0xcd, 0x73, 0x25, 0xf0, 0xa8, 0x66, 0xef, 0x3f, 0x2c,
+	0x0f, 0xa9, 0xdc, 0xfa, 0x42, 0xee, 0xe3, 0x7b, 0xe5, 0xa1, 0x3f, 0xee, 0x95, 0x87, 0xe4, 0x29,
+	0x98, 0x64, 0x81, 0x57, 0x35, 0xcd, 0xf2, 0x4d, 0xaf, 0x9d, 0xf0, 0x1d, 0x38, 0xd6, 0x25, 0xe7,
+	0x29, 0xd7, 0x21, 0x87, 0xb9, 0x4c, 0x14, 0xe6, 0x86, 0x17, 0xf3, 0x2b, 0x72, 0x85, 0x33, 0xca,
+	0x76, 0x2f, 0xcc, 0x7b, 0xc5, 0xd2, 0xfd, 0x26, 0xe1, 0xee, 0x3c, 0x7d, 0xdb, 0x53, 0xbe, 0x01,
+	0x05, 0x16, 0x7e, 0x4d, 0xb7, 0x79, 0x46, 0xb4, 0x00, 0x05, 0xcd, 0x6a, 0x36, 0xb1, 0x47, 0x1c,
+	0xdc, 0xac, 0x79, 0xfb, 0x36, 0x61, 0x45, 0x8d, 0xab, 0x47, 0x22, 0xf1, 0xb5, 0x7d, 0x9b, 0xa0,
+	0x0a, 0x8c, 0x58, 0xbb, 0x26, 0x71, 0xc4, 0x0c, 0x55, 0x57, 0xc5, 0x9f, 0xbe, 0x3f, 0x33, 0xc9,
+	0x11, 0xac, 0xea, 0xba, 0x43, 0x5c, 0xf7, 0xaa, 0xe7, 0x18, 0x66, 0x43, 0x0d, 0xcc, 0xe4, 0xcb,
+	0x50, 0x8c, 0x72, 0xf1, 0x2a, 0xce, 0xc2, 0xb0, 0xa6, 0xdb, 0x9c, 0xb5, 0xff, 0x24, 0x59, 0x5b,
+	0x5b, 0xdf, 0x0c, 0x6d, 0x39, 0x76, 0x6a, 0x2f, 0xff, 0x26, 0x44, 0xb1, 0xdc, 0xe7, 0x0d, 0x1c,
+	0x4d, 0x41, 0xc6, 0xd0, 0xc5, 0xe1, 0x39, 0x61, 0x31, 0x5b, 0x1d, 0x3d, 0x78, 0x58, 0xce, 0x5c,
+	0x5e, 0x57, 0x33, 0x86, 0x8e, 0x26, 0x61, 0xc4, 0xa1, 0x0d, 0x29, 0x66, 0x59, 0x9a, 0x60, 0x81,
+	0x36, 0x00, 0xa2, 0x83, 0x21, 0x8e, 0xb0, 0xca, 0x4e, 0x86, 0x5b, 0x43, 0x4f, 0x46, 0x25, 0x38,
+	0x90, 0x51, 0x63, 0x34, 0x08, 0x2f, 0x41, 0x8d, 0x79, 0xca, 0x5f, 0x0b, 0x70, 0x34, 0x56, 0x23,
+	0x27, 0xec, 0x12, 0x64, 0x35, 0xdd, 0x0e, 0xb7, 0xfc, 0x10, 0xc6, 0x26, 0x29, 0x63, 0xdf, 0x3c,
+	0x2a, 0x4f, 0xc4, 0x84, 0xae, 0xca, 0x02, 0xa0, 0x4b, 0x1d, 0x30, 0x33, 0x0c, 0xe6, 0xc2, 0xa1,
+	0x30, 0x83, 0x18, 0x1d, 0x38, 0x2d, 0xde, 0xb9, 0xeb, 0xc4, 0xb6, 0x5c, 0xc3, 0x7b, 0xee, 0xdb,
+	0x21, 0xbf, 0x0b, 0xc7, 0xba, 0x12, 0xb6, 0xb9, 0xc9, 0xe9, 0x5c, 0xc6, 0xf9, 0x99, 0x49, 0xf2,
+	0xc3, 0xbd, 0xaa, 0x45, 0xce, 0x4d, 0xae, 0x1d, 0xa6, 0xed, 0x2c, 0x5f, 0x04, 0x89, 0x65, 0xb8,
+	0x66, 0x79, 0xb8, 0xb9, 0xe9, 0x18, 0xa6, 0x66, 0xd8, 0xb8, 0xf9, 0xa4, 0x85, 0xc9, 0x1f, 0x08,
+	0xf0, 0xef, 0x9e, 0x71, 0x38, 0xde, 0x3a, 0x14, 0x3c, 0xaa, 0xa9, 0xd9, 0xa1, 0x8a, 0xc3, 0x9e,
+	0x4b, 0xc2, 0xee, 0x0c, 0x51, 0x9d, 0xe6, 0xe8, 0x0b, 0x9d, 0x72, 0x57, 0x3d, 0xe2, 0x75, 0x08,
+	0xe4, 0x8d, 0x38, 0x84, 0xb5, 0x36, 0xbe, 0x27, 0xae, 0xe5, 0x8e, 0x00, 0xb3, 0xbd, 0x03, 0xf1,
+	0x62, 0xb6, 0xa0, 0x18, 0x14, 0x13, 0x39, 0xf2, 0x6a, 0xe6, 0x53, 0xaa, 0x89, 0x82, 0x54, 0x45,
+	0x5e, 0x4e, 0xb1, 0x4b, 0xe1, 0xaa, 0x05, 0xaf, 0x53, 0x22, 0x7f, 0x26, 0xc0, 0x71, 0x06, 0xe4,
+	0xaa, 0x87, 0xeb, 0x46, 0xd3, 0xf0, 0xf6, 0x37, 0x08, 0x59, 0xd5, 0x6f, 0xf8, 0xae, 0xd7, 0x22,
+	0xe6, 0x53, 0xb4, 0xdf, 0x46, 0x8f, 0x83, 0xf0, 0x34, 0xe7, 0xf5, 0x4f, 0x01, 0x4e, 0xf4, 0x07,
+	0xc6, 0x99, 0xfa, 0x48, 0x80, 0x19, 0x37, 0xb4, 0xa9, 0x6d, 0x11, 0x52, 0xc3, 0x91, 0x15, 0xe7,
+	0x6c, 0x31, 0xc9, 0x59, 0xef, 0xb0, 0xd5, 0x32, 0xa7, 0x6e, 0x3a, 0x2d, 0xed, 0xb4, 0xdb, 0x5b,
+	0xf1, 0xec, 0x6e, 0x80, 0x1f, 0x05, 0x98, 0x0e, 0x2a, 0x37, 0x5a, 0x3e, 0xa5, 0xf6, 0x1f, 0xf8,
+	0x9a, 0x20, 0x11, 0xc6, 0xf8, 0x79, 0x65, 0x37, 0xf3, 0xb8, 0x1a, 0x2e, 0x11, 0x82, 0xac, 0xee,
+	0xe0, 0x5d, 0x7e, 0x2b, 0xb3, 0xff, 0x68, 0x1e, 0x26, 0x6c, 0xc7, 0xd0, 0x48, 0x4d, 0xdb, 0xc6,
+	0x66, 0x83, 0xb0, 0x6b, 0x79, 0x5c, 0xcd, 0x33, 0xd9, 0x1a, 0x13, 0xc9, 0x06, 0x88, 0xc9, 0x22,
+	0xf8, 0x96, 0x5d, 0x01, 0x70, 0x03, 0x31, 0xa5, 0x4a, 0xe0, 0x54, 0xf5, 0xba, 0x7b, 0xaf, 0xb6,
+	0xcd, 0xba, 0xbe, 0x5b, 0xb1, 0x00, 0xf2, 0x27, 0x59, 0xc8, 0xc7, 0xae, 0x64, 0xfe, 0x81, 0x11,
+	0x7a, 0x7d, 0x60, 0x62, 0x9c, 0x84, 0x95, 0x23, 0xc8, 0x32, 0x1e, 0x83, 0xb2, 0xd9, 0x7f, 0xf4,
+	0x2a, 0x40, 0xec, 0xdc, 0x65, 0x19, 0xc0, 0x99, 0x8e, 0xbd, 0x6c, 0x63, 0xb4, 0x0c, 0x33, 0x84,
+	0x14, 0xb9, 0xa0, 0x57, 0x60, 0x3c, 0xba, 0x85, 0x46, 0x06, 0xf3, 0x8f, 0x3c, 0xd0, 0xeb, 0x50,
+	0xc4, 0x9a, 0xe6, 0x07, 0xcc, 0xe9, 0xb4, 0xa9, 0x5d, 0x71, 0x74, 0xb0, 0x28, 0x85, 0x98, 0xe3,
+	0x06, 0x21, 0xb4, 0x2f, 0x27, 0xa8, 0x7f, 0xcd, 0xb7, 0x75, 0x2a, 0x13, 0xc7, 0x58, 0x1c, 0xa9,
+	0x12, 0x4c, 0x7b, 0x95, 0x70, 0xda, 0xab, 0x5c, 0x0b, 0xa7, 0xbd, 0x6a, 0x8e, 0x06, 0xba, 0xfb,
+	0xa8, 0x2c, 0xa8, 0x79, 0xea, 0xf9, 0x66, 0xe0, 0x48, 0x7b, 0xcf, 0x30, 0x3d, 0xe2, 0x10, 0xd7,
+	0xab, 0x6d, 0x61, 0xcd, 0xb3, 0x1c, 0x31, 0x17, 0xf4, 0x5e, 0x28, 0xde, 0x60, 0x52, 0x8a, 0x3e,
+	0xd6, 0xa4, 0x3b, 0xb8, 0xe9, 0x13, 0x71, 0x7c, 0x40, 0xf4, 0x91, 0xe3, 0x5b, 0xd4, 0x0f, 0x9d,
+	0x87, 0xe9, 0x48, 0x64, 0xbc, 0xc7, 0x36, 0xbc, 0x16, 0x8c, 0x09, 0xc0, 0x92, 0x4f, 0x25, 0xd4,
+	0x2a, 0xfd, 0x95, 0xbf, 0xcb, 0xc2, 0xb1, 0x9e, 0x0d, 0xd4, 0xde, 0x70, 0x21, 0x75, 0xc3, 0x33,
+	0x7f, 0x73, 0xc3, 0x87, 0x9f, 0xc9, 0x86, 0x67, 0x9f, 0x72, 0xc3, 0x97, 0x3a, 0xe8, 0x67, 0x67,
+	0x92, 0x1f, 0xd0, 0x18, 0xbb, 0x9b, 0x54, 0xdc, 0x8f, 0xdd, 0xd1, 0x7e, 0xec, 0xa2, 0xd3, 0x70,
+	0xb4, 0x69, 0xdc, 0xf4, 0x0d, 0x3d, 0x70, 0x09, 0x92, 0x8c, 0x31, 0x97, 0x62, 0x4c, 0x11, 0x64,
+	0xb9, 0x00, 0xb9, 0x16, 0xde, 0xab, 0xb1, 0x5b, 0x24, 0x37, 0x58, 0x51, 0x63, 0x2d, 0xbc, 0xb7,
+	0x4e, 0x6f, 0x9a, 0x2a, 0x4c, 0x50, 0xdf, 0x5d, 0xc3, 0xdb, 0x66, 0xfe, 0x03, 0xf6, 0x51, 0xbe,
+	0x85, 0xf7, 0xae, 0x73, 0x1f, 0x4a, 0xc8, 0x0e, 0x6e, 0x86, 0x58, 0x89, 0xe3, 0x58, 0x0e, 0x6f,
+	0x9e, 0x42, 0x24, 0xbf, 0x48, 0xc5, 0x2b, 0xdf, 0x02, 0x8c, 0xb0, 0x6b, 0x0b, 0xed, 0xc2, 0x68,
+	0xf0, 0xc6, 0x40, 0x27, 0x92, 0x37, 0x53, 0xf2, 0x29, 0x23, 0xfd, 0xf7, 0x10, 0xab, 0xa0, 0xf9,
+	0xe4, 0xb9, 0x0f, 0x7f, 0xfe, 0xfd, 0xd3, 0x8c, 0x84, 0x44, 0x25, 0xf1, 0x60, 0x0a, 0x1e, 0x31,
+	0xe8, 0x7d, 0xc8, 0x85, 0xaf, 0x13, 0x74, 0x32, 0x25, 0x68, 0xd7, 0xb3, 0x46, 0x5a, 0x38, 0xd4,
+	0x8e, 0xa7, 0x97, 0x59, 0xfa, 0x59, 0x24, 0x25, 0xd3, 0x87, 0x8f, 0x18, 0xf4, 0xb9, 0x00, 0x47,
+	0x3a, 0xe7, 0x20, 0xf4, 0xbf, 0x94, 0xf8, 0x3d, 0x27, 0x3a, 0xe9, 0xcc, 0x80, 0xd6, 0x1c, 0xd3,
+	0x22, 0xc3, 0x24, 0xa3, 0xb9, 0x24, 0xa6, 0xce, 0xe9, 0x0b, 0x7d, 0x21, 0x40, 0xa1, 0x6b, 0xa4,
+	0x41, 0x7d, 0x93, 0x25, 0x26, 0x34, 0xa9, 0x32, 0xa8, 0x39, 0x07, 0xb7, 0xc4, 0xc0, 0x1d, 0x47,
+	0xf3, 0x29, 0xe0, 0x62, 0x48, 0x2c, 0xc8, 0xd2, 0xb7, 0x05, 0x92, 0x53, 0x52, 0xc4, 0x1e, 0x57,
+	0xd2, 0xf1, 0xbe, 0x36, 0x3c, 0x77, 0x89, 0xe5, 0x16, 0xd1, 0x94, 0xd2, 0xeb, 0xe1, 0xed, 0xa2,
+	0x3b, 0x02, 0x0c, 0xaf, 0xe9, 0x36, 0x9a, 0x4f, 0x0f, 0x16, 0xe6, 0x93, 0xfb, 0x99, 0xf0, 0x74,
+	0x2f, 0xb2, 0x74, 0x2b, 0xe8, 0xff, 0xbd, 0xd3, 0x29, 0xb7, 0xd8, 0xf7, 0xf2, 0xb6, 0x72, 0xab,
+	0x6b, 0x02, 0xb9, 0x8d, 0xbe, 0x12, 0xa0, 0x3d, 0xf7, 0xa7, 0xf6, 0x6c, 0xd7, 0x83, 0x46, 0x5a,
+	0x38, 0xd4, 0x8e, 0xe3, 0x5a, 0x65, 0xb8, 0x5e, 0x46, 0x2f, 0xa5, 0xe0, 0x0a, 0xdf, 0x19, 0x7d,
+	0x00, 0xfe, 0x20, 0x40, 0xda, 0x40, 0x87, 0xce, 0xa6, 0xe0, 0xe8, 0x3f, 0x10, 0x4b, 0xe7, 0x9e,
+	0xd4, 0x8d, 0x57, 0xb3, 0xcc, 0xaa, 0x39, 0x8d, 0x96, 0x92, 0xd5, 0xa4, 0x4d, 0x96, 0x5f, 0x0a,
+	0x90, 0x8f, 0x8d, 0x51, 0x68, 0x29, 0x2d, 0x75, 0x62, 0x5e, 0x94, 0x4e, 0x0d, 0x62, 0xca, 0x91,
+	0x9d, 0x67, 0xc8, 0x96, 0x91, 0xd2, 0x03, 0x59, 0x64, 0x9e, 0x64, 0xb7, 0xfa, 0xda, 0xfd, 0x83,
+	0x92, 0xf0, 0xe0, 0xa0, 0x24, 0xfc, 0x7a, 0x50, 0x12, 0xee, 0x3e, 0x2e, 0x0d, 0x3d, 0x78, 0x5c,
+	0x1a, 0xfa, 0xe5, 0x71, 0x69, 0xe8, 0xed, 0x93, 0x0d, 0xc3, 0xdb, 0xf6, 0xeb, 0x15, 0xcd, 0x6a,
+	0x29, 0x2d, 0xdc, 0x20, 0x67, 0x34, 0x6b, 0x87, 0x98, 0x41, 0xfc, 0x3d, 0x96, 0x81, 0x46, 0x70,
+	0xeb, 0xa3, 0x6c, 0x0a, 0x79, 0xe1, 0xaf, 0x01, 0x00, 0x1a, 0x55, 0xcd, 0xa7, 0x6e, 0x13, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -940,6 +1322,10 @@ type QueryClient interface {
 	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
 	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// StabilityFeeAdjustments queries the history of stability fees set by the stability fee controller.
+	StabilityFeeAdjustments(ctx context.Context, in *QueryStabilityFeeAdjustmentsRequest, opts ...grpc.CallOption) (*QueryStabilityFeeAdjustmentsResponse, error)
+	// SimulateCdp queries the state a CDP would be in after a hypothetical deposit and draw or collateral price change.
+	SimulateCdp(ctx context.Context, in *QuerySimulateCdpRequest, opts ...grpc.CallOption) (*QuerySimulateCdpResponse, error)
 }
 
 type queryClient struct {
@@ -1013,6 +1399,24 @@ func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) StabilityFeeAdjustments(ctx context.Context, in *QueryStabilityFeeAdjustmentsRequest, opts ...grpc.CallOption) (*QueryStabilityFeeAdjustmentsResponse, error) {
+	out := new(QueryStabilityFeeAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/StabilityFeeAdjustments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateCdp(ctx context.Context, in *QuerySimulateCdpRequest, opts ...grpc.CallOption) (*QuerySimulateCdpResponse, error) {
+	out := new(QuerySimulateCdpResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Query/SimulateCdp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the cdp module.
@@ -1029,6 +1433,10 @@ type QueryServer interface {
 	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
 	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// StabilityFeeAdjustments queries the history of stability fees set by the stability fee controller.
+	StabilityFeeAdjustments(context.Context, *QueryStabilityFeeAdjustmentsRequest) (*QueryStabilityFeeAdjustmentsResponse, error)
+	// SimulateCdp queries the state a CDP would be in after a hypothetical deposit and draw or collateral price change.
+	SimulateCdp(context.Context, *QuerySimulateCdpRequest) (*QuerySimulateCdpResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1056,6 +1464,12 @@ func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest)
 func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
 }
+func (*UnimplementedQueryServer) StabilityFeeAdjustments(ctx context.Context, req *QueryStabilityFeeAdjustmentsRequest) (*QueryStabilityFeeAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StabilityFeeAdjustments not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCdp(ctx context.Context, req *QuerySimulateCdpRequest) (*QuerySimulateCdpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCdp not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1187,6 +1601,42 @@ func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StabilityFeeAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStabilityFeeAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StabilityFeeAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/StabilityFeeAdjustments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StabilityFeeAdjustments(ctx, req.(*QueryStabilityFeeAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateCdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCdpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCdp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Query/SimulateCdp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCdp(ctx, req.(*QuerySimulateCdpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.cdp.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1219,6 +1669,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposits",
 			Handler:    _Query_Deposits_Handler,
 		},
+		{
+			MethodName: "StabilityFeeAdjustments",
+			Handler:    _Query_StabilityFeeAdjustments_Handler,
+		},
+		{
+			MethodName: "SimulateCdp",
+			Handler:    _Query_SimulateCdp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/cdp/v1beta1/query.proto",
@@ -1728,7 +2186,189 @@ func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStabilityFeeAdjustmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for iNdEx := len(m.StabilityFeeAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCdpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCdpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceChange) > 0 {
+		i -= len(m.PriceChange)
+		copy(dAtA[i:], m.PriceChange)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceChange)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Draw) > 0 {
+		i -= len(m.Draw)
+		copy(dAtA[i:], m.Draw)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Draw)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCdpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCdpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1772,12 +2412,12 @@ func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -1832,6 +2472,114 @@ func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CDPSimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CDPSimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDPSimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidationError) > 0 {
+		i -= len(m.ValidationError)
+		copy(dAtA[i:], m.ValidationError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidationError)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.MaxWithdraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.MaxDraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.LiquidationPrice) > 0 {
+		i -= len(m.LiquidationPrice)
+		copy(dAtA[i:], m.LiquidationPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidationPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CollateralizationRatio) > 0 {
+		i -= len(m.CollateralizationRatio)
+		copy(dAtA[i:], m.CollateralizationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CollateralPrice) > 0 {
+		i -= len(m.CollateralPrice)
+		copy(dAtA[i:], m.CollateralPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2050,28 +2798,104 @@ func (m *QueryTotalCollateralResponse) Size() (n int) {
 	return n
 }
 
-func (m *CDPResponse) Size() (n int) {
+func (m *QueryStabilityFeeAdjustmentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
-	}
-	l = len(m.Owner)
+	l = len(m.CollateralType)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Type)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AccumulatedFees.Size()
+	return n
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for _, e := range m.StabilityFeeAdjustments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCdpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Draw)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceChange)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCdpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Simulation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedFees.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
 	n += 1 + l + sovQuery(uint64(l))
@@ -2088,6 +2912,45 @@ func (m *CDPResponse) Size() (n int) {
 	return n
 }
 
+func (m *CDPSimulationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CollateralPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollateralizationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidationPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxDraw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxWithdraw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ValidationError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3178,19 +4041,1047 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
+			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
+			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeAdjustments = append(m.StabilityFeeAdjustments, StabilityFeeAdjustment{})
+			if err := m.StabilityFeeAdjustments[len(m.StabilityFeeAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateCdpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateCdpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Draw = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceChange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateCdpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateCdpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,64 +5108,13 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
-			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3302,7 +5142,7 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3325,7 +5165,7 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *CDPSimulationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3348,17 +5188,17 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CDPSimulationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CDPSimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3368,100 +5208,29 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
-			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CDPResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CDPResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3471,29 +5240,30 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3503,27 +5273,28 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3550,15 +5321,15 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3568,30 +5339,29 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3601,30 +5371,29 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3634,30 +5403,29 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidationPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDraw", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3667,27 +5435,28 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestFactor = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxDraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdraw", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3714,13 +5483,13 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxWithdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3748,7 +5517,7 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
+			m.ValidationError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
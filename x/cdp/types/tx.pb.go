@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -42,7 +43,7 @@ func (m *MsgCreateCDP) Reset()         { *m = MsgCreateCDP{} }
 func (m *MsgCreateCDP) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCDP) ProtoMessage()    {}
 func (*MsgCreateCDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{0}
+	return fileDescriptor_e4920fb6256fc07f, []int{0}
 }
 func (m *MsgCreateCDP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,7 +109,7 @@ func (m *MsgCreateCDPResponse) Reset()         { *m = MsgCreateCDPResponse{} }
 func (m *MsgCreateCDPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCDPResponse) ProtoMessage()    {}
 func (*MsgCreateCDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{1}
+	return fileDescriptor_e4920fb6256fc07f, []int{1}
 }
 func (m *MsgCreateCDPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,7 +157,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{2}
+	return fileDescriptor_e4920fb6256fc07f, []int{2}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +222,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{3}
+	return fileDescriptor_e4920fb6256fc07f, []int{3}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +263,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{4}
+	return fileDescriptor_e4920fb6256fc07f, []int{4}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +328,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{5}
+	return fileDescriptor_e4920fb6256fc07f, []int{5}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +368,7 @@ func (m *MsgDrawDebt) Reset()         { *m = MsgDrawDebt{} }
 func (m *MsgDrawDebt) String() string { return proto.CompactTextString(m) }
 func (*MsgDrawDebt) ProtoMessage()    {}
 func (*MsgDrawDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{6}
+	return fileDescriptor_e4920fb6256fc07f, []int{6}
 }
 func (m *MsgDrawDebt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +426,7 @@ func (m *MsgDrawDebtResponse) Reset()         { *m = MsgDrawDebtResponse{} }
 func (m *MsgDrawDebtResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDrawDebtResponse) ProtoMessage()    {}
 func (*MsgDrawDebtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{7}
+	return fileDescriptor_e4920fb6256fc07f, []int{7}
 }
 func (m *MsgDrawDebtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +466,7 @@ func (m *MsgRepayDebt) Reset()         { *m = MsgRepayDebt{} }
 func (m *MsgRepayDebt) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayDebt) ProtoMessage()    {}
 func (*MsgRepayDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{8}
+	return fileDescriptor_e4920fb6256fc07f, []int{8}
 }
 func (m *MsgRepayDebt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +524,7 @@ func (m *MsgRepayDebtResponse) Reset()         { *m = MsgRepayDebtResponse{} }
 func (m *MsgRepayDebtResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayDebtResponse) ProtoMessage()    {}
 func (*MsgRepayDebtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{9}
+	return fileDescriptor_e4920fb6256fc07f, []int{9}
 }
 func (m *MsgRepayDebtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +565,7 @@ func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
 func (m *MsgLiquidate) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidate) ProtoMessage()    {}
 func (*MsgLiquidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{10}
+	return fileDescriptor_e4920fb6256fc07f, []int{10}
 }
 func (m *MsgLiquidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +623,7 @@ func (m *MsgLiquidateResponse) Reset()         { *m = MsgLiquidateResponse{} }
 func (m *MsgLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateResponse) ProtoMessage()    {}
 func (*MsgLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{11}
+	return fileDescriptor_e4920fb6256fc07f, []int{11}
 }
 func (m *MsgLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,6 +652,114 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
+// MsgFlashMint defines a message to mint debt to the sender, execute the wrapped
+// messages and burn the debt plus a fee from the sender within the same message.
+type MsgFlashMint struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// msgs are executed after the debt is minted, they must be signed by the sender only
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashMint) Reset()         { *m = MsgFlashMint{} }
+func (m *MsgFlashMint) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMint) ProtoMessage()    {}
+func (*MsgFlashMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{12}
+}
+func (m *MsgFlashMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMint.Merge(m, src)
+}
+func (m *MsgFlashMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMint proto.InternalMessageInfo
+
+func (m *MsgFlashMint) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFlashMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgFlashMint) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgFlashMintResponse defines the Msg/FlashMint response type.
+type MsgFlashMintResponse struct {
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgFlashMintResponse) Reset()         { *m = MsgFlashMintResponse{} }
+func (m *MsgFlashMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMintResponse) ProtoMessage()    {}
+func (*MsgFlashMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4920fb6256fc07f, []int{13}
+}
+func (m *MsgFlashMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMintResponse.Merge(m, src)
+}
+func (m *MsgFlashMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMintResponse proto.InternalMessageInfo
+
+func (m *MsgFlashMintResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateCDP)(nil), "fury.cdp.v1beta1.MsgCreateCDP")
 	proto.RegisterType((*MsgCreateCDPResponse)(nil), "fury.cdp.v1beta1.MsgCreateCDPResponse")
@@ -664,52 +773,61 @@ func init() {
 	proto.RegisterType((*MsgRepayDebtResponse)(nil), "fury.cdp.v1beta1.MsgRepayDebtResponse")
 	proto.RegisterType((*MsgLiquidate)(nil), "fury.cdp.v1beta1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "fury.cdp.v1beta1.MsgLiquidateResponse")
-}
-
-func init() { proto.RegisterFile("fury/cdp/v1beta1/tx.proto", fileDescriptor_3b8c9334ad8ab0d3) }
-
-var fileDescriptor_3b8c9334ad8ab0d3 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0x9b, 0x26, 0x6d, 0xb6, 0xbf, 0x7e, 0x90, 0x09, 0x28, 0x89, 0xc0, 0x8d, 0x22, 0x0a,
-	0xbd, 0xd4, 0xa6, 0x05, 0x21, 0x38, 0xa0, 0x8a, 0x24, 0x97, 0x4a, 0x44, 0xaa, 0x5c, 0x24, 0x24,
-	0x2e, 0xd1, 0xda, 0xbb, 0x72, 0xad, 0x26, 0xde, 0x65, 0x77, 0xdb, 0x34, 0x6f, 0xc1, 0x1b, 0x70,
-	0x41, 0xe2, 0x05, 0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x9c, 0x02, 0x4a, 0x4e, 0x3c, 0x01, 0x57, 0x64,
-	0xaf, 0xbd, 0x8e, 0x52, 0x27, 0x04, 0x10, 0x17, 0x6e, 0xeb, 0xfd, 0x66, 0x3e, 0x7d, 0xdf, 0x78,
-	0x66, 0x16, 0x54, 0x4f, 0xe0, 0x19, 0xb4, 0x5c, 0x44, 0xad, 0xb3, 0x5d, 0x07, 0x0b, 0xb8, 0x6b,
-	0x89, 0x73, 0x93, 0x32, 0x22, 0x88, 0x7e, 0x3d, 0x84, 0x4c, 0x17, 0x51, 0x33, 0x86, 0x6a, 0x86,
-	0x4b, 0x78, 0x9f, 0x70, 0xcb, 0x81, 0x1c, 0xab, 0x78, 0x97, 0xf8, 0x81, 0xcc, 0xa8, 0x55, 0x25,
-	0xde, 0x8d, 0xbe, 0x2c, 0xf9, 0x11, 0x43, 0x65, 0x8f, 0x78, 0x44, 0xde, 0x87, 0x27, 0x79, 0xdb,
-	0xf8, 0xa6, 0x81, 0xff, 0x3a, 0xdc, 0x6b, 0x31, 0x0c, 0x05, 0x6e, 0xb5, 0x0f, 0xf5, 0x07, 0xa0,
-	0xc8, 0x71, 0x80, 0x30, 0xab, 0x68, 0x75, 0x6d, 0xbb, 0xd4, 0xac, 0x7c, 0xfa, 0xb8, 0x53, 0x8e,
-	0x89, 0x9e, 0x23, 0xc4, 0x30, 0xe7, 0x47, 0x82, 0xf9, 0x81, 0x67, 0xc7, 0x71, 0xfa, 0x3e, 0x00,
-	0x2e, 0xe9, 0xf5, 0xa0, 0xc0, 0x0c, 0xf6, 0x2a, 0x2b, 0x75, 0x6d, 0x7b, 0x63, 0xaf, 0x6a, 0xc6,
-	0x29, 0xa1, 0xd0, 0x44, 0xbd, 0xd9, 0x22, 0x7e, 0xd0, 0x5c, 0xbd, 0x18, 0x6d, 0xe6, 0xec, 0xa9,
-	0x14, 0xfd, 0x19, 0x28, 0x51, 0xe6, 0x07, 0xae, 0x4f, 0x61, 0xaf, 0x92, 0x5f, 0x2e, 0x3f, 0xcd,
-	0xd0, 0xef, 0x83, 0x6b, 0x29, 0x59, 0x57, 0x0c, 0x29, 0xae, 0xac, 0x86, 0xd2, 0xed, 0xff, 0xd3,
-	0xeb, 0x97, 0x43, 0x8a, 0x1b, 0x4f, 0x40, 0x79, 0xda, 0xaa, 0x8d, 0x39, 0x25, 0x01, 0xc7, 0x7a,
-	0x1d, 0x14, 0x5d, 0x44, 0xbb, 0x3e, 0x8a, 0x2c, 0xaf, 0x36, 0x4b, 0xe3, 0xd1, 0x66, 0xa1, 0x85,
-	0xe8, 0x41, 0xdb, 0x2e, 0xb8, 0x88, 0x1e, 0xa0, 0xc6, 0x48, 0x03, 0xa0, 0xc3, 0xbd, 0x36, 0xa6,
-	0x84, 0xfb, 0x42, 0x7f, 0x0c, 0x4a, 0x48, 0x1e, 0xc9, 0xcf, 0xcb, 0x94, 0x86, 0xea, 0x26, 0x28,
-	0x90, 0x41, 0x80, 0x59, 0x54, 0xa4, 0x45, 0x39, 0x32, 0x6c, 0xa6, 0xb2, 0xf9, 0x5f, 0xaf, 0xec,
-	0xd2, 0xa5, 0x29, 0x03, 0x3d, 0xf5, 0x97, 0x14, 0xa6, 0xf1, 0x45, 0x03, 0x1b, 0x1d, 0xee, 0xbd,
-	0xf2, 0xc5, 0x31, 0x62, 0x70, 0xf0, 0x0f, 0xfa, 0xbe, 0x09, 0x6e, 0x4c, 0x19, 0x54, 0xc6, 0x3f,
-	0x48, 0xe3, 0x6d, 0x06, 0x07, 0x6d, 0xec, 0x88, 0xdf, 0x18, 0x8a, 0x0c, 0x05, 0x2b, 0x59, 0x0a,
-	0xfe, 0xb0, 0xf9, 0x63, 0x03, 0x89, 0x50, 0x65, 0xe0, 0xbd, 0x1c, 0x6b, 0x1b, 0x53, 0x38, 0xfc,
-	0xdb, 0x0e, 0x9e, 0x82, 0x35, 0x0a, 0x87, 0x7d, 0x1c, 0x88, 0x65, 0xf5, 0x27, 0xf1, 0x8d, 0x5b,
-	0xd1, 0x44, 0x2a, 0x95, 0x4a, 0xfe, 0x3b, 0x29, 0xff, 0x85, 0xff, 0xe6, 0xd4, 0x47, 0x50, 0xe0,
-	0x50, 0xfe, 0x09, 0xc6, 0x74, 0x19, 0xf9, 0x32, 0x4e, 0x7f, 0x04, 0xd6, 0x1d, 0xc2, 0x18, 0x19,
-	0x2c, 0xd1, 0x76, 0x2a, 0x32, 0xcb, 0x74, 0x3e, 0xb3, 0x71, 0xa4, 0x72, 0x25, 0x30, 0x51, 0xbe,
-	0xf7, 0x3d, 0x0f, 0xf2, 0x1d, 0xee, 0xe9, 0x47, 0xa0, 0x94, 0xee, 0x54, 0xc3, 0x9c, 0x5d, 0xe4,
-	0xe6, 0xf4, 0x22, 0xaa, 0xdd, 0x5b, 0x8c, 0xab, 0x45, 0xd5, 0x01, 0x6b, 0xc9, 0x0a, 0xba, 0x9d,
-	0x99, 0x12, 0xa3, 0xb5, 0xbb, 0x8b, 0x50, 0x45, 0x77, 0x08, 0xd6, 0xd5, 0x68, 0xdf, 0xc9, 0xcc,
-	0x48, 0xe0, 0xda, 0xd6, 0x42, 0x78, 0x9a, 0x51, 0xcd, 0x4c, 0x36, 0x63, 0x02, 0xcf, 0x61, 0x9c,
-	0x6d, 0xe4, 0xb0, 0x8e, 0x69, 0x13, 0x67, 0xd7, 0x51, 0xe1, 0x73, 0xea, 0x78, 0xa5, 0xbd, 0x42,
-	0xd2, 0xb4, 0xb5, 0xb2, 0x49, 0x15, 0x3e, 0x87, 0xf4, 0xca, 0x9f, 0x6f, 0xee, 0x5f, 0x8c, 0x0d,
-	0xed, 0x72, 0x6c, 0x68, 0x5f, 0xc7, 0x86, 0xf6, 0x76, 0x62, 0xe4, 0x2e, 0x27, 0x46, 0xee, 0xf3,
-	0xc4, 0xc8, 0xbd, 0xde, 0xf2, 0x7c, 0x71, 0x7c, 0xea, 0x98, 0x2e, 0xe9, 0x5b, 0x21, 0xd7, 0x4e,
-	0x0f, 0x3a, 0x3c, 0x3a, 0x59, 0xe7, 0xd1, 0xc3, 0x1f, 0xf6, 0x19, 0x77, 0x8a, 0xd1, 0x8b, 0xfc,
-	0xf0, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x75, 0x8a, 0x38, 0x11, 0x08, 0x00, 0x00,
+	proto.RegisterType((*MsgFlashMint)(nil), "fury.cdp.v1beta1.MsgFlashMint")
+	proto.RegisterType((*MsgFlashMintResponse)(nil), "fury.cdp.v1beta1.MsgFlashMintResponse")
+}
+
+func init() { proto.RegisterFile("fury/cdp/v1beta1/tx.proto", fileDescriptor_e4920fb6256fc07f) }
+
+var fileDescriptor_e4920fb6256fc07f = []byte{
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x9b, 0x34, 0x69, 0x26, 0xd5, 0xef, 0x87, 0x4c, 0x40, 0xae, 0x05, 0x6e, 0x14, 0xd1,
+	0x90, 0x4b, 0xed, 0xb6, 0xfc, 0x3f, 0x20, 0x68, 0x12, 0x21, 0x55, 0x22, 0x52, 0x95, 0x22, 0x21,
+	0x71, 0xa9, 0x36, 0xf6, 0xd6, 0xb5, 0x9a, 0x78, 0x17, 0xef, 0xa6, 0x69, 0xde, 0x82, 0x37, 0xe0,
+	0x82, 0xc4, 0x95, 0x43, 0x1f, 0xa2, 0x42, 0x1c, 0x2a, 0x4e, 0x9c, 0x0a, 0x4a, 0x4f, 0xbc, 0x05,
+	0x4a, 0x6c, 0xaf, 0xad, 0xca, 0x72, 0x43, 0x11, 0x17, 0x6e, 0x5e, 0x7f, 0x33, 0x9f, 0xbf, 0x6f,
+	0x34, 0x33, 0x5e, 0x58, 0xda, 0x1b, 0x78, 0x23, 0xc3, 0xb4, 0xa8, 0x71, 0xb8, 0xde, 0xc5, 0x1c,
+	0xad, 0x1b, 0xfc, 0x48, 0xa7, 0x1e, 0xe1, 0x44, 0xbe, 0x36, 0x81, 0x74, 0xd3, 0xa2, 0x7a, 0x00,
+	0xa9, 0x9a, 0x49, 0x58, 0x9f, 0x30, 0xa3, 0x8b, 0x18, 0x16, 0xf1, 0x26, 0x71, 0x5c, 0x3f, 0x43,
+	0x5d, 0xf2, 0xf1, 0xdd, 0xe9, 0xc9, 0xf0, 0x0f, 0x01, 0x54, 0xb6, 0x89, 0x4d, 0xfc, 0xf7, 0x93,
+	0xa7, 0x30, 0xc1, 0x26, 0xc4, 0xee, 0x61, 0x63, 0x7a, 0xea, 0x0e, 0xf6, 0x0c, 0xe4, 0x8e, 0x7c,
+	0xa8, 0xfa, 0x53, 0x82, 0xc5, 0x36, 0xb3, 0x9b, 0x1e, 0x46, 0x1c, 0x37, 0x5b, 0xdb, 0xf2, 0x1a,
+	0xe4, 0x19, 0x76, 0x2d, 0xec, 0x29, 0x52, 0x45, 0xaa, 0x17, 0x1b, 0xca, 0xd7, 0xe3, 0xd5, 0x72,
+	0xf0, 0x8d, 0x4d, 0xcb, 0xf2, 0x30, 0x63, 0x3b, 0xdc, 0x73, 0x5c, 0xbb, 0x13, 0xc4, 0xc9, 0xcf,
+	0x00, 0x4c, 0xd2, 0xeb, 0x21, 0x8e, 0x3d, 0xd4, 0x53, 0xe6, 0x2a, 0x52, 0xbd, 0xb4, 0xb1, 0xa4,
+	0x07, 0x29, 0x13, 0x0f, 0xa1, 0x31, 0xbd, 0x49, 0x1c, 0xb7, 0x91, 0x3b, 0x39, 0x5b, 0xce, 0x74,
+	0x62, 0x29, 0xf2, 0x53, 0x28, 0x52, 0xcf, 0x71, 0x4d, 0x87, 0xa2, 0x9e, 0x92, 0x9d, 0x2d, 0x3f,
+	0xca, 0x90, 0xef, 0xc2, 0xff, 0x11, 0xd9, 0x2e, 0x1f, 0x51, 0xac, 0xe4, 0x26, 0xd2, 0x3b, 0xff,
+	0x45, 0xaf, 0x5f, 0x8d, 0x28, 0xae, 0x3e, 0x86, 0x72, 0xdc, 0x6a, 0x07, 0x33, 0x4a, 0x5c, 0x86,
+	0xe5, 0x0a, 0xe4, 0x4d, 0x8b, 0xee, 0x3a, 0xd6, 0xd4, 0x72, 0xae, 0x51, 0x1c, 0x9f, 0x2d, 0xcf,
+	0x37, 0x2d, 0xba, 0xd5, 0xea, 0xcc, 0x9b, 0x16, 0xdd, 0xb2, 0xaa, 0x67, 0x12, 0x40, 0x9b, 0xd9,
+	0x2d, 0x4c, 0x09, 0x73, 0xb8, 0xfc, 0x10, 0x8a, 0x96, 0xff, 0x48, 0x2e, 0x2f, 0x53, 0x14, 0x2a,
+	0xeb, 0x30, 0x4f, 0x86, 0x2e, 0xf6, 0x94, 0xb9, 0x4b, 0x72, 0xfc, 0xb0, 0x0b, 0x95, 0xcd, 0xfe,
+	0x7e, 0x65, 0x67, 0x2e, 0x4d, 0x19, 0xe4, 0xc8, 0x5f, 0x58, 0x98, 0xea, 0x77, 0x09, 0x4a, 0x6d,
+	0x66, 0xbf, 0x76, 0xf8, 0xbe, 0xe5, 0xa1, 0xe1, 0x3f, 0xe8, 0xfb, 0x06, 0x5c, 0x8f, 0x19, 0x14,
+	0xc6, 0x3f, 0xfa, 0xc6, 0x5b, 0x1e, 0x1a, 0xb6, 0x70, 0x97, 0x5f, 0x61, 0x28, 0x12, 0x14, 0xcc,
+	0x25, 0x29, 0xf8, 0xc3, 0xe6, 0x0f, 0x0c, 0x84, 0x42, 0x85, 0x81, 0x0f, 0xfe, 0x58, 0x77, 0x30,
+	0x45, 0xa3, 0xbf, 0xed, 0xe0, 0x09, 0x14, 0x28, 0x1a, 0xf5, 0xb1, 0xcb, 0x67, 0xd5, 0x1f, 0xc6,
+	0x57, 0x6f, 0x42, 0x39, 0xae, 0x52, 0xc8, 0x7f, 0xef, 0xcb, 0x7f, 0xe9, 0xbc, 0x1d, 0x38, 0x16,
+	0xe2, 0x78, 0x22, 0xff, 0x00, 0x63, 0x3a, 0x8b, 0x7c, 0x3f, 0x4e, 0xbe, 0x0f, 0x0b, 0x5d, 0xe2,
+	0x79, 0x64, 0x38, 0x43, 0xdb, 0x89, 0xc8, 0x24, 0xd3, 0xd9, 0xc4, 0xc6, 0xf1, 0x95, 0x0b, 0x81,
+	0x42, 0xf9, 0x27, 0x5f, 0xf9, 0x8b, 0x1e, 0x62, 0xfb, 0x6d, 0xc7, 0xbd, 0x4a, 0xe1, 0x1f, 0x41,
+	0x1e, 0xf5, 0xc9, 0xc0, 0xe5, 0xb3, 0xee, 0xd2, 0x20, 0x5c, 0x7e, 0x00, 0xb9, 0x3e, 0xb3, 0x99,
+	0x92, 0xad, 0x64, 0xeb, 0xa5, 0x8d, 0xb2, 0xee, 0x6f, 0x7d, 0x3d, 0xdc, 0xfa, 0xfa, 0xa6, 0x3b,
+	0x6a, 0x94, 0x3e, 0x1f, 0xaf, 0x16, 0x98, 0x75, 0xa0, 0x4f, 0xea, 0x3e, 0x0d, 0xaf, 0xae, 0x41,
+	0x39, 0xae, 0x58, 0xac, 0x45, 0x05, 0x0a, 0x1e, 0x66, 0x83, 0x1e, 0x67, 0x8a, 0x54, 0xc9, 0xd6,
+	0x17, 0x3b, 0xe1, 0x71, 0xe3, 0x4b, 0x0e, 0xb2, 0x6d, 0x66, 0xcb, 0x3b, 0x50, 0x8c, 0x7e, 0x1c,
+	0x9a, 0x7e, 0xf1, 0x47, 0xa6, 0xc7, 0xb7, 0xad, 0x5a, 0x4b, 0xc7, 0xc5, 0x67, 0xdb, 0x50, 0x08,
+	0xf7, 0xec, 0xad, 0xc4, 0x94, 0x00, 0x55, 0xef, 0xa4, 0xa1, 0x82, 0x6e, 0x1b, 0x16, 0xc4, 0xfe,
+	0xba, 0x9d, 0x98, 0x11, 0xc2, 0xea, 0x4a, 0x2a, 0x1c, 0x67, 0x14, 0x8b, 0x21, 0x99, 0x31, 0x84,
+	0xd5, 0x95, 0x54, 0x58, 0x30, 0xee, 0x40, 0x31, 0x9a, 0xd4, 0xe4, 0x3a, 0x0a, 0x5c, 0xad, 0xa5,
+	0xe3, 0x71, 0xd2, 0x68, 0x7e, 0x92, 0x49, 0x05, 0xae, 0xd6, 0xd2, 0xf1, 0x38, 0x69, 0xd4, 0xda,
+	0xc9, 0xa4, 0x02, 0x57, 0x6b, 0xe9, 0x78, 0x48, 0xda, 0x78, 0x7e, 0x32, 0xd6, 0xa4, 0xd3, 0xb1,
+	0x26, 0xfd, 0x18, 0x6b, 0xd2, 0xbb, 0x73, 0x2d, 0x73, 0x7a, 0xae, 0x65, 0xbe, 0x9d, 0x6b, 0x99,
+	0x37, 0x35, 0xdb, 0xe1, 0xfb, 0x83, 0xae, 0x6e, 0x92, 0xbe, 0xd1, 0x47, 0x36, 0x5e, 0x35, 0xc9,
+	0x21, 0x76, 0x8d, 0xe9, 0x65, 0xea, 0x68, 0x7a, 0x9d, 0x9a, 0x8c, 0x28, 0xeb, 0xe6, 0xa7, 0x3d,
+	0x7e, 0xef, 0xd7, 0x00, 0xd2, 0x02, 0xff, 0x7b, 0x67, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +855,9 @@ type MsgClient interface {
 	// Liquidate defines a method to attempt to liquidate a CDP whos
 	// collateralization ratio is under its liquidation ratio.
 	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// FlashMint defines a method to mint debt that must be repaid with a fee
+	// after executing the wrapped messages.
+	FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +922,15 @@ func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error) {
+	out := new(MsgFlashMintResponse)
+	err := c.cc.Invoke(ctx, "/fury.cdp.v1beta1.Msg/FlashMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateCDP defines a method to create a new CDP.
@@ -816,6 +946,9 @@ type MsgServer interface {
 	// Liquidate defines a method to attempt to liquidate a CDP whos
 	// collateralization ratio is under its liquidation ratio.
 	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// FlashMint defines a method to mint debt that must be repaid with a fee
+	// after executing the wrapped messages.
+	FlashMint(context.Context, *MsgFlashMint) (*MsgFlashMintResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -840,6 +973,9 @@ func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt)
 func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
 }
+func (*UnimplementedMsgServer) FlashMint(ctx context.Context, req *MsgFlashMint) (*MsgFlashMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashMint not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -953,6 +1089,24 @@ func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FlashMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.cdp.v1beta1.Msg/FlashMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashMint(ctx, req.(*MsgFlashMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.cdp.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -981,6 +1135,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Liquidate",
 			Handler:    _Msg_Liquidate_Handler,
 		},
+		{
+			MethodName: "FlashMint",
+			Handler:    _Msg_FlashMint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/cdp/v1beta1/tx.proto",
@@ -1432,6 +1590,92 @@ func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFlashMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1626,6 +1870,42 @@ func (m *MsgLiquidateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFlashMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2929,6 +3209,237 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFlashMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlashMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlashMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlashMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlashMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlashMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	"github.com/mage-coven/fury/app"
 	cdptypes "github.com/mage-coven/fury/x/cdp/types"
 	types "github.com/mage-coven/fury/x/committee/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
 	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
 )
 
@@ -534,6 +535,117 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 	}
 }
 
+func (s *ParamsChangeTestSuite) TestMultiSubparams_HardMoneyMarkets() {
+	moneyMarkets := hardtypes.MoneyMarkets{
+		hardtypes.NewMoneyMarket(
+			"bnb",
+			hardtypes.NewBorrowLimit(true, sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5")),
+			"bnb:usd",
+			sdkmath.NewInt(100000000),
+			hardtypes.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.NewDec(5)),
+			sdk.MustNewDecFromStr("0.025"),
+			sdk.MustNewDecFromStr("0.02"),
+			hardtypes.NewSupplyLimit(true, sdk.NewDec(2000)),
+			hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+			hardtypes.DefaultLiquidationBonus,
+		),
+		hardtypes.NewMoneyMarket(
+			"btc",
+			hardtypes.NewBorrowLimit(true, sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5")),
+			"btc:usd",
+			sdkmath.NewInt(100000000),
+			hardtypes.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.NewDec(5)),
+			sdk.MustNewDecFromStr("0.025"),
+			sdk.MustNewDecFromStr("0.02"),
+			hardtypes.NewSupplyLimit(true, sdk.NewDec(2000)),
+			hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+			hardtypes.DefaultLiquidationBonus,
+		),
+	}
+	requirements := []types.SubparamRequirement{
+		{
+			Key:                        "denom",
+			Val:                        "bnb",
+			AllowedSubparamAttrChanges: []string{"supply_limit"},
+		},
+		{
+			Key:                        "denom",
+			Val:                        "btc",
+			AllowedSubparamAttrChanges: []string{"borrow_limit"},
+		},
+	}
+	moneyMarketValue := func(denom, supplyLimit string) string {
+		return fmt.Sprintf(`{
+			"denom": "%[1]s",
+			"borrow_limit": { "has_max_limit": true, "maximum_limit": "1000.000000000000000000", "loan_to_value": "0.500000000000000000" },
+			"spot_market_id": "%[1]s:usd",
+			"conversion_factor": "100000000",
+			"interest_rate_model": {
+				"base_rate_apy": "0.000000000000000000",
+				"base_multiplier": "0.050000000000000000",
+				"kink": "0.800000000000000000",
+				"jump_multiplier": "5.000000000000000000",
+				"kinks": null
+			},
+			"reserve_factor": "0.025000000000000000",
+			"keeper_reward_percentage": "0.020000000000000000",
+			"supply_limit": { "has_max_limit": true, "maximum_limit": "%[2]s" },
+			"isolation_mode": { "isolated": false, "debt_ceiling": "0.000000000000000000", "borrowable_in_isolation": false },
+			"liquidation_bonus": "0.050000000000000000"
+		}`, denom, supplyLimit)
+	}
+
+	testcases := []struct {
+		name     string
+		expected bool
+		value    string
+	}{
+		{
+			name:     "succeeds if nothing is changed",
+			expected: true,
+			value:    fmt.Sprintf("[%s, %s]", moneyMarketValue("bnb", "2000.000000000000000000"), moneyMarketValue("btc", "2000.000000000000000000")),
+		},
+		{
+			name:     "succeeds when changing an allowed supply limit",
+			expected: true,
+			value:    fmt.Sprintf("[%s, %s]", moneyMarketValue("bnb", "5000.000000000000000000"), moneyMarketValue("btc", "2000.000000000000000000")),
+		},
+		{
+			name:     "fails when changing a supply limit that is not allowed",
+			expected: false,
+			value:    fmt.Sprintf("[%s, %s]", moneyMarketValue("bnb", "2000.000000000000000000"), moneyMarketValue("btc", "5000.000000000000000000")),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			subspace, found := s.pk.GetSubspace(hardtypes.ModuleName)
+			s.Require().True(found)
+			subspace.Set(s.ctx, hardtypes.KeyMoneyMarkets, moneyMarkets)
+
+			permission := types.ParamsChangePermission{
+				AllowedParamsChanges: types.AllowedParamsChanges{{
+					Subspace:                   hardtypes.ModuleName,
+					Key:                        string(hardtypes.KeyMoneyMarkets),
+					MultiSubparamsRequirements: requirements,
+				}},
+			}
+			proposal := paramsproposal.NewParameterChangeProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]paramsproposal.ParamChange{{
+					Subspace: hardtypes.ModuleName,
+					Key:      string(hardtypes.KeyMoneyMarkets),
+					Value:    tc.value,
+				}},
+			)
+			s.Require().Equal(tc.expected, permission.Allows(s.ctx, s.pk, proposal))
+		})
+	}
+}
+
 func (s *ParamsChangeTestSuite) TestAllowedParamsChange_InvalidJSON() {
 	subspace, found := s.pk.GetSubspace(cdptypes.ModuleName)
 	s.Require().True(found)
@@ -18,6 +18,8 @@ func NewCDPGenState(cdc codec.JSONCodec, denom, asset string, liquidationRatio s
 			SurplusAuctionLot:       cdptypes.DefaultSurplusLot,
 			DebtAuctionThreshold:    cdptypes.DefaultDebtThreshold,
 			DebtAuctionLot:          cdptypes.DefaultDebtLot,
+			FlashMintCap:            cdptypes.DefaultFlashMintCap,
+			FlashMintFee:            cdptypes.DefaultFlashMintFee,
 			CollateralParams: cdptypes.CollateralParams{
 				{
 					Denom:                            denom,
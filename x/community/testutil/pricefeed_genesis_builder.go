@@ -41,6 +41,9 @@ func (b lendGenesisBuilder) WithMarket(denom, spotMarketId string, price sdk.Dec
 			hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
 			sdk.MustNewDecFromStr("0.05"),
 			sdk.ZeroDec(),
+			hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+			hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+			hardtypes.DefaultLiquidationBonus,
 		),
 	)
 
@@ -0,0 +1,18 @@
+package earn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/keeper"
+)
+
+// EndBlocker accrues vault fees, rebalances vaults with auto rebalancing
+// enabled, releases queued withdraws, auto-compounds the rewards of opted in
+// depositors and records vault share prices
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.AccrueAllVaultFees(ctx)
+	k.RebalanceVaults(ctx)
+	k.ReleaseWithdrawals(ctx)
+	k.AutoCompoundRewards(ctx)
+	k.RecordVaultSharePrices(ctx)
+}
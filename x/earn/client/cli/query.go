@@ -36,6 +36,12 @@ func GetQueryCmd() *cobra.Command {
 		queryVaultCmd(),
 		queryDepositsCmd(),
 		queryTotalSupplyCmd(),
+		queryVaultAllocationCmd(),
+		queryVaultFeesCmd(),
+		queryAutoCompoundSettingCmd(),
+		querySharePriceHistoryCmd(),
+		queryVaultApyCmd(),
+		queryWithdrawalRequestsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -206,3 +212,190 @@ func queryTotalSupplyCmd() *cobra.Command {
 		},
 	}
 }
+
+func queryVaultAllocationCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "allocation",
+		Short:   "get the strategy allocation of an earn vault",
+		Long:    "Get the value of an earn vault held in each of its strategies, and its drift from the target weights.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s allocation usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultAllocationRequest(args[0])
+			res, err := queryClient.VaultAllocation(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryVaultFeesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "fees",
+		Short:   "get the fees of an earn vault",
+		Long:    "Get the fees configured for an earn vault and the fees accrued to its fee recipient.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s fees usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultFeesRequest(args[0])
+			res, err := queryClient.VaultFees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryAutoCompoundSettingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "auto-compound",
+		Short:   "get the auto-compound setting of a depositor",
+		Long:    "Get the vault and slippage limit a depositor's earn rewards are auto-compounded with.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s auto-compound fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryAutoCompoundSettingRequest(args[0])
+			res, err := queryClient.AutoCompoundSetting(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func querySharePriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "share-price-history",
+		Short:   "get the share price history of an earn vault",
+		Long:    "Get the daily value per share snapshots of an earn vault, oldest first.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s share-price-history usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultSharePriceHistoryRequest(args[0], pageReq)
+			res, err := queryClient.VaultSharePriceHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "share-price-history")
+
+	return cmd
+}
+
+func queryVaultApyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "apy",
+		Short:   "get the realized APY of an earn vault",
+		Long:    "Get the annualized change in share price of an earn vault over 7, 30 and 90 days.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s apy usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultApyRequest(args[0])
+			res, err := queryClient.VaultApy(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryWithdrawalRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-requests",
+		Short: "get queued earn vault withdraws",
+		Long:  "Get queued earn vault withdraws in release order for all or specific accounts and vaults.",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q %[2]s withdrawal-requests
+%[1]s q %[2]s withdrawal-requests --owner fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --denom usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryWithdrawalRequestsRequest(ownerBech, denom, pageReq)
+			res, err := queryClient.WithdrawalRequests(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawal-requests")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for withdrawal requests by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for withdrawal requests by vault denom")
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,13 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdRebalanceVault(),
+		getCmdTokenizeShares(),
+		getCmdRedeemTokenizedShares(),
+		getCmdSetAutoCompound(),
+		getCmdDisableAutoCompound(),
+		getCmdQueueWithdraw(),
+		getCmdCancelWithdrawal(),
 	}
 
 	for _, cmd := range cmds {
@@ -112,6 +120,213 @@ func getCmdWithdraw() *cobra.Command {
 	}
 }
 
+func getCmdRebalanceVault() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebalance-vault [denom]",
+		Short: "rebalance an earn vault's funds between its strategies",
+		Example: fmt.Sprintf(
+			`%s tx %s rebalance-vault usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := types.NewMsgRebalanceVault(sender.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdTokenizeShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-shares [amount]",
+		Short: "convert earn vault shares worth an amount into transferable earn/<denom> tokens",
+		Example: fmt.Sprintf(
+			`%s tx %s tokenize-shares 10000000usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgTokenizeShares(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdRedeemTokenizedShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokenized-shares [amount]",
+		Short: "convert earn/<denom> tokens back into earn vault shares",
+		Example: fmt.Sprintf(
+			`%s tx %s redeem-tokenized-shares 10000000earn/usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgRedeemTokenizedShares(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdSetAutoCompound() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-compound [vault-denom] [slippage-limit]",
+		Short: "auto-compound earn rewards into a vault, swapping other reward denoms within a slippage limit",
+		Example: fmt.Sprintf(
+			`%s tx %s set-auto-compound usdx 0.01 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			slippageLimit, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetAutoCompound(signer.String(), true, args[0], slippageLimit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdDisableAutoCompound() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable-auto-compound",
+		Short: "stop auto-compounding earn rewards",
+		Example: fmt.Sprintf(
+			`%s tx %s disable-auto-compound --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetAutoCompound(signer.String(), false, "", sdk.Dec{})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdQueueWithdraw() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queue-withdraw [amount]",
+		Short: "queue a withdraw from an earn vault, released once the vault has the liquidity",
+		Example: fmt.Sprintf(
+			`%s tx %s queue-withdraw 10000000usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgQueueWithdraw(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdCancelWithdrawal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-withdrawal [request-id]",
+		Short: "cancel a queued earn vault withdraw",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-withdrawal 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelWithdrawal(signer.String(), requestID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdSubmitCommunityPoolDepositProposal implements the command to submit a community-pool deposit proposal
 func GetCmdSubmitCommunityPoolDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package earn
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -50,6 +51,29 @@ func InitGenesis(
 		k.SetVaultRecord(ctx, vaultRecord)
 	}
 
+	for _, feeRecord := range gs.VaultFeeRecords {
+		k.SetVaultFeeRecord(ctx, feeRecord)
+	}
+
+	for _, setting := range gs.AutoCompoundSettings {
+		k.SetAutoCompoundSetting(ctx, setting)
+	}
+
+	k.SetPreviousAutoCompoundTime(ctx, gs.PreviousAutoCompoundTime)
+
+	for _, snapshot := range gs.SharePriceSnapshots {
+		k.SetVaultSharePriceSnapshot(ctx, snapshot)
+	}
+
+	for _, request := range gs.WithdrawalRequests {
+		k.SetWithdrawalRequest(ctx, request)
+	}
+
+	// Genesis states from before the withdrawal queue have no next id
+	if gs.NextWithdrawalRequestID != 0 {
+		k.SetNextWithdrawalRequestID(ctx, gs.NextWithdrawalRequestID)
+	}
+
 	k.SetParams(ctx, gs.Params)
 }
 
@@ -58,6 +82,27 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	vaultRecords := k.GetAllVaultRecords(ctx)
 	vaultShareRecords := k.GetAllVaultShareRecords(ctx)
+	vaultFeeRecords := k.GetAllVaultFeeRecords(ctx)
+	autoCompoundSettings := k.GetAllAutoCompoundSettings(ctx)
+
+	previousAutoCompoundTime, found := k.GetPreviousAutoCompoundTime(ctx)
+	if !found {
+		previousAutoCompoundTime = time.Time{}
+	}
+
+	sharePriceSnapshots := k.GetAllVaultSharePriceSnapshots(ctx)
+	withdrawalRequests := k.GetAllWithdrawalRequests(ctx)
+	nextWithdrawalRequestID := k.GetNextWithdrawalRequestID(ctx)
 
-	return types.NewGenesisState(params, vaultRecords, vaultShareRecords)
+	return types.NewGenesisState(
+		params,
+		vaultRecords,
+		vaultShareRecords,
+		vaultFeeRecords,
+		autoCompoundSettings,
+		previousAutoCompoundTime,
+		sharePriceSnapshots,
+		withdrawalRequests,
+		nextWithdrawalRequestID,
+	)
 }
@@ -2,6 +2,7 @@ package earn_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mage-coven/fury/app"
 	"github.com/mage-coven/fury/x/earn"
@@ -35,6 +36,12 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 			},
 		},
 		types.VaultShareRecords{},
+		types.VaultFeeRecords{},
+		types.AutoCompoundSettings{},
+		time.Time{},
+		types.VaultSharePriceSnapshots{},
+		types.WithdrawalRequests{},
+		types.DefaultNextWithdrawalRequestID,
 	)
 
 	suite.Panics(func() {
@@ -90,6 +97,25 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 				),
 			},
 		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec(), sdk.ZeroDec()),
+		},
+		types.AutoCompoundSettings{
+			types.NewAutoCompoundSetting(depositor_1, "usdx", sdk.MustNewDecFromStr("0.01")),
+		},
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec()),
+		},
+		types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				1,
+				depositor_1,
+				types.NewVaultShare("usdx", sdk.NewDec(100000)),
+				time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		2,
 	)
 
 	earn.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -158,6 +184,25 @@ func (suite *genesisTestSuite) Test_Marshall() {
 				),
 			},
 		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec(), sdk.ZeroDec()),
+		},
+		types.AutoCompoundSettings{
+			types.NewAutoCompoundSetting(depositor_1, "usdx", sdk.MustNewDecFromStr("0.01")),
+		},
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec()),
+		},
+		types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				1,
+				depositor_1,
+				types.NewVaultShare("usdx", sdk.NewDec(100000)),
+				time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		2,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -0,0 +1,310 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+// GetVaultAllocation returns the value of a vault held in each of its
+// strategies, and the largest difference between a strategy's fraction of the
+// vault value and its target weight.
+func (k *Keeper) GetVaultAllocation(
+	ctx sdk.Context,
+	denom string,
+) ([]types.StrategyAllocationResponse, sdk.Dec, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return nil, sdk.Dec{}, types.ErrInvalidVaultDenom
+	}
+
+	weights := allowedVault.GetStrategyWeights()
+	values, total, err := k.getStrategyValues(ctx, weights, denom)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+
+	allocations := make([]types.StrategyAllocationResponse, len(weights))
+	drift := sdk.ZeroDec()
+	for i, weight := range weights {
+		currentWeight := sdk.ZeroDec()
+		if total.IsPositive() {
+			currentWeight = sdk.NewDecFromInt(values[i]).QuoInt(total)
+			drift = sdk.MaxDec(drift, currentWeight.Sub(weight.Weight).Abs())
+		}
+
+		allocations[i] = types.StrategyAllocationResponse{
+			Strategy:      weight.Strategy,
+			Value:         values[i],
+			CurrentWeight: currentWeight,
+			TargetWeight:  weight.Weight,
+		}
+	}
+
+	return allocations, drift, nil
+}
+
+// RebalanceVault moves funds between the strategies of a vault towards their
+// target weights. Funds are withdrawn from strategies above their weight and
+// deposited into strategies below it, with any remainder deposited by weight.
+// An error is returned if the allocation
+// has not drifted from the weights by more than the rebalance threshold.
+func (k *Keeper) RebalanceVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if allowedVault.StrategyAllocation == nil {
+		return errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "vault %s has a single strategy", denom)
+	}
+
+	allocations, drift, err := k.GetVaultAllocation(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if drift.LTE(allowedVault.StrategyAllocation.RebalanceThreshold) {
+		return errorsmod.Wrapf(
+			types.ErrRebalanceNotNeeded,
+			"vault %s drift %s <= threshold %s",
+			denom, drift, allowedVault.StrategyAllocation.RebalanceThreshold,
+		)
+	}
+
+	total := sdk.ZeroInt()
+	for _, allocation := range allocations {
+		total = total.Add(allocation.Value)
+	}
+
+	// Withdraw the excess of over-allocated strategies to the module account.
+	// The amount actually withdrawn is measured from the module account, as
+	// strategies may return more than requested.
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balanceBefore := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), denom).Amount
+
+	deficits := make([]sdkmath.Int, len(allocations))
+	totalDeficit := sdk.ZeroInt()
+	for i, allocation := range allocations {
+		target := sdk.NewDecFromInt(total).Mul(allocation.TargetWeight).TruncateInt()
+		deficits[i] = sdk.ZeroInt()
+
+		if allocation.Value.LT(target) {
+			deficits[i] = target.Sub(allocation.Value)
+			totalDeficit = totalDeficit.Add(deficits[i])
+			continue
+		}
+
+		excess := allocation.Value.Sub(target)
+		if excess.IsZero() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(allocation.Strategy)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Withdraw(ctx, sdk.NewCoin(denom, excess)); err != nil {
+			return err
+		}
+	}
+
+	withdrawn := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), denom).Amount.Sub(balanceBefore)
+
+	// Deposit the withdrawn funds into under-allocated strategies in
+	// proportion to their deficits
+	remaining := withdrawn
+	for i, allocation := range allocations {
+		if deficits[i].IsZero() || remaining.IsZero() {
+			continue
+		}
+
+		amount := withdrawn.Mul(deficits[i]).Quo(totalDeficit)
+		totalDeficit = totalDeficit.Sub(deficits[i])
+		if totalDeficit.IsZero() {
+			// The last under-allocated strategy receives any remainder
+			amount = remaining
+		}
+		remaining = remaining.Sub(amount)
+
+		if amount.IsZero() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(allocation.Strategy)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Deposit(ctx, sdk.NewCoin(denom, amount)); err != nil {
+			return err
+		}
+	}
+
+	// Funds left in the module account are not counted in the vault value, so
+	// anything not deposited above is returned to the strategies by weight
+	if remaining.IsPositive() {
+		if err := k.depositToStrategies(ctx, allowedVault, sdk.NewCoin(denom, remaining)); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyDrift, drift.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawn.String()),
+		),
+	)
+
+	return nil
+}
+
+// RebalanceVaults rebalances all vaults with auto rebalancing enabled whose
+// allocation has drifted by more than their rebalance threshold. A vault that
+// fails to rebalance is left unchanged.
+func (k *Keeper) RebalanceVaults(ctx sdk.Context) {
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) (stop bool) {
+		denom := record.TotalShares.Denom
+
+		allowedVault, found := k.GetAllowedVault(ctx, denom)
+		if !found || allowedVault.StrategyAllocation == nil || !allowedVault.StrategyAllocation.AutoRebalance {
+			return false
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.RebalanceVault(cacheCtx, denom); err != nil {
+			if !errors.Is(err, types.ErrRebalanceNotNeeded) {
+				k.Logger(ctx).Error("failed to rebalance vault", "denom", denom, "err", err)
+			}
+
+			return false
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		return false
+	})
+}
+
+// depositToStrategies deposits an amount held by the module account into the
+// strategies of a vault, split by their target weights.
+func (k *Keeper) depositToStrategies(ctx sdk.Context, allowedVault types.AllowedVault, amount sdk.Coin) error {
+	weights := allowedVault.GetStrategyWeights()
+
+	remaining := amount.Amount
+	for i, weight := range weights {
+		portion := sdk.NewDecFromInt(amount.Amount).Mul(weight.Weight).TruncateInt()
+		if i == len(weights)-1 {
+			// The last strategy receives any remainder
+			portion = remaining
+		}
+		remaining = remaining.Sub(portion)
+
+		if portion.IsZero() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(weight.Strategy)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Deposit(ctx, sdk.NewCoin(amount.Denom, portion)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withdrawFromStrategies withdraws an amount from the strategies of a vault
+// to the module account, split by their target weights. Any part that a
+// strategy cannot cover is withdrawn from the other strategies.
+func (k *Keeper) withdrawFromStrategies(ctx sdk.Context, allowedVault types.AllowedVault, amount sdk.Coin) error {
+	weights := allowedVault.GetStrategyWeights()
+
+	if len(weights) == 1 {
+		strategy, err := k.GetStrategy(weights[0].Strategy)
+		if err != nil {
+			return err
+		}
+
+		return strategy.Withdraw(ctx, amount)
+	}
+
+	values, _, err := k.getStrategyValues(ctx, weights, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	withdrawals := make([]sdkmath.Int, len(weights))
+	remaining := amount.Amount
+	for i, weight := range weights {
+		portion := sdk.NewDecFromInt(amount.Amount).Mul(weight.Weight).TruncateInt()
+		withdrawals[i] = sdkmath.MinInt(portion, values[i])
+		remaining = remaining.Sub(withdrawals[i])
+	}
+
+	for i := range weights {
+		extra := sdkmath.MinInt(remaining, values[i].Sub(withdrawals[i]))
+		withdrawals[i] = withdrawals[i].Add(extra)
+		remaining = remaining.Sub(extra)
+	}
+
+	if remaining.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "vault strategies hold less than %s", amount)
+	}
+
+	for i, weight := range weights {
+		if withdrawals[i].IsZero() {
+			continue
+		}
+
+		strategy, err := k.GetStrategy(weight.Strategy)
+		if err != nil {
+			return err
+		}
+
+		if err := strategy.Withdraw(ctx, sdk.NewCoin(amount.Denom, withdrawals[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getStrategyValues returns the value held in each of the weighted strategies
+// and their total.
+func (k *Keeper) getStrategyValues(
+	ctx sdk.Context,
+	weights types.StrategyWeights,
+	denom string,
+) ([]sdkmath.Int, sdkmath.Int, error) {
+	values := make([]sdkmath.Int, len(weights))
+	total := sdk.ZeroInt()
+
+	for i, weight := range weights {
+		strategy, err := k.GetStrategy(weight.Strategy)
+		if err != nil {
+			return nil, sdkmath.Int{}, types.ErrInvalidVaultStrategy
+		}
+
+		value, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+
+		values[i] = value.Amount
+		total = total.Add(value.Amount)
+	}
+
+	return values, total, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn"
+	"github.com/mage-coven/fury/x/earn/keeper"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type allocationTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *allocationTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.setWeights("0.6", "0.4", false)
+}
+
+func TestAllocationTestSuite(t *testing.T) {
+	suite.Run(t, new(allocationTestSuite))
+}
+
+// setWeights sets a usdx vault spread across the hard and savings strategies
+func (suite *allocationTestSuite) setWeights(hardWeight, savingsWeight string, autoRebalance bool) {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(
+			"usdx",
+			types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+			false,
+			nil,
+			types.WithStrategyAllocation(types.NewStrategyAllocation(
+				types.StrategyWeights{
+					types.NewStrategyWeight(types.STRATEGY_TYPE_HARD, sdk.MustNewDecFromStr(hardWeight)),
+					types.NewStrategyWeight(types.STRATEGY_TYPE_SAVINGS, sdk.MustNewDecFromStr(savingsWeight)),
+				},
+				sdk.MustNewDecFromStr("0.05"),
+				autoRebalance,
+			)),
+		),
+	}))
+}
+
+func (suite *allocationTestSuite) TestDepositAndWithdraw_FollowWeights() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 600)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 400)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)))
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 500), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 300)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 200)))
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("usdx", 500)))
+}
+
+func (suite *allocationTestSuite) TestReleaseWithdrawal_FollowWeights() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 500))
+	suite.Require().NoError(err)
+
+	// A released withdraw is taken from the strategies by their weights
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 300)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 200)))
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("usdx", 500)))
+}
+
+func (suite *allocationTestSuite) TestRebalanceVault() {
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// allocation matches the weights
+	_, err = msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRebalanceVault(acc.GetAddress().String(), "usdx"))
+	suite.Require().ErrorIs(err, types.ErrRebalanceNotNeeded)
+
+	// changing the weights creates drift
+	suite.setWeights("0.2", "0.8", false)
+	res, err := queryServer.VaultAllocation(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultAllocationRequest("usdx"))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.4"), res.Drift)
+	suite.Equal([]types.StrategyAllocationResponse{
+		{
+			Strategy:      types.STRATEGY_TYPE_HARD,
+			Value:         sdkmath.NewInt(600),
+			CurrentWeight: sdk.MustNewDecFromStr("0.6"),
+			TargetWeight:  sdk.MustNewDecFromStr("0.2"),
+		},
+		{
+			Strategy:      types.STRATEGY_TYPE_SAVINGS,
+			Value:         sdkmath.NewInt(400),
+			CurrentWeight: sdk.MustNewDecFromStr("0.4"),
+			TargetWeight:  sdk.MustNewDecFromStr("0.8"),
+		},
+	}, res.Allocations)
+
+	_, err = msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRebalanceVault(acc.GetAddress().String(), "usdx"))
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 200)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 800)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins())
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultRebalance,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, "usdx"),
+		sdk.NewAttribute(types.AttributeKeyDrift, sdk.MustNewDecFromStr("0.4").String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+	))
+}
+
+func (suite *allocationTestSuite) TestEndBlocker_AutoRebalance() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// vaults without auto rebalancing are not rebalanced
+	suite.setWeights("0.5", "0.5", false)
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 600)))
+
+	suite.setWeights("0.5", "0.5", true)
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 500)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 500)))
+}
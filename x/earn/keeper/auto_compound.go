@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of a depositor's
+// earn rewards into a vault.
+func (k *Keeper) SetAutoCompound(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	enabled bool,
+	vaultDenom string,
+	slippageLimit sdk.Dec,
+) error {
+	if !enabled {
+		k.DeleteAutoCompoundSetting(ctx, depositor)
+		return nil
+	}
+
+	allowedVault, found := k.GetAllowedVault(ctx, vaultDenom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if !allowedVault.IsAccountAllowed(depositor) {
+		return types.ErrAccountDepositNotAllowed
+	}
+
+	if !k.hasVaultPosition(ctx, depositor, vaultDenom) {
+		return errorsmod.Wrapf(
+			types.ErrVaultShareRecordNotFound,
+			"auto-compounding requires a deposit in the %s vault",
+			vaultDenom,
+		)
+	}
+
+	setting := types.NewAutoCompoundSetting(depositor, vaultDenom, slippageLimit)
+	if err := setting.Validate(); err != nil {
+		return err
+	}
+
+	k.SetAutoCompoundSetting(ctx, setting)
+
+	return nil
+}
+
+// AutoCompound claims the earn rewards of a depositor, swaps rewards in other
+// denoms to the vault denom through x/swap within the depositor's slippage
+// limit, and deposits the proceeds into the vault. Only rewards that can be
+// claimed without a lockup are compounded. Rewards without a swap pool to the
+// vault denom are left in the depositor's account.
+func (k *Keeper) AutoCompound(ctx sdk.Context, setting types.AutoCompoundSetting) error {
+	allowedVault, found := k.GetAllowedVault(ctx, setting.VaultDenom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	depositor := setting.Depositor
+	balanceBefore := k.bankKeeper.GetBalance(ctx, depositor, setting.VaultDenom).Amount
+
+	rewards, err := k.incentiveKeeper.ClaimUnlockedEarnRewards(ctx, depositor, depositor)
+	if err != nil {
+		return err
+	}
+
+	for _, reward := range rewards {
+		if reward.Denom == setting.VaultDenom {
+			continue
+		}
+
+		if err := k.swapReward(ctx, depositor, reward, setting.VaultDenom, setting.SlippageLimit); err != nil {
+			return err
+		}
+	}
+
+	amount := sdk.NewCoin(
+		setting.VaultDenom,
+		k.bankKeeper.GetBalance(ctx, depositor, setting.VaultDenom).Amount.Sub(balanceBefore),
+	)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if err := k.Deposit(ctx, depositor, amount, allowedVault.Strategies[0]); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, setting.VaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// AutoCompoundRewards auto-compounds the rewards of all depositors with
+// auto-compounding enabled, once every AutoCompoundPeriod. Each round is
+// processed in batches of at most MaxAutoCompoundsPerBlock settings per block,
+// continuing from a stored cursor. Settings of depositors that no longer hold
+// shares of the vault are removed. A depositor whose rewards fail to compound
+// is left unchanged.
+func (k *Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	if k.incentiveKeeper == nil {
+		return
+	}
+
+	cursor, inProgress := k.GetAutoCompoundCursor(ctx)
+	if !inProgress {
+		previousTime, found := k.GetPreviousAutoCompoundTime(ctx)
+		if found && ctx.BlockTime().Before(previousTime.Add(types.AutoCompoundPeriod)) {
+			return
+		}
+
+		k.SetPreviousAutoCompoundTime(ctx, ctx.BlockTime())
+	}
+
+	// Settings are collected first as compounding writes to the store
+	settings, next := k.GetAutoCompoundSettingsBatch(ctx, cursor, types.MaxAutoCompoundsPerBlock)
+	if next != nil {
+		k.SetAutoCompoundCursor(ctx, next)
+	} else {
+		k.DeleteAutoCompoundCursor(ctx)
+	}
+
+	for _, setting := range settings {
+		if !k.hasVaultPosition(ctx, setting.Depositor, setting.VaultDenom) {
+			k.DeleteAutoCompoundSetting(ctx, setting.Depositor)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.AutoCompound(cacheCtx, setting); err != nil {
+			k.Logger(ctx).Error(
+				"failed to auto-compound rewards",
+				"depositor", setting.Depositor.String(),
+				"denom", setting.VaultDenom,
+				"err", err,
+			)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// hasVaultPosition returns true if an account holds shares of a vault, either
+// in its share record or as tokenized shares.
+func (k *Keeper) hasVaultPosition(ctx sdk.Context, acc sdk.AccAddress, vaultDenom string) bool {
+	return k.GetVaultAccountRewardShares(ctx, acc).AmountOf(vaultDenom).IsPositive()
+}
+
+// swapReward swaps a reward to the vault denom through the x/swap pool of the
+// two denoms. The slippage of the swap is limited against the oracle price of
+// the two denoms, so that a swap through a manipulated pool fails. Rewards
+// without a pool or an oracle price are left in the depositor's account.
+func (k *Keeper) swapReward(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	reward sdk.Coin,
+	vaultDenom string,
+	slippageLimit sdk.Dec,
+) error {
+	poolID := swaptypes.PoolID(reward.Denom, vaultDenom)
+	if _, found := k.swapKeeper.GetPool(ctx, poolID); !found {
+		return nil
+	}
+
+	rate, err := k.getOracleExchangeRate(ctx, reward.Denom, vaultDenom)
+	if err != nil {
+		k.Logger(ctx).Info(
+			"reward not swapped for auto-compounding",
+			"depositor", depositor.String(),
+			"denom", reward.Denom,
+			"err", err,
+		)
+		return nil
+	}
+
+	oracleOutput := sdk.NewCoin(vaultDenom, sdk.NewDecFromInt(reward.Amount).Mul(rate).TruncateInt())
+	if oracleOutput.IsZero() {
+		return nil
+	}
+
+	if err := k.swapKeeper.SwapExactForTokens(ctx, depositor, reward, oracleOutput, slippageLimit); err != nil {
+		return errorsmod.Wrapf(err, "failed to swap %s for %s", reward, vaultDenom)
+	}
+
+	return nil
+}
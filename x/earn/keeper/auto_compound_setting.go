@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// AutoCompoundSetting -- depositor opt-in to auto-compounding
+
+// GetAutoCompoundSetting returns the auto-compound setting of a depositor.
+func (k *Keeper) GetAutoCompoundSetting(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+) (types.AutoCompoundSetting, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+
+	bz := store.Get(types.AutoCompoundSettingKey(depositor))
+	if bz == nil {
+		return types.AutoCompoundSetting{}, false
+	}
+
+	var setting types.AutoCompoundSetting
+	k.cdc.MustUnmarshal(bz, &setting)
+
+	return setting, true
+}
+
+// SetAutoCompoundSetting sets the auto-compound setting of a depositor.
+func (k *Keeper) SetAutoCompoundSetting(ctx sdk.Context, setting types.AutoCompoundSetting) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	bz := k.cdc.MustMarshal(&setting)
+	store.Set(types.AutoCompoundSettingKey(setting.Depositor), bz)
+}
+
+// DeleteAutoCompoundSetting deletes the auto-compound setting of a depositor.
+func (k *Keeper) DeleteAutoCompoundSetting(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	store.Delete(types.AutoCompoundSettingKey(depositor))
+}
+
+// IterateAutoCompoundSettings iterates over all auto-compound settings in the
+// store and performs a callback function.
+func (k Keeper) IterateAutoCompoundSettings(
+	ctx sdk.Context,
+	cb func(setting types.AutoCompoundSetting) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var setting types.AutoCompoundSetting
+		k.cdc.MustUnmarshal(iterator.Value(), &setting)
+		if cb(setting) {
+			break
+		}
+	}
+}
+
+// GetAllAutoCompoundSettings returns all auto-compound settings from the
+// store.
+func (k Keeper) GetAllAutoCompoundSettings(ctx sdk.Context) types.AutoCompoundSettings {
+	var settings types.AutoCompoundSettings
+
+	k.IterateAutoCompoundSettings(ctx, func(setting types.AutoCompoundSetting) bool {
+		settings = append(settings, setting)
+		return false
+	})
+
+	return settings
+}
+
+// GetAutoCompoundSettingsBatch returns up to limit auto-compound settings,
+// starting from the setting of the start depositor, or from the first setting
+// if start is nil. The depositor of the setting following the batch is
+// returned, or nil if the batch reaches the end of the store.
+func (k Keeper) GetAutoCompoundSettingsBatch(
+	ctx sdk.Context,
+	start sdk.AccAddress,
+	limit int,
+) (types.AutoCompoundSettings, sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundSettingKeyPrefix)
+
+	var startKey []byte
+	if start != nil {
+		startKey = types.AutoCompoundSettingKey(start)
+	}
+
+	iterator := store.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	var settings types.AutoCompoundSettings
+	for ; iterator.Valid(); iterator.Next() {
+		if len(settings) >= limit {
+			return settings, sdk.AccAddress(iterator.Key())
+		}
+
+		var setting types.AutoCompoundSetting
+		k.cdc.MustUnmarshal(iterator.Value(), &setting)
+		settings = append(settings, setting)
+	}
+
+	return settings, nil
+}
+
+// GetAutoCompoundCursor returns the depositor of the next setting to
+// auto-compound, if a round of auto-compounding is in progress.
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.AutoCompoundCursorKey)
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetAutoCompoundCursor stores the depositor of the next setting to
+// auto-compound.
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.AutoCompoundCursorKey, depositor.Bytes())
+}
+
+// DeleteAutoCompoundCursor deletes the auto-compound cursor, ending the
+// current round of auto-compounding.
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// GetPreviousAutoCompoundTime returns the last time rewards were
+// auto-compounded.
+func (k Keeper) GetPreviousAutoCompoundTime(ctx sdk.Context) (blockTime time.Time, found bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PreviousAutoCompoundTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	if err := blockTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+
+	return blockTime, true
+}
+
+// SetPreviousAutoCompoundTime stores the last time rewards were
+// auto-compounded.
+func (k Keeper) SetPreviousAutoCompoundTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz, err := blockTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.PreviousAutoCompoundTimeKey, bz)
+}
@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/mage-coven/fury/x/earn"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+	incentivetypes "github.com/mage-coven/fury/x/incentive/types"
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type autoCompoundTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *autoCompoundTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ufury", "usdx")),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	// Pool price of 2 usdx per ufury
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("ufury", 500e9), sdk.NewInt64Coin("usdx", 1000e9))
+	provider := suite.CreateAccount(liquidity, 10)
+	err := swapKeeper.Deposit(suite.Ctx, provider.GetAddress(), liquidity[0], liquidity[1], sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// Swaps of rewards are limited against the oracle price
+	setupOraclePrices(&suite.Suite)
+
+	// hard rewards can only be claimed with a lockup, so are not compounded
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+	params := incentiveKeeper.GetParams(suite.Ctx)
+	params.ClaimEnd = suite.Ctx.BlockTime().Add(365 * 24 * time.Hour)
+	params.ClaimMultipliers = incentivetypes.MultipliersPerDenoms{
+		{
+			Denom: "usdx",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier("small", 0, sdk.OneDec()),
+			},
+		},
+		{
+			Denom: "ufury",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier("small", 0, sdk.OneDec()),
+			},
+		},
+		{
+			Denom: "hard",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier("large", 12, sdk.OneDec()),
+			},
+		},
+	}
+	incentiveKeeper.SetParams(suite.Ctx, params)
+}
+
+func TestAutoCompoundTestSuite(t *testing.T) {
+	suite.Run(t, new(autoCompoundTestSuite))
+}
+
+// setEarnRewards sets the unclaimed earn rewards of an account and funds the
+// incentive module account to pay them
+func (suite *autoCompoundTestSuite) setEarnRewards(owner sdk.AccAddress, rewards sdk.Coins) {
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+	incentiveKeeper.SetEarnClaim(suite.Ctx, incentivetypes.NewEarnClaim(owner, rewards, nil))
+
+	err := banktestutil.FundModuleAccount(suite.BankKeeper, suite.Ctx, incentivetypes.IncentiveMacc, rewards)
+	suite.Require().NoError(err)
+}
+
+func (suite *autoCompoundTestSuite) TestSetAutoCompound() {
+	slippage := sdk.MustNewDecFromStr("0.01")
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 100)), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.CreateVault("ufury", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, true, []sdk.AccAddress{acc2.GetAddress()})
+
+	// A deposit in the vault is required
+	err := suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, "usdx", slippage)
+	suite.Require().ErrorIs(err, types.ErrVaultShareRecordNotFound)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 100), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, "usdx", slippage)
+	suite.Require().NoError(err)
+
+	setting, found := suite.Keeper.GetAutoCompoundSetting(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(types.NewAutoCompoundSetting(acc.GetAddress(), "usdx", slippage), setting)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, "busd", slippage)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, "ufury", slippage)
+	suite.Require().ErrorIs(err, types.ErrAccountDepositNotAllowed)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, "usdx", sdk.OneDec())
+	suite.Require().Error(err)
+
+	// Failed updates leave the existing setting in place
+	setting, found = suite.Keeper.GetAutoCompoundSetting(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal("usdx", setting.VaultDenom)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), false, "", sdk.Dec{})
+	suite.Require().NoError(err)
+
+	_, found = suite.Keeper.GetAutoCompoundSetting(suite.Ctx, acc.GetAddress())
+	suite.False(found)
+}
+
+func (suite *autoCompoundTestSuite) TestAutoCompound() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000e6)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, vaultDenom, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	rewards := sdk.NewCoins(
+		sdk.NewInt64Coin("usdx", 100e6),
+		sdk.NewInt64Coin("ufury", 10e6),
+		sdk.NewInt64Coin("hard", 5e6),
+	)
+	suite.setEarnRewards(acc.GetAddress(), rewards)
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	// 10e6 ufury is swapped for a little under 20e6 usdx after swap fees
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, vaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(value.Amount.GT(sdkmath.NewInt(1119e6)), "expected rewards to be compounded, got %s", value)
+	suite.True(value.Amount.LT(sdkmath.NewInt(1120e6)), "expected swap fees to be paid, got %s", value)
+
+	// The locked hard rewards stay in the claim
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins())
+	claim, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("hard", 5e6)), claim.Reward)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultAutoCompound,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRewards, sdk.NewCoins(
+			sdk.NewInt64Coin("usdx", 100e6),
+			sdk.NewInt64Coin("ufury", 10e6),
+		).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, value.Amount.Sub(startBalance.Amount).String()),
+	))
+}
+
+func (suite *autoCompoundTestSuite) TestAutoCompound_Period() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000e6)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, vaultDenom, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	previousTime, found := suite.Keeper.GetPreviousAutoCompoundTime(suite.Ctx)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), previousTime)
+
+	// Rewards are not compounded again until the period has passed
+	suite.setEarnRewards(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6)))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.AutoCompoundPeriod - time.Second))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(startBalance))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 1100e6)))
+}
+
+func (suite *autoCompoundTestSuite) TestAutoCompound_Batches() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000e6)
+	reward := sdk.NewInt64Coin(vaultDenom, 100e6)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	numAccounts := types.MaxAutoCompoundsPerBlock + 5
+	var accs []sdk.AccAddress
+	for i := 0; i < numAccounts; i++ {
+		acc := suite.CreateAccount(sdk.NewCoins(startBalance), i)
+		accs = append(accs, acc.GetAddress())
+
+		err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+		suite.Require().NoError(err)
+
+		err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, vaultDenom, sdk.MustNewDecFromStr("0.01"))
+		suite.Require().NoError(err)
+
+		suite.setEarnRewards(acc.GetAddress(), sdk.NewCoins(reward))
+	}
+
+	// The first block compounds a full batch and stores a cursor
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	_, inProgress := suite.Keeper.GetAutoCompoundCursor(suite.Ctx)
+	suite.True(inProgress)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewCoin(
+		vaultDenom,
+		startBalance.Amount.Add(reward.Amount).MulRaw(types.MaxAutoCompoundsPerBlock).
+			Add(startBalance.Amount.MulRaw(int64(numAccounts-types.MaxAutoCompoundsPerBlock))),
+	)))
+
+	// The round continues in the next block before the period has passed
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(6 * time.Second))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	_, inProgress = suite.Keeper.GetAutoCompoundCursor(suite.Ctx)
+	suite.False(inProgress)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewCoin(
+		vaultDenom,
+		startBalance.Amount.Add(reward.Amount).MulRaw(int64(numAccounts)),
+	)))
+	for _, acc := range accs {
+		_, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, acc)
+		suite.Require().True(found)
+	}
+}
+
+func (suite *autoCompoundTestSuite) TestAutoCompound_RemovesSettingWithoutDeposit() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000e6)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, vaultDenom, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	_, found := suite.Keeper.GetAutoCompoundSetting(suite.Ctx, acc.GetAddress())
+	suite.False(found)
+}
+
+func (suite *autoCompoundTestSuite) TestAutoCompound_ManipulatedPool() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000e6)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.SetAutoCompound(suite.Ctx, acc.GetAddress(), true, vaultDenom, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ufury", 10e6))
+	suite.setEarnRewards(acc.GetAddress(), rewards)
+
+	// Move the pool price of ufury down before the rewards are swapped
+	swapKeeper := suite.App.GetSwapKeeper()
+	manipulation := sdk.NewInt64Coin("ufury", 100e9)
+	attacker := suite.CreateAccount(sdk.NewCoins(manipulation), 1)
+	err = swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		attacker.GetAddress(),
+		manipulation,
+		sdk.NewInt64Coin("usdx", 1),
+		sdk.MustNewDecFromStr("0.99"),
+	)
+	suite.Require().NoError(err)
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	// The swap exceeds the slippage limit against the oracle price, so the
+	// rewards are left unclaimed
+	suite.VaultTotalValuesEqual(sdk.NewCoins(startBalance))
+	claim, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(rewards, claim.Reward)
+}
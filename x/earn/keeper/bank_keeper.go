@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+var _ bankkeeper.Keeper = (*TokenizedSharesBankKeeper)(nil)
+
+// TokenizedSharesBankKeeper is a bank keeper wrapper that calls the earn hooks
+// for the sender and receiver of tokenized vault shares before the tokens are
+// moved, so that rewards accrue to the holders of the tokens. Transfers of
+// other denoms are passed to the wrapped keeper unchanged. The earn keeper
+// is set after construction, as the earn keeper depends on the bank keeper.
+type TokenizedSharesBankKeeper struct {
+	bankkeeper.Keeper
+
+	earnKeeper *Keeper
+}
+
+// NewTokenizedSharesBankKeeper returns a new TokenizedSharesBankKeeper
+// wrapping a bank keeper.
+func NewTokenizedSharesBankKeeper(bk bankkeeper.Keeper) *TokenizedSharesBankKeeper {
+	return &TokenizedSharesBankKeeper{
+		Keeper: bk,
+	}
+}
+
+// SetEarnKeeper sets the earn keeper whose hooks are called for transfers of
+// tokenized shares.
+func (k *TokenizedSharesBankKeeper) SetEarnKeeper(ek *Keeper) *TokenizedSharesBankKeeper {
+	if k.earnKeeper != nil {
+		panic("cannot set earn keeper twice")
+	}
+	k.earnKeeper = ek
+	return k
+}
+
+// SendCoins transfers coins from one account to another.
+func (k *TokenizedSharesBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.transfer(ctx, []transferredCoins{{fromAddr, amt}, {toAddr, amt}}, func(ctx sdk.Context) error {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// InputOutputCoins performs a multi-send from inputs to outputs.
+func (k *TokenizedSharesBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var transfers []transferredCoins
+	for _, input := range inputs {
+		addr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		transfers = append(transfers, transferredCoins{addr, input.Coins})
+	}
+	for _, output := range outputs {
+		addr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		transfers = append(transfers, transferredCoins{addr, output.Coins})
+	}
+
+	return k.transfer(ctx, transfers, func(ctx sdk.Context) error {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an
+// account.
+func (k *TokenizedSharesBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	transfers := []transferredCoins{{authtypes.NewModuleAddress(senderModule), amt}, {recipientAddr, amt}}
+	return k.transfer(ctx, transfers, func(ctx sdk.Context) error {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module
+// account.
+func (k *TokenizedSharesBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	transfers := []transferredCoins{{senderAddr, amt}, {authtypes.NewModuleAddress(recipientModule), amt}}
+	return k.transfer(ctx, transfers, func(ctx sdk.Context) error {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// SendCoinsFromModuleToModule transfers coins from a module account to
+// another module account.
+func (k *TokenizedSharesBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context,
+	senderModule string,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	transfers := []transferredCoins{
+		{authtypes.NewModuleAddress(senderModule), amt},
+		{authtypes.NewModuleAddress(recipientModule), amt},
+	}
+	return k.transfer(ctx, transfers, func(ctx sdk.Context) error {
+		return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+// transferredCoins is an account whose balance of coins is changed by a
+// transfer.
+type transferredCoins struct {
+	addr  sdk.AccAddress
+	coins sdk.Coins
+}
+
+// transfer moves coins with send. If any tokenized shares are moved, the earn
+// hooks are called for each account before its balance changes, as the hooks
+// sync rewards with the balance before the transfer. The hooks and the send
+// run in a cache context that is only written if the send succeeds, so a
+// failed transfer does not modify any rewards.
+func (k *TokenizedSharesBankKeeper) transfer(ctx sdk.Context, transfers []transferredCoins, send func(sdk.Context) error) error {
+	if k.earnKeeper == nil || !hasTokenizedShares(transfers) {
+		return send(ctx)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, transfer := range transfers {
+		k.earnKeeper.BeforeTokenizedSharesBalanceModified(cacheCtx, transfer.addr, tokenizedShares(transfer.coins))
+	}
+
+	if err := send(cacheCtx); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// hasTokenizedShares returns true if any transfer moves tokenized shares.
+func hasTokenizedShares(transfers []transferredCoins) bool {
+	for _, transfer := range transfers {
+		if !tokenizedShares(transfer.coins).Empty() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tokenizedShares returns the tokenized shares in coins.
+func tokenizedShares(coins sdk.Coins) sdk.Coins {
+	shares := sdk.NewCoins()
+	for _, coin := range coins {
+		if _, isTokenizedShares := types.ParseTokenizedSharesDenom(coin.Denom); isTokenizedShares {
+			shares = append(shares, coin)
+		}
+	}
+
+	return shares
+}
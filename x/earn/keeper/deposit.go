@@ -37,6 +37,11 @@ func (k *Keeper) Deposit(
 		return types.ErrAccountDepositNotAllowed
 	}
 
+	// Fees are taken before the share price is used for the deposit
+	if err := k.AccrueVaultFees(ctx, amount.Denom); err != nil {
+		return err
+	}
+
 	// Check if VaultRecord exists, create if not exist
 	vaultRecord, found := k.GetVaultRecord(ctx, amount.Denom)
 	if !found {
@@ -44,16 +49,6 @@ func (k *Keeper) Deposit(
 		vaultRecord = types.NewVaultRecord(amount.Denom, sdk.ZeroDec())
 	}
 
-	// Get the strategy for the vault
-	// NOTE: Currently always uses the first one, AllowedVaults are currently
-	// only valid with 1 and only 1 strategy so this is safe.
-	// If/When multiple strategies are supported and users can specify specific
-	// strategies, shares should be issued per-strategy instead of per-vault.
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return err
-	}
-
 	// Transfer amount to module account
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(
 		ctx,
@@ -96,8 +91,9 @@ func (k *Keeper) Deposit(
 		k.AfterVaultDepositCreated(ctx, amount.Denom, depositor, shares.Amount)
 	}
 
-	// Deposit to the strategy
-	if err := strategy.Deposit(ctx, amount); err != nil {
+	// Deposit to the strategies, split by their target weights. Shares are
+	// issued per-vault, so the deposit strategy only needs to be allowed.
+	if err := k.depositToStrategies(ctx, allowedVault, amount); err != nil {
 		return err
 	}
 
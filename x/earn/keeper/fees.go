@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+const secondsPerYear = 31536000
+
+// AccrueVaultFees takes the management and performance fees of a vault since
+// the last accrual by minting vault shares to the fee recipient. Management
+// fees accrue on the total value of the vault over time, performance fees on
+// any increase of the value per share above the high-water mark. The vault is
+// valued at oracle prices, so the value per share and the high-water mark
+// cannot be raised within a transaction by moving the price of a swap pool.
+func (k *Keeper) AccrueVaultFees(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	feeRecord, found := k.GetVaultFeeRecord(ctx, denom)
+	if allowedVault.Fees == nil {
+		// Keep the accrual time current so fees are not charged for the time
+		// they were disabled if they are enabled again
+		if found {
+			feeRecord.LastAccrualTime = ctx.BlockTime()
+			k.SetVaultFeeRecord(ctx, feeRecord)
+		}
+
+		return nil
+	}
+
+	if !found {
+		feeRecord = types.NewVaultFeeRecord(denom, ctx.BlockTime(), sdk.ZeroDec(), sdk.ZeroDec())
+	}
+
+	elapsedSeconds := int64(ctx.BlockTime().Sub(feeRecord.LastAccrualTime).Seconds())
+	feeRecord.LastAccrualTime = ctx.BlockTime()
+
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		// Shares are issued 1:1 once the vault is empty, so the high-water
+		// mark starts over
+		feeRecord.HighWaterMark = sdk.ZeroDec()
+		k.SetVaultFeeRecord(ctx, feeRecord)
+
+		return nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	value := sdk.NewDecFromInt(totalValue.Amount)
+	totalShares := vaultRecord.TotalShares.Amount
+	if !value.IsPositive() {
+		k.SetVaultFeeRecord(ctx, feeRecord)
+		return nil
+	}
+
+	valuePerShare := value.Quo(totalShares)
+	if feeRecord.HighWaterMark.IsZero() {
+		// No performance fee is charged on the value before fees accrue
+		feeRecord.HighWaterMark = valuePerShare
+	}
+
+	feeValue := sdk.ZeroDec()
+	if elapsedSeconds > 0 {
+		feeValue = value.Mul(allowedVault.Fees.ManagementFee).MulInt64(elapsedSeconds).QuoInt64(secondsPerYear)
+	}
+	if valuePerShare.GT(feeRecord.HighWaterMark) {
+		yield := valuePerShare.Sub(feeRecord.HighWaterMark).Mul(totalShares)
+		feeValue = feeValue.Add(yield.Mul(allowedVault.Fees.PerformanceFee))
+	}
+
+	// Fee shares are issued so that they are worth the fee value:
+	// feeValue = value * feeShares / (totalShares + feeShares)
+	feeShares := sdk.ZeroDec()
+	if feeValue.IsPositive() && feeValue.LT(value) {
+		feeShares = feeValue.Mul(totalShares).QuoTruncate(value.Sub(feeValue))
+	}
+
+	if feeShares.IsPositive() {
+		k.mintFeeShares(ctx, vaultRecord, allowedVault.Fees.Recipient, feeShares)
+		totalShares = totalShares.Add(feeShares)
+		feeRecord.AccruedShares = feeRecord.AccruedShares.Add(feeShares)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVaultFee,
+				sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyOwner, allowedVault.Fees.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyShares, feeShares.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, feeValue.TruncateInt().String()),
+			),
+		)
+	}
+
+	feeRecord.HighWaterMark = sdk.MaxDec(feeRecord.HighWaterMark, value.Quo(totalShares))
+	k.SetVaultFeeRecord(ctx, feeRecord)
+
+	return nil
+}
+
+// AccrueAllVaultFees accrues the fees of all vaults with deposits. A vault
+// that fails to accrue fees is left unchanged.
+func (k *Keeper) AccrueAllVaultFees(ctx sdk.Context) {
+	// Vault records are updated when fee shares are minted, so they are not
+	// modified while iterating
+	for _, record := range k.GetAllVaultRecords(ctx) {
+		denom := record.TotalShares.Denom
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.AccrueVaultFees(cacheCtx, denom); err != nil {
+			k.Logger(ctx).Error("failed to accrue vault fees", "denom", denom, "err", err)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// mintFeeShares issues new vault shares to the fee recipient.
+func (k *Keeper) mintFeeShares(
+	ctx sdk.Context,
+	vaultRecord types.VaultRecord,
+	recipient sdk.AccAddress,
+	amount sdk.Dec,
+) {
+	denom := vaultRecord.TotalShares.Denom
+	shares := types.NewVaultShare(denom, amount)
+
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, recipient)
+	if !found {
+		vaultShareRecord = types.NewVaultShareRecord(recipient, types.NewVaultShares())
+	}
+
+	isNew := vaultShareRecord.Shares.AmountOf(denom).IsZero()
+	if !isNew {
+		k.BeforeVaultDepositModified(ctx, denom, recipient, vaultShareRecord.Shares.AmountOf(denom))
+	}
+
+	vaultRecord.TotalShares = vaultRecord.TotalShares.Add(shares)
+	vaultShareRecord.Shares = vaultShareRecord.Shares.Add(shares)
+
+	k.SetVaultRecord(ctx, vaultRecord)
+	k.SetVaultShareRecord(ctx, vaultShareRecord)
+
+	if isNew {
+		k.AfterVaultDepositCreated(ctx, denom, recipient, shares.Amount)
+	}
+}
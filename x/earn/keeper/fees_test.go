@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn"
+	"github.com/mage-coven/fury/x/earn/keeper"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type feesTestSuite struct {
+	testutil.Suite
+
+	recipient sdk.AccAddress
+}
+
+func (suite *feesTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.recipient = sdk.AccAddress("fee_recipient_______")
+}
+
+func TestFeesTestSuite(t *testing.T) {
+	suite.Run(t, new(feesTestSuite))
+}
+
+// setFees sets a usdx hard vault with the given fees
+func (suite *feesTestSuite) setFees(managementFee, performanceFee string) {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(
+			"usdx",
+			types.StrategyTypes{types.STRATEGY_TYPE_HARD},
+			false,
+			nil,
+			types.WithVaultFees(types.NewVaultFees(
+				sdk.MustNewDecFromStr(managementFee),
+				sdk.MustNewDecFromStr(performanceFee),
+				suite.recipient,
+			)),
+		),
+	}))
+}
+
+// recipientValue returns the value of the vault shares held by the fee recipient
+func (suite *feesTestSuite) recipientValue() sdkmath.Int {
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, "usdx", suite.recipient)
+	suite.Require().NoError(err)
+
+	return value.Amount
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_ManagementFee() {
+	suite.setFees("0.1", "0")
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000e6), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// No fees are taken on the block of the first deposit
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, suite.recipient)
+	suite.False(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+
+	// A year of a 10% management fee is worth 10% of the vault, with fee
+	// shares rounded down in favor of depositors
+	suite.Equal(sdkmath.NewInt(100e6-1), suite.recipientValue())
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)))
+
+	depositorValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, "usdx", acc.GetAddress())
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(900e6), depositorValue.Amount)
+
+	feeRecord, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), feeRecord.LastAccrualTime)
+
+	vaultRecord, found := suite.Keeper.GetVaultRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(vaultRecord.TotalShares.Amount.Sub(sdk.NewDec(1000e6)), feeRecord.AccruedShares)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultFee,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, "usdx"),
+		sdk.NewAttribute(types.AttributeKeyOwner, suite.recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, feeRecord.AccruedShares.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "100000000"),
+	))
+
+	// Accruing again in the same block takes no further fees
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(100e6-1), suite.recipientValue())
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_PerformanceFee() {
+	suite.setFees("0", "0.2")
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000e6), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// The value per share is at the high-water mark, so there is no fee
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, suite.recipient)
+	suite.False(found)
+
+	// Lowering the high-water mark to half the value per share means half of
+	// the vault value is yield above the mark
+	feeRecord, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.OneDec(), feeRecord.HighWaterMark)
+	feeRecord.HighWaterMark = sdk.MustNewDecFromStr("0.5")
+	suite.Keeper.SetVaultFeeRecord(suite.Ctx, feeRecord)
+
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+
+	// 20% of 500e6 yield
+	suite.Equal(sdkmath.NewInt(100e6-1), suite.recipientValue())
+
+	// The high-water mark is raised to the value per share after fees
+	feeRecord, found = suite.Keeper.GetVaultFeeRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	vaultRecord, found := suite.Keeper.GetVaultRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1000e6).Quo(vaultRecord.TotalShares.Amount), feeRecord.HighWaterMark)
+
+	// No further fee is charged without new yield
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(100e6-1), suite.recipientValue())
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_NoFees() {
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000e6), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	_, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, "usdx")
+	suite.False(found)
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare("usdx", sdk.NewDec(1000e6))))
+}
+
+func (suite *feesTestSuite) TestEndBlocker_AccruesFees() {
+	suite.setFees("0.1", "0")
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000e6), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Equal(sdkmath.NewInt(100e6-1), suite.recipientValue())
+}
+
+func (suite *feesTestSuite) TestQueryVaultFees() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	suite.setFees("0.1", "0")
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000e6), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	res, err := queryServer.VaultFees(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), res.Fees.ManagementFee)
+	suite.Equal(suite.Ctx.BlockTime(), res.FeeRecord.LastAccrualTime)
+	suite.Equal(sdkmath.NewInt(100e6-1), res.AccruedValue)
+
+	// The query does not persist the accrued fees
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, suite.recipient)
+	suite.False(found)
+
+	_, err = queryServer.VaultFees(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultFeesRequest("busd"))
+	suite.Require().Error(err)
+}
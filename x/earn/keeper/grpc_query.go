@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/mage-coven/fury/x/earn/types"
 )
@@ -264,6 +266,240 @@ func (s queryServer) Deposits(
 	return s.getOneAccountAllDeposits(sdkCtx, req)
 }
 
+// VaultAllocation implements the gRPC service handler for querying the
+// strategy allocation of a vault.
+func (s queryServer) VaultAllocation(
+	ctx context.Context,
+	req *types.QueryVaultAllocationRequest,
+) (*types.QueryVaultAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	if _, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	allocations, drift, err := s.keeper.GetVaultAllocation(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVaultAllocationResponse{
+		Allocations: allocations,
+		Drift:       drift,
+	}, nil
+}
+
+// VaultFees implements the gRPC service handler for querying the fees of a
+// vault.
+func (s queryServer) VaultFees(
+	ctx context.Context,
+	req *types.QueryVaultFeesRequest,
+) (*types.QueryVaultFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	allowedVault, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	// Fees are accrued up to the current block without persisting them
+	cacheCtx, _ := sdkCtx.CacheContext()
+	if err := s.keeper.AccrueVaultFees(cacheCtx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	feeRecord, found := s.keeper.GetVaultFeeRecord(cacheCtx, req.Denom)
+	if !found {
+		feeRecord = types.NewVaultFeeRecord(req.Denom, sdkCtx.BlockTime(), sdk.ZeroDec(), sdk.ZeroDec())
+	}
+
+	accruedValue := sdk.ZeroInt()
+	if _, found := s.keeper.GetVaultRecord(cacheCtx, req.Denom); found {
+		value, err := s.keeper.ConvertToAssets(cacheCtx, types.NewVaultShare(req.Denom, feeRecord.AccruedShares))
+		if err != nil {
+			return nil, err
+		}
+
+		accruedValue = value.Amount
+	}
+
+	return &types.QueryVaultFeesResponse{
+		Fees:         allowedVault.Fees,
+		FeeRecord:    feeRecord,
+		AccruedValue: accruedValue,
+	}, nil
+}
+
+// AutoCompoundSetting implements the gRPC service handler for querying the
+// auto-compound setting of a depositor.
+func (s queryServer) AutoCompoundSetting(
+	ctx context.Context,
+	req *types.QueryAutoCompoundSettingRequest,
+) (*types.QueryAutoCompoundSettingResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address")
+	}
+
+	setting, found := s.keeper.GetAutoCompoundSetting(sdkCtx, depositor)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auto-compound not enabled for depositor")
+	}
+
+	return &types.QueryAutoCompoundSettingResponse{
+		Setting: setting,
+	}, nil
+}
+
+// VaultSharePriceHistory implements the gRPC service handler for querying the
+// share price history of a vault.
+func (s queryServer) VaultSharePriceHistory(
+	ctx context.Context,
+	req *types.QueryVaultSharePriceHistoryRequest,
+) (*types.QueryVaultSharePriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	var snapshots types.VaultSharePriceSnapshots
+	store := s.keeper.vaultSharePriceSnapshotStore(sdkCtx, req.Denom)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var snapshot types.VaultSharePriceSnapshot
+		if err := s.keeper.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultSharePriceHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VaultApy implements the gRPC service handler for querying the realized APY
+// of a vault.
+func (s queryServer) VaultApy(
+	ctx context.Context,
+	req *types.QueryVaultApyRequest,
+) (*types.QueryVaultApyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	if _, found := s.keeper.GetVaultRecord(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	// The current share price includes fees accrued up to the current block
+	cacheCtx, _ := sdkCtx.CacheContext()
+	if err := s.keeper.AccrueVaultFees(cacheCtx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	sharePrice, apys, err := s.keeper.GetVaultRealizedApys(cacheCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVaultApyResponse{
+		SharePrice: sharePrice,
+		Apys:       apys,
+	}, nil
+}
+
+// WithdrawalRequests implements the gRPC service handler for querying queued
+// withdraws.
+func (s queryServer) WithdrawalRequests(
+	ctx context.Context,
+	req *types.QueryWithdrawalRequestsRequest,
+) (*types.QueryWithdrawalRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid address")
+		}
+	}
+
+	var requests types.WithdrawalRequests
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.WithdrawalRequestKeyPrefix)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var request types.WithdrawalRequest
+		if err := s.keeper.cdc.Unmarshal(value, &request); err != nil {
+			return false, err
+		}
+
+		if owner != nil && !request.Owner.Equals(owner) {
+			return false, nil
+		}
+
+		if req.Denom != "" && request.Shares.Denom != req.Denom {
+			return false, nil
+		}
+
+		if accumulate {
+			requests = append(requests, request)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalRequestsResponse{
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
+}
+
 // TotalSupply implements the gRPC service handler for querying x/earn total supply (TVL)
 func (s queryServer) TotalSupply(
 	ctx context.Context,
@@ -9,26 +9,66 @@ import (
 // Implements EarnHooks interface
 var _ types.EarnHooks = Keeper{}
 
-// AfterVaultDepositCreated - call hook if registered
+// AfterVaultDepositCreated - call hook if registered. The tokenized shares
+// held in the module account accrue rewards to their holders, so no hooks are
+// called for the module account.
 func (k Keeper) AfterVaultDepositCreated(
 	ctx sdk.Context,
 	vaultDenom string,
 	depositor sdk.AccAddress,
 	sharesOwned sdk.Dec,
 ) {
-	if k.hooks != nil {
-		k.hooks.AfterVaultDepositCreated(ctx, vaultDenom, depositor, sharesOwned)
+	if k.hooks == nil || depositor.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		return
 	}
+
+	// Holders of tokenized shares already accrue rewards, so the existing
+	// claim is synced instead of created
+	tokenizedShares := k.getTokenizedShares(ctx, depositor, vaultDenom)
+	if tokenizedShares.IsPositive() {
+		k.hooks.BeforeVaultDepositModified(ctx, vaultDenom, depositor, tokenizedShares)
+		return
+	}
+
+	k.hooks.AfterVaultDepositCreated(ctx, vaultDenom, depositor, sharesOwned)
 }
 
-// BeforeVaultDepositModified - call hook if registered
+// BeforeVaultDepositModified - call hook if registered. The shares owned
+// include the tokenized shares of the depositor.
 func (k Keeper) BeforeVaultDepositModified(
 	ctx sdk.Context,
 	vaultDenom string,
 	depositor sdk.AccAddress,
 	sharesOwned sdk.Dec,
 ) {
-	if k.hooks != nil {
-		k.hooks.BeforeVaultDepositModified(ctx, vaultDenom, depositor, sharesOwned)
+	if k.hooks == nil || depositor.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		return
+	}
+
+	sharesOwned = sharesOwned.Add(k.getTokenizedShares(ctx, depositor, vaultDenom))
+	k.hooks.BeforeVaultDepositModified(ctx, vaultDenom, depositor, sharesOwned)
+}
+
+// BeforeTokenizedSharesBalanceModified calls the earn hooks for an account
+// before its balance of any tokenized shares in coins changes, as tokenized
+// shares accrue rewards to their holder.
+func (k Keeper) BeforeTokenizedSharesBalanceModified(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) {
+	if k.hooks == nil || owner.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		return
+	}
+
+	for _, coin := range coins {
+		vaultDenom, isTokenizedShares := types.ParseTokenizedSharesDenom(coin.Denom)
+		if !isTokenizedShares {
+			continue
+		}
+
+		sharesOwned := k.GetVaultAccountRewardShares(ctx, owner).AmountOf(vaultDenom)
+		if sharesOwned.IsZero() {
+			k.hooks.AfterVaultDepositCreated(ctx, vaultDenom, owner, sharesOwned)
+			continue
+		}
+
+		k.hooks.BeforeVaultDepositModified(ctx, vaultDenom, owner, sharesOwned)
 	}
 }
@@ -11,6 +11,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "vault-records", VaultRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "vault-shares", VaultSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenized-shares", TokenizedSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -24,7 +25,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := VaultSharesInvariant(k)(ctx)
+		if res, stop := VaultSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := TokenizedSharesInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -113,3 +118,31 @@ func VaultSharesInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// TokenizedSharesInvariant iterates all vaults and ensures the supply of their
+// tokenized shares matches the shares held by the module account
+func TokenizedSharesInvariant(k Keeper) sdk.Invariant {
+	broken := false
+	message := sdk.FormatInvariant(types.ModuleName, "tokenized shares broken", "tokenized shares supply does not match module account shares")
+
+	return func(ctx sdk.Context) (string, bool) {
+		moduleShares := types.NewVaultShares()
+		if record, found := k.GetVaultShareRecord(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName)); found {
+			moduleShares = record.Shares
+		}
+
+		k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+			denom := record.TotalShares.Denom
+			supply := k.bankKeeper.GetSupply(ctx, types.TokenizedSharesDenom(denom))
+
+			if !sdk.NewDecFromInt(supply.Amount).Equal(moduleShares.AmountOf(denom)) {
+				broken = true
+				return true
+			}
+
+			return false
+		})
+
+		return message, broken
+	}
+}
@@ -180,3 +180,20 @@ func (suite *invariantTestSuite) TestVaultSharesInvariant() {
 	suite.Equal("earn: vault shares broken invariant\nvault shares do not match depositor shares\n", message)
 	suite.Equal(true, broken)
 }
+
+func (suite *invariantTestSuite) TestTokenizedSharesInvariant() {
+	message, broken := suite.runInvariant("tokenized-shares", keeper.TokenizedSharesInvariant)
+	suite.Equal("earn: tokenized shares broken invariant\ntokenized shares supply does not match module account shares\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	message, broken = suite.runInvariant("tokenized-shares", keeper.TokenizedSharesInvariant)
+	suite.Equal("earn: tokenized shares broken invariant\ntokenized shares supply does not match module account shares\n", message)
+	suite.Equal(false, broken)
+
+	// broken when tokens are minted without module account shares
+	suite.AddCoinsToModule(sdk.NewCoins(sdk.NewInt64Coin(types.TokenizedSharesDenom("usdx"), 10)))
+	message, broken = suite.runInvariant("tokenized-shares", keeper.TokenizedSharesInvariant)
+	suite.Equal("earn: tokenized shares broken invariant\ntokenized shares supply does not match module account shares\n", message)
+	suite.Equal(true, broken)
+}
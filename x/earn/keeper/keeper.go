@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/mage-coven/fury/x/earn/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -21,9 +25,17 @@ type Keeper struct {
 	// Keepers used for strategies
 	hardKeeper    types.HardKeeper
 	savingsKeeper types.SavingsKeeper
+	swapKeeper    types.SwapKeeper
+
+	// Keeper for the oracle prices used to value swap strategy positions
+	pricefeedKeeper types.PricefeedKeeper
 
 	// Keeper for community pool transfers
 	distKeeper types.DistributionKeeper
+
+	// Keeper for claiming rewards to auto-compound, set after construction as
+	// the incentive keeper depends on the earn keeper
+	incentiveKeeper types.IncentiveKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -36,6 +48,8 @@ func NewKeeper(
 	liquidKeeper types.LiquidKeeper,
 	hardKeeper types.HardKeeper,
 	savingsKeeper types.SavingsKeeper,
+	swapKeeper types.SwapKeeper,
+	pricefeedKeeper types.PricefeedKeeper,
 	distKeeper types.DistributionKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
@@ -43,15 +57,17 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		liquidKeeper:  liquidKeeper,
-		hardKeeper:    hardKeeper,
-		savingsKeeper: savingsKeeper,
-		distKeeper:    distKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		liquidKeeper:    liquidKeeper,
+		hardKeeper:      hardKeeper,
+		savingsKeeper:   savingsKeeper,
+		swapKeeper:      swapKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		distKeeper:      distKeeper,
 	}
 }
 
@@ -64,7 +80,22 @@ func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 	return k
 }
 
+// SetIncentiveKeeper sets the keeper used to claim rewards for
+// auto-compounding.
+func (k *Keeper) SetIncentiveKeeper(ik types.IncentiveKeeper) *Keeper {
+	if k.incentiveKeeper != nil {
+		panic("cannot set earn incentive keeper twice")
+	}
+	k.incentiveKeeper = ik
+	return k
+}
+
 // ClearHooks clears the hooks on the keeper
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
 }
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
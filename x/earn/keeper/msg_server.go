@@ -68,3 +68,159 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+// RebalanceVault handles MsgRebalanceVault messages
+func (m msgServer) RebalanceVault(goCtx context.Context, msg *types.MsgRebalanceVault) (*types.MsgRebalanceVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RebalanceVault(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgRebalanceVaultResponse{}, nil
+}
+
+// TokenizeShares handles MsgTokenizeShares messages
+func (m msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := m.keeper.TokenizeShares(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{Tokens: tokens}, nil
+}
+
+// RedeemTokenizedShares handles MsgRedeemTokenizedShares messages
+func (m msgServer) RedeemTokenizedShares(
+	goCtx context.Context,
+	msg *types.MsgRedeemTokenizedShares,
+) (*types.MsgRedeemTokenizedSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := m.keeper.RedeemTokenizedShares(ctx, owner, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgRedeemTokenizedSharesResponse{Shares: shares}, nil
+}
+
+// SetAutoCompound handles MsgSetAutoCompound messages
+func (m msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SetAutoCompound(ctx, depositor, msg.Enabled, msg.VaultDenom, msg.SlippageLimit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+// QueueWithdraw handles MsgQueueWithdraw messages
+func (m msgServer) QueueWithdraw(goCtx context.Context, msg *types.MsgQueueWithdraw) (*types.MsgQueueWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := m.keeper.QueueWithdraw(ctx, from, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgQueueWithdrawResponse{
+		RequestId: request.ID,
+		Shares:    request.Shares,
+	}, nil
+}
+
+// CancelWithdrawal handles MsgCancelWithdrawal messages
+func (m msgServer) CancelWithdrawal(
+	goCtx context.Context,
+	msg *types.MsgCancelWithdrawal,
+) (*types.MsgCancelWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelWithdrawal(ctx, owner, msg.RequestId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgCancelWithdrawalResponse{}, nil
+}
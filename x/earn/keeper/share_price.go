@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+// GetVaultSharePrice returns the value of one share of a vault in the vault
+// denom.
+func (k *Keeper) GetVaultSharePrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	totalShares, found := k.GetVaultTotalShares(ctx, denom)
+	if !found || totalShares.Amount.IsZero() {
+		return sdk.Dec{}, types.ErrVaultRecordNotFound
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.NewDecFromInt(totalValue.Amount).Quo(totalShares.Amount), nil
+}
+
+// RecordVaultSharePrices records a share price snapshot of each vault once
+// every SharePriceSnapshotPeriod, and removes snapshots older than
+// SharePriceHistoryRetention.
+func (k *Keeper) RecordVaultSharePrices(ctx sdk.Context) {
+	for _, record := range k.GetAllVaultRecords(ctx) {
+		denom := record.TotalShares.Denom
+
+		latest, found := k.GetLatestVaultSharePriceSnapshot(ctx, denom)
+		if found && ctx.BlockTime().Before(latest.Time.Add(types.SharePriceSnapshotPeriod)) {
+			continue
+		}
+
+		sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get vault share price", "denom", denom, "err", err)
+			continue
+		}
+
+		k.SetVaultSharePriceSnapshot(ctx, types.NewVaultSharePriceSnapshot(denom, ctx.BlockTime(), sharePrice))
+		k.pruneVaultSharePriceSnapshots(ctx, denom, ctx.BlockTime().Add(-types.SharePriceHistoryRetention))
+	}
+}
+
+// GetVaultRealizedApys returns the current share price of a vault and its
+// realized APY over each of the ApyWindowDays. Windows longer than the
+// recorded share price history are omitted.
+func (k *Keeper) GetVaultRealizedApys(ctx sdk.Context, denom string) (sdk.Dec, []types.VaultRealizedApy, error) {
+	sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	var apys []types.VaultRealizedApy
+	for _, windowDays := range types.ApyWindowDays {
+		windowStart := ctx.BlockTime().Add(-time.Duration(windowDays) * 24 * time.Hour)
+
+		start, found := k.GetVaultSharePriceSnapshotAtOrBefore(ctx, denom, windowStart)
+		if !found || !start.SharePrice.IsPositive() {
+			continue
+		}
+
+		elapsed := int64(ctx.BlockTime().Sub(start.Time).Seconds())
+		if elapsed <= 0 {
+			continue
+		}
+
+		// apy = (sharePrice / startSharePrice - 1) * secondsPerYear / elapsed
+		apy := sharePrice.Quo(start.SharePrice).
+			Sub(sdk.OneDec()).
+			MulInt64(secondsPerYear).
+			QuoInt64(elapsed)
+
+		apys = append(apys, types.VaultRealizedApy{
+			WindowDays: windowDays,
+			Start:      start,
+			Apy:        apy,
+		})
+	}
+
+	return sharePrice, apys, nil
+}
+
+// ----------------------------------------------------------------------------
+// VaultSharePriceSnapshot -- vault share price history
+
+// GetLatestVaultSharePriceSnapshot returns the most recent share price
+// snapshot of a vault.
+func (k *Keeper) GetLatestVaultSharePriceSnapshot(
+	ctx sdk.Context,
+	denom string,
+) (types.VaultSharePriceSnapshot, bool) {
+	store := k.vaultSharePriceSnapshotStore(ctx, denom)
+
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.VaultSharePriceSnapshot{}, false
+	}
+
+	var snapshot types.VaultSharePriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+	return snapshot, true
+}
+
+// GetVaultSharePriceSnapshotAtOrBefore returns the most recent share price
+// snapshot of a vault recorded at or before the given time.
+func (k *Keeper) GetVaultSharePriceSnapshotAtOrBefore(
+	ctx sdk.Context,
+	denom string,
+	blockTime time.Time,
+) (types.VaultSharePriceSnapshot, bool) {
+	store := k.vaultSharePriceSnapshotStore(ctx, denom)
+
+	iterator := store.ReverseIterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(blockTime)))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.VaultSharePriceSnapshot{}, false
+	}
+
+	var snapshot types.VaultSharePriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+	return snapshot, true
+}
+
+// SetVaultSharePriceSnapshot sets a share price snapshot of a vault.
+func (k *Keeper) SetVaultSharePriceSnapshot(ctx sdk.Context, snapshot types.VaultSharePriceSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotKeyPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.VaultSharePriceSnapshotKey(snapshot.VaultDenom, snapshot.Time), bz)
+}
+
+// IterateVaultSharePriceSnapshots iterates over all share price snapshots of
+// all vaults, oldest first for each vault, and performs a callback function.
+func (k Keeper) IterateVaultSharePriceSnapshots(
+	ctx sdk.Context,
+	cb func(snapshot types.VaultSharePriceSnapshot) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VaultSharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllVaultSharePriceSnapshots returns all share price snapshots of all
+// vaults.
+func (k Keeper) GetAllVaultSharePriceSnapshots(ctx sdk.Context) types.VaultSharePriceSnapshots {
+	var snapshots types.VaultSharePriceSnapshots
+
+	k.IterateVaultSharePriceSnapshots(ctx, func(snapshot types.VaultSharePriceSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+
+	return snapshots
+}
+
+// pruneVaultSharePriceSnapshots deletes the share price snapshots of a vault
+// recorded before the cutoff time.
+func (k *Keeper) pruneVaultSharePriceSnapshots(ctx sdk.Context, denom string, cutoff time.Time) {
+	store := k.vaultSharePriceSnapshotStore(ctx, denom)
+
+	// Keys are collected first as deleting while iterating is not supported
+	var keys [][]byte
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// vaultSharePriceSnapshotStore returns a store of the share price snapshots of
+// a vault keyed by time.
+func (k *Keeper) vaultSharePriceSnapshotStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.key),
+		append(types.SharePriceSnapshotKeyPrefix, types.VaultSharePriceSnapshotsKey(denom)...),
+	)
+}
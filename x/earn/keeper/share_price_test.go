@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/mage-coven/fury/x/earn"
+	"github.com/mage-coven/fury/x/earn/keeper"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type sharePriceTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *sharePriceTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestSharePriceTestSuite(t *testing.T) {
+	suite.Run(t, new(sharePriceTestSuite))
+}
+
+// deposit creates a usdx vault with a deposit, for a share price of 1
+func (suite *sharePriceTestSuite) deposit() {
+	startBalance := sdk.NewInt64Coin("usdx", 1000e6)
+
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
+
+func (suite *sharePriceTestSuite) TestRecordVaultSharePrices() {
+	// Vaults without deposits have no share price
+	suite.CreateVault("ufury", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.deposit()
+	startTime := suite.Ctx.BlockTime()
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Equal(types.VaultSharePriceSnapshots{
+		types.NewVaultSharePriceSnapshot("usdx", startTime, sdk.OneDec()),
+	}, suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx))
+
+	// No snapshot is recorded until the period has passed
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.SharePriceSnapshotPeriod - time.Second))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Len(suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx), 1)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.SharePriceSnapshotPeriod))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	latest, found := suite.Keeper.GetLatestVaultSharePriceSnapshot(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime(), latest.Time)
+	suite.Len(suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx), 2)
+}
+
+func (suite *sharePriceTestSuite) TestRecordVaultSharePrices_Prunes() {
+	suite.deposit()
+	now := suite.Ctx.BlockTime()
+
+	expired := now.Add(-types.SharePriceHistoryRetention - time.Second)
+	retained := now.Add(-types.SharePriceHistoryRetention)
+	suite.Keeper.SetVaultSharePriceSnapshot(suite.Ctx, types.NewVaultSharePriceSnapshot("usdx", expired, sdk.OneDec()))
+	suite.Keeper.SetVaultSharePriceSnapshot(suite.Ctx, types.NewVaultSharePriceSnapshot("usdx", retained, sdk.OneDec()))
+
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Equal(types.VaultSharePriceSnapshots{
+		types.NewVaultSharePriceSnapshot("usdx", retained, sdk.OneDec()),
+		types.NewVaultSharePriceSnapshot("usdx", now, sdk.OneDec()),
+	}, suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx))
+}
+
+func (suite *sharePriceTestSuite) TestQueryVaultApy() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	suite.deposit()
+	now := suite.Ctx.BlockTime()
+
+	// There is no history covering the 90 day window
+	sevenDays := types.NewVaultSharePriceSnapshot("usdx", now.Add(-7*24*time.Hour), sdk.MustNewDecFromStr("0.5"))
+	thirtyDays := types.NewVaultSharePriceSnapshot("usdx", now.Add(-30*24*time.Hour), sdk.MustNewDecFromStr("0.8"))
+	suite.Keeper.SetVaultSharePriceSnapshot(suite.Ctx, sevenDays)
+	suite.Keeper.SetVaultSharePriceSnapshot(suite.Ctx, thirtyDays)
+
+	res, err := queryServer.VaultApy(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultApyRequest("usdx"))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.OneDec(), res.SharePrice)
+	suite.Equal([]types.VaultRealizedApy{
+		{
+			WindowDays: 7,
+			Start:      sevenDays,
+			// (1 / 0.5 - 1) * 365 / 7
+			Apy: sdk.MustNewDecFromStr("52.142857142857142857"),
+		},
+		{
+			WindowDays: 30,
+			Start:      thirtyDays,
+			// (1 / 0.8 - 1) * 365 / 30
+			Apy: sdk.MustNewDecFromStr("3.041666666666666666"),
+		},
+	}, res.Apys)
+
+	_, err = queryServer.VaultApy(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultApyRequest("busd"))
+	suite.Require().Error(err)
+}
+
+func (suite *sharePriceTestSuite) TestQueryVaultSharePriceHistory() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	suite.deposit()
+	startTime := suite.Ctx.BlockTime()
+
+	for i := 0; i < 3; i++ {
+		suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Duration(i) * types.SharePriceSnapshotPeriod))
+		earn.EndBlocker(suite.Ctx, suite.Keeper)
+	}
+
+	res, err := queryServer.VaultSharePriceHistory(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultSharePriceHistoryRequest("usdx", &query.PageRequest{Limit: 2}),
+	)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.VaultSharePriceSnapshots{
+		types.NewVaultSharePriceSnapshot("usdx", startTime, sdk.OneDec()),
+		types.NewVaultSharePriceSnapshot("usdx", startTime.Add(types.SharePriceSnapshotPeriod), sdk.OneDec()),
+	}, res.Snapshots)
+	suite.NotNil(res.Pagination.NextKey)
+
+	res, err = queryServer.VaultSharePriceHistory(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultSharePriceHistoryRequest("usdx", &query.PageRequest{Key: res.Pagination.NextKey}),
+	)
+	suite.Require().NoError(err)
+	suite.Equal(types.VaultSharePriceSnapshots{
+		types.NewVaultSharePriceSnapshot("usdx", startTime.Add(2*types.SharePriceSnapshotPeriod), sdk.OneDec()),
+	}, res.Snapshots)
+}
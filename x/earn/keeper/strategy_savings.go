@@ -16,10 +16,10 @@ func (s *SavingsStrategy) GetStrategyType() types.StrategyType {
 }
 
 // GetEstimatedTotalAssets returns the current value of all assets deposited
-// in savings.
+// in savings, including accrued savings rate interest.
 func (s *SavingsStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
-	deposit, found := s.savingsKeeper.GetDeposit(ctx, macc.GetAddress())
+	deposit, found := s.savingsKeeper.GetSyncedDeposit(ctx, macc.GetAddress())
 	if !found {
 		// Return 0 if no deposit exists for module account
 		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
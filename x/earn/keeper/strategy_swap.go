@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
+)
+
+// SwapStrategy defines the strategy that provides liquidity to a pool in
+// x/swap. Vault assets are zapped into the pool by swapping part of each
+// deposit for the paired asset, and unwound on withdraw by swapping the paired
+// asset back.
+//
+// The position is valued from the pool reserves at the oracle price of the
+// paired asset rather than at the pool price, as the pool price can be moved
+// by swapping against the pool in the same block. Whole pool shares are
+// removed on withdraw, so a withdraw can return slightly more than the amount.
+// The vault deposits the excess back or sends it to the withdrawer.
+type SwapStrategy Keeper
+
+var _ Strategy = (*SwapStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *SwapStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_SWAP
+}
+
+// GetEstimatedTotalAssets returns the value of the pool shares held for the
+// vault at the oracle price. The pool reserves are not used directly, as they
+// can be moved by swapping against the pool. This does not include the fees
+// and price impact of unwinding the position.
+func (s *SwapStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	swapParams, err := s.getSwapStrategyParams(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), swapParams.PoolID)
+	if !found {
+		// Return 0 if no deposit exists for module account
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	poolRecord, found := s.swapKeeper.GetPool(ctx, swapParams.PoolID)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrSwapPoolNotFound, "%s", swapParams.PoolID)
+	}
+
+	value, err := s.getFairPoolValue(ctx, poolRecord, denom, swapParams.PairedDenom(denom), shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, value), nil
+}
+
+// Deposit swaps the part of the amount that balances the remainder at the
+// pool price after the swap, then deposits both assets into the pool.
+func (s *SwapStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	swapParams, err := s.getSwapStrategyParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	poolRecord, found := s.swapKeeper.GetPool(ctx, swapParams.PoolID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSwapPoolNotFound, "%s", swapParams.PoolID)
+	}
+
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	if err != nil {
+		return err
+	}
+
+	reserves := poolRecord.Reserves()
+	pairedDenom := swapParams.PairedDenom(amount.Denom)
+	fee := s.swapKeeper.GetSwapFee(ctx)
+	swapInput := sdk.NewCoin(amount.Denom, calculateZapSwapAmount(reserves.AmountOf(amount.Denom), amount.Amount, fee))
+	if !swapInput.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit of %s is too small to swap in pool %s", amount, swapParams.PoolID)
+	}
+	swapOutput, _ := pool.SwapWithExactInput(swapInput, fee)
+
+	// The slippage of the swap is limited against the oracle price, so a
+	// deposit fails when the pool has been moved away from it
+	rate, err := (*Keeper)(s).getOracleExchangeRate(ctx, amount.Denom, pairedDenom)
+	if err != nil {
+		return err
+	}
+	oracleOutput := sdk.NewCoin(pairedDenom, sdk.NewDecFromInt(swapInput.Amount).Mul(rate).TruncateInt())
+	if oracleOutput.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit of %s is too small to swap in pool %s", amount, swapParams.PoolID)
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := s.swapKeeper.SwapExactForTokens(ctx, macc.GetAddress(), swapInput, oracleOutput, swapParams.SlippageLimit); err != nil {
+		return err
+	}
+
+	return s.swapKeeper.Deposit(ctx, macc.GetAddress(), amount.Sub(swapInput), swapOutput, swapParams.SlippageLimit)
+}
+
+// Withdraw removes the fewest pool shares that are worth at least the amount
+// once the paired asset is swapped back to the vault denom. An error is
+// returned if the oracle value of the removed shares exceeds the amount by
+// more than the slippage limit.
+func (s *SwapStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	swapParams, err := s.getSwapStrategyParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	ownedShares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), swapParams.PoolID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "no liquidity in swap pool %s", swapParams.PoolID)
+	}
+
+	poolRecord, found := s.swapKeeper.GetPool(ctx, swapParams.PoolID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSwapPoolNotFound, "%s", swapParams.PoolID)
+	}
+
+	pairedDenom := swapParams.PairedDenom(amount.Denom)
+	fee := s.swapKeeper.GetSwapFee(ctx)
+
+	// simulateUnwind returns the coins removed from the pool and the paired
+	// asset swapped back to the vault denom for a number of shares
+	simulateUnwind := func(shares sdkmath.Int) (sdk.Coins, sdk.Coin, error) {
+		pool, err := swaptypes.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+		if err != nil {
+			return nil, sdk.Coin{}, err
+		}
+		removed := pool.RemoveLiquidity(shares)
+		if removed.AmountOf(amount.Denom).IsZero() || removed.AmountOf(pairedDenom).IsZero() {
+			return removed, sdk.NewCoin(amount.Denom, sdk.ZeroInt()), nil
+		}
+		swapOutput, _ := pool.SwapWithExactInput(sdk.NewCoin(pairedDenom, removed.AmountOf(pairedDenom)), fee)
+		return removed, swapOutput, nil
+	}
+
+	// The pool cannot be emptied, as the paired asset could not be swapped
+	maxShares := sdkmath.MinInt(ownedShares, poolRecord.TotalShares.SubRaw(1))
+	if !maxShares.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "cannot remove all liquidity from swap pool %s", swapParams.PoolID)
+	}
+
+	// Binary search for the fewest shares that unwind to at least the amount
+	low, high := sdk.ZeroInt(), maxShares
+	for low.LT(high) {
+		mid := low.Add(high).QuoRaw(2)
+		removed, swapOutput, err := simulateUnwind(mid)
+		if err != nil {
+			return err
+		}
+		if removed.AmountOf(amount.Denom).Add(swapOutput.Amount).GTE(amount.Amount) {
+			high = mid
+		} else {
+			low = mid.AddRaw(1)
+		}
+	}
+
+	removed, swapOutput, err := simulateUnwind(high)
+	if err != nil {
+		return err
+	}
+	if removed.AmountOf(amount.Denom).Add(swapOutput.Amount).LT(amount.Amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"swap pool %s liquidity unwinds to less than %s",
+			swapParams.PoolID, amount,
+		)
+	}
+
+	poolValue, err := s.getFairPoolValue(ctx, poolRecord, amount.Denom, pairedDenom, high)
+	if err != nil {
+		return err
+	}
+	slippage := sdk.NewDecFromInt(poolValue).Quo(sdk.NewDecFromInt(amount.Amount)).Sub(sdk.OneDec())
+	if slippage.GT(swapParams.SlippageLimit) {
+		return errorsmod.Wrapf(swaptypes.ErrSlippageExceeded, "slippage %s > limit %s", slippage, swapParams.SlippageLimit)
+	}
+
+	if err := s.swapKeeper.Withdraw(
+		ctx,
+		macc.GetAddress(),
+		high,
+		sdk.NewCoin(amount.Denom, removed.AmountOf(amount.Denom)),
+		sdk.NewCoin(pairedDenom, removed.AmountOf(pairedDenom)),
+	); err != nil {
+		return err
+	}
+
+	// The slippage of the unwind was checked against the oracle value above
+	return s.swapKeeper.SwapExactForTokens(
+		ctx,
+		macc.GetAddress(),
+		sdk.NewCoin(pairedDenom, removed.AmountOf(pairedDenom)),
+		swapOutput,
+		swapParams.SlippageLimit,
+	)
+}
+
+// getSwapStrategyParams returns the swap strategy params of the vault with the
+// given denom.
+func (s *SwapStrategy) getSwapStrategyParams(ctx sdk.Context, denom string) (types.SwapStrategyParams, error) {
+	allowedVault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found || allowedVault.SwapStrategyParams == nil {
+		return types.SwapStrategyParams{}, errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "vault %s has no swap strategy params", denom)
+	}
+
+	return *allowedVault.SwapStrategyParams, nil
+}
+
+// getFairPoolValue returns the value in the vault denom of pool shares at the
+// oracle price. The reserves are replaced by the fair reserves that have the
+// same product at the oracle price. As swapping against the pool does not
+// lower the product of the reserves, the value cannot be moved by trading:
+// value = 2 * sqrt(reservesVault * reservesPaired * rate) * shares / totalShares
+// where rate is the oracle price of the paired asset in the vault denom.
+func (s *SwapStrategy) getFairPoolValue(
+	ctx sdk.Context,
+	poolRecord swaptypes.PoolRecord,
+	denom string,
+	pairedDenom string,
+	shares sdkmath.Int,
+) (sdkmath.Int, error) {
+	if !poolRecord.TotalShares.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	rate, err := (*Keeper)(s).getOracleExchangeRate(ctx, pairedDenom, denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	reserves := poolRecord.Reserves()
+	product := sdk.NewDecFromInt(reserves.AmountOf(denom)).MulInt(reserves.AmountOf(pairedDenom))
+	productRoot, err := product.ApproxSqrt()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	rateRoot, err := rate.ApproxSqrt()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return productRoot.Mul(rateRoot).MulInt64(2).MulInt(shares).QuoInt(poolRecord.TotalShares).TruncateInt(), nil
+}
+
+// getOracleExchangeRate returns the amount of the quote denom that one unit of
+// the base denom is worth at the oracle price. Prices are read from the
+// pricefeed market of the hard money market of each denom, and scaled by the
+// money market conversion factors.
+func (k Keeper) getOracleExchangeRate(ctx sdk.Context, baseDenom, quoteDenom string) (sdk.Dec, error) {
+	basePrice, baseConversionFactor, err := k.getOraclePrice(ctx, baseDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	quotePrice, quoteConversionFactor, err := k.getOraclePrice(ctx, quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return basePrice.MulInt(quoteConversionFactor).Quo(quotePrice.MulInt(baseConversionFactor)), nil
+}
+
+// getOraclePrice returns the oracle price of a denom and the conversion factor
+// of its hard money market.
+func (k Keeper) getOraclePrice(ctx sdk.Context, denom string) (sdk.Dec, sdkmath.Int, error) {
+	moneyMarket, found := k.hardKeeper.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.Dec{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrPriceNotFound, "no money market for %s", denom)
+	}
+
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrPriceNotFound, "%s: %s", denom, err)
+	}
+	if !price.Price.IsPositive() {
+		return sdk.Dec{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrPriceNotFound, "price of %s is not positive", denom)
+	}
+
+	return price.Price, moneyMarket.ConversionFactor, nil
+}
+
+// calculateZapSwapAmount returns the amount of a single sided deposit to swap
+// so that the remainder and the swap output are in the pool ratio after the
+// swap. With input reserves r, deposit x and fee f, this is the solution of
+// (x - s) / (r + s) = out(s) / (reservesOut - out(s)):
+// s = (sqrt(((2-f)r)^2 + 4(1-f)xr) - (2-f)r) / (2(1-f))
+func calculateZapSwapAmount(reservesIn, amount sdkmath.Int, fee sdk.Dec) sdkmath.Int {
+	r := sdk.NewDecFromInt(reservesIn)
+	x := sdk.NewDecFromInt(amount)
+	feeMultiplier := sdk.OneDec().Sub(fee)
+	b := sdk.OneDec().Add(feeMultiplier).Mul(r)
+
+	root, err := b.Mul(b).Add(feeMultiplier.MulInt64(4).Mul(x).Mul(r)).ApproxSqrt()
+	if err != nil {
+		// fall back to swapping half
+		return amount.QuoRaw(2)
+	}
+
+	return root.Sub(b).Quo(feeMultiplier.MulInt64(2)).TruncateInt()
+}
+
+// GetSwapStrategyVaultDenom returns the denom of the vault that provides
+// liquidity to a swap pool with the swap strategy.
+func (k Keeper) GetSwapStrategyVaultDenom(ctx sdk.Context, poolID string) (string, bool) {
+	for _, allowedVault := range k.GetAllowedVaults(ctx) {
+		if allowedVault.SwapStrategyParams != nil && allowedVault.SwapStrategyParams.PoolID == poolID {
+			return allowedVault.Denom, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	swapVaultDenom = "usdx"
+	swapPoolID     = "ufury:usdx"
+)
+
+type strategySwapTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *strategySwapTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ufury", "usdx")),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	// Pool price of 2 usdx per ufury
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("ufury", 500e9), sdk.NewInt64Coin("usdx", 1000e9))
+	provider := suite.CreateAccount(liquidity, 0)
+	err := swapKeeper.Deposit(suite.Ctx, provider.GetAddress(), liquidity[0], liquidity[1], sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// The swap strategy is valued at the oracle price of the hard money markets
+	setupOraclePrices(&suite.Suite)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(
+			swapVaultDenom,
+			types.StrategyTypes{types.STRATEGY_TYPE_SWAP},
+			false,
+			nil,
+			types.WithSwapStrategyParams(types.NewSwapStrategyParams(swapPoolID, sdk.MustNewDecFromStr("0.01"))),
+		),
+	}))
+}
+
+// setupOraclePrices adds a ufury hard money market using the fury oracle
+// price, and sets the current prices of ufury and usdx.
+func setupOraclePrices(suite *testutil.Suite) {
+	hardParams := suite.HardKeeper.GetParams(suite.Ctx)
+	ufuryMarket, found := suite.HardKeeper.GetMoneyMarket(suite.Ctx, "fury")
+	suite.Require().True(found)
+	ufuryMarket.Denom = "ufury"
+	hardParams.MoneyMarkets = append(hardParams.MoneyMarkets, ufuryMarket)
+	suite.HardKeeper.SetParams(suite.Ctx, hardParams)
+	suite.HardKeeper.SetMoneyMarket(suite.Ctx, ufuryMarket.Denom, ufuryMarket)
+	for _, marketID := range []string{"fury:usd", "usdx:usd"} {
+		suite.Require().NoError(suite.App.GetPriceFeedKeeper().SetCurrentPrices(suite.Ctx, marketID))
+	}
+}
+
+func TestStrategySwapTestSuite(t *testing.T) {
+	suite.Run(t, new(strategySwapTestSuite))
+}
+
+func (suite *strategySwapTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_SWAP, strategy.GetStrategyType())
+}
+
+func (suite *strategySwapTestSuite) TestDepositAndWithdraw() {
+	startBalance := sdk.NewInt64Coin(swapVaultDenom, 10e9)
+	depositAmount := sdk.NewInt64Coin(swapVaultDenom, 1e9)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	// The whole deposit is zapped into the pool, losing only the swap fee on the swapped half
+	swapKeeper := suite.App.GetSwapKeeper()
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	shares, found := swapKeeper.GetDepositorSharesAmount(suite.Ctx, macc.GetAddress(), swapPoolID)
+	suite.Require().True(found)
+	suite.True(shares.IsPositive())
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, macc.GetAddress(), "ufury").Amount.LT(sdkmath.NewInt(10)))
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(totalValue.Amount.LT(depositAmount.Amount))
+	suite.True(totalValue.Amount.GT(depositAmount.Amount.MulRaw(998).QuoRaw(1000)), "got %s", totalValue)
+
+	// Withdrawing unwinds enough liquidity to return the share value of the amount
+	withdrawAmount, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapVaultDenom, 5e8), types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+	suite.True(withdrawAmount.Amount.GTE(sdkmath.NewInt(5e8-1)), "got %s", withdrawAmount)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(startBalance.Sub(depositAmount).Add(withdrawAmount)))
+
+	remainingShares, found := swapKeeper.GetDepositorSharesAmount(suite.Ctx, macc.GetAddress(), swapPoolID)
+	suite.Require().True(found)
+	suite.True(remainingShares.LT(shares))
+
+	// A withdraw over the slippage limit fails
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(
+			swapVaultDenom,
+			types.StrategyTypes{types.STRATEGY_TYPE_SWAP},
+			false,
+			nil,
+			types.WithSwapStrategyParams(types.NewSwapStrategyParams(swapPoolID, sdk.MustNewDecFromStr("0.0001"))),
+		),
+	}))
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapVaultDenom, 4e8), types.STRATEGY_TYPE_SWAP)
+	suite.Require().ErrorIs(err, swaptypes.ErrSlippageExceeded)
+}
+
+func (suite *strategySwapTestSuite) TestWithdraw_UnwindExcess() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 10e9)), 1)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapVaultDenom, 1e9), types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	// Whole pool shares are removed, so the unwinds return slightly more than
+	// the amounts. The excess is too small to deposit back into the pool and
+	// is sent to the withdrawer instead of being left in the module account.
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	maccBalance := suite.BankKeeper.GetBalance(suite.Ctx, macc.GetAddress(), swapVaultDenom)
+	for i := int64(0); i < 5; i++ {
+		amount := sdk.NewInt64Coin(swapVaultDenom, 1e7+i*12345)
+		balance := suite.BankKeeper.GetBalance(suite.Ctx, acc.GetAddress(), swapVaultDenom)
+
+		withdrawAmount, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), amount, types.STRATEGY_TYPE_SWAP)
+		suite.Require().NoError(err)
+		suite.True(withdrawAmount.IsGTE(amount.SubAmount(sdk.OneInt())), "got %s", withdrawAmount)
+		suite.Equal(balance.Add(withdrawAmount), suite.BankKeeper.GetBalance(suite.Ctx, acc.GetAddress(), swapVaultDenom))
+		suite.Equal(maccBalance, suite.BankKeeper.GetBalance(suite.Ctx, macc.GetAddress(), swapVaultDenom))
+	}
+}
+
+func (suite *strategySwapTestSuite) TestDepositAndWithdraw_ManipulatedPool() {
+	depositAmount := sdk.NewInt64Coin(swapVaultDenom, 1e9)
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 10e9)), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapVaultDenom)
+	suite.Require().NoError(err)
+
+	// Move the pool price far from the oracle price
+	swapKeeper := suite.App.GetSwapKeeper()
+	attacker := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 1000e9)), 2)
+	err = swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		attacker.GetAddress(),
+		sdk.NewInt64Coin(swapVaultDenom, 1000e9),
+		sdk.NewInt64Coin("ufury", 1),
+		sdk.OneDec(),
+	)
+	suite.Require().NoError(err)
+
+	// The vault value does not follow the pool reserves, only rising by the swap fees
+	valueAfter, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(valueAfter.Amount.GTE(valueBefore.Amount), "got %s, before %s", valueAfter, valueBefore)
+	suite.True(valueAfter.Amount.LT(valueBefore.Amount.MulRaw(1001).QuoRaw(1000)), "got %s, before %s", valueAfter, valueBefore)
+
+	// Deposits at the moved price fail the slippage check against the oracle price
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP)
+	suite.Require().ErrorIs(err, swaptypes.ErrSlippageExceeded)
+}
+
+func (suite *strategySwapTestSuite) TestAccrueVaultFees_ManipulatedPool() {
+	recipient := sdk.AccAddress("fee_recipient_______")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(
+			swapVaultDenom,
+			types.StrategyTypes{types.STRATEGY_TYPE_SWAP},
+			false,
+			nil,
+			types.WithSwapStrategyParams(types.NewSwapStrategyParams(swapPoolID, sdk.MustNewDecFromStr("0.01"))),
+			types.WithVaultFees(types.NewVaultFees(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.2"), recipient)),
+		),
+	}))
+
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 10e9)), 1)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapVaultDenom, 1e9), types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Keeper.AccrueVaultFees(suite.Ctx, swapVaultDenom))
+	feeRecord, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, swapVaultDenom)
+	suite.Require().True(found)
+	highWaterMark := feeRecord.HighWaterMark
+
+	// Moving the pool price does not raise the value per share
+	swapKeeper := suite.App.GetSwapKeeper()
+	attacker := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 1000e9)), 2)
+	err = swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		attacker.GetAddress(),
+		sdk.NewInt64Coin(swapVaultDenom, 1000e9),
+		sdk.NewInt64Coin("ufury", 1),
+		sdk.OneDec(),
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Keeper.AccrueVaultFees(suite.Ctx, swapVaultDenom))
+
+	// Only the swap fees paid into the pool are charged a performance fee
+	feeRecord, found = suite.Keeper.GetVaultFeeRecord(suite.Ctx, swapVaultDenom)
+	suite.Require().True(found)
+	suite.True(
+		feeRecord.HighWaterMark.LT(highWaterMark.Mul(sdk.MustNewDecFromStr("1.002"))),
+		"got %s, before %s", feeRecord.HighWaterMark, highWaterMark,
+	)
+	recipientValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapVaultDenom, recipient)
+	suite.Require().NoError(err)
+	suite.True(recipientValue.Amount.LT(sdkmath.NewInt(1e6)), "got %s", recipientValue)
+}
+
+func (suite *strategySwapTestSuite) TestGetEstimatedTotalAssets_NoPrice() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapVaultDenom, 10e9)), 1)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapVaultDenom, 1e9), types.STRATEGY_TYPE_SWAP)
+	suite.Require().NoError(err)
+
+	suite.HardKeeper.DeleteMoneyMarket(suite.Ctx, "ufury")
+
+	_, err = suite.Keeper.GetVaultTotalValue(suite.Ctx, swapVaultDenom)
+	suite.Require().ErrorIs(err, types.ErrPriceNotFound)
+}
+
+func (suite *strategySwapTestSuite) TestGetSwapStrategyVaultDenom() {
+	vaultDenom, found := suite.Keeper.GetSwapStrategyVaultDenom(suite.Ctx, swapPoolID)
+	suite.True(found)
+	suite.Equal(swapVaultDenom, vaultDenom)
+
+	_, found = suite.Keeper.GetSwapStrategyVaultDenom(suite.Ctx, "busd:usdx")
+	suite.False(found)
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+// TokenizeShares converts the vault shares of a depositor worth the provided
+// amount into earn/<denom> tokens, one token for each whole share. The
+// tokenized shares are held in the share record of the module account, so the
+// shares in all share records continue to sum to the vault's total shares.
+// Tokenized shares accrue earn rewards to the holder of the tokens.
+func (k *Keeper) TokenizeShares(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	amount sdk.Coin,
+) (sdk.Coin, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidVaultDenom
+	}
+
+	// Tokens can be transferred to any account, so shares of private vaults
+	// cannot be tokenized
+	if allowedVault.IsPrivateVault {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrInvalidTokenizedShares,
+			"shares of private vault %s cannot be tokenized",
+			amount.Denom,
+		)
+	}
+
+	// Fees are taken before the share price is used to convert the amount
+	if err := k.AccrueVaultFees(ctx, amount.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, found := k.GetVaultRecord(ctx, amount.Denom); !found {
+		return sdk.Coin{}, types.ErrVaultRecordNotFound
+	}
+
+	shares, err := k.ConvertToShares(ctx, amount)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to convert assets to shares: %w", err)
+	}
+
+	tokens := sdk.NewCoin(types.TokenizedSharesDenom(amount.Denom), shares.Amount.TruncateInt())
+	if tokens.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrInvalidTokenizedShares,
+			"%s is worth less than one vault share",
+			amount,
+		)
+	}
+
+	tokenizedShares := types.NewVaultShare(amount.Denom, sdk.NewDecFromInt(tokens.Amount))
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.transferShares(ctx, depositor, moduleAddr, tokenizedShares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(tokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(tokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, tokenizedShares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		),
+	)
+
+	return tokens, nil
+}
+
+// RedeemTokenizedShares burns earn/<denom> tokens and moves the vault shares
+// they represent from the module account to the share record of the owner.
+func (k *Keeper) RedeemTokenizedShares(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	tokens sdk.Coin,
+) (types.VaultShare, error) {
+	vaultDenom, isTokenizedShares := types.ParseTokenizedSharesDenom(tokens.Denom)
+	if !isTokenizedShares {
+		return types.VaultShare{}, errorsmod.Wrapf(
+			types.ErrInvalidTokenizedShares,
+			"%s is not a tokenized shares denom",
+			tokens.Denom,
+		)
+	}
+
+	if !tokens.IsPositive() {
+		return types.VaultShare{}, errorsmod.Wrapf(types.ErrInsufficientAmount, "%s", tokens)
+	}
+
+	// Vaults may be made private after shares were tokenized
+	if allowedVault, found := k.GetAllowedVault(ctx, vaultDenom); found && !allowedVault.IsAccountAllowed(owner) {
+		return types.VaultShare{}, types.ErrAccountDepositNotAllowed
+	}
+
+	// The shares are moved while the owner still holds the tokens, so the earn
+	// hooks sync the rewards accrued on the tokens
+	shares := types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(tokens.Amount))
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.transferShares(ctx, moduleAddr, owner, shares); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(tokens)); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(tokens)); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		),
+	)
+
+	return shares, nil
+}
+
+// transferShares moves vault shares between share records, calling the earn
+// hooks for both accounts. The vault's total shares are unchanged.
+func (k *Keeper) transferShares(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	to sdk.AccAddress,
+	shares types.VaultShare,
+) error {
+	fromRecord, found := k.GetVaultShareRecord(ctx, from)
+	if !found {
+		return types.ErrVaultShareRecordNotFound
+	}
+
+	fromShares := fromRecord.Shares.AmountOf(shares.Denom)
+	if fromShares.LT(shares.Amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less %s vault shares than transferred shares, %s < %s",
+			shares.Denom,
+			fromShares,
+			shares.Amount,
+		)
+	}
+
+	// Shares locked by queued withdraws cannot be moved
+	availableShares := fromShares.Sub(k.GetLockedShares(ctx, from, shares.Denom))
+	if availableShares.LT(shares.Amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less unlocked %s vault shares than transferred shares, %s < %s",
+			shares.Denom,
+			availableShares,
+			shares.Amount,
+		)
+	}
+
+	k.BeforeVaultDepositModified(ctx, shares.Denom, from, fromShares)
+
+	fromRecord.Shares = fromRecord.Shares.Sub(shares)
+	k.UpdateVaultShareRecord(ctx, fromRecord)
+
+	toRecord, found := k.GetVaultShareRecord(ctx, to)
+	if !found {
+		toRecord = types.NewVaultShareRecord(to, types.NewVaultShares())
+	}
+
+	toShares := toRecord.Shares.AmountOf(shares.Denom)
+	if !toShares.IsZero() {
+		k.BeforeVaultDepositModified(ctx, shares.Denom, to, toShares)
+	}
+
+	toRecord.Shares = toRecord.Shares.Add(shares)
+	k.SetVaultShareRecord(ctx, toRecord)
+
+	if toShares.IsZero() {
+		k.AfterVaultDepositCreated(ctx, shares.Denom, to, shares.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/keeper"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+	"github.com/mage-coven/fury/x/earn/types/mocks"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tokenizedSharesTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *tokenizedSharesTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestTokenizedSharesTestSuite(t *testing.T) {
+	suite.Run(t, new(tokenizedSharesTestSuite))
+}
+
+func (suite *tokenizedSharesTestSuite) TestTokenizeAndRedeem() {
+	vaultDenom := "usdx"
+	tokenDenom := types.TokenizedSharesDenom(vaultDenom)
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(), 1)
+	moduleAddr := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	tokens, err := suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(tokenDenom, 400), tokens)
+
+	// Tokenized shares are held by the module account, so total shares are unchanged
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(tokens))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(1000))))
+	accShares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(600), accShares.AmountOf(vaultDenom))
+	moduleShares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, moduleAddr)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(400), moduleShares.AmountOf(vaultDenom))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultTokenizeShares,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+	))
+
+	// Tokens are transferable and redeemable by any holder
+	err = suite.BankKeeper.SendCoins(suite.Ctx, acc.GetAddress(), acc2.GetAddress(), sdk.NewCoins(tokens))
+	suite.Require().NoError(err)
+
+	shares, err := suite.Keeper.RedeemTokenizedShares(suite.Ctx, acc2.GetAddress(), tokens)
+	suite.Require().NoError(err)
+	suite.Equal(types.NewVaultShare(vaultDenom, sdk.NewDec(400)), shares)
+	suite.Equal(sdk.NewInt64Coin(tokenDenom, 0), suite.BankKeeper.GetSupply(suite.Ctx, tokenDenom))
+
+	_, found = suite.Keeper.GetVaultShareRecord(suite.Ctx, moduleAddr)
+	suite.False(found)
+
+	withdrawAmount, err := suite.Keeper.Withdraw(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc2.GetAddress(), sdk.NewCoins(withdrawAmount))
+
+	_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *tokenizedSharesTestSuite) TestTokenizeShares_Invalid() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, true, []sdk.AccAddress{acc.GetAddress()})
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidTokenizedShares)
+
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("busd", 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	suite.CreateVault("ufury", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("ufury", 100))
+	suite.Require().ErrorIs(err, types.ErrVaultRecordNotFound)
+
+	_, err = suite.Keeper.RedeemTokenizedShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidTokenizedShares)
+}
+
+func (suite *tokenizedSharesTestSuite) TestTokenizeShares_Hooks() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Keeper.ClearHooks()
+	earnHooks := mocks.NewEarnHooks(suite.T())
+	suite.Keeper.SetHooks(earnHooks)
+
+	// Only the depositor is synced, as the tokenized shares held by the
+	// module account accrue rewards to the holder of the tokens
+	earnHooks.On("BeforeVaultDepositModified", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(1000)).Once()
+	tokens, err := suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+
+	// The shares owned by the depositor include the tokens being redeemed
+	earnHooks.On("BeforeVaultDepositModified", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(1000)).Once()
+	_, err = suite.Keeper.RedeemTokenizedShares(suite.Ctx, acc.GetAddress(), tokens)
+	suite.Require().NoError(err)
+}
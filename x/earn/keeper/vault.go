@@ -21,7 +21,8 @@ func (k *Keeper) GetVaultTotalShares(
 }
 
 // GetVaultTotalValue returns the total value of a vault, i.e. the realizable
-// total value if the vault were to liquidate its entire strategies.
+// total value if the vault were to liquidate its entire strategies. This is
+// the sum of the values held in each of the vault's strategies.
 //
 // **Note:** This does not include the tokens held in bank by the module
 // account. If it were to be included, also note that the module account is
@@ -35,13 +36,13 @@ func (k *Keeper) GetVaultTotalValue(
 		return sdk.Coin{}, types.ErrVaultRecordNotFound
 	}
 
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	// Denom can be different from allowedVault.Denom for bfury
+	_, total, err := k.getStrategyValues(ctx, allowedVault.GetStrategyWeights(), denom)
 	if err != nil {
-		return sdk.Coin{}, types.ErrInvalidVaultStrategy
+		return sdk.Coin{}, err
 	}
 
-	// Denom can be different from allowedVault.Denom for bfury
-	return strategy.GetEstimatedTotalAssets(ctx, denom)
+	return sdk.NewCoin(denom, total), nil
 }
 
 // GetVaultAccountShares returns the shares for a single address for all vaults.
@@ -57,6 +58,36 @@ func (k *Keeper) GetVaultAccountShares(
 	return vaultShareRecord.Shares, true
 }
 
+// GetVaultAccountRewardShares returns the shares of a single address for all
+// vaults that accrue earn rewards. These are the shares in its share record
+// and its tokenized shares. The share record of the module account holds the
+// tokenized shares, which accrue rewards to their holders instead.
+func (k *Keeper) GetVaultAccountRewardShares(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+) types.VaultShares {
+	shares := types.NewVaultShares()
+	if vaultShareRecord, found := k.GetVaultShareRecord(ctx, acc); found &&
+		!acc.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		shares = vaultShareRecord.Shares
+	}
+
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, acc) {
+		if vaultDenom, isTokenizedShares := types.ParseTokenizedSharesDenom(coin.Denom); isTokenizedShares {
+			shares = shares.Add(types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(coin.Amount)))
+		}
+	}
+
+	return shares
+}
+
+// getTokenizedShares returns the tokenized shares of a vault held by an
+// account.
+func (k *Keeper) getTokenizedShares(ctx sdk.Context, acc sdk.AccAddress, vaultDenom string) sdk.Dec {
+	balance := k.bankKeeper.GetBalance(ctx, acc, types.TokenizedSharesDenom(vaultDenom))
+	return sdk.NewDecFromInt(balance.Amount)
+}
+
 // GetVaultAccountValue returns the value of a single address within a vault
 // if the account were to withdraw their entire balance.
 func (k *Keeper) GetVaultAccountValue(
@@ -10,7 +10,10 @@ import (
 )
 
 // Withdraw removes the amount of supplied tokens from a vault and transfers it
-// back to the account.
+// back to the account. A withdraw is not ordered with the queued withdraws of
+// the vault, so it is made from any liquidity the strategies have even while
+// queued withdraws wait for liquidity. Only the shares locked by the queued
+// withdraws of the account cannot be withdrawn.
 func (k *Keeper) Withdraw(
 	ctx sdk.Context,
 	from sdk.AccAddress,
@@ -32,6 +35,22 @@ func (k *Keeper) Withdraw(
 		return sdk.Coin{}, types.ErrInvalidVaultStrategy
 	}
 
+	return k.withdraw(ctx, allowedVault, from, wantAmount)
+}
+
+// withdraw removes the amount of supplied tokens from the strategies of a
+// vault by its allocation and transfers it back to the account.
+func (k *Keeper) withdraw(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+) (sdk.Coin, error) {
+	// Fees are taken before the share price is used for the withdraw
+	if err := k.AccrueVaultFees(ctx, wantAmount.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// Check if VaultRecord exists
 	vaultRecord, found := k.GetVaultRecord(ctx, wantAmount.Denom)
 	if !found {
@@ -61,6 +80,18 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
+	// Shares locked by queued withdraws are withdrawn when released
+	availableShares := accCurrentShares.Sub(k.GetLockedShares(ctx, from, wantAmount.Denom))
+	if availableShares.LT(withdrawShares.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less unlocked %s vault shares than withdraw shares, %s < %s",
+			wantAmount.Denom,
+			availableShares,
+			withdrawShares.Amount,
+		)
+	}
+
 	// Convert shares to amount to get truncated true share value
 	withdrawAmount, err := k.ConvertToAssets(ctx, withdrawShares)
 	if err != nil {
@@ -83,27 +114,39 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
-	// Get the strategy for the vault
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return sdk.Coin{}, err
-	}
-
 	// Not necessary to check if amount denom is allowed for the strategy, as
 	// there would be no vault record if it weren't allowed.
 
-	// Withdraw the withdrawAmount from the strategy
-	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
+	// Withdraw the withdrawAmount from the strategies
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balanceBefore := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), withdrawAmount.Denom).Amount
+	if err := k.withdrawFromStrategies(ctx, allowedVault, withdrawAmount); err != nil {
 		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
 	}
 
+	// A strategy can return more than the amount, such as the swap strategy
+	// that removes whole pool shares. The excess is deposited back into the
+	// vault, or sent with the withdraw if it is too small to be deposited, so
+	// it is not left in the module account.
+	sendAmount := withdrawAmount
+	excess := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), withdrawAmount.Denom).Amount.Sub(balanceBefore).Sub(withdrawAmount.Amount)
+	if excess.IsPositive() {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.depositToStrategies(cacheCtx, allowedVault, sdk.NewCoin(withdrawAmount.Denom, excess)); err == nil {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		} else {
+			sendAmount = sendAmount.AddAmount(excess)
+		}
+	}
+
 	// Send coins back to account, must withdraw from strategy first or the
 	// module account may not have any funds to send.
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx,
 		types.ModuleName,
 		from,
-		sdk.NewCoins(withdrawAmount),
+		sdk.NewCoins(sendAmount),
 	); err != nil {
 		return sdk.Coin{}, err
 	}
@@ -144,11 +187,11 @@ func (k *Keeper) Withdraw(
 			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
 			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
 			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sendAmount.Amount.String()),
 		),
 	)
 
-	return withdrawAmount, nil
+	return sendAmount, nil
 }
 
 // WithdrawFromModuleAccount removes the amount of supplied tokens from a vault and transfers it
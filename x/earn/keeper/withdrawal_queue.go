@@ -0,0 +1,362 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn/types"
+)
+
+// QueueWithdraw queues a withdraw of an amount from a vault, locking the
+// vault shares worth the amount until the withdraw is released or cancelled.
+// Queued withdraws are released in order once the vault strategies have the
+// liquidity for them. Direct withdraws from the vault are not ordered with the
+// queue and may use liquidity before a queued withdraw is released.
+func (k *Keeper) QueueWithdraw(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+) (types.WithdrawalRequest, error) {
+	if _, found := k.GetAllowedVault(ctx, wantAmount.Denom); !found {
+		return types.WithdrawalRequest{}, types.ErrInvalidVaultDenom
+	}
+
+	if !wantAmount.IsPositive() {
+		return types.WithdrawalRequest{}, types.ErrInsufficientAmount
+	}
+
+	// Fees are taken before the share price is used to lock the shares
+	if err := k.AccrueVaultFees(ctx, wantAmount.Denom); err != nil {
+		return types.WithdrawalRequest{}, err
+	}
+
+	if _, found := k.GetVaultRecord(ctx, wantAmount.Denom); !found {
+		return types.WithdrawalRequest{}, types.ErrVaultRecordNotFound
+	}
+
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
+	if !found {
+		return types.WithdrawalRequest{}, types.ErrVaultShareRecordNotFound
+	}
+
+	withdrawShares, err := k.ConvertToShares(ctx, wantAmount)
+	if err != nil {
+		return types.WithdrawalRequest{}, fmt.Errorf("failed to convert assets to shares: %w", err)
+	}
+
+	availableShares := vaultShareRecord.Shares.AmountOf(wantAmount.Denom).
+		Sub(k.GetLockedShares(ctx, from, wantAmount.Denom))
+	if availableShares.LT(withdrawShares.Amount) {
+		return types.WithdrawalRequest{}, errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less unlocked %s vault shares than withdraw shares, %s < %s",
+			wantAmount.Denom,
+			availableShares,
+			withdrawShares.Amount,
+		)
+	}
+
+	id := k.GetNextWithdrawalRequestID(ctx)
+	request := types.NewWithdrawalRequest(id, from, withdrawShares, ctx.BlockTime())
+	k.SetWithdrawalRequest(ctx, request)
+	k.SetNextWithdrawalRequestID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultQueueWithdraw,
+			sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, wantAmount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
+		),
+	)
+
+	return request, nil
+}
+
+// CancelWithdrawal removes a queued withdraw of an owner, unlocking its shares.
+func (k *Keeper) CancelWithdrawal(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	request, found := k.GetWithdrawalRequest(ctx, id)
+	if !found || !request.Owner.Equals(owner) {
+		return errorsmod.Wrapf(types.ErrWithdrawalRequestNotFound, "id %d owned by %s", id, owner)
+	}
+
+	k.DeleteWithdrawalRequest(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultCancelWithdraw,
+			sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, request.Shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, request.Shares.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseWithdrawals releases queued withdraws of each vault in order of id,
+// up to MaxWithdrawalReleasesPerBlock attempts in total. Once a withdraw from a
+// vault cannot be released, later withdraws from the same vault wait for it,
+// unless it was queued more than WithdrawalRequestExpiry ago, in which case it
+// is removed so it cannot hold up the vault forever.
+func (k *Keeper) ReleaseWithdrawals(ctx sdk.Context) {
+	attempts := 0
+	for _, allowedVault := range k.GetAllowedVaults(ctx) {
+		if attempts >= types.MaxWithdrawalReleasesPerBlock {
+			return
+		}
+
+		// Requests are collected first as releasing writes to the store
+		requests := k.GetWithdrawalRequestsByDenom(
+			ctx,
+			allowedVault.Denom,
+			types.MaxWithdrawalReleasesPerBlock-attempts,
+		)
+
+		for _, request := range requests {
+			attempts++
+
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.releaseWithdrawal(cacheCtx, allowedVault, request); err != nil {
+				k.Logger(ctx).Debug(
+					"queued withdraw not released",
+					"id", request.ID,
+					"denom", request.Shares.Denom,
+					"err", err,
+				)
+
+				if ctx.BlockTime().Before(request.Time.Add(types.WithdrawalRequestExpiry)) {
+					break
+				}
+
+				k.expireWithdrawal(ctx, request)
+				continue
+			}
+
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+}
+
+// releaseWithdrawal withdraws the current value of the shares of a queued
+// withdraw to its owner and removes the request. The value is withdrawn from
+// the vault strategies by their allocation, the same as a direct withdraw.
+func (k *Keeper) releaseWithdrawal(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	request types.WithdrawalRequest,
+) error {
+	// The shares are unlocked before withdrawing them
+	k.DeleteWithdrawalRequest(ctx, request.ID)
+
+	if err := k.AccrueVaultFees(ctx, request.Shares.Denom); err != nil {
+		return err
+	}
+
+	amount, err := k.ConvertToAssets(ctx, request.Shares)
+	if err != nil {
+		return err
+	}
+
+	// Shares worth less than one coin can never be released, so the request
+	// is removed and the shares are left with the owner
+	if !amount.IsPositive() {
+		k.expireWithdrawal(ctx, request)
+		return nil
+	}
+
+	withdrawAmount, err := k.withdraw(ctx, allowedVault, request.Owner, amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultReleaseWithdraw,
+			sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", request.ID)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, request.Shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, request.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// expireWithdrawal removes a queued withdraw that cannot be released, unlocking
+// its shares.
+func (k *Keeper) expireWithdrawal(ctx sdk.Context, request types.WithdrawalRequest) {
+	k.DeleteWithdrawalRequest(ctx, request.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultExpireWithdraw,
+			sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", request.ID)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, request.Shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, request.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, request.Shares.Amount.String()),
+		),
+	)
+}
+
+// GetLockedShares returns the vault shares of an owner locked by queued
+// withdraws from a vault.
+func (k *Keeper) GetLockedShares(ctx sdk.Context, owner sdk.AccAddress, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedSharesKeyPrefix)
+
+	bz := store.Get(types.LockedSharesKey(owner, denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var locked sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &locked)
+
+	return locked.Dec
+}
+
+// addLockedShares adds an amount, which may be negative, to the vault shares
+// of an owner locked by queued withdraws.
+func (k *Keeper) addLockedShares(ctx sdk.Context, owner sdk.AccAddress, denom string, amount sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedSharesKeyPrefix)
+	key := types.LockedSharesKey(owner, denom)
+
+	locked := k.GetLockedShares(ctx, owner, denom).Add(amount)
+	if !locked.IsPositive() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&sdk.DecProto{Dec: locked}))
+}
+
+// ----------------------------------------------------------------------------
+// WithdrawalRequest -- queued withdraws
+
+// GetWithdrawalRequest returns a queued withdraw by id.
+func (k *Keeper) GetWithdrawalRequest(ctx sdk.Context, id uint64) (types.WithdrawalRequest, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+
+	bz := store.Get(types.WithdrawalRequestKey(id))
+	if bz == nil {
+		return types.WithdrawalRequest{}, false
+	}
+
+	var request types.WithdrawalRequest
+	k.cdc.MustUnmarshal(bz, &request)
+
+	return request, true
+}
+
+// SetWithdrawalRequest sets a queued withdraw, updating the vault index and
+// the locked shares of its owner.
+func (k *Keeper) SetWithdrawalRequest(ctx sdk.Context, request types.WithdrawalRequest) {
+	k.DeleteWithdrawalRequest(ctx, request.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	bz := k.cdc.MustMarshal(&request)
+	store.Set(types.WithdrawalRequestKey(request.ID), bz)
+
+	denomStore := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByDenomKeyPrefix)
+	denomStore.Set(types.WithdrawalRequestByDenomKey(request.Shares.Denom, request.ID), []byte{})
+
+	k.addLockedShares(ctx, request.Owner, request.Shares.Denom, request.Shares.Amount)
+}
+
+// DeleteWithdrawalRequest deletes a queued withdraw, updating the vault index
+// and the locked shares of its owner.
+func (k *Keeper) DeleteWithdrawalRequest(ctx sdk.Context, id uint64) {
+	request, found := k.GetWithdrawalRequest(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	store.Delete(types.WithdrawalRequestKey(id))
+
+	denomStore := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByDenomKeyPrefix)
+	denomStore.Delete(types.WithdrawalRequestByDenomKey(request.Shares.Denom, id))
+
+	k.addLockedShares(ctx, request.Owner, request.Shares.Denom, request.Shares.Amount.Neg())
+}
+
+// GetWithdrawalRequestsByDenom returns up to limit queued withdraws from a
+// vault in order of id.
+func (k *Keeper) GetWithdrawalRequestsByDenom(
+	ctx sdk.Context,
+	denom string,
+	limit int,
+) types.WithdrawalRequests {
+	store := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestByDenomKeyPrefix),
+		types.WithdrawalRequestsByDenomKey(denom),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var requests types.WithdrawalRequests
+	for ; iterator.Valid() && len(requests) < limit; iterator.Next() {
+		request, found := k.GetWithdrawalRequest(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("withdrawal request index has no request for key %x", iterator.Key()))
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+// IterateWithdrawalRequests iterates over all queued withdraws in order of id
+// and performs a callback function.
+func (k Keeper) IterateWithdrawalRequests(
+	ctx sdk.Context,
+	cb func(request types.WithdrawalRequest) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalRequestKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.WithdrawalRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		if cb(request) {
+			break
+		}
+	}
+}
+
+// GetAllWithdrawalRequests returns all queued withdraws.
+func (k Keeper) GetAllWithdrawalRequests(ctx sdk.Context) types.WithdrawalRequests {
+	var requests types.WithdrawalRequests
+
+	k.IterateWithdrawalRequests(ctx, func(request types.WithdrawalRequest) bool {
+		requests = append(requests, request)
+		return false
+	})
+
+	return requests
+}
+
+// GetNextWithdrawalRequestID returns the id of the next queued withdraw.
+func (k Keeper) GetNextWithdrawalRequestID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextWithdrawalRequestIDKey)
+	if bz == nil {
+		return types.DefaultNextWithdrawalRequestID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextWithdrawalRequestID sets the id of the next queued withdraw.
+func (k Keeper) SetNextWithdrawalRequestID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextWithdrawalRequestIDKey, sdk.Uint64ToBigEndian(id))
+}
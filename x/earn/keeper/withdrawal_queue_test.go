@@ -0,0 +1,376 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/earn"
+	"github.com/mage-coven/fury/x/earn/keeper"
+	"github.com/mage-coven/fury/x/earn/testutil"
+	"github.com/mage-coven/fury/x/earn/types"
+	hardtypes "github.com/mage-coven/fury/x/hard/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type withdrawalQueueTestSuite struct {
+	testutil.Suite
+
+	// liquidityHolder holds hard liquidity removed to make withdraws illiquid
+	liquidityHolder sdk.AccAddress
+}
+
+func (suite *withdrawalQueueTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+	suite.liquidityHolder = suite.CreateAccount(sdk.NewCoins(), 10).GetAddress()
+}
+
+func TestWithdrawalQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(withdrawalQueueTestSuite))
+}
+
+// removeHardLiquidity moves coins out of the hard module account, as if they
+// were borrowed
+func (suite *withdrawalQueueTestSuite) removeHardLiquidity(amount sdk.Coin) {
+	err := suite.BankKeeper.SendCoinsFromModuleToAccount(
+		suite.Ctx,
+		hardtypes.ModuleAccountName,
+		suite.liquidityHolder,
+		sdk.NewCoins(amount),
+	)
+	suite.Require().NoError(err)
+}
+
+// returnHardLiquidity moves coins back into the hard module account, as if
+// they were repaid
+func (suite *withdrawalQueueTestSuite) returnHardLiquidity(amount sdk.Coin) {
+	err := suite.BankKeeper.SendCoinsFromAccountToModule(
+		suite.Ctx,
+		suite.liquidityHolder,
+		hardtypes.ModuleAccountName,
+		sdk.NewCoins(amount),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_Release() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.removeHardLiquidity(startBalance)
+
+	// Withdraws fail while hard has no liquidity
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400), types.STRATEGY_TYPE_HARD)
+	suite.Require().Error(err)
+
+	request, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+	suite.Equal(
+		types.NewWithdrawalRequest(1, acc.GetAddress(), types.NewVaultShare(vaultDenom, sdk.NewDec(400)), suite.Ctx.BlockTime()),
+		request,
+	)
+	suite.Equal(sdk.NewDec(400), suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), vaultDenom))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultQueueWithdraw,
+		sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+	))
+
+	// The request waits while there is no liquidity
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	_, found := suite.Keeper.GetWithdrawalRequest(suite.Ctx, request.ID)
+	suite.True(found)
+
+	suite.returnHardLiquidity(startBalance)
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	_, found = suite.Keeper.GetWithdrawalRequest(suite.Ctx, request.ID)
+	suite.False(found)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 400)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(600))))
+	suite.True(suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), vaultDenom).IsZero())
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultReleaseWithdraw,
+		sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+	))
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_FIFO() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	for _, acc := range []sdk.AccAddress{acc1.GetAddress(), acc2.GetAddress()} {
+		err := suite.Keeper.Deposit(suite.Ctx, acc, startBalance, types.STRATEGY_TYPE_HARD)
+		suite.Require().NoError(err)
+	}
+
+	suite.removeHardLiquidity(sdk.NewInt64Coin(vaultDenom, 2000))
+
+	request1, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+	request2, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 200))
+	suite.Require().NoError(err)
+	suite.Equal(request1.ID+1, request2.ID)
+
+	// There is liquidity for the second request but not the first, which is
+	// released first
+	suite.returnHardLiquidity(sdk.NewInt64Coin(vaultDenom, 300))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Len(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx), 2)
+
+	suite.returnHardLiquidity(sdk.NewInt64Coin(vaultDenom, 300))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.AccountBalanceEqual(acc1.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 400)))
+	suite.AccountBalanceEqual(acc2.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 200)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_Expire() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	for _, acc := range []sdk.AccAddress{acc1.GetAddress(), acc2.GetAddress()} {
+		err := suite.Keeper.Deposit(suite.Ctx, acc, startBalance, types.STRATEGY_TYPE_HARD)
+		suite.Require().NoError(err)
+	}
+
+	suite.removeHardLiquidity(sdk.NewInt64Coin(vaultDenom, 2000))
+
+	request1, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+	request2, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 200))
+	suite.Require().NoError(err)
+
+	suite.returnHardLiquidity(sdk.NewInt64Coin(vaultDenom, 300))
+
+	// The first request holds up the vault until it expires
+	suite.Ctx = suite.Ctx.WithBlockTime(request1.Time.Add(types.WithdrawalRequestExpiry).Add(-time.Second))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal(types.WithdrawalRequests{request1, request2}, suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(request1.Time.Add(types.WithdrawalRequestExpiry))
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	// The expired request is removed with its shares unlocked, and the next
+	// request is released in the same block
+	suite.Empty(suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.True(suite.Keeper.GetLockedShares(suite.Ctx, acc1.GetAddress(), vaultDenom).IsZero())
+	suite.AccountBalanceEqual(acc1.GetAddress(), sdk.NewCoins())
+	suite.AccountBalanceEqual(acc2.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 200)))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultExpireWithdraw,
+		sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc1.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+	))
+
+	shareRecord, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, acc1.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1000), shareRecord.Shares.AmountOf(vaultDenom))
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_LocksShares() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+
+	// Locked shares cannot be queued, withdrawn or tokenized again
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 601))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 601), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 601))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	// Unlocked shares can still be withdrawn
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 600), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_LockedSharesTotal() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	request1, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 100))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewDec(500), suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), vaultDenom))
+	suite.True(suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), "ufury").IsZero())
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc.GetAddress(), request1.ID)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewDec(100), suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), vaultDenom))
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_ReleasePerVault() {
+	startBalance := sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("ufury", 1000))
+
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.CreateVault("ufury", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	acc := suite.CreateAccount(startBalance, 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance[1], types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance[0], types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.removeHardLiquidity(sdk.NewInt64Coin("usdx", 1000))
+
+	blocked, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 400))
+	suite.Require().NoError(err)
+	released, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("ufury", 400))
+	suite.Require().NoError(err)
+
+	suite.Equal(types.WithdrawalRequests{blocked}, suite.Keeper.GetWithdrawalRequestsByDenom(suite.Ctx, "usdx", 10))
+	suite.Equal(types.WithdrawalRequests{released}, suite.Keeper.GetWithdrawalRequestsByDenom(suite.Ctx, "ufury", 10))
+
+	// A blocked withdraw from one vault does not hold up other vaults
+	earn.EndBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Equal(types.WithdrawalRequests{blocked}, suite.Keeper.GetAllWithdrawalRequests(suite.Ctx))
+	suite.Empty(suite.Keeper.GetWithdrawalRequestsByDenom(suite.Ctx, "ufury", 10))
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("ufury", 400)))
+	suite.True(suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), "ufury").IsZero())
+	suite.Equal(sdk.NewDec(400), suite.Keeper.GetLockedShares(suite.Ctx, acc.GetAddress(), "usdx"))
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueueWithdraw_Invalid() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	_, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("busd", 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 100))
+	suite.Require().ErrorIs(err, types.ErrVaultRecordNotFound)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 1001))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+}
+
+func (suite *withdrawalQueueTestSuite) TestCancelWithdrawal() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	request, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 400))
+	suite.Require().NoError(err)
+
+	// Only the owner can cancel
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc2.GetAddress(), request.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalRequestNotFound)
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc.GetAddress(), request.ID)
+	suite.Require().NoError(err)
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultCancelWithdraw,
+		sdk.NewAttribute(types.AttributeKeyRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(400).String()),
+	))
+
+	err = suite.Keeper.CancelWithdrawal(suite.Ctx, acc.GetAddress(), request.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalRequestNotFound)
+
+	// All shares are unlocked
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), startBalance, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) TestQueryWithdrawalRequests() {
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	startBalance := sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("busd", 1000))
+
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.CreateVault("busd", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	acc1 := suite.CreateAccount(startBalance, 0)
+	acc2 := suite.CreateAccount(startBalance, 1)
+
+	var requests types.WithdrawalRequests
+	for _, acc := range []sdk.AccAddress{acc1.GetAddress(), acc2.GetAddress()} {
+		for _, coin := range startBalance {
+			err := suite.Keeper.Deposit(suite.Ctx, acc, coin, types.STRATEGY_TYPE_HARD)
+			suite.Require().NoError(err)
+
+			request, err := suite.Keeper.QueueWithdraw(suite.Ctx, acc, sdk.NewInt64Coin(coin.Denom, 100))
+			suite.Require().NoError(err)
+			requests = append(requests, request)
+		}
+	}
+
+	res, err := queryServer.WithdrawalRequests(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryWithdrawalRequestsRequest("", "", nil),
+	)
+	suite.Require().NoError(err)
+	suite.Equal(requests, res.Requests)
+
+	res, err = queryServer.WithdrawalRequests(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryWithdrawalRequestsRequest(acc2.GetAddress().String(), "usdx", nil),
+	)
+	suite.Require().NoError(err)
+	suite.Equal(types.WithdrawalRequests{requests[3]}, res.Requests)
+
+	_, err = queryServer.WithdrawalRequests(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryWithdrawalRequestsRequest("invalid", "", nil),
+	)
+	suite.Require().Error(err)
+}
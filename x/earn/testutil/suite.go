@@ -23,7 +23,6 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
@@ -104,6 +103,9 @@ func (suite *Suite) SetupTest() {
 				),
 				sdk.MustNewDecFromStr("0.05"),
 				sdk.ZeroDec(),
+				hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+				hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+				hardtypes.DefaultLiquidationBonus,
 			),
 			hardtypes.NewMoneyMarket(
 				"busd",
@@ -122,6 +124,9 @@ func (suite *Suite) SetupTest() {
 				),
 				sdk.MustNewDecFromStr("0.05"),
 				sdk.ZeroDec(),
+				hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+				hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+				hardtypes.DefaultLiquidationBonus,
 			),
 			hardtypes.NewMoneyMarket(
 				"fury",
@@ -140,9 +145,16 @@ func (suite *Suite) SetupTest() {
 				),
 				sdk.MustNewDecFromStr("0.05"),
 				sdk.ZeroDec(),
+				hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+				hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+				hardtypes.DefaultLiquidationBonus,
 			),
 		},
 		sdk.NewDec(10),
+		0,
+		nil,
+		hardtypes.DefaultFlashLoanFee,
+		hardtypes.DefaultCloseFactor,
 	),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -150,6 +162,9 @@ func (suite *Suite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEfficiencyCategories,
+		hardtypes.DefaultIsolatedDebts,
+		hardtypes.DefaultAdaptiveRates, hardtypes.DefaultCreditDelegations,
 	)
 
 	savingsGS := savingstypes.NewGenesisState(
@@ -162,8 +177,13 @@ func (suite *Suite) SetupTest() {
 				TestBfuryDenoms[1],
 				TestBfuryDenoms[2],
 			},
+			sdk.ZeroDec(),
+			savingstypes.LockupTiers{},
 		),
 		nil,
+		nil,
+		nil,
+		savingstypes.DefaultNextLockedDepositID,
 	)
 
 	stakingParams := stakingtypes.DefaultParams()
@@ -205,17 +225,13 @@ func (suite *Suite) GetEvents() sdk.Events {
 
 // AddCoinsToModule adds coins to the earn module account
 func (suite *Suite) AddCoinsToModule(amount sdk.Coins) {
-	// Does not use suite.BankKeeper.MintCoins as module account would not have permission to mint
 	err := banktestutil.FundModuleAccount(suite.BankKeeper, suite.Ctx, types.ModuleName, amount)
 	suite.Require().NoError(err)
 }
 
 // RemoveCoinsFromModule removes coins to the earn module account
 func (suite *Suite) RemoveCoinsFromModule(amount sdk.Coins) {
-	// Earn module does not have BurnCoins permission so we need to transfer to gov first to burn
-	err := suite.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleAccountName, govtypes.ModuleName, amount)
-	suite.Require().NoError(err)
-	err = suite.BankKeeper.BurnCoins(suite.Ctx, govtypes.ModuleName, amount)
+	err := suite.BankKeeper.BurnCoins(suite.Ctx, types.ModuleAccountName, amount)
 	suite.Require().NoError(err)
 }
 
@@ -15,6 +15,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "earn/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "earn/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgRebalanceVault{}, "earn/MsgRebalanceVault", nil)
+	cdc.RegisterConcrete(&MsgTokenizeShares{}, "earn/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokenizedShares{}, "earn/MsgRedeemTokenizedShares", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "earn/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgQueueWithdraw{}, "earn/MsgQueueWithdraw", nil)
+	cdc.RegisterConcrete(&MsgCancelWithdrawal{}, "earn/MsgCancelWithdrawal", nil)
 	cdc.RegisterConcrete(&CommunityPoolDepositProposal{}, "fury/CommunityPoolDepositProposal", nil)
 	cdc.RegisterConcrete(&CommunityPoolWithdrawProposal{}, "fury/CommunityPoolWithdrawProposal", nil)
 }
@@ -25,6 +31,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgRebalanceVault{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokenizedShares{},
+		&MsgSetAutoCompound{},
+		&MsgQueueWithdraw{},
+		&MsgCancelWithdrawal{},
 	)
 	registry.RegisterImplementations((*govv1beta1.Content)(nil),
 		&CommunityPoolDepositProposal{},
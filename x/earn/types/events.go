@@ -2,11 +2,23 @@ package types
 
 // Event types for earn module
 const (
-	AttributeValueCategory = ModuleName
-	EventTypeVaultDeposit  = "vault_deposit"
-	EventTypeVaultWithdraw = "vault_withdraw"
-	AttributeKeyVaultDenom = "vault_denom"
-	AttributeKeyDepositor  = "depositor"
-	AttributeKeyShares     = "shares"
-	AttributeKeyOwner      = "owner"
+	AttributeValueCategory        = ModuleName
+	EventTypeVaultDeposit         = "vault_deposit"
+	EventTypeVaultWithdraw        = "vault_withdraw"
+	EventTypeVaultRebalance       = "vault_rebalance"
+	EventTypeVaultFee             = "vault_fee"
+	EventTypeVaultTokenizeShares  = "vault_tokenize_shares"
+	EventTypeVaultRedeemShares    = "vault_redeem_shares"
+	EventTypeVaultAutoCompound    = "vault_auto_compound"
+	EventTypeVaultQueueWithdraw   = "vault_queue_withdraw"
+	EventTypeVaultCancelWithdraw  = "vault_cancel_withdraw"
+	EventTypeVaultReleaseWithdraw = "vault_release_withdraw"
+	EventTypeVaultExpireWithdraw  = "vault_expire_withdraw"
+	AttributeKeyVaultDenom        = "vault_denom"
+	AttributeKeyDepositor         = "depositor"
+	AttributeKeyShares            = "shares"
+	AttributeKeyOwner             = "owner"
+	AttributeKeyDrift             = "drift"
+	AttributeKeyRewards           = "rewards"
+	AttributeKeyRequestID         = "request_id"
 )
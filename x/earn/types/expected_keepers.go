@@ -1,12 +1,15 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	hardtypes "github.com/mage-coven/fury/x/hard/types"
+	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
 	savingstypes "github.com/mage-coven/fury/x/savings/types"
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
 )
 
 // AccountKeeper defines the expected account keeper
@@ -20,10 +23,14 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // DistributionKeeper defines the expected interface needed for community-pool deposits to earn vaults
@@ -46,6 +53,7 @@ type HardKeeper interface {
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
 	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetMoneyMarket(ctx sdk.Context, denom string) (hardtypes.MoneyMarket, bool)
 }
 
 // SavingsKeeper defines the expected interface needed for the savings strategy.
@@ -53,7 +61,30 @@ type SavingsKeeper interface {
 	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
-	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+}
+
+// SwapKeeper defines the expected interface needed for the swap strategy.
+type SwapKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool)
+	GetSwapFee(ctx sdk.Context) sdk.Dec
+}
+
+// PricefeedKeeper defines the expected interface needed to value swap strategy
+// positions at the oracle price.
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+}
+
+// IncentiveKeeper defines the expected interface needed to claim earn rewards
+// for auto-compounding.
+type IncentiveKeeper interface {
+	ClaimUnlockedEarnRewards(ctx sdk.Context, owner, receiver sdk.AccAddress) (sdk.Coins, error)
 }
 
 // EarnHooks are event hooks called when a user's deposit to a earn vault changes.
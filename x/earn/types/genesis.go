@@ -1,15 +1,32 @@
 package types
 
+import (
+	"fmt"
+	"time"
+)
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	vaultRecords VaultRecords,
 	vaultShareRecords VaultShareRecords,
+	vaultFeeRecords VaultFeeRecords,
+	autoCompoundSettings AutoCompoundSettings,
+	previousAutoCompoundTime time.Time,
+	sharePriceSnapshots VaultSharePriceSnapshots,
+	withdrawalRequests WithdrawalRequests,
+	nextWithdrawalRequestID uint64,
 ) GenesisState {
 	return GenesisState{
-		Params:            params,
-		VaultRecords:      vaultRecords,
-		VaultShareRecords: vaultShareRecords,
+		Params:                   params,
+		VaultRecords:             vaultRecords,
+		VaultShareRecords:        vaultShareRecords,
+		VaultFeeRecords:          vaultFeeRecords,
+		AutoCompoundSettings:     autoCompoundSettings,
+		PreviousAutoCompoundTime: previousAutoCompoundTime,
+		SharePriceSnapshots:      sharePriceSnapshots,
+		WithdrawalRequests:       withdrawalRequests,
+		NextWithdrawalRequestID:  nextWithdrawalRequestID,
 	}
 }
 
@@ -27,6 +44,32 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.VaultFeeRecords.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.AutoCompoundSettings.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SharePriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.WithdrawalRequests.Validate(); err != nil {
+		return err
+	}
+
+	for _, request := range gs.WithdrawalRequests {
+		if request.ID >= gs.NextWithdrawalRequestID {
+			return fmt.Errorf(
+				"withdrawal request id %d must be less than the next withdrawal request id %d",
+				request.ID,
+				gs.NextWithdrawalRequestID,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -36,5 +79,11 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		VaultRecords{},
 		VaultShareRecords{},
+		VaultFeeRecords{},
+		AutoCompoundSettings{},
+		time.Time{},
+		VaultSharePriceSnapshots{},
+		WithdrawalRequests{},
+		DefaultNextWithdrawalRequestID,
 	)
 }
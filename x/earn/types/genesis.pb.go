@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,13 +35,26 @@ type GenesisState struct {
 	VaultRecords VaultRecords `protobuf:"bytes,2,rep,name=vault_records,json=vaultRecords,proto3,castrepeated=VaultRecords" json:"vault_records"`
 	// share_records defines the owned shares of each vault
 	VaultShareRecords VaultShareRecords `protobuf:"bytes,3,rep,name=vault_share_records,json=vaultShareRecords,proto3,castrepeated=VaultShareRecords" json:"vault_share_records"`
+	// vault_fee_records defines the fee accrual state of each vault
+	VaultFeeRecords VaultFeeRecords `protobuf:"bytes,4,rep,name=vault_fee_records,json=vaultFeeRecords,proto3,castrepeated=VaultFeeRecords" json:"vault_fee_records"`
+	// auto_compound_settings defines the depositors with auto-compounding enabled
+	AutoCompoundSettings AutoCompoundSettings `protobuf:"bytes,5,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3,castrepeated=AutoCompoundSettings" json:"auto_compound_settings"`
+	// previous_auto_compound_time is the last time rewards were auto-compounded
+	PreviousAutoCompoundTime time.Time `protobuf:"bytes,6,opt,name=previous_auto_compound_time,json=previousAutoCompoundTime,proto3,stdtime" json:"previous_auto_compound_time"`
+	// share_price_snapshots defines the recorded share price history of each
+	// vault
+	SharePriceSnapshots VaultSharePriceSnapshots `protobuf:"bytes,7,rep,name=share_price_snapshots,json=sharePriceSnapshots,proto3,castrepeated=VaultSharePriceSnapshots" json:"share_price_snapshots"`
+	// withdrawal_requests defines the queued withdraws
+	WithdrawalRequests WithdrawalRequests `protobuf:"bytes,8,rep,name=withdrawal_requests,json=withdrawalRequests,proto3,castrepeated=WithdrawalRequests" json:"withdrawal_requests"`
+	// next_withdrawal_request_id is the id of the next queued withdraw
+	NextWithdrawalRequestID uint64 `protobuf:"varint,9,opt,name=next_withdrawal_request_id,json=nextWithdrawalRequestId,proto3" json:"next_withdrawal_request_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_514fe130cb964f8c, []int{0}
+	return fileDescriptor_89ed6600a93a244a, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -87,33 +104,91 @@ func (m *GenesisState) GetVaultShareRecords() VaultShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetVaultFeeRecords() VaultFeeRecords {
+	if m != nil {
+		return m.VaultFeeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundSettings() AutoCompoundSettings {
+	if m != nil {
+		return m.AutoCompoundSettings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousAutoCompoundTime() time.Time {
+	if m != nil {
+		return m.PreviousAutoCompoundTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetSharePriceSnapshots() VaultSharePriceSnapshots {
+	if m != nil {
+		return m.SharePriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalRequests() WithdrawalRequests {
+	if m != nil {
+		return m.WithdrawalRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalRequestID() uint64 {
+	if m != nil {
+		return m.NextWithdrawalRequestID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.earn.v1beta1.GenesisState")
 }
 
-func init() { proto.RegisterFile("fury/earn/v1beta1/genesis.proto", fileDescriptor_514fe130cb964f8c) }
+func init() { proto.RegisterFile("fury/earn/v1beta1/genesis.proto", fileDescriptor_89ed6600a93a244a) }
 
-var fileDescriptor_514fe130cb964f8c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc5,
-	0x94, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x48, 0x2b, 0x4d, 0x62, 0xe2, 0xe2, 0x71, 0x87, 0xd8,
-	0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0xd1, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa9, 0x87, 0xe1, 0x12, 0xbd, 0x00, 0xb0, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0xca, 0x85, 0x22, 0xb9, 0x78, 0xc1, 0x06, 0xc7, 0x17, 0xa5, 0x26, 0xe7, 0x17,
-	0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0x1f, 0x06, 0x52, 0x17,
-	0x04, 0x56, 0xe6, 0x24, 0x02, 0x32, 0x64, 0xd5, 0x7d, 0x79, 0x1e, 0x24, 0xc1, 0xe2, 0x20, 0x9e,
-	0x32, 0x24, 0x9e, 0x50, 0x1e, 0x97, 0x30, 0xc4, 0xe8, 0xe2, 0x8c, 0xc4, 0xa2, 0x54, 0xb8, 0x05,
-	0xcc, 0x60, 0x0b, 0x94, 0x71, 0x59, 0x10, 0x0c, 0x52, 0x0c, 0xb5, 0x45, 0x12, 0x6a, 0x8b, 0x20,
-	0xba, 0x4c, 0x71, 0x90, 0x60, 0x19, 0xba, 0x90, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x83, 0xac, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x20, 0x81, 0x5c, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x54,
-	0x3b, 0x56, 0xe8, 0x01, 0x00, 0x00,
+var fileDescriptor_89ed6600a93a244a = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0x1a, 0xd2, 0xe2, 0x06, 0x55, 0x99, 0x04, 0xe2, 0xba, 0x60, 0x87, 0x8b, 0x20,
+	0x42, 0xc2, 0x56, 0xcb, 0x82, 0x75, 0x0d, 0x02, 0xb1, 0x41, 0x95, 0x83, 0xb8, 0x6d, 0xac, 0x89,
+	0x33, 0x71, 0x2c, 0xc5, 0x1e, 0x77, 0x66, 0xec, 0xa4, 0xac, 0x78, 0x84, 0x3e, 0x07, 0xbc, 0x48,
+	0x97, 0x5d, 0xb2, 0x6a, 0x51, 0xf2, 0x22, 0xc8, 0x33, 0x93, 0x92, 0xd4, 0x4e, 0x77, 0xf6, 0x7f,
+	0xbe, 0x39, 0xdf, 0x58, 0xe7, 0x58, 0x35, 0x87, 0x29, 0x39, 0xb1, 0x11, 0x24, 0xb1, 0x9d, 0xed,
+	0xf7, 0x11, 0x83, 0xfb, 0x76, 0x80, 0x62, 0x44, 0x43, 0x6a, 0x25, 0x04, 0x33, 0x0c, 0x1a, 0x39,
+	0x60, 0xe5, 0x80, 0x25, 0x01, 0xbd, 0x15, 0xe0, 0x00, 0xf3, 0xaa, 0x9d, 0x3f, 0x09, 0x50, 0x37,
+	0x03, 0x8c, 0x83, 0x31, 0xb2, 0xf9, 0x5b, 0x3f, 0x1d, 0xda, 0x2c, 0x8c, 0x10, 0x65, 0x30, 0x4a,
+	0x24, 0x60, 0x14, 0x55, 0x09, 0x24, 0x30, 0x92, 0x26, 0xfd, 0x61, 0xb1, 0x9e, 0xc1, 0x74, 0xcc,
+	0x44, 0xf9, 0xf1, 0xef, 0x4d, 0xb5, 0xfe, 0x5e, 0x5c, 0xad, 0xc7, 0x20, 0x43, 0xe0, 0xb5, 0x5a,
+	0x13, 0xe7, 0x35, 0xa5, 0xa3, 0x74, 0xb7, 0x0f, 0x76, 0xad, 0xc2, 0x55, 0xad, 0x23, 0x0e, 0x38,
+	0xd5, 0xb3, 0x0b, 0xb3, 0xe2, 0x4a, 0x1c, 0x7c, 0x53, 0xef, 0xf2, 0xc6, 0x1e, 0x41, 0x3e, 0x26,
+	0x03, 0xaa, 0xdd, 0xea, 0x6c, 0x74, 0xb7, 0x0f, 0x8c, 0x92, 0xf3, 0x9f, 0x73, 0xce, 0xe5, 0x98,
+	0xd3, 0xca, 0x9b, 0xfc, 0xba, 0x34, 0xeb, 0x4b, 0x21, 0x75, 0xeb, 0xd9, 0xd2, 0x1b, 0x88, 0xd5,
+	0xa6, 0x68, 0x4d, 0x47, 0x90, 0xa0, 0x2b, 0xc1, 0x06, 0x17, 0x3c, 0x59, 0x27, 0xe8, 0xe5, 0xb0,
+	0xb4, 0xec, 0x4a, 0x4b, 0xe3, 0x7a, 0x85, 0xba, 0x8d, 0xec, 0x7a, 0x04, 0x86, 0xaa, 0x08, 0xbd,
+	0x21, 0xfa, 0x6f, 0xab, 0x72, 0xdb, 0xa3, 0x75, 0xb6, 0x77, 0x68, 0xe1, 0x6a, 0x4b, 0xd7, 0xce,
+	0x6a, 0x4e, 0xdd, 0x9d, 0x6c, 0x35, 0x00, 0x3f, 0xd4, 0xfb, 0x30, 0x65, 0xd8, 0xf3, 0x71, 0x94,
+	0xe0, 0x34, 0x1e, 0x78, 0x14, 0x31, 0x16, 0xc6, 0x01, 0xd5, 0x6e, 0x73, 0xd9, 0xb3, 0x12, 0xd9,
+	0x61, 0xca, 0xf0, 0x1b, 0xc9, 0xf7, 0x04, 0xee, 0x3c, 0x90, 0xc6, 0x56, 0x49, 0x91, 0xba, 0x2d,
+	0x58, 0x92, 0x02, 0x5f, 0xdd, 0x4b, 0x08, 0xca, 0x42, 0x9c, 0x52, 0x6f, 0xf5, 0x12, 0xf9, 0x86,
+	0x69, 0x35, 0x3e, 0x7c, 0xdd, 0x12, 0xeb, 0x67, 0x2d, 0xd6, 0xcf, 0xfa, 0xb4, 0x58, 0x3f, 0x67,
+	0x2b, 0x97, 0x9e, 0x5e, 0x9a, 0x8a, 0xab, 0x2d, 0x1a, 0x2d, 0xeb, 0x73, 0x10, 0xfc, 0x54, 0xd4,
+	0x7b, 0x62, 0x66, 0x09, 0x09, 0x7d, 0xe4, 0xd1, 0x18, 0x26, 0x74, 0x84, 0x19, 0xd5, 0x36, 0xf9,
+	0x07, 0xbe, 0xb8, 0x71, 0x76, 0x47, 0xf9, 0x99, 0x9e, 0x3c, 0xe2, 0x74, 0xe4, 0x47, 0x6a, 0x6b,
+	0x00, 0xea, 0x36, 0x69, 0x31, 0x04, 0xc7, 0x6a, 0x73, 0x12, 0xb2, 0xd1, 0x80, 0xc0, 0x09, 0x1c,
+	0x7b, 0x04, 0x1d, 0xa7, 0x88, 0x32, 0xaa, 0x6d, 0x71, 0xff, 0xd3, 0x12, 0xff, 0x97, 0x2b, 0xda,
+	0x15, 0xb0, 0xa3, 0x4b, 0x33, 0x28, 0x94, 0xa8, 0x0b, 0x26, 0x85, 0x0c, 0x7c, 0x55, 0xf5, 0x18,
+	0x4d, 0x99, 0x57, 0xf4, 0x7a, 0xe1, 0x40, 0xbb, 0xd3, 0x51, 0xba, 0x55, 0x67, 0x6f, 0x76, 0x61,
+	0xb6, 0x3f, 0xa2, 0x29, 0x2b, 0xf4, 0xfc, 0xf0, 0xd6, 0x6d, 0xc7, 0xa5, 0x85, 0x81, 0x73, 0x78,
+	0x36, 0x33, 0x94, 0xf3, 0x99, 0xa1, 0xfc, 0x9d, 0x19, 0xca, 0xe9, 0xdc, 0xa8, 0x9c, 0xcf, 0x8d,
+	0xca, 0x9f, 0xb9, 0x51, 0xf9, 0xfe, 0x3c, 0x08, 0xd9, 0x28, 0xed, 0x5b, 0x3e, 0x8e, 0xec, 0x08,
+	0x06, 0xe8, 0xa5, 0x8f, 0x33, 0x14, 0xdb, 0xfc, 0xe7, 0x9f, 0x8a, 0xdf, 0x9f, 0x9d, 0x24, 0x88,
+	0xf6, 0x6b, 0x7c, 0x94, 0xaf, 0xfe, 0x0d, 0x00, 0x91, 0x2d, 0xa1, 0xd1, 0xa3, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +211,75 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextWithdrawalRequestID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalRequestID))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.WithdrawalRequests) > 0 {
+		for iNdEx := len(m.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SharePriceSnapshots) > 0 {
+		for iNdEx := len(m.SharePriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharePriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAutoCompoundTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAutoCompoundTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VaultFeeRecords) > 0 {
+		for iNdEx := len(m.VaultFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VaultShareRecords) > 0 {
 		for iNdEx := len(m.VaultShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +352,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VaultFeeRecords) > 0 {
+		for _, e := range m.VaultFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAutoCompoundTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SharePriceSnapshots) > 0 {
+		for _, e := range m.SharePriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalRequests) > 0 {
+		for _, e := range m.WithdrawalRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalRequestID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalRequestID))
+	}
 	return n
 }
 
@@ -347,6 +520,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultFeeRecords = append(m.VaultFeeRecords, VaultFeeRecord{})
+			if err := m.VaultFeeRecords[len(m.VaultFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAutoCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAutoCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharePriceSnapshots = append(m.SharePriceSnapshots, VaultSharePriceSnapshot{})
+			if err := m.SharePriceSnapshots[len(m.SharePriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRequests = append(m.WithdrawalRequests, WithdrawalRequest{})
+			if err := m.WithdrawalRequests[len(m.WithdrawalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalRequestID", wireType)
+			}
+			m.NextWithdrawalRequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalRequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,13 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -20,14 +27,58 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// TokenizedSharesDenomPrefix prefix of the denom of tokenized vault shares
+	TokenizedSharesDenomPrefix = ModuleName + "/"
 )
 
 // key prefixes for store
 var (
-	VaultRecordKeyPrefix      = []byte{0x01} // denom -> vault
-	VaultShareRecordKeyPrefix = []byte{0x02} // depositor address -> vault shares
+	VaultRecordKeyPrefix              = []byte{0x01} // denom -> vault
+	VaultShareRecordKeyPrefix         = []byte{0x02} // depositor address -> vault shares
+	VaultFeeRecordKeyPrefix           = []byte{0x03} // denom -> vault fee accrual state
+	AutoCompoundSettingKeyPrefix      = []byte{0x04} // depositor address -> auto-compound setting
+	PreviousAutoCompoundTimeKey       = []byte{0x05} // previous auto-compound time
+	SharePriceSnapshotKeyPrefix       = []byte{0x06} // denom + time -> share price snapshot
+	WithdrawalRequestKeyPrefix        = []byte{0x07} // id -> withdrawal request
+	NextWithdrawalRequestIDKey        = []byte{0x08} // next withdrawal request id
+	AutoCompoundCursorKey             = []byte{0x09} // depositor address of the next auto-compound
+	WithdrawalRequestByDenomKeyPrefix = []byte{0x0A} // denom + id -> nil
+	LockedSharesKeyPrefix             = []byte{0x0B} // owner address + denom -> locked vault shares
 )
 
+const (
+	// AutoCompoundPeriod is the minimum time between auto-compounds of rewards
+	AutoCompoundPeriod = 24 * time.Hour
+
+	// SharePriceSnapshotPeriod is the minimum time between share price
+	// snapshots of a vault
+	SharePriceSnapshotPeriod = 24 * time.Hour
+
+	// SharePriceHistoryRetention is how long share price snapshots are kept.
+	// It covers the longest APY window plus one snapshot period.
+	SharePriceHistoryRetention = 91 * 24 * time.Hour
+
+	// MaxWithdrawalReleasesPerBlock is the maximum number of queued withdraws
+	// attempted each block
+	MaxWithdrawalReleasesPerBlock = 20
+
+	// WithdrawalRequestExpiry is how long a queued withdraw can fail to be
+	// released before it is removed and its shares are unlocked
+	WithdrawalRequestExpiry = 30 * 24 * time.Hour
+
+	// MaxAutoCompoundsPerBlock is the maximum number of auto-compound
+	// settings processed each block
+	MaxAutoCompoundsPerBlock = 20
+)
+
+// DefaultNextWithdrawalRequestID is the id of the first queued withdraw
+const DefaultNextWithdrawalRequestID uint64 = 1
+
+// ApyWindowDays are the windows in days the realized APY of vaults is
+// calculated over
+var ApyWindowDays = []uint32{7, 30, 90}
+
 // VaultKey returns a key generated from a vault denom
 func VaultKey(denom string) []byte {
 	return []byte(denom)
@@ -37,3 +88,57 @@ func VaultKey(denom string) []byte {
 func DepositorVaultSharesKey(depositor sdk.AccAddress) []byte {
 	return depositor.Bytes()
 }
+
+// TokenizedSharesDenom returns the denom of the tokenized shares of a vault
+func TokenizedSharesDenom(vaultDenom string) string {
+	return TokenizedSharesDenomPrefix + vaultDenom
+}
+
+// ParseTokenizedSharesDenom returns the vault denom of a tokenized shares denom
+func ParseTokenizedSharesDenom(denom string) (string, bool) {
+	if !strings.HasPrefix(denom, TokenizedSharesDenomPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(denom, TokenizedSharesDenomPrefix), true
+}
+
+// AutoCompoundSettingKey returns a key from a depositor address
+func AutoCompoundSettingKey(depositor sdk.AccAddress) []byte {
+	return depositor.Bytes()
+}
+
+// VaultSharePriceSnapshotsKey returns the key prefix of the share price
+// snapshots of a vault
+func VaultSharePriceSnapshotsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// VaultSharePriceSnapshotKey returns a key from a vault denom and snapshot time
+func VaultSharePriceSnapshotKey(denom string, blockTime time.Time) []byte {
+	return append(VaultSharePriceSnapshotsKey(denom), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// WithdrawalRequestKey returns a key from a withdrawal request id
+func WithdrawalRequestKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// WithdrawalRequestsByDenomKey returns the key prefix of the queued withdraws
+// from a vault
+func WithdrawalRequestsByDenomKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// WithdrawalRequestByDenomKey returns a key from a vault denom and withdrawal
+// request id
+func WithdrawalRequestByDenomKey(denom string, id uint64) []byte {
+	return append(WithdrawalRequestsByDenomKey(denom), WithdrawalRequestKey(id)...)
+}
+
+// LockedSharesKey returns a key from an owner address and vault denom
+func LockedSharesKey(owner sdk.AccAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(owner), []byte(denom)...)
+}
@@ -10,14 +10,32 @@ import (
 var (
 	_ sdk.Msg            = &MsgDeposit{}
 	_ sdk.Msg            = &MsgWithdraw{}
+	_ sdk.Msg            = &MsgRebalanceVault{}
+	_ sdk.Msg            = &MsgTokenizeShares{}
+	_ sdk.Msg            = &MsgRedeemTokenizedShares{}
+	_ sdk.Msg            = &MsgSetAutoCompound{}
+	_ sdk.Msg            = &MsgQueueWithdraw{}
+	_ sdk.Msg            = &MsgCancelWithdrawal{}
 	_ legacytx.LegacyMsg = &MsgDeposit{}
 	_ legacytx.LegacyMsg = &MsgWithdraw{}
+	_ legacytx.LegacyMsg = &MsgRebalanceVault{}
+	_ legacytx.LegacyMsg = &MsgTokenizeShares{}
+	_ legacytx.LegacyMsg = &MsgRedeemTokenizedShares{}
+	_ legacytx.LegacyMsg = &MsgSetAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgQueueWithdraw{}
+	_ legacytx.LegacyMsg = &MsgCancelWithdrawal{}
 )
 
 // legacy message types
 const (
-	TypeMsgDeposit  = "earn_msg_deposit"
-	TypeMsgWithdraw = "earn_msg_withdraw"
+	TypeMsgDeposit               = "earn_msg_deposit"
+	TypeMsgWithdraw              = "earn_msg_withdraw"
+	TypeMsgRebalanceVault        = "earn_msg_rebalance_vault"
+	TypeMsgTokenizeShares        = "earn_msg_tokenize_shares"
+	TypeMsgRedeemTokenizedShares = "earn_msg_redeem_tokenized_shares"
+	TypeMsgSetAutoCompound       = "earn_msg_set_auto_compound"
+	TypeMsgQueueWithdraw         = "earn_msg_queue_withdraw"
+	TypeMsgCancelWithdrawal      = "earn_msg_cancel_withdrawal"
 )
 
 // NewMsgDeposit returns a new MsgDeposit.
@@ -123,3 +141,313 @@ func (msg MsgWithdraw) Route() string {
 func (msg MsgWithdraw) Type() string {
 	return TypeMsgWithdraw
 }
+
+// NewMsgRebalanceVault returns a new MsgRebalanceVault.
+func NewMsgRebalanceVault(sender string, denom string) *MsgRebalanceVault {
+	return &MsgRebalanceVault{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRebalanceVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRebalanceVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRebalanceVault) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRebalanceVault) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRebalanceVault) Type() string {
+	return TypeMsgRebalanceVault
+}
+
+// NewMsgTokenizeShares returns a new MsgTokenizeShares.
+func NewMsgTokenizeShares(depositor string, amount sdk.Coin) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, isTokenizedShares := ParseTokenizedSharesDenom(msg.Amount.Denom); isTokenizedShares {
+		return errorsmod.Wrapf(ErrInvalidVaultDenom, "%s is a tokenized shares denom", msg.Amount.Denom)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgTokenizeShares) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgTokenizeShares) Type() string {
+	return TypeMsgTokenizeShares
+}
+
+// NewMsgRedeemTokenizedShares returns a new MsgRedeemTokenizedShares.
+func NewMsgRedeemTokenizedShares(owner string, amount sdk.Coin) *MsgRedeemTokenizedShares {
+	return &MsgRedeemTokenizedShares{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemTokenizedShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, isTokenizedShares := ParseTokenizedSharesDenom(msg.Amount.Denom); !isTokenizedShares {
+		return errorsmod.Wrapf(ErrInvalidTokenizedShares, "%s is not a tokenized shares denom", msg.Amount.Denom)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemTokenizedShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemTokenizedShares) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRedeemTokenizedShares) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRedeemTokenizedShares) Type() string {
+	return TypeMsgRedeemTokenizedShares
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(
+	depositor string,
+	enabled bool,
+	vaultDenom string,
+	slippageLimit sdk.Dec,
+) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Depositor:     depositor,
+		Enabled:       enabled,
+		VaultDenom:    vaultDenom,
+		SlippageLimit: slippageLimit,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Enabled {
+		return nil
+	}
+
+	if err := NewAutoCompoundSetting(depositor, msg.VaultDenom, msg.SlippageLimit).Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetAutoCompound) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+// NewMsgQueueWithdraw returns a new MsgQueueWithdraw.
+func NewMsgQueueWithdraw(from string, amount sdk.Coin) *MsgQueueWithdraw {
+	return &MsgQueueWithdraw{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgQueueWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgQueueWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgQueueWithdraw) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgQueueWithdraw) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgQueueWithdraw) Type() string {
+	return TypeMsgQueueWithdraw
+}
+
+// NewMsgCancelWithdrawal returns a new MsgCancelWithdrawal.
+func NewMsgCancelWithdrawal(owner string, requestID uint64) *MsgCancelWithdrawal {
+	return &MsgCancelWithdrawal{
+		Owner:     owner,
+		RequestId: requestID,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelWithdrawal) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelWithdrawal) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelWithdrawal) Type() string {
+	return TypeMsgCancelWithdrawal
+}
@@ -19,6 +19,58 @@ func NewQueryVaultRequest(denom string) *QueryVaultRequest {
 	}
 }
 
+// NewQueryVaultAllocationRequest returns a new QueryVaultAllocationRequest
+func NewQueryVaultAllocationRequest(denom string) *QueryVaultAllocationRequest {
+	return &QueryVaultAllocationRequest{
+		Denom: denom,
+	}
+}
+
+// NewQueryVaultFeesRequest returns a new QueryVaultFeesRequest
+func NewQueryVaultFeesRequest(denom string) *QueryVaultFeesRequest {
+	return &QueryVaultFeesRequest{
+		Denom: denom,
+	}
+}
+
+// NewQueryAutoCompoundSettingRequest returns a new QueryAutoCompoundSettingRequest
+func NewQueryAutoCompoundSettingRequest(depositor string) *QueryAutoCompoundSettingRequest {
+	return &QueryAutoCompoundSettingRequest{
+		Depositor: depositor,
+	}
+}
+
+// NewQueryVaultSharePriceHistoryRequest returns a new QueryVaultSharePriceHistoryRequest
+func NewQueryVaultSharePriceHistoryRequest(
+	denom string,
+	pagination *query.PageRequest,
+) *QueryVaultSharePriceHistoryRequest {
+	return &QueryVaultSharePriceHistoryRequest{
+		Denom:      denom,
+		Pagination: pagination,
+	}
+}
+
+// NewQueryVaultApyRequest returns a new QueryVaultApyRequest
+func NewQueryVaultApyRequest(denom string) *QueryVaultApyRequest {
+	return &QueryVaultApyRequest{
+		Denom: denom,
+	}
+}
+
+// NewQueryWithdrawalRequestsRequest returns a new QueryWithdrawalRequestsRequest
+func NewQueryWithdrawalRequestsRequest(
+	owner string,
+	denom string,
+	pagination *query.PageRequest,
+) *QueryWithdrawalRequestsRequest {
+	return &QueryWithdrawalRequestsRequest{
+		Owner:      owner,
+		Denom:      denom,
+		Pagination: pagination,
+	}
+}
+
 // NewQueryDepositsRequest returns a new QueryDepositsRequest
 func NewQueryDepositsRequest(
 	depositor string,
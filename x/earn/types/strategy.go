@@ -3,11 +3,15 @@ package types
 import (
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/mage-coven/fury/x/swap/types"
 )
 
 // IsValid returns true if the StrategyType status is valid and false otherwise.
 func (s StrategyType) IsValid() bool {
-	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS
+	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS || s == STRATEGY_TYPE_SWAP
 }
 
 // Validate returns an error if the StrategyType is invalid.
@@ -26,6 +30,8 @@ func NewStrategyTypeFromString(str string) StrategyType {
 		return STRATEGY_TYPE_HARD
 	case "savings":
 		return STRATEGY_TYPE_SAVINGS
+	case "swap":
+		return STRATEGY_TYPE_SWAP
 	default:
 		return STRATEGY_TYPE_UNSPECIFIED
 	}
@@ -40,10 +46,6 @@ func (strategies StrategyTypes) Validate() error {
 		return fmt.Errorf("empty StrategyTypes")
 	}
 
-	if len(strategies) != 1 {
-		return fmt.Errorf("must have exactly one strategy type, multiple strategies are not supported")
-	}
-
 	uniqueStrategies := make(map[StrategyType]bool)
 
 	for _, strategy := range strategies {
@@ -60,3 +62,131 @@ func (strategies StrategyTypes) Validate() error {
 
 	return nil
 }
+
+// NewStrategyAllocation returns a new StrategyAllocation with the given values.
+func NewStrategyAllocation(weights StrategyWeights, rebalanceThreshold sdk.Dec, autoRebalance bool) *StrategyAllocation {
+	return &StrategyAllocation{
+		Weights:            weights,
+		RebalanceThreshold: rebalanceThreshold,
+		AutoRebalance:      autoRebalance,
+	}
+}
+
+// Validate returns an error if the StrategyAllocation is invalid for a vault
+// with the given strategies.
+func (a StrategyAllocation) Validate(strategies StrategyTypes) error {
+	if err := a.Weights.Validate(); err != nil {
+		return err
+	}
+
+	if len(a.Weights) != len(strategies) {
+		return fmt.Errorf("strategy weights must match the vault strategies, got %d weights for %d strategies", len(a.Weights), len(strategies))
+	}
+
+	for _, strategy := range strategies {
+		if _, found := a.Weights.Get(strategy); !found {
+			return fmt.Errorf("missing weight for strategy %s", strategy)
+		}
+	}
+
+	if a.RebalanceThreshold.IsNil() || !a.RebalanceThreshold.IsPositive() || a.RebalanceThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalance threshold must be > 0 and <= 1, got %s", a.RebalanceThreshold)
+	}
+
+	return nil
+}
+
+// NewStrategyWeight returns a new StrategyWeight with the given values.
+func NewStrategyWeight(strategy StrategyType, weight sdk.Dec) StrategyWeight {
+	return StrategyWeight{
+		Strategy: strategy,
+		Weight:   weight,
+	}
+}
+
+// StrategyWeights defines a slice of StrategyWeight
+type StrategyWeights []StrategyWeight
+
+// Validate returns an error if the StrategyWeights are invalid.
+func (weights StrategyWeights) Validate() error {
+	uniqueStrategies := make(map[StrategyType]bool)
+	total := sdk.ZeroDec()
+
+	for _, weight := range weights {
+		if err := weight.Strategy.Validate(); err != nil {
+			return err
+		}
+
+		if uniqueStrategies[weight.Strategy] {
+			return fmt.Errorf("duplicate strategy weight %s", weight.Strategy)
+		}
+
+		uniqueStrategies[weight.Strategy] = true
+
+		if weight.Weight.IsNil() || !weight.Weight.IsPositive() {
+			return fmt.Errorf("strategy weight must be positive, got %s for %s", weight.Weight, weight.Strategy)
+		}
+
+		total = total.Add(weight.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("strategy weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// Get returns the weight of the given strategy.
+func (weights StrategyWeights) Get(strategy StrategyType) (sdk.Dec, bool) {
+	for _, weight := range weights {
+		if weight.Strategy == strategy {
+			return weight.Weight, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// NewSwapStrategyParams returns a new SwapStrategyParams with the given values.
+func NewSwapStrategyParams(poolID string, slippageLimit sdk.Dec) *SwapStrategyParams {
+	return &SwapStrategyParams{
+		PoolID:        poolID,
+		SlippageLimit: slippageLimit,
+	}
+}
+
+// Validate returns an error if the SwapStrategyParams are invalid for a vault
+// with the given denom.
+func (p SwapStrategyParams) Validate(vaultDenom string) error {
+	denoms := strings.Split(p.PoolID, ":")
+	if len(denoms) != 2 || denoms[0] == denoms[1] || swaptypes.PoolID(denoms[0], denoms[1]) != p.PoolID {
+		return fmt.Errorf("invalid swap pool id %s", p.PoolID)
+	}
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid swap pool id %s: %w", p.PoolID, err)
+		}
+	}
+
+	if denoms[0] != vaultDenom && denoms[1] != vaultDenom {
+		return fmt.Errorf("swap pool %s does not contain vault denom %s", p.PoolID, vaultDenom)
+	}
+
+	if p.SlippageLimit.IsNil() || !p.SlippageLimit.IsPositive() || p.SlippageLimit.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap slippage limit must be > 0 and <= 1, got %s", p.SlippageLimit)
+	}
+
+	return nil
+}
+
+// PairedDenom returns the denom of the pool asset that is not the vault denom.
+func (p SwapStrategyParams) PairedDenom(vaultDenom string) string {
+	denoms := strings.Split(p.PoolID, ":")
+	if denoms[0] == vaultDenom {
+		return denoms[1]
+	}
+
+	return denoms[0]
+}
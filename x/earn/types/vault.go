@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +42,247 @@ func (vrs VaultRecords) Validate() error {
 	return nil
 }
 
+// NewVaultFees returns a new VaultFees with the given values.
+func NewVaultFees(managementFee, performanceFee sdk.Dec, recipient sdk.AccAddress) *VaultFees {
+	return &VaultFees{
+		ManagementFee:  managementFee,
+		PerformanceFee: performanceFee,
+		Recipient:      recipient,
+	}
+}
+
+// Validate returns an error if the VaultFees are invalid.
+func (f VaultFees) Validate() error {
+	if f.ManagementFee.IsNil() || f.ManagementFee.IsNegative() || f.ManagementFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("management fee must be >= 0 and < 1, got %s", f.ManagementFee)
+	}
+
+	if f.PerformanceFee.IsNil() || f.PerformanceFee.IsNegative() || f.PerformanceFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("performance fee must be >= 0 and < 1, got %s", f.PerformanceFee)
+	}
+
+	if f.Recipient.Empty() && (f.ManagementFee.IsPositive() || f.PerformanceFee.IsPositive()) {
+		return fmt.Errorf("vault fees require a recipient")
+	}
+
+	return nil
+}
+
+// NewVaultFeeRecord returns a new VaultFeeRecord with the given values.
+func NewVaultFeeRecord(
+	vaultDenom string,
+	lastAccrualTime time.Time,
+	highWaterMark sdk.Dec,
+	accruedShares sdk.Dec,
+) VaultFeeRecord {
+	return VaultFeeRecord{
+		Denom:           vaultDenom,
+		LastAccrualTime: lastAccrualTime,
+		HighWaterMark:   highWaterMark,
+		AccruedShares:   accruedShares,
+	}
+}
+
+// Validate returns an error if a VaultFeeRecord is invalid.
+func (r VaultFeeRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if r.HighWaterMark.IsNil() || r.HighWaterMark.IsNegative() {
+		return fmt.Errorf("high-water mark must be >= 0, got %s", r.HighWaterMark)
+	}
+
+	if r.AccruedShares.IsNil() || r.AccruedShares.IsNegative() {
+		return fmt.Errorf("accrued shares must be >= 0, got %s", r.AccruedShares)
+	}
+
+	return nil
+}
+
+// VaultFeeRecords is a slice of VaultFeeRecord.
+type VaultFeeRecords []VaultFeeRecord
+
+// Validate returns an error if a slice of VaultFeeRecords is invalid.
+func (rs VaultFeeRecords) Validate() error {
+	denoms := make(map[string]bool)
+
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[r.Denom] {
+			return fmt.Errorf("duplicate vault fee record denom %s", r.Denom)
+		}
+
+		denoms[r.Denom] = true
+	}
+
+	return nil
+}
+
+// NewAutoCompoundSetting returns a new AutoCompoundSetting with the given
+// values.
+func NewAutoCompoundSetting(
+	depositor sdk.AccAddress,
+	vaultDenom string,
+	slippageLimit sdk.Dec,
+) AutoCompoundSetting {
+	return AutoCompoundSetting{
+		Depositor:     depositor,
+		VaultDenom:    vaultDenom,
+		SlippageLimit: slippageLimit,
+	}
+}
+
+// Validate returns an error if an AutoCompoundSetting is invalid.
+func (s AutoCompoundSetting) Validate() error {
+	if s.Depositor.Empty() {
+		return fmt.Errorf("depositor is empty")
+	}
+
+	if err := sdk.ValidateDenom(s.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if s.SlippageLimit.IsNil() || !s.SlippageLimit.IsPositive() || s.SlippageLimit.GTE(sdk.OneDec()) {
+		return fmt.Errorf("slippage limit must be > 0 and < 1, got %s", s.SlippageLimit)
+	}
+
+	return nil
+}
+
+// AutoCompoundSettings is a slice of AutoCompoundSetting.
+type AutoCompoundSettings []AutoCompoundSetting
+
+// Validate returns an error if a slice of AutoCompoundSettings is invalid.
+func (ss AutoCompoundSettings) Validate() error {
+	addrs := make(map[string]bool)
+
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		if addrs[s.Depositor.String()] {
+			return fmt.Errorf("duplicate auto-compound setting for %s", s.Depositor)
+		}
+
+		addrs[s.Depositor.String()] = true
+	}
+
+	return nil
+}
+
+// NewVaultSharePriceSnapshot returns a new VaultSharePriceSnapshot with the
+// given values.
+func NewVaultSharePriceSnapshot(
+	vaultDenom string,
+	blockTime time.Time,
+	sharePrice sdk.Dec,
+) VaultSharePriceSnapshot {
+	return VaultSharePriceSnapshot{
+		VaultDenom: vaultDenom,
+		Time:       blockTime,
+		SharePrice: sharePrice,
+	}
+}
+
+// Validate returns an error if a VaultSharePriceSnapshot is invalid.
+func (s VaultSharePriceSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if s.Time.IsZero() {
+		return fmt.Errorf("share price snapshot time is zero")
+	}
+
+	if s.SharePrice.IsNil() || s.SharePrice.IsNegative() {
+		return fmt.Errorf("share price must be non-negative, got %s", s.SharePrice)
+	}
+
+	return nil
+}
+
+// VaultSharePriceSnapshots is a slice of VaultSharePriceSnapshot.
+type VaultSharePriceSnapshots []VaultSharePriceSnapshot
+
+// Validate returns an error if a slice of VaultSharePriceSnapshots is invalid.
+func (ss VaultSharePriceSnapshots) Validate() error {
+	keys := make(map[string]bool)
+
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := string(VaultSharePriceSnapshotKey(s.VaultDenom, s.Time))
+		if keys[key] {
+			return fmt.Errorf("duplicate share price snapshot for %s at %s", s.VaultDenom, s.Time)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// NewWithdrawalRequest returns a new WithdrawalRequest with the given values.
+func NewWithdrawalRequest(
+	id uint64,
+	owner sdk.AccAddress,
+	shares VaultShare,
+	blockTime time.Time,
+) WithdrawalRequest {
+	return WithdrawalRequest{
+		ID:     id,
+		Owner:  owner,
+		Shares: shares,
+		Time:   blockTime,
+	}
+}
+
+// Validate returns an error if a WithdrawalRequest is invalid.
+func (r WithdrawalRequest) Validate() error {
+	if r.Owner.Empty() {
+		return fmt.Errorf("withdrawal request %d owner is empty", r.ID)
+	}
+
+	if err := r.Shares.Validate(); err != nil {
+		return fmt.Errorf("invalid withdrawal request %d shares: %w", r.ID, err)
+	}
+
+	if !r.Shares.Amount.IsPositive() {
+		return fmt.Errorf("withdrawal request %d shares must be positive, got %s", r.ID, r.Shares.Amount)
+	}
+
+	return nil
+}
+
+// WithdrawalRequests is a slice of WithdrawalRequest.
+type WithdrawalRequests []WithdrawalRequest
+
+// Validate returns an error if a slice of WithdrawalRequests is invalid.
+func (rs WithdrawalRequests) Validate() error {
+	ids := make(map[uint64]bool)
+
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if ids[r.ID] {
+			return fmt.Errorf("duplicate withdrawal request id %d", r.ID)
+		}
+
+		ids[r.ID] = true
+	}
+
+	return nil
+}
+
 // NewVaultShareRecord returns a new VaultShareRecord with the provided supplied
 // coins.
 func NewVaultShareRecord(depositor sdk.AccAddress, shares VaultShares) VaultShareRecord {
@@ -85,19 +327,49 @@ func (vsrs VaultShareRecords) Validate() error {
 	return nil
 }
 
+// AllowedVaultOption sets an optional field of an AllowedVault.
+type AllowedVaultOption func(*AllowedVault)
+
+// WithSwapStrategyParams sets the params of the swap strategy of a vault.
+func WithSwapStrategyParams(swapStrategyParams *SwapStrategyParams) AllowedVaultOption {
+	return func(a *AllowedVault) {
+		a.SwapStrategyParams = swapStrategyParams
+	}
+}
+
+// WithStrategyAllocation sets the weights of the strategies of a vault.
+func WithStrategyAllocation(strategyAllocation *StrategyAllocation) AllowedVaultOption {
+	return func(a *AllowedVault) {
+		a.StrategyAllocation = strategyAllocation
+	}
+}
+
+// WithVaultFees sets the fees charged by a vault.
+func WithVaultFees(fees *VaultFees) AllowedVaultOption {
+	return func(a *AllowedVault) {
+		a.Fees = fees
+	}
+}
+
 // NewAllowedVault returns a new AllowedVault with the given values.
 func NewAllowedVault(
 	denom string,
 	strategyTypes StrategyTypes,
 	isPrivateVault bool,
 	allowedDepositors []sdk.AccAddress,
+	opts ...AllowedVaultOption,
 ) AllowedVault {
-	return AllowedVault{
+	allowedVault := AllowedVault{
 		Denom:             denom,
 		Strategies:        strategyTypes,
 		IsPrivateVault:    isPrivateVault,
 		AllowedDepositors: allowedDepositors,
 	}
+	for _, opt := range opts {
+		opt(&allowedVault)
+	}
+
+	return allowedVault
 }
 
 // Validate returns an error if the AllowedVault is invalid
@@ -116,7 +388,53 @@ func (a *AllowedVault) Validate() error {
 		return fmt.Errorf("non-private vaults cannot have any AllowedDepositors")
 	}
 
-	return a.Strategies.Validate()
+	if err := a.Strategies.Validate(); err != nil {
+		return err
+	}
+
+	// Multiple strategies -> strategy allocation
+	if len(a.Strategies) > 1 {
+		if a.StrategyAllocation == nil {
+			return fmt.Errorf("vaults with multiple strategies require a StrategyAllocation")
+		}
+
+		if err := a.StrategyAllocation.Validate(a.Strategies); err != nil {
+			return err
+		}
+	} else if a.StrategyAllocation != nil {
+		return fmt.Errorf("only vaults with multiple strategies can have a StrategyAllocation")
+	}
+
+	if a.Fees != nil {
+		if err := a.Fees.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Swap strategy -> swap strategy params
+	if a.IsStrategyAllowed(STRATEGY_TYPE_SWAP) {
+		if a.SwapStrategyParams == nil {
+			return fmt.Errorf("vaults with the swap strategy require SwapStrategyParams")
+		}
+
+		return a.SwapStrategyParams.Validate(a.Denom)
+	}
+
+	if a.SwapStrategyParams != nil {
+		return fmt.Errorf("only vaults with the swap strategy can have SwapStrategyParams")
+	}
+
+	return nil
+}
+
+// GetStrategyWeights returns the target weight of each strategy of the vault.
+// A vault with a single strategy allocates all of its value to it.
+func (a *AllowedVault) GetStrategyWeights() StrategyWeights {
+	if a.StrategyAllocation == nil {
+		return StrategyWeights{NewStrategyWeight(a.Strategies[0], sdk.OneDec())}
+	}
+
+	return a.StrategyAllocation.Weights
 }
 
 // IsStrategyAllowed returns true if the given strategy type is allowed for the
@@ -154,6 +472,7 @@ type AllowedVaults []AllowedVault
 // Validate returns an error if the AllowedVaults is invalid.
 func (a AllowedVaults) Validate() error {
 	denoms := make(map[string]bool)
+	swapPools := make(map[string]bool)
 
 	for _, v := range a {
 		if err := v.Validate(); err != nil {
@@ -165,6 +484,16 @@ func (a AllowedVaults) Validate() error {
 		}
 
 		denoms[v.Denom] = true
+
+		// The earn module account holds a single position in each pool, so
+		// it can only belong to one vault.
+		if v.SwapStrategyParams != nil {
+			if swapPools[v.SwapStrategyParams.PoolID] {
+				return fmt.Errorf("duplicate swap strategy pool %s", v.SwapStrategyParams.PoolID)
+			}
+
+			swapPools[v.SwapStrategyParams.PoolID] = true
+		}
 	}
 
 	return nil
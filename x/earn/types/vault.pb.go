@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,13 +44,22 @@ type AllowedVault struct {
 	// are not allowed to deposit into this vault. If IsPrivateVault is false,
 	// this should be empty and ignored.
 	AllowedDepositors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowed_depositors,json=allowedDepositors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowed_depositors,omitempty"`
+	// SwapStrategyParams configures the swap strategy. It must be set if and
+	// only if the vault uses the swap strategy.
+	SwapStrategyParams *SwapStrategyParams `protobuf:"bytes,5,opt,name=swap_strategy_params,json=swapStrategyParams,proto3" json:"swap_strategy_params,omitempty"`
+	// StrategyAllocation configures how the vault value is spread across its
+	// strategies. It must be set if and only if the vault has more than one
+	// strategy.
+	StrategyAllocation *StrategyAllocation `protobuf:"bytes,6,opt,name=strategy_allocation,json=strategyAllocation,proto3" json:"strategy_allocation,omitempty"`
+	// Fees configures the management and performance fees taken by the vault.
+	Fees *VaultFees `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees,omitempty"`
 }
 
 func (m *AllowedVault) Reset()         { *m = AllowedVault{} }
 func (m *AllowedVault) String() string { return proto.CompactTextString(m) }
 func (*AllowedVault) ProtoMessage()    {}
 func (*AllowedVault) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{0}
+	return fileDescriptor_9183aa7b63d72704, []int{0}
 }
 func (m *AllowedVault) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,6 +116,297 @@ func (m *AllowedVault) GetAllowedDepositors() []github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *AllowedVault) GetSwapStrategyParams() *SwapStrategyParams {
+	if m != nil {
+		return m.SwapStrategyParams
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetStrategyAllocation() *StrategyAllocation {
+	if m != nil {
+		return m.StrategyAllocation
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetFees() *VaultFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// SwapStrategyParams defines the pool that a vault using the swap strategy
+// provides liquidity to.
+type SwapStrategyParams struct {
+	// PoolID is the ID of the swap pool, it must contain the vault denom.
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// SlippageLimit is the maximum slippage allowed when swapping between the
+	// vault denom and the other pool asset.
+	SlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slippage_limit,json=slippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage_limit"`
+}
+
+func (m *SwapStrategyParams) Reset()         { *m = SwapStrategyParams{} }
+func (m *SwapStrategyParams) String() string { return proto.CompactTextString(m) }
+func (*SwapStrategyParams) ProtoMessage()    {}
+func (*SwapStrategyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{1}
+}
+func (m *SwapStrategyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapStrategyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapStrategyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapStrategyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapStrategyParams.Merge(m, src)
+}
+func (m *SwapStrategyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapStrategyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapStrategyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapStrategyParams proto.InternalMessageInfo
+
+func (m *SwapStrategyParams) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+// StrategyAllocation defines the target weights of a vault's strategies and
+// when the vault is rebalanced towards them.
+type StrategyAllocation struct {
+	// Weights are the target fractions of the vault value for each strategy of
+	// the vault, they must sum to one.
+	Weights StrategyWeights `protobuf:"bytes,1,rep,name=weights,proto3,castrepeated=StrategyWeights" json:"weights"`
+	// RebalanceThreshold is the drift of any strategy's fraction of the vault
+	// value from its weight above which the vault can be rebalanced.
+	RebalanceThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rebalance_threshold,json=rebalanceThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalance_threshold"`
+	// AutoRebalance rebalances the vault at the end of each block in which the
+	// drift exceeds the RebalanceThreshold.
+	AutoRebalance bool `protobuf:"varint,3,opt,name=auto_rebalance,json=autoRebalance,proto3" json:"auto_rebalance,omitempty"`
+}
+
+func (m *StrategyAllocation) Reset()         { *m = StrategyAllocation{} }
+func (m *StrategyAllocation) String() string { return proto.CompactTextString(m) }
+func (*StrategyAllocation) ProtoMessage()    {}
+func (*StrategyAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{2}
+}
+func (m *StrategyAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategyAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategyAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyAllocation.Merge(m, src)
+}
+func (m *StrategyAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyAllocation proto.InternalMessageInfo
+
+func (m *StrategyAllocation) GetWeights() StrategyWeights {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *StrategyAllocation) GetAutoRebalance() bool {
+	if m != nil {
+		return m.AutoRebalance
+	}
+	return false
+}
+
+// StrategyWeight defines the target fraction of a vault's value for a
+// strategy.
+type StrategyWeight struct {
+	Strategy StrategyType                           `protobuf:"varint,1,opt,name=strategy,proto3,enum=fury.earn.v1beta1.StrategyType" json:"strategy,omitempty"`
+	Weight   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *StrategyWeight) Reset()         { *m = StrategyWeight{} }
+func (m *StrategyWeight) String() string { return proto.CompactTextString(m) }
+func (*StrategyWeight) ProtoMessage()    {}
+func (*StrategyWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{3}
+}
+func (m *StrategyWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategyWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategyWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyWeight.Merge(m, src)
+}
+func (m *StrategyWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyWeight proto.InternalMessageInfo
+
+func (m *StrategyWeight) GetStrategy() StrategyType {
+	if m != nil {
+		return m.Strategy
+	}
+	return STRATEGY_TYPE_UNSPECIFIED
+}
+
+// VaultFees defines the fees of a vault. Fees are taken by minting vault
+// shares to the recipient.
+type VaultFees struct {
+	// ManagementFee is the annual rate charged on the total value of the vault.
+	ManagementFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=management_fee,json=managementFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"management_fee"`
+	// PerformanceFee is the fraction of the yield above the high-water mark
+	// taken as fees.
+	PerformanceFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=performance_fee,json=performanceFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_fee"`
+	// Recipient is the address that receives the vault shares minted for fees.
+	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+}
+
+func (m *VaultFees) Reset()         { *m = VaultFees{} }
+func (m *VaultFees) String() string { return proto.CompactTextString(m) }
+func (*VaultFees) ProtoMessage()    {}
+func (*VaultFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{4}
+}
+func (m *VaultFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFees.Merge(m, src)
+}
+func (m *VaultFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultFees proto.InternalMessageInfo
+
+func (m *VaultFees) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+// VaultFeeRecord is the fee accrual state of a vault.
+type VaultFeeRecord struct {
+	// Denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// LastAccrualTime is the time fees were last accrued.
+	LastAccrualTime time.Time `protobuf:"bytes,2,opt,name=last_accrual_time,json=lastAccrualTime,proto3,stdtime" json:"last_accrual_time"`
+	// HighWaterMark is the highest value per share of the vault after fees.
+	// Performance fees are only charged on value per share above it.
+	HighWaterMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_water_mark"`
+	// AccruedShares is the total of vault shares minted for fees.
+	AccruedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=accrued_shares,json=accruedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accrued_shares"`
+}
+
+func (m *VaultFeeRecord) Reset()         { *m = VaultFeeRecord{} }
+func (m *VaultFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultFeeRecord) ProtoMessage()    {}
+func (*VaultFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{5}
+}
+func (m *VaultFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFeeRecord.Merge(m, src)
+}
+func (m *VaultFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultFeeRecord proto.InternalMessageInfo
+
+func (m *VaultFeeRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VaultFeeRecord) GetLastAccrualTime() time.Time {
+	if m != nil {
+		return m.LastAccrualTime
+	}
+	return time.Time{}
+}
+
 // VaultRecord is the state of a vault.
 type VaultRecord struct {
 	// TotalShares is the total distributed number of shares in the vault.
@@ -113,7 +417,7 @@ func (m *VaultRecord) Reset()         { *m = VaultRecord{} }
 func (m *VaultRecord) String() string { return proto.CompactTextString(m) }
 func (*VaultRecord) ProtoMessage()    {}
 func (*VaultRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{1}
+	return fileDescriptor_9183aa7b63d72704, []int{6}
 }
 func (m *VaultRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +465,7 @@ func (m *VaultShareRecord) Reset()         { *m = VaultShareRecord{} }
 func (m *VaultShareRecord) String() string { return proto.CompactTextString(m) }
 func (*VaultShareRecord) ProtoMessage()    {}
 func (*VaultShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{2}
+	return fileDescriptor_9183aa7b63d72704, []int{7}
 }
 func (m *VaultShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +517,7 @@ type VaultShare struct {
 func (m *VaultShare) Reset()      { *m = VaultShare{} }
 func (*VaultShare) ProtoMessage() {}
 func (*VaultShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{3}
+	return fileDescriptor_9183aa7b63d72704, []int{8}
 }
 func (m *VaultShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,151 +553,288 @@ func (m *VaultShare) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AllowedVault)(nil), "fury.earn.v1beta1.AllowedVault")
-	proto.RegisterType((*VaultRecord)(nil), "fury.earn.v1beta1.VaultRecord")
-	proto.RegisterType((*VaultShareRecord)(nil), "fury.earn.v1beta1.VaultShareRecord")
-	proto.RegisterType((*VaultShare)(nil), "fury.earn.v1beta1.VaultShare")
+// AutoCompoundSetting defines a depositor's opt-in to have their earn rewards
+// claimed and deposited into a vault periodically.
+type AutoCompoundSetting struct {
+	// depositor represents the owner of the rewards
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// vault_denom is the denom of the vault the rewards are deposited into
+	VaultDenom string `protobuf:"bytes,2,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	// slippage_limit is the maximum slippage of swaps of rewards to the vault
+	// denom
+	SlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slippage_limit,json=slippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage_limit"`
 }
 
-func init() { proto.RegisterFile("fury/earn/v1beta1/vault.proto", fileDescriptor_884eb89509fbdc04) }
-
-var fileDescriptor_884eb89509fbdc04 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x93, 0x10, 0xd1, 0x4b, 0xa8, 0x1a, 0xb7, 0x83, 0xa9, 0x54, 0xdb, 0xca, 0x80, 0xbc,
-	0xd8, 0x56, 0xcb, 0x86, 0x18, 0x88, 0x15, 0x21, 0xc4, 0x84, 0xae, 0x85, 0x81, 0x25, 0xba, 0xd8,
-	0x47, 0x6a, 0xd5, 0xc9, 0x45, 0x7e, 0x17, 0x97, 0x2c, 0x7c, 0x06, 0x46, 0x24, 0x16, 0xe6, 0xce,
-	0xfd, 0x0c, 0xa8, 0x63, 0xd5, 0x09, 0x31, 0xa4, 0x28, 0xf9, 0x16, 0x4c, 0xe8, 0xfe, 0xa8, 0x8e,
-	0x14, 0x10, 0x0c, 0x4c, 0xb9, 0xfb, 0xbd, 0xf7, 0x7e, 0x7f, 0x5e, 0x7c, 0xe8, 0xe0, 0x8c, 0x94,
-	0x24, 0xa2, 0xa4, 0x98, 0x44, 0xe5, 0xe1, 0x90, 0x72, 0x72, 0x18, 0x95, 0x64, 0x96, 0xf3, 0x70,
-	0x5a, 0x30, 0xce, 0xac, 0x8e, 0x28, 0x87, 0xa2, 0x1c, 0xea, 0xf2, 0xfe, 0xc3, 0x84, 0xc1, 0x98,
-	0xc1, 0x40, 0x36, 0x44, 0xea, 0xa2, 0xba, 0xf7, 0xf7, 0x46, 0x6c, 0xc4, 0x14, 0x2e, 0x4e, 0x1a,
-	0xf5, 0x36, 0x25, 0x80, 0x17, 0x84, 0xd3, 0xd1, 0x5c, 0x75, 0x74, 0x3f, 0xd7, 0x50, 0xbb, 0x97,
-	0xe7, 0xec, 0x9c, 0xa6, 0x6f, 0x84, 0xb8, 0xb5, 0x87, 0xee, 0xa5, 0x74, 0xc2, 0xc6, 0xb6, 0xe9,
-	0x99, 0xfe, 0x16, 0x56, 0x17, 0x0b, 0x23, 0xa4, 0x07, 0x33, 0x0a, 0x76, 0xcd, 0xab, 0xfb, 0xdb,
-	0x47, 0x6e, 0xb8, 0xe1, 0x30, 0x3c, 0xd6, 0xec, 0x27, 0xf3, 0x29, 0x8d, 0x3b, 0x17, 0xb7, 0xee,
-	0x83, 0x75, 0x04, 0xf0, 0x1a, 0x8b, 0xe5, 0xa3, 0x9d, 0x4c, 0x64, 0xc9, 0x4a, 0xc2, 0xe9, 0x40,
-	0x46, 0xb7, 0xeb, 0x9e, 0xe9, 0xdf, 0xc7, 0xdb, 0x19, 0xbc, 0x52, 0xb0, 0xf2, 0x74, 0x8e, 0x2c,
-	0xa2, 0x3c, 0x0e, 0x52, 0x3a, 0x65, 0x90, 0x71, 0x56, 0x80, 0xdd, 0xf0, 0xea, 0x7e, 0x3b, 0x7e,
-	0xf1, 0x73, 0xe1, 0x06, 0xa3, 0x8c, 0x9f, 0xce, 0x86, 0x61, 0xc2, 0xc6, 0x7a, 0x2b, 0xfa, 0x27,
-	0x80, 0xf4, 0x2c, 0xe2, 0x42, 0x39, 0xec, 0x25, 0x49, 0x2f, 0x4d, 0x0b, 0x0a, 0x70, 0x73, 0x19,
-	0xec, 0xea, 0xdd, 0x69, 0x24, 0x9e, 0x73, 0x0a, 0xb8, 0xa3, 0x35, 0xfa, 0x77, 0x12, 0xdd, 0xd7,
-	0xa8, 0x25, 0x1d, 0x60, 0x9a, 0xb0, 0x22, 0xb5, 0x9e, 0xa3, 0x36, 0x67, 0x9c, 0xe4, 0x03, 0x38,
-	0x25, 0x05, 0x05, 0xb9, 0xa2, 0xd6, 0xd1, 0xc1, 0x6f, 0xf6, 0x20, 0xa7, 0x8e, 0x45, 0x57, 0xdc,
-	0xb8, 0x5a, 0xb8, 0x06, 0x6e, 0xc9, 0x41, 0x89, 0x40, 0xf7, 0xab, 0x89, 0x76, 0xaa, 0x0e, 0x4d,
-	0xfe, 0x0e, 0x6d, 0xdd, 0x85, 0x93, 0xcc, 0xff, 0x33, 0x5b, 0x45, 0x6d, 0xbd, 0x44, 0x4d, 0x6d,
-	0x5f, 0xfc, 0x8d, 0x7f, 0xb5, 0xbf, 0x2b, 0xec, 0x5f, 0xdc, 0xba, 0xad, 0x0a, 0x03, 0xac, 0x19,
-	0xba, 0x1f, 0x10, 0xaa, 0xe0, 0x3f, 0x7c, 0x3a, 0x27, 0xa8, 0x49, 0xc6, 0x6c, 0x36, 0xe1, 0x76,
-	0x4d, 0xc0, 0xf1, 0x53, 0x41, 0xf8, 0x7d, 0xe1, 0x3e, 0xfa, 0x87, 0x60, 0x7d, 0x9a, 0xdc, 0x5c,
-	0x06, 0x48, 0x27, 0xea, 0xd3, 0x04, 0x6b, 0xae, 0x27, 0x8d, 0x4f, 0x5f, 0x5c, 0x23, 0x7e, 0x76,
-	0xb5, 0x74, 0xcc, 0xeb, 0xa5, 0x63, 0xfe, 0x58, 0x3a, 0xe6, 0xc7, 0x95, 0x63, 0x5c, 0xaf, 0x1c,
-	0xe3, 0xdb, 0xca, 0x31, 0xde, 0xae, 0xb3, 0x8b, 0x7c, 0x41, 0x4e, 0x86, 0x20, 0x4f, 0xd1, 0x7b,
-	0xf5, 0x20, 0xa4, 0xc2, 0xb0, 0x29, 0x9f, 0xc1, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xa9, 0xba, 0x64, 0x8d, 0x03, 0x00, 0x00,
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{9}
 }
-
-func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AllowedVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
 }
 
-func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDepositors) > 0 {
-		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedDepositors[iNdEx])
-			copy(dAtA[i:], m.AllowedDepositors[iNdEx])
-			i = encodeVarintVault(dAtA, i, uint64(len(m.AllowedDepositors[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.IsPrivateVault {
-		i--
-		if m.IsPrivateVault {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Strategies) > 0 {
-		dAtA2 := make([]byte, len(m.Strategies)*10)
-		var j1 int
-		for _, num := range m.Strategies {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintVault(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+func (m *AutoCompoundSetting) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AutoCompoundSetting) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VaultSharePriceSnapshot is the value of one share of a vault at a point in
+// time.
+type VaultSharePriceSnapshot struct {
+	// vault_denom is the denom of the vault
+	VaultDenom string `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	// time is the block time the snapshot was recorded
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// share_price is the vault value per share in the vault denom
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price"`
 }
 
-func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+func (m *VaultSharePriceSnapshot) Reset()         { *m = VaultSharePriceSnapshot{} }
+func (m *VaultSharePriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VaultSharePriceSnapshot) ProtoMessage()    {}
+func (*VaultSharePriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{10}
+}
+func (m *VaultSharePriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSharePriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSharePriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *VaultSharePriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSharePriceSnapshot.Merge(m, src)
+}
+func (m *VaultSharePriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSharePriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSharePriceSnapshot.DiscardUnknown(m)
 }
 
-func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_VaultSharePriceSnapshot proto.InternalMessageInfo
+
+func (m *VaultSharePriceSnapshot) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
+	}
+	return ""
+}
+
+func (m *VaultSharePriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// WithdrawalRequest is a queued withdraw of vault shares. Requests are
+// released in order of id once the vault strategies have the liquidity, and
+// removed if they cannot be released for WithdrawalRequestExpiry.
+type WithdrawalRequest struct {
+	// id is the unique id of the request
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner represents the address that queued the withdraw
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// shares are the vault shares locked for the withdraw
+	Shares VaultShare `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
+	// time is the block time the request was queued
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *WithdrawalRequest) Reset()         { *m = WithdrawalRequest{} }
+func (m *WithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalRequest) ProtoMessage()    {}
+func (*WithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{11}
+}
+func (m *WithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalRequest.Merge(m, src)
+}
+func (m *WithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalRequest proto.InternalMessageInfo
+
+func (m *WithdrawalRequest) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *WithdrawalRequest) GetShares() VaultShare {
+	if m != nil {
+		return m.Shares
+	}
+	return VaultShare{}
+}
+
+func (m *WithdrawalRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*AllowedVault)(nil), "fury.earn.v1beta1.AllowedVault")
+	proto.RegisterType((*SwapStrategyParams)(nil), "fury.earn.v1beta1.SwapStrategyParams")
+	proto.RegisterType((*StrategyAllocation)(nil), "fury.earn.v1beta1.StrategyAllocation")
+	proto.RegisterType((*StrategyWeight)(nil), "fury.earn.v1beta1.StrategyWeight")
+	proto.RegisterType((*VaultFees)(nil), "fury.earn.v1beta1.VaultFees")
+	proto.RegisterType((*VaultFeeRecord)(nil), "fury.earn.v1beta1.VaultFeeRecord")
+	proto.RegisterType((*VaultRecord)(nil), "fury.earn.v1beta1.VaultRecord")
+	proto.RegisterType((*VaultShareRecord)(nil), "fury.earn.v1beta1.VaultShareRecord")
+	proto.RegisterType((*VaultShare)(nil), "fury.earn.v1beta1.VaultShare")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "fury.earn.v1beta1.AutoCompoundSetting")
+	proto.RegisterType((*VaultSharePriceSnapshot)(nil), "fury.earn.v1beta1.VaultSharePriceSnapshot")
+	proto.RegisterType((*WithdrawalRequest)(nil), "fury.earn.v1beta1.WithdrawalRequest")
+}
+
+func init() { proto.RegisterFile("fury/earn/v1beta1/vault.proto", fileDescriptor_9183aa7b63d72704) }
+
+var fileDescriptor_9183aa7b63d72704 = []byte{
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xae, 0x5d, 0x37, 0x79, 0x4e, 0x9c, 0x66, 0x12, 0xd1, 0x25, 0xa2, 0x5e, 0x63, 0x54,
+	0xf0, 0x25, 0x36, 0x0d, 0x17, 0x44, 0xb9, 0xd8, 0x58, 0x11, 0x41, 0x20, 0x45, 0x1b, 0xd3, 0x48,
+	0x48, 0xb0, 0x9a, 0xec, 0x3e, 0xaf, 0x57, 0xd9, 0xdd, 0x59, 0x76, 0xc6, 0x31, 0xb9, 0x20, 0x04,
+	0x7f, 0xa0, 0x47, 0x4e, 0x08, 0x89, 0x5b, 0xcf, 0xbd, 0x73, 0x40, 0x42, 0x3d, 0x56, 0xe5, 0x82,
+	0x38, 0x24, 0x28, 0xf9, 0x17, 0x1c, 0x10, 0x9a, 0xd9, 0xf5, 0x3a, 0xc5, 0x0d, 0x6d, 0xa5, 0x15,
+	0x27, 0x7b, 0xdf, 0xbc, 0xf9, 0xde, 0xfb, 0xbe, 0xf7, 0xe6, 0xcd, 0xc0, 0xad, 0xe1, 0x38, 0x39,
+	0xe9, 0x20, 0x4d, 0xa2, 0xce, 0xf1, 0x9d, 0x43, 0x14, 0xf4, 0x4e, 0xe7, 0x98, 0x8e, 0x03, 0xd1,
+	0x8e, 0x13, 0x26, 0x18, 0x59, 0x93, 0xcb, 0x6d, 0xb9, 0xdc, 0xce, 0x96, 0x37, 0x5f, 0x75, 0x18,
+	0x0f, 0x19, 0xb7, 0x95, 0x43, 0x27, 0xfd, 0x48, 0xbd, 0x37, 0x37, 0x3c, 0xe6, 0xb1, 0xd4, 0x2e,
+	0xff, 0x65, 0x56, 0xd3, 0x63, 0xcc, 0x0b, 0xb0, 0xa3, 0xbe, 0x0e, 0xc7, 0xc3, 0x8e, 0xf0, 0x43,
+	0xe4, 0x82, 0x86, 0x71, 0xe6, 0xd0, 0x98, 0xcf, 0x81, 0x8b, 0x84, 0x0a, 0xf4, 0x4e, 0x52, 0x8f,
+	0xe6, 0xdf, 0x25, 0x58, 0xee, 0x06, 0x01, 0x9b, 0xa0, 0x7b, 0x4f, 0x66, 0x47, 0x36, 0xe0, 0x9a,
+	0x8b, 0x11, 0x0b, 0x0d, 0xad, 0xa1, 0xb5, 0x96, 0xac, 0xf4, 0x83, 0x58, 0x00, 0xd9, 0x46, 0x1f,
+	0xb9, 0xa1, 0x37, 0x4a, 0xad, 0xda, 0xb6, 0xd9, 0x9e, 0xa3, 0xd0, 0xde, 0xcf, 0xd0, 0x07, 0x27,
+	0x31, 0xf6, 0xd6, 0x1e, 0x9c, 0x99, 0x2b, 0x97, 0x2d, 0xdc, 0xba, 0x84, 0x42, 0x5a, 0x70, 0xc3,
+	0x97, 0x64, 0xfd, 0x63, 0x2a, 0xd0, 0x56, 0xda, 0x18, 0xa5, 0x86, 0xd6, 0x5a, 0xb4, 0x6a, 0x3e,
+	0xdf, 0x4b, 0xcd, 0x69, 0x4e, 0x13, 0x20, 0x34, 0xcd, 0xd1, 0x76, 0x31, 0x66, 0xdc, 0x17, 0x2c,
+	0xe1, 0x46, 0xb9, 0x51, 0x6a, 0x2d, 0xf7, 0x3e, 0xfc, 0xeb, 0xd4, 0xdc, 0xf2, 0x7c, 0x31, 0x1a,
+	0x1f, 0xb6, 0x1d, 0x16, 0x66, 0xb2, 0x65, 0x3f, 0x5b, 0xdc, 0x3d, 0xea, 0x08, 0x19, 0xb9, 0xdd,
+	0x75, 0x9c, 0xae, 0xeb, 0x26, 0xc8, 0xf9, 0x93, 0x87, 0x5b, 0xeb, 0x99, 0xb8, 0x99, 0xa5, 0x77,
+	0x22, 0x90, 0x5b, 0x6b, 0x59, 0x8c, 0x7e, 0x1e, 0x82, 0x1c, 0xc0, 0x06, 0x9f, 0xd0, 0xd8, 0x9e,
+	0x8a, 0x66, 0xc7, 0x34, 0xa1, 0x21, 0x37, 0xae, 0x35, 0xb4, 0x56, 0x75, 0xfb, 0xf6, 0xb3, 0x04,
+	0x98, 0xd0, 0x78, 0x4a, 0x79, 0x4f, 0x39, 0x5b, 0x84, 0xcf, 0xd9, 0xc8, 0x3d, 0x58, 0xcf, 0x31,
+	0x65, 0x58, 0x87, 0x0a, 0x9f, 0x45, 0x46, 0xe5, 0x6a, 0xdc, 0xcc, 0xbb, 0x9b, 0x3b, 0x5b, 0x84,
+	0xcf, 0xd9, 0xc8, 0xdb, 0x50, 0x1e, 0x22, 0x72, 0xe3, 0xba, 0x02, 0x7a, 0xed, 0x19, 0x40, 0x4a,
+	0xd1, 0x1d, 0x44, 0x6e, 0x29, 0xcf, 0xe6, 0x0f, 0x1a, 0x90, 0xf9, 0xa4, 0xc9, 0x1b, 0x70, 0x3d,
+	0x66, 0x2c, 0xb0, 0x7d, 0x37, 0x6d, 0x84, 0x1e, 0x9c, 0x9f, 0x9a, 0x95, 0x3d, 0xc6, 0x82, 0xdd,
+	0xbe, 0x55, 0x91, 0x4b, 0xbb, 0x2e, 0x71, 0xa0, 0xc6, 0x03, 0x3f, 0x8e, 0xa9, 0x87, 0x76, 0xe0,
+	0x87, 0xbe, 0x30, 0x74, 0xe5, 0xfb, 0xfe, 0xa3, 0x53, 0x73, 0xe1, 0x8f, 0x53, 0xf3, 0xcd, 0x17,
+	0xa8, 0x4b, 0x1f, 0x9d, 0x27, 0x0f, 0xb7, 0x20, 0x2b, 0x48, 0x1f, 0x1d, 0x6b, 0x65, 0x8a, 0xf9,
+	0xb1, 0x84, 0x6c, 0x7e, 0xa3, 0x03, 0x99, 0x67, 0x4f, 0x06, 0x70, 0x7d, 0x82, 0xbe, 0x37, 0x12,
+	0xdc, 0xd0, 0x1a, 0xa5, 0x56, 0x75, 0xfb, 0xf5, 0xff, 0x50, 0xed, 0x40, 0x79, 0xf6, 0x6e, 0xca,
+	0xbc, 0x1e, 0x9c, 0x99, 0xab, 0x4f, 0xdb, 0xb9, 0x35, 0x85, 0x22, 0x21, 0xac, 0x27, 0x78, 0x48,
+	0x03, 0x1a, 0x39, 0x68, 0x8b, 0x51, 0x82, 0x7c, 0xc4, 0x02, 0xb7, 0x10, 0x5a, 0x24, 0x07, 0x1e,
+	0x4c, 0x71, 0xc9, 0x6d, 0xa8, 0xd1, 0xb1, 0x60, 0x76, 0xbe, 0x94, 0x1d, 0x80, 0x15, 0x69, 0xb5,
+	0xa6, 0xc6, 0xe6, 0x4f, 0x1a, 0xd4, 0x9e, 0x4e, 0x99, 0xdc, 0x85, 0xc5, 0x69, 0xf9, 0x55, 0x81,
+	0x9e, 0x7f, 0x1c, 0xad, 0x7c, 0x03, 0x19, 0x40, 0x25, 0x25, 0x5c, 0x08, 0xb1, 0x0c, 0xab, 0xf9,
+	0xb3, 0x0e, 0x4b, 0x79, 0x77, 0xc9, 0xde, 0x08, 0x69, 0x44, 0x3d, 0x0c, 0x31, 0x12, 0xf6, 0x10,
+	0xd1, 0xd0, 0x0a, 0x88, 0xb5, 0x32, 0xc3, 0xdc, 0x41, 0x24, 0x08, 0xab, 0x31, 0x26, 0x43, 0x96,
+	0x84, 0xaa, 0x60, 0x32, 0x4a, 0x11, 0x8c, 0x6a, 0x97, 0x40, 0x65, 0x98, 0x21, 0x2c, 0x25, 0xe8,
+	0xf8, 0xb1, 0x8f, 0x51, 0x3a, 0xa2, 0x8a, 0x1c, 0x3b, 0x33, 0xe8, 0xe6, 0x2f, 0x3a, 0xd4, 0xa6,
+	0x0a, 0x5a, 0xe8, 0xb0, 0xc4, 0xbd, 0x62, 0x1c, 0xef, 0xc1, 0x5a, 0x40, 0xb9, 0xb0, 0xa9, 0xe3,
+	0x24, 0x63, 0x1a, 0xd8, 0x72, 0xee, 0x2b, 0xe6, 0xd5, 0xed, 0xcd, 0x76, 0x7a, 0x29, 0xb4, 0xa7,
+	0x97, 0x42, 0x7b, 0x30, 0xbd, 0x14, 0x7a, 0x8b, 0x52, 0x95, 0xfb, 0x67, 0xa6, 0x66, 0xad, 0xca,
+	0xed, 0xdd, 0x74, 0xb7, 0x5c, 0x27, 0x2e, 0xac, 0x8e, 0x7c, 0x6f, 0x64, 0x4f, 0xa8, 0xc0, 0xc4,
+	0x0e, 0x69, 0x72, 0x64, 0x94, 0x0a, 0x50, 0x72, 0x45, 0x82, 0x1e, 0x48, 0xcc, 0x4f, 0x68, 0x72,
+	0x24, 0x9b, 0x42, 0xa5, 0x8c, 0xae, 0xcd, 0x47, 0x34, 0x41, 0x39, 0xc4, 0x0b, 0x08, 0x92, 0x61,
+	0xee, 0x2b, 0xc8, 0xe6, 0xa7, 0x50, 0x55, 0x22, 0x66, 0x0a, 0xee, 0xc0, 0xb2, 0x60, 0x82, 0x06,
+	0xd3, 0x88, 0x9a, 0x92, 0xe9, 0xd6, 0x55, 0xa3, 0x51, 0x81, 0xf4, 0xca, 0x32, 0x21, 0xab, 0xaa,
+	0x36, 0x66, 0xb0, 0xbf, 0x6a, 0x70, 0x63, 0xe6, 0x91, 0x81, 0x0f, 0x61, 0x29, 0xbf, 0x91, 0x0c,
+	0xad, 0xe8, 0xce, 0xc8, 0xa1, 0xc9, 0x47, 0x50, 0xc9, 0xd2, 0xd7, 0x1b, 0xa5, 0xe7, 0xa7, 0xbf,
+	0x9e, 0x0d, 0xba, 0xea, 0xcc, 0xc6, 0xad, 0x0c, 0xa1, 0xf9, 0x35, 0xc0, 0xcc, 0x7c, 0x45, 0x83,
+	0x0d, 0xa0, 0x42, 0x43, 0x36, 0x8e, 0x0a, 0x9a, 0x10, 0x29, 0xd6, 0x7b, 0xe5, 0xef, 0x7f, 0x34,
+	0x17, 0x9a, 0xdf, 0xe9, 0xb0, 0xde, 0x1d, 0x0b, 0xf6, 0x01, 0x0b, 0x63, 0x36, 0x8e, 0xdc, 0x7d,
+	0x14, 0xc2, 0x8f, 0xbc, 0xff, 0x4d, 0x4b, 0x13, 0xaa, 0xea, 0xb1, 0x61, 0xa7, 0xbc, 0x15, 0x41,
+	0x0b, 0x94, 0xa9, 0xaf, 0xc8, 0xcf, 0x5f, 0x6b, 0xa5, 0xe2, 0xaf, 0xb5, 0xdf, 0x34, 0xb8, 0x39,
+	0x2b, 0xc3, 0x5e, 0xe2, 0x3b, 0xb8, 0x1f, 0xd1, 0x98, 0x8f, 0x98, 0xf8, 0x77, 0x86, 0xda, 0x5c,
+	0x86, 0xef, 0x42, 0xf9, 0xa5, 0x8f, 0xbc, 0xda, 0x41, 0x3e, 0x87, 0xaa, 0x6a, 0x03, 0xf9, 0xee,
+	0x72, 0xb0, 0x10, 0x62, 0xc0, 0x73, 0x06, 0xcd, 0x6f, 0x75, 0x58, 0x3b, 0xf0, 0xc5, 0xc8, 0x4d,
+	0xe8, 0x84, 0x06, 0x16, 0x7e, 0x39, 0x46, 0x2e, 0xc8, 0x2b, 0xa0, 0x67, 0xef, 0x88, 0x72, 0xaf,
+	0x72, 0x7e, 0x6a, 0xea, 0xbb, 0x7d, 0x4b, 0xf7, 0x5d, 0xf2, 0x05, 0x5c, 0x63, 0x93, 0x08, 0x13,
+	0x43, 0x2f, 0xb8, 0xda, 0x29, 0x2c, 0xb9, 0x9b, 0x9f, 0x9a, 0xd2, 0x8b, 0x1f, 0xfa, 0x6c, 0x4b,
+	0xae, 0x71, 0xf9, 0x65, 0x35, 0xee, 0x75, 0x1f, 0x9d, 0xd7, 0xb5, 0xc7, 0xe7, 0x75, 0xed, 0xcf,
+	0xf3, 0xba, 0x76, 0xff, 0xa2, 0xbe, 0xf0, 0xf8, 0xa2, 0xbe, 0xf0, 0xfb, 0x45, 0x7d, 0xe1, 0xb3,
+	0xb7, 0x2e, 0xb1, 0x0b, 0xa9, 0x87, 0x5b, 0x0e, 0x3b, 0xc6, 0xa8, 0xa3, 0x5e, 0xe9, 0x5f, 0xa5,
+	0xef, 0x74, 0x45, 0xf1, 0xb0, 0xa2, 0xc2, 0xbc, 0xf3, 0xcf, 0x00, 0x76, 0xf2, 0xe3, 0x26, 0x45,
+	0x0c, 0x00, 0x00,
+}
+
+func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -403,41 +844,100 @@ func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllowedVault) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVault(dAtA, i, uint64(size))
+	if m.Fees != nil {
+		{
+			size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StrategyAllocation != nil {
+		{
+			size, err := m.StrategyAllocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SwapStrategyParams != nil {
+		{
+			size, err := m.SwapStrategyParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AllowedDepositors) > 0 {
+		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDepositors[iNdEx])
+			copy(dAtA[i:], m.AllowedDepositors[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.AllowedDepositors[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+	if m.IsPrivateVault {
+		i--
+		if m.IsPrivateVault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Strategies) > 0 {
+		dAtA5 := make([]byte, len(m.Strategies)*10)
+		var j4 int
+		for _, num := range m.Strategies {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintVault(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VaultShare) Marshal() (dAtA []byte, err error) {
+func (m *SwapStrategyParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -447,128 +947,1752 @@ func (m *VaultShare) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VaultShare) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapStrategyParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapStrategyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.SlippageLimit.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SlippageLimit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.PoolID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVault(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StrategyAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AllowedVault) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StrategyAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
-	}
-	if len(m.Strategies) > 0 {
-		l = 0
-		for _, e := range m.Strategies {
-			l += sovVault(uint64(e))
+	if m.AutoRebalance {
+		i--
+		if m.AutoRebalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovVault(uint64(l)) + l
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.IsPrivateVault {
-		n += 2
+	{
+		size := m.RebalanceThreshold.Size()
+		i -= size
+		if _, err := m.RebalanceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
-	if len(m.AllowedDepositors) > 0 {
-		for _, b := range m.AllowedDepositors {
-			l = len(b)
-			n += 1 + l + sovVault(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VaultRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StrategyWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VaultShareRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StrategyWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
-	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovVault(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.Strategy != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VaultShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *VaultFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.PerformanceFee.Size()
+		i -= size
+		if _, err := m.PerformanceFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ManagementFee.Size()
+		i -= size
+		if _, err := m.ManagementFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AccruedShares.Size()
+		i -= size
+		if _, err := m.AccruedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAccrualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAccrualTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintVault(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlippageLimit.Size()
+		i -= size
+		if _, err := m.SlippageLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultSharePriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSharePriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSharePriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintVault(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintVault(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowedVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Strategies) > 0 {
+		l = 0
+		for _, e := range m.Strategies {
+			l += sovVault(uint64(e))
+		}
+		n += 1 + sovVault(uint64(l)) + l
+	}
+	if m.IsPrivateVault {
+		n += 2
+	}
+	if len(m.AllowedDepositors) > 0 {
+		for _, b := range m.AllowedDepositors {
+			l = len(b)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if m.SwapStrategyParams != nil {
+		l = m.SwapStrategyParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.StrategyAllocation != nil {
+		l = m.StrategyAllocation.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapStrategyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.SlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *StrategyAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	l = m.RebalanceThreshold.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.AutoRebalance {
+		n += 2
+	}
+	return n
+}
+
+func (m *StrategyWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovVault(uint64(m.Strategy))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ManagementFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.PerformanceFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAccrualTime)
+	n += 1 + l + sovVault(uint64(l))
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AccruedShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovVault(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.SlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultSharePriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVault(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *WithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovVault(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v StrategyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= StrategyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Strategies = append(m.Strategies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVault
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVault
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Strategies) == 0 {
+					m.Strategies = make([]StrategyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v StrategyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVault
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= StrategyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Strategies = append(m.Strategies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrivateVault = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapStrategyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapStrategyParams == nil {
+				m.SwapStrategyParams = &SwapStrategyParams{}
+			}
+			if err := m.SwapStrategyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StrategyAllocation == nil {
+				m.StrategyAllocation = &StrategyAllocation{}
+			}
+			if err := m.StrategyAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &VaultFees{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapStrategyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStrategyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStrategyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategyAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategyAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategyAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, StrategyWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebalanceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRebalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRebalance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategyWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategyWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategyWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagementFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManagementFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVault(x uint64) (n int) {
-	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,17 +2715,17 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -611,98 +2735,198 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v StrategyType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= StrategyType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
 				}
-				m.Strategies = append(m.Strategies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthVault
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthVault
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Strategies) == 0 {
-					m.Strategies = make([]StrategyType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v StrategyType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowVault
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= StrategyType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Strategies = append(m.Strategies, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, VaultShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -712,17 +2936,29 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPrivateVault = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -732,23 +2968,25 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
-			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -771,7 +3009,7 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultRecord) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -794,17 +3032,17 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -814,22 +3052,89 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -854,7 +3159,7 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
+func (m *VaultSharePriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,17 +3182,17 @@ func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -897,29 +3202,27 @@ func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -946,8 +3249,41 @@ func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, VaultShare{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -972,7 +3308,7 @@ func (m *VaultShareRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultShare) Unmarshal(dAtA []byte) error {
+func (m *WithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -995,17 +3331,36 @@ func (m *VaultShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1015,29 +3370,31 @@ func (m *VaultShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1047,23 +3404,55 @@ func (m *VaultShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -308,6 +309,201 @@ func TestAllowedVaultsValidate(t *testing.T) {
 				contains:   "non-private vaults cannot have any AllowedDepositors",
 			},
 		},
+		{
+			name: "valid - multiple strategies",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyAllocation: types.NewStrategyAllocation(
+						types.StrategyWeights{
+							types.NewStrategyWeight(types.STRATEGY_TYPE_HARD, sdk.MustNewDecFromStr("0.6")),
+							types.NewStrategyWeight(types.STRATEGY_TYPE_SAVINGS, sdk.MustNewDecFromStr("0.4")),
+						},
+						sdk.MustNewDecFromStr("0.05"),
+						true,
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - multiple strategies without allocation",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vaults with multiple strategies require a StrategyAllocation",
+			},
+		},
+		{
+			name: "invalid - weights do not sum to 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyAllocation: types.NewStrategyAllocation(
+						types.StrategyWeights{
+							types.NewStrategyWeight(types.STRATEGY_TYPE_HARD, sdk.MustNewDecFromStr("0.6")),
+							types.NewStrategyWeight(types.STRATEGY_TYPE_SAVINGS, sdk.MustNewDecFromStr("0.5")),
+						},
+						sdk.MustNewDecFromStr("0.05"),
+						false,
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "strategy weights must sum to 1",
+			},
+		},
+		{
+			name: "invalid - weight for a strategy not in the vault",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyAllocation: types.NewStrategyAllocation(
+						types.StrategyWeights{
+							types.NewStrategyWeight(types.STRATEGY_TYPE_HARD, sdk.MustNewDecFromStr("0.6")),
+							types.NewStrategyWeight(types.STRATEGY_TYPE_SWAP, sdk.MustNewDecFromStr("0.4")),
+						},
+						sdk.MustNewDecFromStr("0.05"),
+						false,
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "missing weight for strategy STRATEGY_TYPE_SAVINGS",
+			},
+		},
+		{
+			name: "invalid - allocation with a single strategy",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					StrategyAllocation: types.NewStrategyAllocation(
+						types.StrategyWeights{
+							types.NewStrategyWeight(types.STRATEGY_TYPE_HARD, sdk.OneDec()),
+						},
+						sdk.MustNewDecFromStr("0.05"),
+						false,
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "only vaults with multiple strategies can have a StrategyAllocation",
+			},
+		},
+		{
+			name: "valid - swap strategy",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_SWAP},
+					SwapStrategyParams: types.NewSwapStrategyParams("ufury:usdx", sdk.MustNewDecFromStr("0.01")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - swap strategy without params",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_SWAP},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vaults with the swap strategy require SwapStrategyParams",
+			},
+		},
+		{
+			name: "invalid - swap pool without vault denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_SWAP},
+					SwapStrategyParams: types.NewSwapStrategyParams("busd:ufury", sdk.MustNewDecFromStr("0.01")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap pool busd:ufury does not contain vault denom usdx",
+			},
+		},
+		{
+			name: "invalid - duplicate swap pool",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_SWAP},
+					SwapStrategyParams: types.NewSwapStrategyParams("ufury:usdx", sdk.MustNewDecFromStr("0.01")),
+				},
+				{
+					Denom:              "ufury",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_SWAP},
+					SwapStrategyParams: types.NewSwapStrategyParams("ufury:usdx", sdk.MustNewDecFromStr("0.01")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate swap strategy pool ufury:usdx",
+			},
+		},
+		{
+			name: "valid - fees",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					Fees:       types.NewVaultFees(sdk.MustNewDecFromStr("0.02"), sdk.MustNewDecFromStr("0.2"), sdk.AccAddress("recipient")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - fee of 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					Fees:       types.NewVaultFees(sdk.OneDec(), sdk.ZeroDec(), sdk.AccAddress("recipient")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "management fee must be >= 0 and < 1",
+			},
+		},
+		{
+			name: "invalid - fees without recipient",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					Fees:       types.NewVaultFees(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.2"), nil),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vault fees require a recipient",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -383,3 +579,64 @@ func TestNewVaultShareRecord(t *testing.T) {
 	shareRecord := types.NewVaultShareRecord(addrs[0], shares)
 	require.Equal(t, shares, shareRecord.Shares)
 }
+
+func TestVaultSharePriceSnapshotsValidate(t *testing.T) {
+	snapshotTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		snapshots types.VaultSharePriceSnapshots
+		contains  string
+	}{
+		{
+			name: "valid snapshots",
+			snapshots: types.VaultSharePriceSnapshots{
+				types.NewVaultSharePriceSnapshot("usdx", snapshotTime, sdk.OneDec()),
+				types.NewVaultSharePriceSnapshot("usdx", snapshotTime.Add(24*time.Hour), sdk.MustNewDecFromStr("1.01")),
+				types.NewVaultSharePriceSnapshot("ufury", snapshotTime, sdk.ZeroDec()),
+			},
+		},
+		{
+			name: "invalid - duplicate denom and time",
+			snapshots: types.VaultSharePriceSnapshots{
+				types.NewVaultSharePriceSnapshot("usdx", snapshotTime, sdk.OneDec()),
+				types.NewVaultSharePriceSnapshot("usdx", snapshotTime, sdk.MustNewDecFromStr("1.01")),
+			},
+			contains: "duplicate share price snapshot for usdx",
+		},
+		{
+			name: "invalid - invalid denom",
+			snapshots: types.VaultSharePriceSnapshots{
+				types.NewVaultSharePriceSnapshot("", snapshotTime, sdk.OneDec()),
+			},
+			contains: "invalid denom",
+		},
+		{
+			name: "invalid - zero time",
+			snapshots: types.VaultSharePriceSnapshots{
+				types.NewVaultSharePriceSnapshot("usdx", time.Time{}, sdk.OneDec()),
+			},
+			contains: "share price snapshot time is zero",
+		},
+		{
+			name: "invalid - negative share price",
+			snapshots: types.VaultSharePriceSnapshots{
+				types.NewVaultSharePriceSnapshot("usdx", snapshotTime, sdk.NewDec(-1)),
+			},
+			contains: "share price must be non-negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.snapshots.Validate()
+
+			if test.contains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.contains)
+			}
+		})
+	}
+}
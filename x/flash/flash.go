@@ -0,0 +1,84 @@
+// Package flash holds the msg allowlist and dispatch shared by msgs that execute other msgs with funds that must be
+// returned in the same msg, such as cdp flash mints and hard flash loans. It is not a module.
+package flash
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AllowedMsgTypes are the type urls of the msgs that can be executed within a flash mint or flash loan.
+// Msgs that execute other msgs, such as flash mints, flash loans and authz execs, are never allowed.
+var AllowedMsgTypes = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/fury.cdp.v1beta1.MsgCreateCDP",
+	"/fury.cdp.v1beta1.MsgDeposit",
+	"/fury.cdp.v1beta1.MsgWithdraw",
+	"/fury.cdp.v1beta1.MsgDrawDebt",
+	"/fury.cdp.v1beta1.MsgRepayDebt",
+	"/fury.cdp.v1beta1.MsgLiquidate",
+	"/fury.hard.v1beta1.MsgDeposit",
+	"/fury.hard.v1beta1.MsgWithdraw",
+	"/fury.hard.v1beta1.MsgRepay",
+	"/fury.hard.v1beta1.MsgLiquidate",
+	"/fury.hard.v1beta1.MsgPartialLiquidate",
+	"/fury.swap.v1beta1.MsgDeposit",
+	"/fury.swap.v1beta1.MsgWithdraw",
+	"/fury.swap.v1beta1.MsgSwapExactForTokens",
+	"/fury.swap.v1beta1.MsgSwapForExactTokens",
+}
+
+// MsgExecutor is implemented by msgs that execute other msgs, such as flash mints, flash loans and authz execs.
+type MsgExecutor interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// ValidateMsg returns an error if a msg cannot be executed within a flash mint or flash loan of the sender
+func ValidateMsg(msg sdk.Msg, sender sdk.AccAddress) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	// checked separately from the allowed types so flash msgs can never contain another flash msg at any depth
+	if _, ok := msg.(MsgExecutor); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "flash executed messages cannot execute other messages: %s", typeURL)
+	}
+	if !isAllowedMsgType(typeURL) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg type %s cannot be executed within a flash mint or flash loan", typeURL)
+	}
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sender) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "flash executed messages must only be signed by the sender %s", sender)
+	}
+	return nil
+}
+
+// DispatchMsgs validates, routes and executes the msgs wrapped by a flash mint or flash loan of the sender
+func DispatchMsgs(ctx sdk.Context, router *baseapp.MsgServiceRouter, sender sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		if err := ValidateMsg(msg, sender); err != nil {
+			return nil, err
+		}
+
+		handler := router.Handler(msg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d", i)
+		}
+		results[i] = res.Data
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	return results, nil
+}
+
+func isAllowedMsgType(typeURL string) bool {
+	for _, allowed := range AllowedMsgTypes {
+		if typeURL == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -5,7 +5,8 @@ import (
 	"github.com/mage-coven/fury/x/hard/keeper"
 )
 
-// BeginBlocker updates interest rates
+// BeginBlocker updates interest rates and liquidates borrowers outside of the valid LTV range
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.ApplyInterestRateUpdates(ctx)
+	k.LiquidateBorrowers(ctx)
 }
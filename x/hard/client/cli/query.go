@@ -19,6 +19,11 @@ const (
 	flagName  = "name"
 	flagDenom = "denom"
 	flagOwner = "owner"
+
+	flagDelegator  = "delegator"
+	flagDelegatee  = "delegatee"
+	flagOnBehalfOf = "on-behalf-of"
+	flagReceipt    = "receipt"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -41,6 +46,11 @@ func GetQueryCmd() *cobra.Command {
 		queryUnsyncedBorrowsCmd(),
 		queryTotalBorrowedCmd(),
 		queryInterestRateCmd(),
+		querySupplyLimitsCmd(),
+		queryIsolatedDebtsCmd(),
+		queryCreditDelegationsCmd(),
+		queryAccountHealthCmd(),
+		queryLiquidationCandidatesCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
 	}
@@ -461,6 +471,193 @@ func queryInterestRateCmd() *cobra.Command {
 	return cmd
 }
 
+func querySupplyLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-limits",
+		Short: "get money market supply limits",
+		Long:  "get the maximum amount of each money market asset that can be deposited",
+		Example: fmt.Sprintf(`%[1]s q %[2]s supply-limits
+%[1]s q %[2]s supply-limits --denom bnb`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyLimits(context.Background(), &types.QuerySupplyLimitsRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "(optional) filter supply limits by denom")
+
+	return cmd
+}
+
+func queryIsolatedDebtsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "isolated-debts",
+		Short: "get the debt backed by isolated assets",
+		Long:  "get the USD value of debt backed by each isolated money market asset and its debt ceiling",
+		Example: fmt.Sprintf(`%[1]s q %[2]s isolated-debts
+%[1]s q %[2]s isolated-debts --denom bnb`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IsolatedDebts(context.Background(), &types.QueryIsolatedDebtsRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "(optional) filter isolated debts by denom")
+
+	return cmd
+}
+
+func queryCreditDelegationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-delegations",
+		Short: "query hard module credit delegations with optional filters",
+		Long:  "query for all hard module credit delegations or the credit delegations of a delegator or delegatee using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s credit-delegations
+%[1]s q %[2]s credit-delegations --delegator fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+%[1]s q %[2]s credit-delegations --delegatee fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := cmd.Flags().GetString(flagDelegator)
+			if err != nil {
+				return err
+			}
+			delegatee, err := cmd.Flags().GetString(flagDelegatee)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CreditDelegations(context.Background(), &types.QueryCreditDelegationsRequest{
+				Delegator:  delegator,
+				Delegatee:  delegatee,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit delegations")
+
+	cmd.Flags().String(flagDelegator, "", "(optional) filter for credit delegations by delegator address")
+	cmd.Flags().String(flagDelegatee, "", "(optional) filter for credit delegations by delegatee address")
+
+	return cmd
+}
+
+func queryAccountHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "account-health [owner]",
+		Short:   "query the health of a hard account",
+		Long:    "query the loan-to-value, borrow limit used, liquidation price of each collateral and maximum withdrawal of each denom of a hard account at current prices",
+		Example: fmt.Sprintf(`%[1]s q %[2]s account-health fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountHealth(context.Background(), &types.QueryAccountHealthRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryLiquidationCandidatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "liquidation-candidates",
+		Short:   "query the health of hard borrowers that can be liquidated",
+		Long:    "query the health of hard borrowers that can be liquidated at current prices, ordered from the lowest health factor",
+		Example: fmt.Sprintf(`%[1]s q %[2]s liquidation-candidates --limit 10`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidationCandidates(context.Background(), &types.QueryLiquidationCandidatesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "liquidation candidates")
+
+	return cmd
+}
+
 func queryReserves() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reserves",
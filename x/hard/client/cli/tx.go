@@ -31,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdPartialLiquidate(),
+		getCmdSetEfficiencyCategory(),
+		getCmdGrantCreditDelegation(),
+		getCmdRevokeCreditDelegation(),
 	}
 
 	for _, cmd := range cmds {
@@ -43,12 +47,13 @@ func GetTxCmd() *cobra.Command {
 }
 
 func getCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [amount]",
 		Short: "deposit coins to hard",
-		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000bnb --from <key>`, version.AppName, types.ModuleName,
-		),
+		Long:  strings.TrimSpace(`deposit coins to hard with optional --receipt param to receive transferable hard/<denom> receipt tokens instead of a deposit that can be used as collateral`),
+		Example: fmt.Sprintf(`
+%[1]s tx %[2]s deposit 10000000bnb --from <key>
+%[1]s tx %[2]s deposit 10000000bnb --receipt --from <key>`, version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -59,13 +64,22 @@ func getCmdDeposit() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			receipt, err := cmd.Flags().GetBool(flagReceipt)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), amount)
+			msg.Receipt = receipt
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagReceipt, false, "mint hard/<denom> receipt tokens instead of recording a deposit")
+
+	return cmd
 }
 
 func getCmdWithdraw() *cobra.Command {
@@ -74,7 +88,7 @@ func getCmdWithdraw() *cobra.Command {
 		Short: "withdraw coins from hard",
 		Args:  cobra.ExactArgs(1),
 		Example: fmt.Sprintf(
-			`%s tx %s withdraw 10000000bnb --from <key>`, version.AppName, types.ModuleName,
+			`%s tx %s withdraw 10000000bnb,5000000hard/bnb --from <key>`, version.AppName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -96,14 +110,14 @@ func getCmdWithdraw() *cobra.Command {
 }
 
 func getCmdBorrow() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "borrow [amount]",
 		Short: "borrow tokens from the hard protocol",
-		Long:  strings.TrimSpace(`borrows tokens from the hard protocol`),
+		Long:  strings.TrimSpace(`borrows tokens from the hard protocol with optional --on-behalf-of param to borrow against another account's deposit using its credit delegation`),
 		Args:  cobra.ExactArgs(1),
-		Example: fmt.Sprintf(
-			`%s tx %s borrow 1000000000ufury --from <key>`, version.AppName, types.ModuleName,
-		),
+		Example: fmt.Sprintf(`
+%[1]s tx %[2]s borrow 1000000000ufury --from <key>
+%[1]s tx %[2]s borrow 1000000000ufury --on-behalf-of <delegator-address> --from <key>`, version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -115,13 +129,29 @@ func getCmdBorrow() *cobra.Command {
 				return err
 			}
 
+			onBehalfOfStr, err := cmd.Flags().GetString(flagOnBehalfOf)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgBorrow(clientCtx.GetFromAddress(), coins)
+			if len(onBehalfOfStr) > 0 {
+				onBehalfOf, err := sdk.AccAddressFromBech32(onBehalfOfStr)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgBorrowOnBehalfOf(clientCtx.GetFromAddress(), onBehalfOf, coins)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagOnBehalfOf, "", "delegator's address whose deposit the borrow is against, the debt is recorded on its borrow")
+
+	return cmd
 }
 
 func getCmdRepay() *cobra.Command {
@@ -203,3 +233,131 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdPartialLiquidate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "partial-liquidate [borrower-addr] [repay] [collateral-denom]",
+		Short: "repay part of the debt of a borrower that's over their liquidation threshold for their collateral at a discount",
+		Long: strings.TrimSpace(`repay part of the debt of a borrower that's over their liquidation threshold and receive their collateral
+worth the repaid debt plus the collateral market's liquidation bonus. The repayment is capped at the close factor of the debt.`),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(
+			`%s tx %s partial-liquidate fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j 1000000usdx ufury --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			repay, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPartialLiquidate(clientCtx.GetFromAddress(), borrower, repay, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetEfficiencyCategory() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-efficiency-category [category]",
+		Short: "opt into an efficiency category, or out of the current one if no category is given",
+		Long: strings.TrimSpace(`opt into an efficiency category of correlated assets to borrow them against each other with higher limits.
+Accounts in a category can only borrow assets in the category. Omit the category to opt out.`),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s set-efficiency-category stablecoins --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var category string
+			if len(args) > 0 {
+				category = args[0]
+			}
+
+			msg := types.NewMsgSetEfficiencyCategory(clientCtx.GetFromAddress(), category)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdGrantCreditDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-credit-delegation [delegatee-addr] [allowance]",
+		Short: "allow another account to borrow against your deposit",
+		Long: strings.TrimSpace(`allows another account to borrow up to an allowance of each denom against your deposit, replacing any
+existing allowance. The debt is recorded on your borrow.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s grant-credit-delegation fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000000usdx,100000000bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantCreditDelegation(clientCtx.GetFromAddress(), delegatee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdRevokeCreditDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-credit-delegation [delegatee-addr]",
+		Short: "remove another account's allowance to borrow against your deposit",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s revoke-credit-delegation fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeCreditDelegation(clientCtx.GetFromAddress(), delegatee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
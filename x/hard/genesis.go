@@ -39,6 +39,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, aec := range gs.AccountEfficiencyCategories {
+		k.SetAccountEfficiencyCategory(ctx, aec.Owner, aec.Category)
+	}
+
+	for _, id := range gs.IsolatedDebts {
+		k.SetIsolatedDebt(ctx, id.Denom, id.Principal)
+	}
+
+	for _, ar := range gs.AdaptiveRates {
+		k.SetAdaptiveRate(ctx, ar.Denom, ar.RateAtTarget)
+	}
+
+	for _, cd := range gs.CreditDelegations {
+		k.SetCreditDelegation(ctx, cd)
+	}
+
+	// borrowers are indexed once their efficiency category is known
+	for _, borrow := range gs.Borrows {
+		k.UpdateLtvIndex(ctx, borrow.Borrower)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
@@ -109,8 +130,36 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 
 	}
+	// accounts in categories removed by governance are exported without a category
+	aecs := types.AccountEfficiencyCategories{}
+	k.IterateAccountEfficiencyCategories(ctx, func(owner sdk.AccAddress, category string) bool {
+		if _, found := params.EfficiencyCategories.Get(category); found {
+			aecs = append(aecs, types.NewAccountEfficiencyCategory(owner, category))
+		}
+		return false
+	})
+
+	isolatedDebts := types.IsolatedDebts{}
+	k.IterateIsolatedDebts(ctx, func(debt types.IsolatedDebt) bool {
+		isolatedDebts = append(isolatedDebts, debt)
+		return false
+	})
+
+	adaptiveRates := types.AdaptiveRates{}
+	k.IterateAdaptiveRates(ctx, func(denom string, rateAtTarget sdk.Dec) bool {
+		adaptiveRates = append(adaptiveRates, types.NewAdaptiveRate(denom, rateAtTarget))
+		return false
+	})
+
+	creditDelegations := types.CreditDelegations{}
+	k.IterateCreditDelegations(ctx, func(cd types.CreditDelegation) bool {
+		creditDelegations = append(creditDelegations, cd)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
+		aecs, isolatedDebts, adaptiveRates, creditDelegations,
 	)
 }
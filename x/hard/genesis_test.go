@@ -60,9 +60,18 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 				),
 				sdk.MustNewDecFromStr("0.05"),
 				sdk.ZeroDec(),
+				types.NewSupplyLimit(false, sdk.ZeroDec()),
+				types.NewIsolationMode(false, sdk.ZeroDec(), false),
+				types.DefaultLiquidationBonus,
 			),
 		},
 		sdk.NewDec(10),
+		0,
+		types.EfficiencyCategories{
+			types.NewEfficiencyCategory("fury", []string{"ufury"}, sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.85")),
+		},
+		types.DefaultFlashLoanFee,
+		types.DefaultCloseFactor,
 	)
 
 	deposits := types.Deposits{
@@ -115,6 +124,13 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalSupplied,
 		totalBorrowed,
 		sdk.Coins{},
+		types.AccountEfficiencyCategories{
+			types.NewAccountEfficiencyCategory(suite.addrs[1], "fury"),
+		},
+		types.IsolatedDebts{
+			types.NewIsolatedDebt("ufury", sdk.NewDecCoins(sdk.NewDecCoinFromDec("usdx", sdk.NewDec(20)))),
+		},
+		types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 	)
 
 	suite.NotPanics(
@@ -13,6 +13,12 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	return k.BorrowOnBehalfOf(ctx, borrower, borrower, coins)
+}
+
+// BorrowOnBehalfOf borrows funds against the deposit of an account, which owes the debt, and sends them to the borrower.
+// Borrowing on behalf of another account spends the borrower's credit delegation from it.
+func (k Keeper) BorrowOnBehalfOf(ctx sdk.Context, borrower, onBehalfOf sdk.AccAddress, coins sdk.Coins) error {
 	// Set any new denoms' global borrow index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
@@ -25,20 +31,20 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	// Call incentive hooks
-	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, borrower)
+	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, onBehalfOf)
 	if hasExistingDeposit {
 		k.BeforeDepositModified(ctx, existingDeposit)
 	}
-	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, borrower)
+	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, onBehalfOf)
 	if hasExistingBorrow {
 		k.BeforeBorrowModified(ctx, existingBorrow)
 	}
 
-	k.SyncSupplyInterest(ctx, borrower)
-	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, onBehalfOf)
+	k.SyncBorrowInterest(ctx, onBehalfOf)
 
 	// Validate borrow amount within user and protocol limits
-	err := k.ValidateBorrow(ctx, borrower, coins)
+	err := k.ValidateBorrow(ctx, borrower, onBehalfOf, coins)
 	if err != nil {
 		return err
 	}
@@ -63,7 +69,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	interestFactors := types.BorrowInterestFactors{}
-	currBorrow, foundBorrow := k.GetBorrow(ctx, borrower)
+	currBorrow, foundBorrow := k.GetBorrow(ctx, onBehalfOf)
 	if foundBorrow {
 		interestFactors = currBorrow.Index
 	}
@@ -83,7 +89,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	// Construct the user's new/updated borrow with amount and interest factors
-	borrow := types.NewBorrow(borrower, amount, interestFactors)
+	borrow := types.NewBorrow(onBehalfOf, amount, interestFactors)
 	if borrow.Amount.Empty() {
 		k.DeleteBorrow(ctx, borrow)
 	} else {
@@ -93,30 +99,43 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	// Update total borrowed amount by newly borrowed coins. Don't add user's pending interest as
 	// it has already been included in the total borrowed coins by the BeginBlocker.
 	k.IncrementBorrowedCoins(ctx, coins)
+	k.IncrementIsolatedDebt(ctx, onBehalfOf, coins)
 
 	if !hasExistingBorrow {
 		k.AfterBorrowCreated(ctx, borrow)
 	} else {
 		k.AfterBorrowModified(ctx, borrow)
 	}
+	k.UpdateLtvIndex(ctx, onBehalfOf)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeHardBorrow,
-			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
-			sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeHardBorrow,
+		sdk.NewAttribute(types.AttributeKeyBorrower, onBehalfOf.String()),
+		sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
 	)
+	if !borrower.Equals(onBehalfOf) {
+		k.spendCreditAllowance(ctx, onBehalfOf, borrower, coins)
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyDelegatee, borrower.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
 
 	return nil
 }
 
-// ValidateBorrow validates a borrow request against borrower and protocol requirements
-func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins) error {
+// ValidateBorrow validates a borrow request against borrower and protocol requirements. The borrow is validated against
+// the deposit and existing borrow of the account it is on behalf of, which must have granted the borrower a credit
+// delegation covering the amount if it is another account.
+func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower, onBehalfOf sdk.AccAddress, amount sdk.Coins) error {
 	if amount.IsZero() {
 		return types.ErrBorrowEmptyCoins
 	}
 
+	if !borrower.Equals(onBehalfOf) {
+		if err := k.validateCreditAllowance(ctx, onBehalfOf, borrower, amount); err != nil {
+			return err
+		}
+	}
+
 	// The reserve coins aren't available for users to borrow
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
@@ -132,6 +151,16 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		return errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s", amount, fundsAvailableToBorrow)
 	}
 
+	// Accounts in an efficiency category can only borrow assets in the category
+	category, hasCategory := k.GetEfficiencyCategory(ctx, onBehalfOf)
+	if hasCategory {
+		for _, coin := range amount {
+			if !category.HasDenom(coin.Denom) {
+				return errorsmod.Wrapf(types.ErrInvalidEfficiencyCategoryBorrow, "%s is not in efficiency category %s", coin.Denom, category.Name)
+			}
+		}
+	}
+
 	// Get the proposed borrow USD value
 	proprosedBorrowUSDValue := sdk.ZeroDec()
 	for _, coin := range amount {
@@ -167,10 +196,19 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	}
 
 	// Get the total borrowable USD amount at user's existing deposits
-	deposit, found := k.GetDeposit(ctx, borrower)
+	deposit, found := k.GetDeposit(ctx, onBehalfOf)
 	if !found {
-		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
+		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", onBehalfOf)
 	}
+
+	// Accounts with isolated collateral can only borrow assets borrowable in isolation, up to the debt ceiling
+	isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, deposit.Amount)
+	if isIsolated {
+		if err := k.validateIsolatedBorrow(ctx, isolatedMarket, amount, proprosedBorrowUSDValue); err != nil {
+			return err
+		}
+	}
+
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -184,13 +222,17 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		loanToValue := moneyMarket.BorrowLimit.LoanToValue
+		if hasCategory && category.HasDenom(coin.Denom) {
+			loanToValue = category.LoanToValue
+		}
+		borrowableAmountForDeposit := depositUSDValue.Mul(loanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
 	// Get the total USD value of user's existing borrows
 	existingBorrowUSDValue := sdk.ZeroDec()
-	existingBorrow, found := k.GetBorrow(ctx, borrower)
+	existingBorrow, found := k.GetBorrow(ctx, onBehalfOf)
 	if found {
 		for _, coin := range existingBorrow.Amount {
 			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -313,16 +313,20 @@ func (suite *KeeperTestSuite) TestBorrow() {
 			// hard module genesis state
 			hardGS := types.NewGenesisState(types.NewParams(
 				types.MoneyMarkets{
-					types.NewMoneyMarket("usdx", types.NewBorrowLimit(true, tc.args.usdxBorrowLimit, sdk.MustNewDecFromStr("1")), "usdx:usd", sdkmath.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("busd", types.NewBorrowLimit(false, sdk.NewDec(100000000*BUSD_CF), sdk.MustNewDecFromStr("1")), "busd:usd", sdkmath.NewInt(BUSD_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("ufury", types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), tc.args.loanToValueFURY), "fury:usd", sdkmath.NewInt(FURY_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("btcb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BTCB_CF), tc.args.loanToValueBTCB), "btcb:usd", sdkmath.NewInt(BTCB_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), tc.args.loanToValueBNB), "bnb:usd", sdkmath.NewInt(BNB_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.args.loanToValueBNB), "xyz:usd", sdkmath.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+					types.NewMoneyMarket("usdx", types.NewBorrowLimit(true, tc.args.usdxBorrowLimit, sdk.MustNewDecFromStr("1")), "usdx:usd", sdkmath.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+					types.NewMoneyMarket("busd", types.NewBorrowLimit(false, sdk.NewDec(100000000*BUSD_CF), sdk.MustNewDecFromStr("1")), "busd:usd", sdkmath.NewInt(BUSD_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+					types.NewMoneyMarket("ufury", types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), tc.args.loanToValueFURY), "fury:usd", sdkmath.NewInt(FURY_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+					types.NewMoneyMarket("btcb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BTCB_CF), tc.args.loanToValueBTCB), "btcb:usd", sdkmath.NewInt(BTCB_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+					types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), tc.args.loanToValueBNB), "bnb:usd", sdkmath.NewInt(BNB_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.args.loanToValueBNB), "xyz:usd", sdkmath.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
 				},
 				sdk.NewDec(10),
+				0,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 			)
 
 			// Pricefeed module genesis state
@@ -456,20 +460,26 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 			types.MoneyMarkets{
 				types.NewMoneyMarket("usdx",
 					types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
-					"usdx:usd",                     // Market ID
-					sdkmath.NewInt(USDX_CF),        // Conversion Factor
-					model,                          // Interest Rate Model
-					sdk.MustNewDecFromStr("1.0"),   // Reserve Factor (high)
-					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+					"usdx:usd",                    // Market ID
+					sdkmath.NewInt(USDX_CF),       // Conversion Factor
+					model,                         // Interest Rate Model
+					sdk.MustNewDecFromStr("1.0"),  // Reserve Factor (high)
+					sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+					types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 				types.NewMoneyMarket("ufury",
 					types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-					"fury:usd",                     // Market ID
-					sdkmath.NewInt(FURY_CF),        // Conversion Factor
-					model,                          // Interest Rate Model
-					sdk.MustNewDecFromStr("1.0"),   // Reserve Factor (high)
-					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+					"fury:usd",                    // Market ID
+					sdkmath.NewInt(FURY_CF),       // Conversion Factor
+					model,                         // Interest Rate Model
+					sdk.MustNewDecFromStr("1.0"),  // Reserve Factor (high)
+					sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+					types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 			},
 			sdk.NewDec(10),
+			0,
+			nil,
+			types.DefaultFlashLoanFee,
+			types.DefaultCloseFactor,
 		),
 		types.DefaultAccumulationTimes,
 		types.DefaultDeposits,
@@ -477,6 +487,9 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 		types.DefaultTotalSupplied,
 		types.DefaultTotalBorrowed,
 		types.DefaultTotalReserves,
+		types.DefaultAccountEfficiencyCategories,
+		types.DefaultIsolatedDebts,
+		types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 	)
 
 	// Pricefeed module genesis state
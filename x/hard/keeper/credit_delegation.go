@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// GrantCreditDelegation allows a delegatee to borrow up to an allowance against the delegator's deposit, replacing any
+// existing allowance. The debt is recorded on the delegator's borrow.
+func (k Keeper) GrantCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress, allowance sdk.Coins) error {
+	creditDelegation := types.NewCreditDelegation(delegator, delegatee, allowance)
+	if err := creditDelegation.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, coin := range allowance {
+		if _, found := k.GetMoneyMarket(ctx, coin.Denom); !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+	}
+	k.SetCreditDelegation(ctx, creditDelegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardGrantCreditDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeCreditDelegation removes a delegatee's allowance to borrow against the delegator's deposit. Debt already
+// borrowed by the delegatee remains on the delegator's borrow.
+func (k Keeper) RevokeCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress) error {
+	if _, found := k.GetCreditDelegation(ctx, delegator, delegatee); !found {
+		return errorsmod.Wrapf(types.ErrCreditDelegationNotFound, "from %s to %s", delegator, delegatee)
+	}
+	k.DeleteCreditDelegation(ctx, delegator, delegatee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardRevokeCreditDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee.String()),
+		),
+	)
+	return nil
+}
+
+// validateCreditAllowance validates that a delegatee's credit delegation from a delegator covers a borrow
+func (k Keeper) validateCreditAllowance(ctx sdk.Context, delegator, delegatee sdk.AccAddress, amount sdk.Coins) error {
+	creditDelegation, found := k.GetCreditDelegation(ctx, delegator, delegatee)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCreditDelegationNotFound, "from %s to %s", delegator, delegatee)
+	}
+	if !creditDelegation.Allowance.IsAllGTE(amount) {
+		return errorsmod.Wrapf(types.ErrExceedsCreditAllowance, "requested borrow %s > allowance %s", amount, creditDelegation.Allowance)
+	}
+	return nil
+}
+
+// spendCreditAllowance reduces a delegatee's credit delegation from a delegator by a borrowed amount, deleting the
+// credit delegation once the allowance is used up.
+func (k Keeper) spendCreditAllowance(ctx sdk.Context, delegator, delegatee sdk.AccAddress, amount sdk.Coins) {
+	creditDelegation, found := k.GetCreditDelegation(ctx, delegator, delegatee)
+	if !found {
+		return
+	}
+	creditDelegation.Allowance = creditDelegation.Allowance.Sub(amount...)
+	if creditDelegation.Allowance.Empty() {
+		k.DeleteCreditDelegation(ctx, delegator, delegatee)
+		return
+	}
+	k.SetCreditDelegation(ctx, creditDelegation)
+}
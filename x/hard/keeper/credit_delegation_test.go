@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard/keeper"
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestCreditDelegation() {
+	addrs := suite.setupIsolationMode()
+	delegator, delegatee := addrs[0], addrs[1]
+	bk := suite.app.GetBankKeeper()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	usdx := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(amount*USDX_CF)))
+	}
+
+	// borrowing on behalf of another account requires its credit delegation
+	_, err := msgServer.Borrow(sdk.WrapSDKContext(suite.ctx), &types.MsgBorrow{
+		Borrower:   delegatee.String(),
+		Amount:     usdx(100),
+		OnBehalfOf: delegator.String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, delegator, delegatee, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100))))
+	suite.Require().ErrorIs(err, types.ErrMarketNotFound)
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, delegator, delegatee, usdx(300))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.BorrowOnBehalfOf(suite.ctx, delegatee, delegator, usdx(400))
+	suite.Require().ErrorIs(err, types.ErrExceedsCreditAllowance)
+
+	// the delegatee receives the coins and the debt is recorded on the delegator's borrow
+	_, err = msgServer.Borrow(sdk.WrapSDKContext(suite.ctx), &types.MsgBorrow{
+		Borrower:   delegatee.String(),
+		Amount:     usdx(200),
+		OnBehalfOf: delegator.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(1200*USDX_CF), bk.GetBalance(suite.ctx, delegatee, "usdx").Amount)
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, delegator)
+	suite.Require().True(found)
+	suite.Equal(usdx(200), borrow.Amount)
+	_, found = suite.keeper.GetBorrow(suite.ctx, delegatee)
+	suite.False(found)
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper, suite.app.GetAccountKeeper(), suite.app.GetBankKeeper())
+	res, err := queryServer.CreditDelegations(sdk.WrapSDKContext(suite.ctx), &types.QueryCreditDelegationsRequest{Delegatee: delegatee.String()})
+	suite.Require().NoError(err)
+	suite.Equal(types.CreditDelegationResponses{
+		types.NewCreditDelegationResponse(delegator, delegatee, usdx(100)),
+	}, res.CreditDelegations)
+
+	// the credit delegation is removed once the allowance is used up
+	err = suite.keeper.BorrowOnBehalfOf(suite.ctx, delegatee, delegator, usdx(100))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetCreditDelegation(suite.ctx, delegator, delegatee)
+	suite.False(found)
+
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, delegator, delegatee, usdx(50))
+	suite.Require().NoError(err)
+	err = suite.keeper.RevokeCreditDelegation(suite.ctx, delegator, delegatee)
+	suite.Require().NoError(err)
+	err = suite.keeper.BorrowOnBehalfOf(suite.ctx, delegatee, delegator, usdx(50))
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+	err = suite.keeper.RevokeCreditDelegation(suite.ctx, delegator, delegatee)
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+}
@@ -37,6 +37,10 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 	if err != nil {
 		return err
 	}
+	err = k.ValidateIsolatedDeposit(ctx, depositor, coins)
+	if err != nil {
+		return err
+	}
 
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
@@ -87,11 +91,13 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 	}
 
 	k.IncrementSuppliedCoins(ctx, coins)
+	k.UpdateIsolatedDebtCollateral(ctx, depositor, existingDeposit.Amount)
 	if !foundDeposit { // User's first deposit
 		k.AfterDepositCreated(ctx, deposit)
 	} else {
 		k.AfterDepositModified(ctx, deposit)
 	}
+	k.UpdateLtvIndex(ctx, depositor)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -107,10 +113,21 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 // ValidateDeposit validates a deposit
 func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 	for _, depCoin := range coins {
-		_, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
+		moneyMarket, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
 		if !foundMm {
 			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", depCoin.Denom)
 		}
+
+		// Validate the requested deposit amount for the asset against the money market's global supply limit
+		if moneyMarket.SupplyLimit.HasMaxLimit {
+			suppliedCoins, _ := k.GetSuppliedCoins(ctx)
+			newProposedAssetTotalSuppliedAmount := sdk.NewDecFromInt(suppliedCoins.AmountOf(depCoin.Denom).Add(depCoin.Amount))
+			if newProposedAssetTotalSuppliedAmount.GT(moneyMarket.SupplyLimit.MaximumLimit) {
+				return errorsmod.Wrapf(types.ErrGreaterThanAssetSupplyLimit,
+					"proposed deposit would result in %s supplied, but the maximum global asset supply limit is %s",
+					newProposedAssetTotalSuppliedAmount, moneyMarket.SupplyLimit.MaximumLimit)
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// SetEfficiencyCategory opts an account into an efficiency category, or out of its current category when the name is empty.
+// All of the account's existing borrows must be in the new category, and the account must remain within the valid LTV range.
+func (k Keeper) SetEfficiencyCategory(ctx sdk.Context, owner sdk.AccAddress, name string) error {
+	borrow, hasBorrow := k.GetBorrow(ctx, owner)
+
+	// update the category on a cache so an invalid position leaves no state changes
+	cacheCtx, writeCache := ctx.CacheContext()
+	if name == "" {
+		k.DeleteAccountEfficiencyCategory(cacheCtx, owner)
+	} else {
+		category, found := k.GetParams(ctx).EfficiencyCategories.Get(name)
+		if !found {
+			return errorsmod.Wrapf(types.ErrEfficiencyCategoryNotFound, "%s", name)
+		}
+		if hasBorrow {
+			for _, coin := range borrow.Amount {
+				if !category.HasDenom(coin.Denom) {
+					return errorsmod.Wrapf(types.ErrInvalidEfficiencyCategoryBorrow, "existing borrow of %s is not in efficiency category %s", coin.Denom, name)
+				}
+			}
+		}
+		k.SetAccountEfficiencyCategory(cacheCtx, owner, name)
+	}
+
+	if hasBorrow {
+		// the position is checked with its accrued interest
+		deposit, hasDeposit := k.GetDeposit(cacheCtx, owner)
+		if hasDeposit {
+			k.BeforeDepositModified(cacheCtx, deposit)
+		}
+		k.BeforeBorrowModified(cacheCtx, borrow)
+		k.SyncBorrowInterest(cacheCtx, owner)
+		k.SyncSupplyInterest(cacheCtx, owner)
+		deposit, hasDeposit = k.GetDeposit(cacheCtx, owner)
+		if hasDeposit {
+			k.AfterDepositModified(cacheCtx, deposit)
+		}
+		borrow, _ = k.GetBorrow(cacheCtx, owner)
+		k.AfterBorrowModified(cacheCtx, borrow)
+
+		valid, err := k.IsWithinValidLtvRange(cacheCtx, deposit, borrow)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "existing borrow exceeds the allowable amount in efficiency category '%s'", name)
+		}
+		k.UpdateLtvIndex(cacheCtx, owner)
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetEfficiencyCategory,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyEfficiencyCategory, name),
+		),
+	)
+	return nil
+}
+
+// GetEfficiencyCategory returns the efficiency category an account has opted into.
+// Accounts in a category that has since been removed from the params have no category.
+func (k Keeper) GetEfficiencyCategory(ctx sdk.Context, owner sdk.AccAddress) (types.EfficiencyCategory, bool) {
+	if owner.Empty() {
+		return types.EfficiencyCategory{}, false
+	}
+	name, found := k.GetAccountEfficiencyCategory(ctx, owner)
+	if !found {
+		return types.EfficiencyCategory{}, false
+	}
+	return k.GetParams(ctx).EfficiencyCategories.Get(name)
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mage-coven/fury/app"
+	"github.com/mage-coven/fury/x/hard"
+	"github.com/mage-coven/fury/x/hard/types"
+	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) setupEfficiencyCategories() (borrower, lender sdk.AccAddress) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	borrower, lender = addrs[0], addrs[1]
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		sdk.NewCoins(
+			sdk.NewCoin("ufury", sdkmath.NewInt(1000*FURY_CF)),
+			sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)),
+			sdk.NewCoin("busd", sdkmath.NewInt(1000*BUSD_CF)),
+		),
+		addrs,
+	)
+
+	model := types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.NewDec(5))
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+				types.NewMoneyMarket("busd", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "busd:usd", sdkmath.NewInt(BUSD_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+				types.NewMoneyMarket("ufury", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "fury:usd", sdkmath.NewInt(FURY_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+			},
+			sdk.NewDec(10),
+			0,
+			types.EfficiencyCategories{
+				types.NewEfficiencyCategory("stables", []string{"usdx", "busd"}, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.97")),
+			},
+			types.DefaultFlashLoanFee,
+			types.DefaultCloseFactor,
+		),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "fury:usd", BaseAsset: "fury", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.OneDec(), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "busd:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.OneDec(), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "fury:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.NewDec(2), Expiry: time.Now().Add(time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	// Run BeginBlocker once to transition MoneyMarkets
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)), sdk.NewCoin("ufury", sdkmath.NewInt(1000*FURY_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(100*BUSD_CF))))
+	suite.Require().NoError(err)
+	return borrower, lender
+}
+
+func (suite *KeeperTestSuite) TestSetEfficiencyCategory() {
+	borrower, _ := suite.setupEfficiencyCategories()
+
+	// without a category the market's loan-to-value applies
+	err := suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(90*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "unknown")
+	suite.Require().ErrorIs(err, types.ErrEfficiencyCategoryNotFound)
+
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "stables")
+	suite.Require().NoError(err)
+	category, found := suite.keeper.GetEfficiencyCategory(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal("stables", category.Name)
+
+	// the category's loan-to-value applies to deposits in the category
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// assets outside of the category cannot be borrowed
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(1*FURY_CF))))
+	suite.Require().ErrorIs(err, types.ErrInvalidEfficiencyCategoryBorrow)
+
+	// opting out fails while the position relies on the category
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "")
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	_, found = suite.keeper.GetAccountEfficiencyCategory(suite.ctx, borrower)
+	suite.Require().True(found)
+
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "")
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetAccountEfficiencyCategory(suite.ctx, borrower)
+	suite.Require().False(found)
+
+	// borrowers of assets outside a category cannot opt into it
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(1*FURY_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "stables")
+	suite.Require().ErrorIs(err, types.ErrInvalidEfficiencyCategoryBorrow)
+}
+
+func (suite *KeeperTestSuite) TestSetEfficiencyCategory_AccruedInterest() {
+	borrower, _ := suite.setupEfficiencyCategories()
+
+	err := suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "stables")
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(80*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// the borrow is at the market's loan-to-value until interest accrues
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err = suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "")
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	_, found := suite.keeper.GetAccountEfficiencyCategory(suite.ctx, borrower)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestEfficiencyCategoryLiquidationThreshold() {
+	borrower, keeperAddr := suite.setupEfficiencyCategories()
+
+	err := suite.keeper.SetEfficiencyCategory(suite.ctx, borrower, "stables")
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(90*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// the position exceeds the category's loan-to-value, but not its liquidation threshold
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.935"))
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, borrower)
+	borrow, _ := suite.keeper.GetBorrow(suite.ctx, borrower)
+	valid, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.False(valid)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeperAddr, borrower)
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.92"))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeperAddr, borrower)
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) setPrice(marketID string, price sdk.Dec) {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, marketID, price, suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, marketID))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/flash"
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// FlashLoan sends coins from the hard module account to the borrower, executes the input messages and then takes the
+// coins back from the borrower. A fee of the loaned amount times the flash loan fee param is also taken from the borrower
+// and added to the total reserves. The whole message fails if the borrower cannot repay the amount plus fee.
+func (k Keeper) FlashLoan(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) ([][]byte, error) {
+	if amount.IsZero() {
+		return nil, types.ErrBorrowEmptyCoins
+	}
+	for _, coin := range amount {
+		if _, found := k.GetMoneyMarket(ctx, coin.Denom); !found {
+			return nil, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+	}
+
+	// The reserve coins aren't available for users to borrow
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
+	if !foundReserveCoins {
+		reserveCoins = sdk.NewCoins()
+	}
+	fundsAvailableToBorrow, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
+	if isNegative {
+		return nil, errorsmod.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
+	}
+	if amount.IsAnyGT(fundsAvailableToBorrow) {
+		return nil, errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested flash loan %s > available to borrow %s", amount, fundsAvailableToBorrow)
+	}
+
+	feeRate := k.GetParams(ctx).FlashLoanFee
+	fee := sdk.NewCoins()
+	for _, coin := range amount {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(feeRate).Ceil().TruncateInt()))
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := flash.DispatchMsgs(ctx, k.router, borrower, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// take back the loan and fee, the fee is kept as protocol reserves
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, amount.Add(fee...))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrFlashLoanNotRepaid, err.Error())
+	}
+	if !fee.IsZero() {
+		reserveCoins, _ = k.GetTotalReserves(ctx)
+		k.SetTotalReserves(ctx, reserveCoins.Add(fee...))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashLoan,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fee.String()),
+		),
+	)
+	return results, nil
+}
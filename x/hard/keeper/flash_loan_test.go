@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashLoan() {
+	addrs := suite.setupIsolationMode()
+	borrower := addrs[1]
+	bk := suite.app.GetBankKeeper()
+
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500*USDX_CF)))
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(borrower, borrower, amount),
+	}
+	results, err := suite.keeper.FlashLoan(suite.ctx, borrower, amount, msgs)
+	suite.Require().NoError(err)
+	suite.Len(results, 1)
+
+	// the loan is repaid and the fee is added to the reserves
+	suite.Equal(sdkmath.NewInt(999_500_000), bk.GetBalance(suite.ctx, borrower, "usdx").Amount)
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500_000))), reserves)
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHardFlashLoan {
+			found = true
+		}
+	}
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestFlashLoan_NotRepaid() {
+	addrs := suite.setupIsolationMode()
+	borrower, other := addrs[1], addrs[2]
+
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500*USDX_CF)))
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(borrower, other, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1500*USDX_CF)))),
+	}
+	ctx, _ := suite.ctx.CacheContext()
+	_, err := suite.keeper.FlashLoan(ctx, borrower, amount, msgs)
+	suite.Require().ErrorIs(err, types.ErrFlashLoanNotRepaid)
+
+	// the fee alone cannot be paid either
+	msgs = []sdk.Msg{
+		banktypes.NewMsgSend(borrower, other, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)))),
+	}
+	ctx, _ = suite.ctx.CacheContext()
+	_, err = suite.keeper.FlashLoan(ctx, borrower, amount, msgs)
+	suite.Require().ErrorIs(err, types.ErrFlashLoanNotRepaid)
+}
+
+func (suite *KeeperTestSuite) TestFlashLoan_Invalid() {
+	addrs := suite.setupIsolationMode()
+	borrower := addrs[1]
+
+	selfSend := []sdk.Msg{
+		banktypes.NewMsgSend(borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1)))),
+	}
+
+	// only the available cash of a market can be loaned
+	_, err := suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1001*USDX_CF))), selfSend)
+	suite.Require().ErrorIs(err, types.ErrExceedsProtocolBorrowableBalance)
+
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("xrp", sdkmath.NewInt(1000))), selfSend)
+	suite.Require().ErrorIs(err, types.ErrMarketNotFound)
+
+	otherSigner := []sdk.Msg{
+		banktypes.NewMsgSend(addrs[2], borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1)))),
+	}
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), otherSigner)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	nested, err := types.NewMsgFlashLoan(borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), selfSend)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), []sdk.Msg{&nested})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	flashMint, err := cdptypes.NewMsgFlashMint(borrower, sdk.NewCoin("usdx", sdkmath.NewInt(1000)), selfSend)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), []sdk.Msg{&flashMint})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	exec := authz.NewMsgExec(borrower, selfSend)
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), []sdk.Msg{&exec})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	notAllowed := []sdk.Msg{
+		banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1))))},
+			[]banktypes.Output{banktypes.NewOutput(borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1))))},
+		),
+	}
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))), notAllowed)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -448,7 +451,7 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY, err := CalculateBorrowRate(s.keeper.GetInterestRateModel(sdkCtx, moneyMarket), sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
@@ -471,6 +474,227 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 	}, nil
 }
 
+func (s queryServer) SupplyLimits(ctx context.Context, req *types.QuerySupplyLimitsRequest) (*types.QuerySupplyLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var moneyMarkets types.MoneyMarkets
+	if len(req.Denom) > 0 {
+		moneyMarket, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom)
+		if !found {
+			return nil, types.ErrMoneyMarketNotFound
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	} else {
+		moneyMarkets = s.keeper.GetAllMoneyMarkets(sdkCtx)
+	}
+
+	suppliedCoins, _ := s.keeper.GetSuppliedCoins(sdkCtx)
+
+	var supplyLimits types.MoneyMarketSupplyLimits
+	for _, moneyMarket := range moneyMarkets {
+		totalSupplied := suppliedCoins.AmountOf(moneyMarket.Denom)
+		supplyLimits = append(supplyLimits, types.NewMoneyMarketSupplyLimit(moneyMarket.Denom, moneyMarket.SupplyLimit, totalSupplied))
+	}
+
+	return &types.QuerySupplyLimitsResponse{
+		SupplyLimits: supplyLimits,
+	}, nil
+}
+
+func (s queryServer) IsolatedDebts(ctx context.Context, req *types.QueryIsolatedDebtsRequest) (*types.QueryIsolatedDebtsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var moneyMarkets types.MoneyMarkets
+	if len(req.Denom) > 0 {
+		moneyMarket, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom)
+		if !found {
+			return nil, types.ErrMoneyMarketNotFound
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	} else {
+		moneyMarkets = s.keeper.GetAllMoneyMarkets(sdkCtx)
+	}
+
+	isolatedDebts := types.MoneyMarketIsolatedDebts{}
+	for _, moneyMarket := range moneyMarkets {
+		if !moneyMarket.IsolationMode.Isolated {
+			continue
+		}
+		isolatedDebt, err := s.keeper.GetIsolatedDebtUSDValue(sdkCtx, moneyMarket.Denom)
+		if err != nil {
+			return nil, err
+		}
+		isolatedDebts = append(isolatedDebts, types.NewMoneyMarketIsolatedDebt(moneyMarket.Denom, moneyMarket.IsolationMode.DebtCeiling, isolatedDebt))
+	}
+
+	return &types.QueryIsolatedDebtsResponse{
+		IsolatedDebts: isolatedDebts,
+	}, nil
+}
+
+func (s queryServer) CreditDelegations(ctx context.Context, req *types.QueryCreditDelegationsRequest) (*types.QueryCreditDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.CreditDelegationPrefix)
+	if len(req.Delegator) > 0 {
+		delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		store = prefix.NewStore(store, address.MustLengthPrefix(delegator))
+	}
+
+	var delegatee sdk.AccAddress
+	if len(req.Delegatee) > 0 {
+		var err error
+		delegatee, err = sdk.AccAddressFromBech32(req.Delegatee)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	creditDelegations := types.CreditDelegations{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var creditDelegation types.CreditDelegation
+		if err := s.keeper.cdc.Unmarshal(value, &creditDelegation); err != nil {
+			return false, err
+		}
+		if !delegatee.Empty() && !creditDelegation.Delegatee.Equals(delegatee) {
+			return false, nil
+		}
+		if accumulate {
+			creditDelegations = append(creditDelegations, creditDelegation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCreditDelegationsResponse{
+		CreditDelegations: creditDelegations.ToResponse(),
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (s queryServer) AccountHealth(ctx context.Context, req *types.QueryAccountHealthRequest) (*types.QueryAccountHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	accountHealth, err := s.keeper.GetAccountHealth(sdkCtx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountHealthResponse{
+		AccountHealth: accountHealth,
+	}, nil
+}
+
+// LiquidationCandidates returns the current health of the borrowers that can be liquidated at current prices, least
+// healthy first. Only borrowers below the LTV index thresholds are valued, and borrowers that cannot be priced are left out.
+func (s queryServer) LiquidationCandidates(ctx context.Context, req *types.QueryLiquidationCandidatesRequest) (*types.QueryLiquidationCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	thresholds := s.keeper.getLtvIndexThresholdsByPair(sdkCtx)
+	type candidate struct {
+		health       types.AccountHealth
+		healthFactor sdk.Dec
+	}
+	var candidates []candidate
+	s.keeper.IterateBorrows(sdkCtx, func(borrow types.Borrow) bool {
+		if !s.keeper.isLiquidationCandidate(sdkCtx, borrow.Borrower, thresholds) {
+			return false
+		}
+		accountHealth, err := s.keeper.GetAccountHealth(sdkCtx, borrow.Borrower)
+		if err != nil || !accountHealth.Liquidatable {
+			return false
+		}
+		candidates = append(candidates, candidate{accountHealth, sdk.MustNewDecFromStr(accountHealth.HealthFactor)})
+		return false
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].healthFactor.LT(candidates[j].healthFactor)
+	})
+
+	start, end, pageRes, err := paginateSlice(len(candidates), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	healths := types.AccountHealths{}
+	for _, c := range candidates[start:end] {
+		healths = append(healths, c.health)
+	}
+
+	return &types.QueryLiquidationCandidatesResponse{
+		Candidates: healths,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateSlice returns the bounds of the requested page of a slice of the given length. The key of the next page is
+// its offset.
+func paginateSlice(length int, pageReq *query.PageRequest) (int, int, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	offset := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		offset = sdk.BigEndianToUint64(pageReq.Key)
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(length)
+	start, end := offset, offset+limit
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+	return int(start), int(end), pageRes, nil
+}
+
 func (s queryServer) Reserves(ctx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -64,6 +64,11 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 	var expected types.GenesisState
 	defaultHARDState := NewHARDGenState(suite.tApp.AppCodec())
 	suite.tApp.AppCodec().MustUnmarshalJSON(defaultHARDState[types.ModuleName], &expected)
+	// the params store does not distinguish empty from nil lists
+	expected.Params.EfficiencyCategories = nil
+	for i := range expected.Params.MoneyMarkets {
+		expected.Params.MoneyMarkets[i].InterestRateModel.Kinks = nil
+	}
 
 	suite.Equal(expected.Params, res.Params, "params should equal test genesis state")
 }
@@ -513,6 +518,71 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryInterestFactors() {
 	}, res)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQuerySupplyLimits() {
+	moneyMarket, found := suite.keeper.GetMoneyMarket(suite.ctx, "bnb")
+	suite.Require().True(found)
+	moneyMarket.SupplyLimit = types.NewSupplyLimit(true, sdk.NewDec(150000000))
+	suite.keeper.SetMoneyMarket(suite.ctx, "bnb", moneyMarket)
+	suite.addDeposits()
+
+	tests := []struct {
+		giveName         string
+		giveDenom        string
+		wantSupplyLimits types.MoneyMarketSupplyLimits
+		shouldError      bool
+	}{
+		{
+			"limited denom",
+			"bnb",
+			types.MoneyMarketSupplyLimits{
+				{
+					Denom:             "bnb",
+					HasMaxLimit:       true,
+					MaximumLimit:      "150000000.000000000000000000",
+					TotalSupplied:     "120000000",
+					AvailableToSupply: "30000000",
+				},
+			},
+			false,
+		},
+		{
+			"unlimited denom",
+			"busd",
+			types.MoneyMarketSupplyLimits{
+				{
+					Denom:         "busd",
+					HasMaxLimit:   false,
+					MaximumLimit:  "0.000000000000000000",
+					TotalSupplied: "28000000",
+				},
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			"bun",
+			types.MoneyMarketSupplyLimits{},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.SupplyLimits(sdk.WrapSDKContext(suite.ctx), &types.QuerySupplyLimitsRequest{
+				Denom: tt.giveDenom,
+			})
+
+			if tt.shouldError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				suite.Equal(tt.wantSupplyLimits, res.SupplyLimits)
+			}
+		})
+	}
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryReserves() {
 	suite.addDeposits()
 	suite.addBorrows()
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// GetAccountHealth returns the loan-to-value, borrow limit, health factor, liquidation prices and maximum withdrawals of
+// an account's synced deposit and borrow at current prices
+func (k Keeper) GetAccountHealth(ctx sdk.Context, owner sdk.AccAddress) (types.AccountHealth, error) {
+	deposit, foundDeposit := k.GetSyncedDeposit(ctx, owner)
+	borrow, foundBorrow := k.GetSyncedBorrow(ctx, owner)
+	if !foundDeposit && !foundBorrow {
+		return types.AccountHealth{}, errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit or borrow found for %s", owner)
+	}
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return types.AccountHealth{}, err
+	}
+
+	depositValue, borrowLimit, liquidationValue := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		depositValue = depositValue.Add(usdValue)
+		borrowLimit = borrowLimit.Add(usdValue.Mul(lData.ltv))
+		liquidationValue = liquidationValue.Add(usdValue.Mul(lData.liquidationThreshold))
+	}
+
+	borrowValue := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		borrowValue = borrowValue.Add(usdValue)
+	}
+
+	ltv := sdk.ZeroDec()
+	if depositValue.IsPositive() {
+		ltv = borrowValue.Quo(depositValue)
+	}
+	borrowLimitUsed := sdk.ZeroDec()
+	if borrowLimit.IsPositive() {
+		borrowLimitUsed = borrowValue.Quo(borrowLimit)
+	} else if borrowValue.IsPositive() {
+		borrowLimitUsed = sdk.MaxSortableDec
+	}
+	healthFactor := sdk.MaxSortableDec
+	if borrowValue.IsPositive() {
+		healthFactor = sdk.MinDec(liquidationValue.Quo(borrowValue), sdk.MaxSortableDec)
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	collateral := types.CollateralHealths{}
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		conversionFactor := sdk.NewDecFromInt(lData.conversionFactor)
+		depositUnits := sdk.NewDecFromInt(coin.Amount).Quo(conversionFactor)
+		borrowUnits := sdk.NewDecFromInt(borrow.Amount.AmountOf(coin.Denom)).Quo(conversionFactor)
+
+		// With all other prices unchanged, the liquidation value less the borrow value is linear in this denom's price
+		exposure := depositUnits.Mul(lData.liquidationThreshold).Sub(borrowUnits)
+		otherMargin := liquidationValue.Sub(borrowValue).Sub(exposure.Mul(lData.price))
+		liquidationPrice := sdk.ZeroDec()
+		if exposure.IsPositive() && otherMargin.IsNegative() {
+			liquidationPrice = otherMargin.Neg().Quo(exposure)
+		}
+
+		maxWithdraw := coin.Amount
+		borrowLimitPerUnit := lData.price.Mul(lData.ltv)
+		if foundBorrow {
+			if borrowValue.GT(borrowLimit) {
+				maxWithdraw = sdk.ZeroInt()
+			} else if borrowLimitPerUnit.IsPositive() {
+				withdrawable := borrowLimit.Sub(borrowValue).Quo(borrowLimitPerUnit).Mul(conversionFactor).TruncateInt()
+				maxWithdraw = sdkmath.MinInt(maxWithdraw, withdrawable)
+			}
+		}
+		maxWithdraw = sdkmath.MinInt(maxWithdraw, k.bankKeeper.GetBalance(ctx, moduleAddress, coin.Denom).Amount)
+
+		collateral = append(collateral, types.NewCollateralHealth(coin.Denom, liquidationPrice, maxWithdraw))
+	}
+
+	return types.NewAccountHealth(
+		owner, deposit.Amount, borrow.Amount, depositValue, borrowValue, borrowLimit, ltv, borrowLimitUsed,
+		borrowValue.GT(liquidationValue), collateral, healthFactor,
+	), nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/mage-coven/fury/x/hard/keeper"
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestAccountHealth() {
+	addrs := suite.setupIsolationMode()
+	queryServer := keeper.NewQueryServerImpl(suite.keeper, suite.app.GetAccountKeeper(), suite.app.GetBankKeeper())
+	busd := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(amount*BUSD_CF)))
+	}
+	usdx := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(amount*USDX_CF)))
+	}
+
+	for i, borrowAmount := range []int64{300, 350} {
+		borrower := addrs[i+1]
+		suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, busd(500)))
+		suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, usdx(borrowAmount)))
+	}
+
+	res, err := queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{Owner: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Equal(types.NewAccountHealth(
+		addrs[1], busd(500), usdx(300), sdk.NewDec(500), sdk.NewDec(300), sdk.NewDec(400), sdk.MustNewDecFromStr("0.6"),
+		sdk.MustNewDecFromStr("0.75"), false, types.CollateralHealths{
+			types.NewCollateralHealth("busd", sdk.MustNewDecFromStr("0.75"), sdkmath.NewInt(125*BUSD_CF)),
+		}, sdk.MustNewDecFromStr("1.333333333333333333"),
+	), res.AccountHealth)
+
+	// healthy borrowers are not liquidation candidates
+	candidates, err := queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(candidates.Candidates)
+
+	// borrowers become candidates as prices move, without being re-indexed
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.8"))
+	candidates, err = queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(candidates.Candidates, 1)
+	suite.Equal(addrs[2].String(), candidates.Candidates[0].Owner)
+	suite.Equal(sdk.MustNewDecFromStr("1.09375").String(), candidates.Candidates[0].BorrowLimitUsed)
+	suite.Equal(sdk.MustNewDecFromStr("0.914285714285714286").String(), candidates.Candidates[0].HealthFactor)
+	suite.True(candidates.Candidates[0].Liquidatable)
+
+	// candidates are sorted from the least healthy
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.7"))
+	candidates, err = queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(candidates.Candidates, 1)
+	suite.Equal(addrs[2].String(), candidates.Candidates[0].Owner)
+	suite.Equal(uint64(2), candidates.Pagination.Total)
+	candidates, err = queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{
+		Pagination: &query.PageRequest{Key: candidates.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(candidates.Candidates, 1)
+	suite.Equal(addrs[1].String(), candidates.Candidates[0].Owner)
+	suite.Nil(candidates.Pagination.NextKey)
+
+	// below the liquidation price the account can be liquidated and nothing can be withdrawn
+	res, err = queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{Owner: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.True(res.AccountHealth.Liquidatable)
+	suite.Equal(sdkmath.ZeroInt().String(), res.AccountHealth.Collateral[0].MaxWithdraw)
+
+	_, err = queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{Owner: sdk.AccAddress("unknown").String()})
+	suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+}
@@ -33,6 +33,16 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 					},
 					ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
 					KeeperRewardPercentage: sdk.ZeroDec(),
+					SupplyLimit: types.SupplyLimit{
+						HasMaxLimit:  false,
+						MaximumLimit: sdk.ZeroDec(),
+					},
+					IsolationMode: types.IsolationMode{
+						Isolated:              false,
+						DebtCeiling:           sdk.ZeroDec(),
+						BorrowableInIsolation: false,
+					},
+					LiquidationBonus: types.DefaultLiquidationBonus,
 				},
 				types.MoneyMarket{
 					Denom: "bnb",
@@ -51,6 +61,16 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 					},
 					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
+					SupplyLimit: types.SupplyLimit{
+						HasMaxLimit:  false,
+						MaximumLimit: sdk.ZeroDec(),
+					},
+					IsolationMode: types.IsolationMode{
+						Isolated:              false,
+						DebtCeiling:           sdk.ZeroDec(),
+						BorrowableInIsolation: false,
+					},
+					LiquidationBonus: types.DefaultLiquidationBonus,
 				},
 				types.MoneyMarket{
 					Denom: "busd",
@@ -69,9 +89,23 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 					},
 					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
+					SupplyLimit: types.SupplyLimit{
+						HasMaxLimit:  false,
+						MaximumLimit: sdk.ZeroDec(),
+					},
+					IsolationMode: types.IsolationMode{
+						Isolated:              false,
+						DebtCeiling:           sdk.ZeroDec(),
+						BorrowableInIsolation: false,
+					},
+					LiquidationBonus: types.DefaultLiquidationBonus,
 				},
 			},
 			sdk.MustNewDecFromStr("10"),
+			0,
+			nil,
+			types.DefaultFlashLoanFee,
+			types.DefaultCloseFactor,
 		),
 		PreviousAccumulationTimes: types.GenesisAccumulationTimes{
 			types.NewGenesisAccumulationTime(
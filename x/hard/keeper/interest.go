@@ -37,6 +37,10 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 
 		// Update the interest rate in the store if the params have changed
 		if !moneyMarket.Equal(mm) {
+			// A new interest rate model restarts from its own rate at target
+			if !moneyMarket.InterestRateModel.Equal(mm.InterestRateModel) {
+				k.DeleteAdaptiveRate(ctx, mm.Denom)
+			}
 			k.SetMoneyMarket(ctx, mm.Denom, mm)
 		}
 		denomSet[mm.Denom] = true
@@ -53,6 +57,7 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 
 			// Delete the money market from the store
 			k.DeleteMoneyMarket(ctx, denom)
+			k.DeleteAdaptiveRate(ctx, denom)
 		}
 		return false
 	})
@@ -116,7 +121,8 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	model := k.GetInterestRateModel(ctx, mm)
+	borrowRateApy, err := CalculateBorrowRate(model, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	if err != nil {
 		return err
 	}
@@ -153,23 +159,42 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 
+	// Move the rate at target of adaptive models toward the rate that would bring utilization to the target
+	if model.ModelType == types.INTEREST_RATE_MODEL_TYPE_ADAPTIVE {
+		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+		k.SetAdaptiveRate(ctx, denom, model.Adaptive.AdaptRateAtTarget(utilRatio, timeElapsed))
+	}
+
 	return nil
 }
 
+// GetInterestRateModel returns the interest rate model of a money market. Adaptive models are returned with the
+// market's current rate at target in place of the initial rate at target set by governance.
+func (k Keeper) GetInterestRateModel(ctx sdk.Context, mm types.MoneyMarket) types.InterestRateModel {
+	model := mm.InterestRateModel
+	if model.ModelType != types.INTEREST_RATE_MODEL_TYPE_ADAPTIVE || model.Adaptive == nil {
+		return model
+	}
+	rateAtTarget, found := k.GetAdaptiveRate(ctx, mm.Denom)
+	if !found {
+		return model
+	}
+	adaptive := *model.Adaptive
+	adaptive.RateAtTarget = rateAtTarget
+	model.Adaptive = &adaptive
+	return model
+}
+
 // CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
 // based on the current utilization.
 func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
 	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
 
-	// Calculate normal borrow rate (under kink)
-	if utilRatio.LTE(model.Kink) {
-		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
+	curve, err := model.Curve()
+	if err != nil {
+		return sdk.ZeroDec(), err
 	}
-
-	// Calculate jump borrow rate (over kink)
-	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
-	excessUtil := utilRatio.Sub(model.Kink)
-	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
+	return curve.BorrowRate(utilRatio), nil
 }
 
 // CalculateUtilizationRatio calculates an asset's current utilization rate
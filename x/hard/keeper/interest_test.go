@@ -113,6 +113,19 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 	// 	- JumpMultiplier:   0.5
 	normalModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 
+	// Multi-kink model has a base rate of 0.01 and kinks at 50%, 90% and 100% utilization
+	multiKinkModel := types.NewMultiKinkInterestRateModel(sdk.MustNewDecFromStr("0.01"), types.InterestRateKinks{
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.05")),
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.1")),
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("1.0")),
+	})
+
+	// Adaptive model has a rate of 0.04 at 90% utilization and a curve steepness of 4
+	adaptiveModel := types.NewAdaptiveInterestRateModel(types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("50"), sdk.MustNewDecFromStr("4"),
+	))
+
 	testCases := []test{
 		{
 			"normal no jump",
@@ -209,6 +222,66 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 				expectedValue: sdk.MustNewDecFromStr("0.0"),
 			},
 		},
+		{
+			"multi-kink first segment",
+			args{
+				cash:          sdk.MustNewDecFromStr("5000"),
+				borrows:       sdk.MustNewDecFromStr("1000"),
+				reserves:      sdk.MustNewDecFromStr("1000"),
+				model:         multiKinkModel,
+				expectedValue: sdk.MustNewDecFromStr("0.026"),
+			},
+		},
+		{
+			"multi-kink second segment",
+			args{
+				cash:          sdk.MustNewDecFromStr("2000"),
+				borrows:       sdk.MustNewDecFromStr("7000"),
+				reserves:      sdk.MustNewDecFromStr("1000"),
+				model:         multiKinkModel,
+				expectedValue: sdk.MustNewDecFromStr("0.096875"),
+			},
+		},
+		{
+			"multi-kink full utilization",
+			args{
+				cash:          sdk.MustNewDecFromStr("0"),
+				borrows:       sdk.MustNewDecFromStr("1000"),
+				reserves:      sdk.MustNewDecFromStr("0"),
+				model:         multiKinkModel,
+				expectedValue: sdk.MustNewDecFromStr("1.0"),
+			},
+		},
+		{
+			"adaptive below target",
+			args{
+				cash:          sdk.MustNewDecFromStr("5500"),
+				borrows:       sdk.MustNewDecFromStr("4500"),
+				reserves:      sdk.MustNewDecFromStr("0"),
+				model:         adaptiveModel,
+				expectedValue: sdk.MustNewDecFromStr("0.025"),
+			},
+		},
+		{
+			"adaptive at target",
+			args{
+				cash:          sdk.MustNewDecFromStr("1000"),
+				borrows:       sdk.MustNewDecFromStr("9000"),
+				reserves:      sdk.MustNewDecFromStr("0"),
+				model:         adaptiveModel,
+				expectedValue: sdk.MustNewDecFromStr("0.04"),
+			},
+		},
+		{
+			"adaptive full utilization",
+			args{
+				cash:          sdk.MustNewDecFromStr("0"),
+				borrows:       sdk.MustNewDecFromStr("1000"),
+				reserves:      sdk.MustNewDecFromStr("0"),
+				model:         adaptiveModel,
+				expectedValue: sdk.MustNewDecFromStr("0.16"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -848,11 +921,16 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdkmath.NewInt(FURY_CF),   // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 				},
 				sdk.NewDec(10),
+				0,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 			)
 
 			// Pricefeed module genesis state
@@ -1253,18 +1331,24 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdkmath.NewInt(FURY_CF),   // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("bnb",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
 						"bnb:usd",                 // Market ID
 						sdkmath.NewInt(BNB_CF),    // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 				},
 				sdk.NewDec(10),
+				0,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 			)
 
 			// Pricefeed module genesis state
@@ -1435,6 +1519,48 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAdaptiveInterestRate() {
+	addrs := suite.setupIsolationMode()
+	adaptive := types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.01"),
+		sdk.OneDec(), sdk.NewDec(10), sdk.NewDec(4),
+	)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MoneyMarkets[0].InterestRateModel = types.NewAdaptiveInterestRateModel(adaptive)
+	suite.keeper.SetParams(suite.ctx, params)
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Borrow(suite.ctx, addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(800*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// utilization above the target raises the rate at target
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	rateAtTarget, found := suite.keeper.GetAdaptiveRate(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(adaptive.AdaptRateAtTarget(sdk.MustNewDecFromStr("0.8"), 86400), rateAtTarget)
+	suite.True(rateAtTarget.GT(adaptive.RateAtTarget))
+
+	mm, _ := suite.keeper.GetMoneyMarket(suite.ctx, "usdx")
+	suite.Equal(rateAtTarget, suite.keeper.GetInterestRateModel(suite.ctx, mm).Adaptive.RateAtTarget)
+
+	// a new interest rate model restarts from its own rate at target
+	adaptive.AdjustmentSpeed = sdk.NewDec(20)
+	params.MoneyMarkets[0].InterestRateModel = types.NewAdaptiveInterestRateModel(adaptive)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetAdaptiveRate(suite.ctx, "usdx")
+	suite.False(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	rateAtTarget, found = suite.keeper.GetAdaptiveRate(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.True(rateAtTarget.GT(adaptive.RateAtTarget))
+	suite.True(rateAtTarget.LT(sdk.MustNewDecFromStr("0.0401")))
+}
+
 func TestInterestTestSuite(t *testing.T) {
 	suite.Run(t, new(InterestTestSuite))
 }
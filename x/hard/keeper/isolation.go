@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// GetIsolatedCollateral returns the money market of the isolated asset in the deposited coins, if there is one
+func (k Keeper) GetIsolatedCollateral(ctx sdk.Context, coins sdk.Coins) (types.MoneyMarket, bool) {
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && moneyMarket.IsolationMode.Isolated {
+			return moneyMarket, true
+		}
+	}
+	return types.MoneyMarket{}, false
+}
+
+// ValidateIsolatedDeposit validates that a deposit adds at most one isolated asset to the depositor's collateral,
+// and that a depositor's borrow only becomes backed by an isolated asset if it could have been borrowed against it
+func (k Keeper) ValidateIsolatedDeposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	existingCoins := sdk.NewCoins()
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if found {
+		existingCoins = deposit.Amount
+	}
+	proposedCoins := existingCoins.Add(coins...)
+
+	isolatedDenoms := []string{}
+	for _, coin := range proposedCoins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && moneyMarket.IsolationMode.Isolated {
+			isolatedDenoms = append(isolatedDenoms, coin.Denom)
+		}
+	}
+	for _, coin := range coins {
+		if existingCoins.AmountOf(coin.Denom).IsZero() && len(isolatedDenoms) > 1 {
+			return errorsmod.Wrapf(types.ErrInvalidIsolatedCollateral, "cannot deposit more than one isolated asset, found %v", isolatedDenoms)
+		}
+	}
+
+	_, wasIsolated := k.GetIsolatedCollateral(ctx, existingCoins)
+	proposedMarket, isIsolated := k.GetIsolatedCollateral(ctx, proposedCoins)
+	borrow, hasBorrow := k.GetSyncedBorrow(ctx, depositor)
+	if !hasBorrow || wasIsolated || !isIsolated {
+		return nil
+	}
+	borrowUSDValue, err := k.calculateUSDValue(ctx, borrow.Amount)
+	if err != nil {
+		return err
+	}
+	if err := k.validateIsolatedBorrow(ctx, proposedMarket, borrow.Amount, borrowUSDValue); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidIsolatedCollateral, "existing borrow cannot be backed by %s: %s", proposedMarket.Denom, err)
+	}
+	return nil
+}
+
+// validateIsolatedBorrow validates that a borrow against isolated collateral is of assets borrowable in isolation and
+// does not increase the debt backed by the isolated asset above its debt ceiling
+func (k Keeper) validateIsolatedBorrow(ctx sdk.Context, isolatedMarket types.MoneyMarket, amount sdk.Coins, borrowUSDValue sdk.Dec) error {
+	for _, coin := range amount {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if !moneyMarket.IsolationMode.BorrowableInIsolation {
+			return errorsmod.Wrapf(types.ErrNotBorrowableInIsolation, "%s cannot be borrowed against isolated collateral %s", coin.Denom, isolatedMarket.Denom)
+		}
+	}
+
+	isolatedDebt, err := k.GetIsolatedDebtUSDValue(ctx, isolatedMarket.Denom)
+	if err != nil {
+		return err
+	}
+	proposedIsolatedDebt := isolatedDebt.Add(borrowUSDValue)
+	if proposedIsolatedDebt.GT(isolatedMarket.IsolationMode.DebtCeiling) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtCeiling,
+			"proposed borrow would result in $%s of debt backed by %s, but the debt ceiling is $%s",
+			proposedIsolatedDebt, isolatedMarket.Denom, isolatedMarket.IsolationMode.DebtCeiling)
+	}
+	return nil
+}
+
+// GetIsolatedDebtUSDValue returns the USD value of the debt backed by an isolated asset, with its principal valued at
+// current interest factors and prices
+func (k Keeper) GetIsolatedDebtUSDValue(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, principal := range k.GetIsolatedDebt(ctx, denom) {
+		moneyMarket, found := k.GetMoneyMarket(ctx, principal.Denom)
+		if !found {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", principal.Denom)
+		}
+		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+		}
+		amount := principal.Amount.Mul(k.getBorrowInterestFactor(ctx, principal.Denom))
+		total = total.Add(amount.Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price))
+	}
+	return total, nil
+}
+
+// IncrementIsolatedDebt increases the debt backed by the owner's isolated collateral by the principal of the coins
+func (k Keeper) IncrementIsolatedDebt(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) {
+	isolatedMarket, isIsolated := k.getAccountIsolatedCollateral(ctx, owner)
+	if !isIsolated {
+		return
+	}
+	k.addIsolatedDebt(ctx, isolatedMarket.Denom, coins)
+}
+
+// DecrementIsolatedDebt decreases the debt backed by the owner's isolated collateral by the principal of the coins
+func (k Keeper) DecrementIsolatedDebt(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) {
+	isolatedMarket, isIsolated := k.getAccountIsolatedCollateral(ctx, owner)
+	if !isIsolated {
+		return
+	}
+	k.subtractIsolatedDebt(ctx, isolatedMarket.Denom, coins)
+}
+
+// UpdateIsolatedDebtCollateral moves the owner's borrow from the debt of the isolated asset in the existing coins to the
+// debt of the isolated asset in the owner's current deposit, when a deposit, withdrawal or liquidation changes it
+func (k Keeper) UpdateIsolatedDebtCollateral(ctx sdk.Context, owner sdk.AccAddress, existingCoins sdk.Coins) {
+	existingMarket, wasIsolated := k.GetIsolatedCollateral(ctx, existingCoins)
+	currentMarket, isIsolated := k.getAccountIsolatedCollateral(ctx, owner)
+	if wasIsolated == isIsolated && existingMarket.Denom == currentMarket.Denom {
+		return
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, owner)
+	if !found {
+		return
+	}
+	if wasIsolated {
+		k.subtractIsolatedDebt(ctx, existingMarket.Denom, borrow.Amount)
+	}
+	if isIsolated {
+		k.addIsolatedDebt(ctx, currentMarket.Denom, borrow.Amount)
+	}
+}
+
+// addIsolatedDebt increases the debt backed by an isolated asset by the principal of the coins
+func (k Keeper) addIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	debt := k.GetIsolatedDebt(ctx, denom)
+	k.SetIsolatedDebt(ctx, denom, debt.Add(k.calculateIsolatedDebtPrincipal(ctx, coins)...))
+}
+
+// subtractIsolatedDebt decreases the debt backed by an isolated asset by the principal of the coins.
+// The principal of each denom is floored at zero to absorb rounding.
+func (k Keeper) subtractIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	principal := k.calculateIsolatedDebtPrincipal(ctx, coins)
+	updatedDebt := sdk.DecCoins{}
+	for _, coin := range k.GetIsolatedDebt(ctx, denom) {
+		remaining := coin.Amount.Sub(principal.AmountOf(coin.Denom))
+		if remaining.IsPositive() {
+			updatedDebt = updatedDebt.Add(sdk.NewDecCoinFromDec(coin.Denom, remaining))
+		}
+	}
+	k.SetIsolatedDebt(ctx, denom, updatedDebt)
+}
+
+// getAccountIsolatedCollateral returns the money market of the isolated asset in the owner's deposit, if there is one
+func (k Keeper) getAccountIsolatedCollateral(ctx sdk.Context, owner sdk.AccAddress) (types.MoneyMarket, bool) {
+	deposit, found := k.GetDeposit(ctx, owner)
+	if !found {
+		return types.MoneyMarket{}, false
+	}
+	return k.GetIsolatedCollateral(ctx, deposit.Amount)
+}
+
+// calculateIsolatedDebtPrincipal returns the principal of the coins, dividing each amount by the current borrow
+// interest factor of its denom so that the debt does not depend on the price feed
+func (k Keeper) calculateIsolatedDebtPrincipal(ctx sdk.Context, coins sdk.Coins) sdk.DecCoins {
+	principal := sdk.DecCoins{}
+	for _, coin := range coins {
+		amount := sdk.NewDecFromInt(coin.Amount).Quo(k.getBorrowInterestFactor(ctx, coin.Denom))
+		if amount.IsPositive() {
+			principal = principal.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return principal
+}
+
+// calculateUSDValue returns the USD value of the coins at current prices
+func (k Keeper) calculateUSDValue(ctx sdk.Context, coins sdk.Coins) (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+		}
+		total = total.Add(sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price))
+	}
+	return total, nil
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mage-coven/fury/app"
+	"github.com/mage-coven/fury/x/hard"
+	"github.com/mage-coven/fury/x/hard/keeper"
+	"github.com/mage-coven/fury/x/hard/types"
+	pricefeedtypes "github.com/mage-coven/fury/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) setupIsolationMode() []sdk.AccAddress {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		sdk.NewCoins(
+			sdk.NewCoin("ufury", sdkmath.NewInt(1000*FURY_CF)),
+			sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)),
+			sdk.NewCoin("busd", sdkmath.NewInt(1000*BUSD_CF)),
+		),
+		addrs,
+	)
+
+	model := types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.NewDec(5))
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), true), types.DefaultLiquidationBonus),
+				types.NewMoneyMarket("busd", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "busd:usd", sdkmath.NewInt(BUSD_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+				types.NewMoneyMarket("ufury", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "fury:usd", sdkmath.NewInt(FURY_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(true, sdk.NewDec(100), false), types.DefaultLiquidationBonus),
+			},
+			sdk.NewDec(10),
+			0,
+			nil,
+			types.DefaultFlashLoanFee,
+			types.DefaultCloseFactor,
+		),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "fury:usd", BaseAsset: "fury", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.OneDec(), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "busd:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.OneDec(), Expiry: time.Now().Add(time.Hour)},
+			{MarketID: "fury:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.NewDec(2), Expiry: time.Now().Add(time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	// Run BeginBlocker once to transition MoneyMarkets
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)), sdk.NewCoin("busd", sdkmath.NewInt(500*BUSD_CF))))
+	suite.Require().NoError(err)
+	return addrs
+}
+
+func (suite *KeeperTestSuite) TestIsolatedBorrow() {
+	addrs := suite.setupIsolationMode()
+	borrower, borrower2 := addrs[1], addrs[2]
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF))))
+	suite.Require().NoError(err)
+
+	// only assets borrowable in isolation can be borrowed against isolated collateral
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(20*BUSD_CF))))
+	suite.Require().ErrorIs(err, types.ErrNotBorrowableInIsolation)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(60), suite.isolatedDebtUSDValue("ufury"))
+
+	// the debt ceiling is shared by all accounts with the isolated collateral
+	err = suite.keeper.Deposit(suite.ctx, borrower2, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF)), sdk.NewCoin("busd", sdkmath.NewInt(100*BUSD_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower2, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtCeiling)
+	err = suite.keeper.Borrow(suite.ctx, borrower2, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(100), suite.isolatedDebtUSDValue("ufury"))
+
+	// repayments free up the debt ceiling
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(80), suite.isolatedDebtUSDValue("ufury"))
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper, suite.app.GetAccountKeeper(), suite.app.GetBankKeeper())
+	res, err := queryServer.IsolatedDebts(sdk.WrapSDKContext(suite.ctx), &types.QueryIsolatedDebtsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.MoneyMarketIsolatedDebts{
+		types.NewMoneyMarketIsolatedDebt("ufury", sdk.NewDec(100), sdk.NewDec(80)),
+	}, res.IsolatedDebts)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedCollateral() {
+	addrs := suite.setupIsolationMode()
+	lender, borrower := addrs[0], addrs[1]
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF)), sdk.NewCoin("busd", sdkmath.NewInt(100*BUSD_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewDec(40), suite.isolatedDebtUSDValue("ufury"))
+
+	// the isolated collateral backing a borrow can be removed within the loan-to-value, taking its debt with it
+	health, err := suite.keeper.GetAccountHealth(suite.ctx, borrower)
+	suite.Require().NoError(err)
+	suite.Equal(types.NewCollateralHealth("ufury", sdk.ZeroDec(), sdkmath.NewInt(100*FURY_CF)), health.Collateral[1])
+	err = suite.keeper.Withdraw(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF))))
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetIsolatedDebt(suite.ctx, "ufury"))
+
+	// isolated collateral can only be added to a borrow that could have been borrowed against it
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(50*FURY_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(40), suite.isolatedDebtUSDValue("ufury"))
+	err = suite.keeper.Borrow(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(20*BUSD_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(1*FURY_CF))))
+	suite.Require().ErrorIs(err, types.ErrInvalidIsolatedCollateral)
+
+	// liquidated borrows no longer count toward the debt ceiling
+	suite.setPrice("fury:usd", sdk.MustNewDecFromStr("0.01"))
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.4"))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, lender, borrower)
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetIsolatedDebt(suite.ctx, "ufury"))
+}
+
+func (suite *KeeperTestSuite) TestIsolatedDebtPriceIndependent() {
+	addrs := suite.setupIsolationMode()
+	borrower := addrs[1]
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("usdx", sdkmath.NewInt(100*USDX_CF))), suite.keeper.GetIsolatedDebt(suite.ctx, "ufury"))
+
+	// the debt is valued at the current price of the borrowed asset
+	suite.setPrice("usdx:usd", sdk.MustNewDecFromStr("0.5"))
+	suite.Equal(sdk.NewDec(50), suite.isolatedDebtUSDValue("ufury"))
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(60), suite.isolatedDebtUSDValue("ufury"))
+
+	// accrued interest counts toward the debt ceiling
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	suite.setPrice("usdx:usd", sdk.MustNewDecFromStr("0.5"))
+	suite.True(suite.isolatedDebtUSDValue("ufury").GT(sdk.NewDec(60)))
+
+	// repaying the whole borrow at a different price clears the debt, apart from dust from truncated interest
+	suite.setPrice("usdx:usd", sdk.MustNewDecFromStr("2"))
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(200*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.True(suite.keeper.GetIsolatedDebt(suite.ctx, "ufury").AmountOf("usdx").LT(sdk.OneDec()))
+}
+
+func (suite *KeeperTestSuite) isolatedDebtUSDValue(denom string) sdk.Dec {
+	value, err := suite.keeper.GetIsolatedDebtUSDValue(suite.ctx, denom)
+	suite.Require().NoError(err)
+	return value
+}
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/mage-coven/fury/x/hard/types"
 )
@@ -22,12 +25,13 @@ type Keeper struct {
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
 	hooks           types.HARDHooks
+	router          *baseapp.MsgServiceRouter
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, router *baseapp.MsgServiceRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -42,6 +46,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
 		hooks:           nil,
+		router:          router,
 	}
 }
 
@@ -54,6 +59,11 @@ func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	return k
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
 func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
@@ -364,3 +374,212 @@ func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom stri
 		}
 	}
 }
+
+// SetLtvIndexScore sets a borrower's score for a pair of deposit and borrow denoms in the store's LTV index
+func (k Keeper) SetLtvIndexScore(ctx sdk.Context, borrower sdk.AccAddress, depositDenom, borrowDenom string, score sdk.Dec) {
+	pairKey := types.LtvIndexPairKey(depositDenom, borrowDenom)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	store.Set(types.LtvIndexKey(pairKey, score, borrower), borrower)
+	borrowerStore := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: score})
+	borrowerStore.Set(types.BorrowerLtvKey(borrower, pairKey), bz)
+}
+
+// RemoveBorrowerFromLtvIndex deletes all of the borrower's scores from the store's LTV index
+func (k Keeper) RemoveBorrowerFromLtvIndex(ctx sdk.Context, borrower sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	borrowerStore := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	k.IterateBorrowerLtvScores(ctx, borrower, func(depositDenom, borrowDenom string, score sdk.Dec) (stop bool) {
+		pairKey := types.LtvIndexPairKey(depositDenom, borrowDenom)
+		store.Delete(types.LtvIndexKey(pairKey, score, borrower))
+		borrowerStore.Delete(types.BorrowerLtvKey(borrower, pairKey))
+		return false
+	})
+}
+
+// IterateBorrowerLtvScores iterates over a borrower's scores in the LTV index and performs a callback function
+func (k Keeper) IterateBorrowerLtvScores(ctx sdk.Context, borrower sdk.AccAddress, cb func(depositDenom, borrowDenom string, score sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	iterKey := types.BorrowerLtvIterKey(borrower)
+
+	// collect the entries first so the callback can modify the store
+	iterator := sdk.KVStorePrefixIterator(store, iterKey)
+	var pairKeys [][]byte
+	var scores []sdk.Dec
+	for ; iterator.Valid(); iterator.Next() {
+		var score sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		pairKeys = append(pairKeys, iterator.Key()[len(iterKey):])
+		scores = append(scores, score.Dec)
+	}
+	iterator.Close()
+	for i, pairKey := range pairKeys {
+		depositDenom, borrowDenom := types.SplitLtvIndexPairKey(pairKey)
+		if cb(depositDenom, borrowDenom, scores[i]) {
+			break
+		}
+	}
+}
+
+// IterateLtvIndexByPair iterates over the borrowers indexed for a pair of deposit and borrow denoms with a score below
+// maxScore, from the lowest to the highest score, and performs a callback function
+func (k Keeper) IterateLtvIndexByPair(ctx sdk.Context, depositDenom, borrowDenom string, maxScore sdk.Dec, cb func(borrower sdk.AccAddress) (stop bool)) {
+	pairKey := types.LtvIndexPairKey(depositDenom, borrowDenom)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	iterator := store.Iterator(pairKey, types.LtvIndexIterKey(pairKey, maxScore))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAccountEfficiencyCategory returns the name of the efficiency category an account has opted into
+func (k Keeper) GetAccountEfficiencyCategory(ctx sdk.Context, owner sdk.AccAddress) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EfficiencyCategoryPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetAccountEfficiencyCategory sets the efficiency category of an account in the store
+func (k Keeper) SetAccountEfficiencyCategory(ctx sdk.Context, owner sdk.AccAddress, category string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EfficiencyCategoryPrefix)
+	store.Set(owner, []byte(category))
+}
+
+// DeleteAccountEfficiencyCategory deletes the efficiency category of an account from the store
+func (k Keeper) DeleteAccountEfficiencyCategory(ctx sdk.Context, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EfficiencyCategoryPrefix)
+	store.Delete(owner)
+}
+
+// IterateAccountEfficiencyCategories iterates over all accounts with an efficiency category and performs a callback function
+func (k Keeper) IterateAccountEfficiencyCategories(ctx sdk.Context, cb func(owner sdk.AccAddress, category string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EfficiencyCategoryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()), string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetIsolatedDebt returns the principal of the debt backed by an isolated asset
+func (k Keeper) GetIsolatedDebt(ctx sdk.Context, denom string) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.DecCoins{}
+	}
+	var debt types.IsolatedDebt
+	k.cdc.MustUnmarshal(bz, &debt)
+	return debt.Principal
+}
+
+// SetIsolatedDebt sets the principal of the debt backed by an isolated asset
+func (k Keeper) SetIsolatedDebt(ctx sdk.Context, denom string, principal sdk.DecCoins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	if principal.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&types.IsolatedDebt{Denom: denom, Principal: principal})
+	store.Set([]byte(denom), bz)
+}
+
+// GetAdaptiveRate returns the current rate at target of a money market with an adaptive interest rate model
+func (k Keeper) GetAdaptiveRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var rate sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate.Dec, true
+}
+
+// SetAdaptiveRate sets the current rate at target of a money market with an adaptive interest rate model
+func (k Keeper) SetAdaptiveRate(ctx sdk.Context, denom string, rateAtTarget sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rateAtTarget})
+	store.Set([]byte(denom), bz)
+}
+
+// DeleteAdaptiveRate deletes the current rate at target of a money market
+func (k Keeper) DeleteAdaptiveRate(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateAdaptiveRates iterates over the current rate at target of each money market and performs a callback function
+func (k Keeper) IterateAdaptiveRates(ctx sdk.Context, cb func(denom string, rateAtTarget sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rate sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &rate)
+		if cb(string(iterator.Key()), rate.Dec) {
+			break
+		}
+	}
+}
+
+// IterateIsolatedDebts iterates over the debt backed by each isolated asset and performs a callback function
+func (k Keeper) IterateIsolatedDebts(ctx sdk.Context, cb func(debt types.IsolatedDebt) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var debt types.IsolatedDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &debt)
+		if cb(debt) {
+			break
+		}
+	}
+}
+
+// GetCreditDelegation returns the credit delegation from a delegator to a delegatee
+func (k Keeper) GetCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress) (types.CreditDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	bz := store.Get(types.CreditDelegationKey(delegator, delegatee))
+	if len(bz) == 0 {
+		return types.CreditDelegation{}, false
+	}
+	var creditDelegation types.CreditDelegation
+	k.cdc.MustUnmarshal(bz, &creditDelegation)
+	return creditDelegation, true
+}
+
+// SetCreditDelegation sets a credit delegation in the store
+func (k Keeper) SetCreditDelegation(ctx sdk.Context, creditDelegation types.CreditDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	bz := k.cdc.MustMarshal(&creditDelegation)
+	store.Set(types.CreditDelegationKey(creditDelegation.Delegator, creditDelegation.Delegatee), bz)
+}
+
+// DeleteCreditDelegation deletes the credit delegation from a delegator to a delegatee from the store
+func (k Keeper) DeleteCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	store.Delete(types.CreditDelegationKey(delegator, delegatee))
+}
+
+// IterateCreditDelegations iterates over all credit delegations and performs a callback function
+func (k Keeper) IterateCreditDelegations(ctx sdk.Context, cb func(creditDelegation types.CreditDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var creditDelegation types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &creditDelegation)
+		if cb(creditDelegation) {
+			break
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
@@ -12,9 +13,10 @@ import (
 
 // LiqData holds liquidation-related data
 type LiqData struct {
-	price            sdk.Dec
-	ltv              sdk.Dec
-	conversionFactor sdkmath.Int
+	price                sdk.Dec
+	ltv                  sdk.Dec
+	liquidationThreshold sdk.Dec
+	conversionFactor     sdkmath.Int
 }
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
@@ -46,14 +48,17 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return types.ErrBorrowNotFound
 	}
 
-	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	isWithinThreshold, err := k.IsWithinLiquidationThreshold(ctx, deposit, borrow)
 	if err != nil {
 		return err
 	}
-	if isWithinRange {
+	if isWithinThreshold {
 		return errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
 	}
 
+	// The liquidated borrow no longer counts toward the debt ceiling of the isolated collateral
+	k.DecrementIsolatedDebt(ctx, borrower, borrow.Amount)
+
 	// Sending coins to auction module with keeper address getting % of the profits
 	borrowDenoms := getDenoms(borrow.Amount)
 	depositDenoms := getDenoms(deposit.Amount)
@@ -69,9 +74,410 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	borrow.Amount = sdk.NewCoins()
 	k.DeleteBorrow(ctx, borrow)
 	k.AfterBorrowModified(ctx, borrow)
+
+	k.RemoveBorrowerFromLtvIndex(ctx, borrower)
 	return nil
 }
 
+// PartialLiquidate enables a keeper to repay part of the debt of a borrower over their liquidation threshold in exchange for
+// the borrower's collateral worth the repaid debt plus the collateral market's liquidation bonus. No auctions are started.
+// The repayment is capped at the close factor of the borrower's debt in the repaid denom and at the collateral available.
+func (k Keeper) PartialLiquidate(ctx sdk.Context, keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) (sdk.Coin, sdk.Coin, error) {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrDepositNotFound
+	}
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrBorrowNotFound
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, _ = k.GetDeposit(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+
+	isWithinThreshold, err := k.IsWithinLiquidationThreshold(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if isWithinThreshold {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	}
+
+	borrowedAmount := borrow.Amount.AmountOf(repay.Denom)
+	if borrowedAmount.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRepaymentDenom, "%s", repay.Denom)
+	}
+	depositedAmount := deposit.Amount.AmountOf(collateralDenom)
+	if depositedAmount.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidCollateralDenom, "%s", collateralDenom)
+	}
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	bData, dData := liqMap[repay.Denom], liqMap[collateralDenom]
+	collateralMarket, _ := k.GetMoneyMarket(ctx, collateralDenom)
+	bonus := sdk.OneDec().Add(collateralMarket.LiquidationBonus)
+
+	maxRepayAmount := k.GetParams(ctx).CloseFactor.MulInt(borrowedAmount).Ceil().TruncateInt()
+	repayAmount := sdk.MinInt(repay.Amount, maxRepayAmount)
+
+	// The seized collateral is worth the repaid debt plus the liquidation bonus
+	repayUSDValue := sdk.NewDecFromInt(repayAmount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price)
+	seizeAmount := repayUSDValue.Mul(bonus).MulInt(dData.conversionFactor).Quo(dData.price).TruncateInt()
+	if seizeAmount.GT(depositedAmount) {
+		// Reduce the repayment to the value of all collateral of this denom less the bonus
+		seizeAmount = depositedAmount
+		seizeUSDValue := sdk.NewDecFromInt(seizeAmount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
+		repayAmount = sdk.MinInt(repayAmount, seizeUSDValue.Quo(bonus).MulInt(bData.conversionFactor).Quo(bData.price).Ceil().TruncateInt())
+	}
+	if !repayAmount.IsPositive() || !seizeAmount.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLiquidationAmount, "repay %s%s for %s%s", repayAmount, repay.Denom, seizeAmount, collateralDenom)
+	}
+	repaid := sdk.NewCoin(repay.Denom, repayAmount)
+	seized := sdk.NewCoin(collateralDenom, seizeAmount)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, keeper, types.ModuleAccountName, sdk.NewCoins(repaid))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// The repaid debt no longer counts toward the debt ceiling of the isolated collateral
+	k.DecrementIsolatedDebt(ctx, borrower, sdk.NewCoins(repaid))
+
+	// If the borrow or deposit denom has been completely cleared reset its index factor
+	if repaid.Amount.Equal(borrowedAmount) {
+		borrowIndex, removed := borrow.Index.RemoveInterestFactor(repaid.Denom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", repaid.Denom)
+		}
+		borrow.Index = borrowIndex
+	}
+	borrow.Amount = borrow.Amount.Sub(repaid)
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	if err := k.DecrementBorrowedCoins(ctx, sdk.NewCoins(repaid)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if seized.Amount.Equal(depositedAmount) {
+		depositIndex, removed := deposit.Index.RemoveInterestFactor(seized.Denom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", seized.Denom)
+		}
+		deposit.Index = depositIndex
+	}
+	deposit.Amount = deposit.Amount.Sub(seized)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	if err := k.DecrementSuppliedCoins(ctx, sdk.NewCoins(seized)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.UpdateIsolatedDebtCollateral(ctx, borrower, deposit.Amount.Add(seized))
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, keeper, sdk.NewCoins(seized))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Call incentive hooks
+	k.AfterDepositModified(ctx, deposit)
+	k.AfterBorrowModified(ctx, borrow)
+	k.UpdateLtvIndex(ctx, borrower)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardPartialLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, seized.String()),
+		),
+	)
+	return repaid, seized, nil
+}
+
+// LiquidateBorrowers checks the borrowers whose LTV index score is below the current threshold of a pair of deposit and
+// borrow denoms and liquidates those outside of their liquidation threshold. Up to CheckLtvIndexCount borrowers are checked
+// in total, taken in turn from each pair starting from the lowest score, so a pair with many healthy borrowers below its
+// threshold cannot use up the whole budget. Automatic liquidations pay no keeper reward.
+func (k Keeper) LiquidateBorrowers(ctx sdk.Context) {
+	count := k.GetParams(ctx).CheckLtvIndexCount
+	if count == 0 {
+		return
+	}
+
+	var candidatesByPair [][]sdk.AccAddress
+	for _, pair := range k.getLtvIndexThresholds(ctx) {
+		var candidates []sdk.AccAddress
+		k.IterateLtvIndexByPair(ctx, pair.depositDenom, pair.borrowDenom, pair.threshold, func(borrower sdk.AccAddress) (stop bool) {
+			candidates = append(candidates, borrower)
+			return uint64(len(candidates)) >= count
+		})
+		candidatesByPair = append(candidatesByPair, candidates)
+	}
+
+	var borrowers []sdk.AccAddress
+	seen := make(map[string]bool)
+	for rank := 0; uint64(len(borrowers)) < count; rank++ {
+		exhausted := true
+		for _, candidates := range candidatesByPair {
+			if rank >= len(candidates) {
+				continue
+			}
+			exhausted = false
+			borrower := candidates[rank]
+			if seen[borrower.String()] || uint64(len(borrowers)) >= count {
+				continue
+			}
+			seen[borrower.String()] = true
+			borrowers = append(borrowers, borrower)
+		}
+		if exhausted {
+			break
+		}
+	}
+
+	for _, borrower := range borrowers {
+		// liquidate on a cache so a failed liquidation leaves no partial state changes
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.AttemptKeeperLiquidation(cacheCtx, sdk.AccAddress{}, borrower)
+		if err == nil {
+			writeCache()
+			continue
+		}
+		if !errors.Is(err, types.ErrBorrowNotLiquidatable) {
+			k.Logger(ctx).Error("automatic liquidation failed", "borrower", borrower.String(), "error", err.Error())
+		}
+		k.UpdateLtvIndex(ctx, borrower)
+	}
+}
+
+// isLiquidationCandidate returns true if any of the borrower's LTV index scores is below the current threshold of its pair
+func (k Keeper) isLiquidationCandidate(ctx sdk.Context, borrower sdk.AccAddress, thresholds map[string]sdk.Dec) bool {
+	isCandidate := false
+	k.IterateBorrowerLtvScores(ctx, borrower, func(depositDenom, borrowDenom string, score sdk.Dec) (stop bool) {
+		threshold, found := thresholds[string(types.LtvIndexPairKey(depositDenom, borrowDenom))]
+		isCandidate = found && score.LT(threshold)
+		return isCandidate
+	})
+	return isCandidate
+}
+
+// UpdateLtvIndex re-indexes a borrower by their synced deposit and borrow amounts. Addresses without a borrow are
+// removed from the index.
+//
+// A borrower is indexed under every pair of a deposit denom i and a borrow denom j with the score
+//
+//	s_ij = (a_i / w_i) / (b_j / v_j)
+//
+// where a_i is the deposit amount times its liquidation threshold, b_j is the borrowed amount, both in whole units and
+// divided by the current interest factor of their market, and w_i and v_j are positive weights that each add up to one.
+// With p the prices and S and I the supply and borrow interest factors, a borrower is outside of their liquidation
+// threshold when sum_i(a_i S_i p_i) < sum_j(b_j I_j p_j), which is only possible if s_ij < (I_j p_j) / (S_i p_i) for some
+// pair. The index therefore finds every liquidatable borrower for any prices and interest factors, while the weights,
+// each denom's share of the position's value when it is indexed, only affect how many healthy positions are checked.
+func (k Keeper) UpdateLtvIndex(ctx sdk.Context, borrower sdk.AccAddress) {
+	k.RemoveBorrowerFromLtvIndex(ctx, borrower)
+
+	borrow, found := k.GetSyncedBorrow(ctx, borrower)
+	if !found || borrow.Amount.IsZero() {
+		return
+	}
+	deposit, found := k.GetSyncedDeposit(ctx, borrower)
+	if !found || deposit.Amount.IsZero() {
+		return
+	}
+
+	category, hasCategory := k.GetEfficiencyCategory(ctx, borrower)
+	depositUnits := types.NewValuationMap()
+	for _, coin := range deposit.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		liquidationThreshold := mm.BorrowLimit.LoanToValue
+		if hasCategory && category.HasDenom(coin.Denom) {
+			liquidationThreshold = category.LiquidationThreshold
+		}
+		units := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor)).Mul(liquidationThreshold)
+		if units.IsPositive() {
+			depositUnits.Increment(coin.Denom, units.Quo(k.getSupplyInterestFactor(ctx, coin.Denom)))
+		}
+	}
+	borrowUnits := types.NewValuationMap()
+	for _, coin := range borrow.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		units := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor))
+		borrowUnits.Increment(coin.Denom, units.Quo(k.getBorrowInterestFactor(ctx, coin.Denom)))
+	}
+
+	// without any collateral value the borrower is always outside of their liquidation threshold
+	if len(depositUnits.Usd) == 0 {
+		for _, depCoin := range deposit.Amount {
+			for _, bCoin := range borrow.Amount {
+				k.SetLtvIndexScore(ctx, borrower, depCoin.Denom, bCoin.Denom, sdk.ZeroDec())
+			}
+		}
+		return
+	}
+
+	depositWeights, borrowWeights := k.getLtvIndexWeights(ctx, depositUnits, borrowUnits)
+	for _, depositDenom := range depositUnits.GetSortedKeys() {
+		weightedDeposit := depositUnits.Get(depositDenom).Quo(depositWeights.Get(depositDenom))
+		for _, borrowDenom := range borrowUnits.GetSortedKeys() {
+			weightedBorrow := borrowUnits.Get(borrowDenom).Quo(borrowWeights.Get(borrowDenom))
+			score := sdk.MaxSortableDec
+			if weightedBorrow.IsPositive() {
+				score = weightedDeposit.Quo(weightedBorrow)
+			}
+			k.SetLtvIndexScore(ctx, borrower, depositDenom, borrowDenom, score)
+		}
+	}
+}
+
+// getLtvIndexWeights returns each denom's share of the deposit and borrow value at current prices and interest factors.
+// Equal weights are used if any denom cannot be priced.
+func (k Keeper) getLtvIndexWeights(ctx sdk.Context, depositUnits, borrowUnits types.ValuationMap) (types.ValuationMap, types.ValuationMap) {
+	depositValues, borrowValues := types.NewValuationMap(), types.NewValuationMap()
+	priced := true
+	for denom, units := range depositUnits.Usd {
+		price, found := k.getLtvIndexPrice(ctx, denom)
+		priced = priced && found
+		depositValues.Increment(denom, units.Mul(k.getSupplyInterestFactor(ctx, denom)).Mul(price))
+	}
+	for denom, units := range borrowUnits.Usd {
+		price, found := k.getLtvIndexPrice(ctx, denom)
+		priced = priced && found
+		borrowValues.Increment(denom, units.Mul(k.getBorrowInterestFactor(ctx, denom)).Mul(price))
+	}
+	if !priced {
+		return equalWeights(depositUnits), equalWeights(borrowUnits)
+	}
+	return shareWeights(depositValues), shareWeights(borrowValues)
+}
+
+// ltvIndexThreshold is the current score below which borrowers indexed under a pair of deposit and borrow denoms may be
+// outside of their liquidation threshold
+type ltvIndexThreshold struct {
+	depositDenom string
+	borrowDenom  string
+	threshold    sdk.Dec
+}
+
+// getLtvIndexThresholds returns the current threshold of every pair of money markets that can be priced
+func (k Keeper) getLtvIndexThresholds(ctx sdk.Context) []ltvIndexThreshold {
+	moneyMarkets := k.GetAllMoneyMarkets(ctx)
+	prices := make(map[string]sdk.Dec)
+	for _, mm := range moneyMarkets {
+		if price, found := k.getLtvIndexPrice(ctx, mm.Denom); found {
+			prices[mm.Denom] = price
+		}
+	}
+
+	var thresholds []ltvIndexThreshold
+	for _, depositMarket := range moneyMarkets {
+		depositPrice, found := prices[depositMarket.Denom]
+		if !found {
+			continue
+		}
+		depositValue := depositPrice.Mul(k.getSupplyInterestFactor(ctx, depositMarket.Denom))
+		for _, borrowMarket := range moneyMarkets {
+			borrowPrice, found := prices[borrowMarket.Denom]
+			if !found {
+				continue
+			}
+			borrowValue := borrowPrice.Mul(k.getBorrowInterestFactor(ctx, borrowMarket.Denom))
+			threshold := sdk.MaxSortableDec
+			if depositValue.IsPositive() {
+				threshold = sdk.MinDec(borrowValue.Quo(depositValue), sdk.MaxSortableDec)
+			}
+			thresholds = append(thresholds, ltvIndexThreshold{depositMarket.Denom, borrowMarket.Denom, threshold})
+		}
+	}
+	return thresholds
+}
+
+// getLtvIndexThresholdsByPair returns the current thresholds keyed by LTV index pair key
+func (k Keeper) getLtvIndexThresholdsByPair(ctx sdk.Context) map[string]sdk.Dec {
+	thresholds := make(map[string]sdk.Dec)
+	for _, pair := range k.getLtvIndexThresholds(ctx) {
+		thresholds[string(types.LtvIndexPairKey(pair.depositDenom, pair.borrowDenom))] = pair.threshold
+	}
+	return thresholds
+}
+
+// getLtvIndexPrice returns the current price of a money market's denom
+func (k Keeper) getLtvIndexPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+	if err != nil {
+		return sdk.ZeroDec(), false
+	}
+	return priceData.Price, true
+}
+
+// getSupplyInterestFactor returns the supply interest factor of a denom, which starts at one
+func (k Keeper) getSupplyInterestFactor(ctx sdk.Context, denom string) sdk.Dec {
+	factor, found := k.GetSupplyInterestFactor(ctx, denom)
+	if !found {
+		return sdk.OneDec()
+	}
+	return factor
+}
+
+// getBorrowInterestFactor returns the borrow interest factor of a denom, which starts at one
+func (k Keeper) getBorrowInterestFactor(ctx sdk.Context, denom string) sdk.Dec {
+	factor, found := k.GetBorrowInterestFactor(ctx, denom)
+	if !found {
+		return sdk.OneDec()
+	}
+	return factor
+}
+
+// equalWeights returns the same weight for every denom in a valuation map, adding up to one
+func equalWeights(vm types.ValuationMap) types.ValuationMap {
+	weights := types.NewValuationMap()
+	for denom := range vm.Usd {
+		weights.Increment(denom, sdk.OneDec().QuoInt64(int64(len(vm.Usd))))
+	}
+	return weights
+}
+
+// shareWeights returns each denom's share of the total value of a valuation map, or equal weights if any value is zero
+func shareWeights(vm types.ValuationMap) types.ValuationMap {
+	total := vm.Sum()
+	weights := types.NewValuationMap()
+	for denom, value := range vm.Usd {
+		if !value.IsPositive() {
+			return equalWeights(vm)
+		}
+		weights.Increment(denom, value.Quo(total))
+	}
+	return weights
+}
+
 // SeizeDeposits seizes a list of deposits and sends them to auction
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
@@ -81,15 +487,17 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 		return err
 	}
 
-	// Seize % of every deposit and send to the keeper
+	// Seize % of every deposit and send to the keeper, if the liquidation was triggered by one
 	keeperRewardCoins := sdk.Coins{}
-	for _, depCoin := range deposit.Amount {
-		mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
-		keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
-		if keeperReward.GT(sdk.ZeroInt()) {
-			// Send keeper their reward
-			keeperCoin := sdk.NewCoin(depCoin.Denom, keeperReward)
-			keeperRewardCoins = append(keeperRewardCoins, keeperCoin)
+	if !keeper.Empty() {
+		for _, depCoin := range deposit.Amount {
+			mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
+			keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
+			if keeperReward.GT(sdk.ZeroInt()) {
+				// Send keeper their reward
+				keeperCoin := sdk.NewCoin(depCoin.Denom, keeperReward)
+				keeperRewardCoins = append(keeperRewardCoins, keeperCoin)
+			}
 		}
 	}
 	if !keeperRewardCoins.Empty() {
@@ -296,16 +704,64 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 // IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
-	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	totalBorrowableUSDAmount, totalBorrowedUSDAmount, err := k.calculateBorrowableAndBorrowedUSD(ctx, deposit, borrow, false)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the user's has borrowed more than they're allowed to
+	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// IsWithinLiquidationThreshold compares a borrow and deposit to see if it can be liquidated at current prices.
+// The liquidation threshold only differs from the LTV range for accounts in an efficiency category.
+func (k Keeper) IsWithinLiquidationThreshold(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
+	totalLiquidationUSDAmount, totalBorrowedUSDAmount, err := k.calculateBorrowableAndBorrowedUSD(ctx, deposit, borrow, true)
 	if err != nil {
 		return false, err
 	}
 
+	return totalBorrowedUSDAmount.LTE(totalLiquidationUSDAmount), nil
+}
+
+// CalculateBorrowLimitUsed returns the fraction of a deposit's liquidation threshold used by a borrow at current prices.
+// Positions using more than their whole liquidation threshold can be liquidated.
+func (k Keeper) CalculateBorrowLimitUsed(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	totalBorrowableUSDAmount, totalBorrowedUSDAmount, err := k.calculateBorrowableAndBorrowedUSD(ctx, deposit, borrow, true)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if totalBorrowableUSDAmount.IsZero() {
+		if totalBorrowedUSDAmount.IsZero() {
+			return sdk.ZeroDec(), nil
+		}
+		return sdk.MaxSortableDec, nil
+	}
+	return totalBorrowedUSDAmount.Quo(totalBorrowableUSDAmount), nil
+}
+
+// calculateBorrowableAndBorrowedUSD returns the USD value that can be borrowed against a deposit and the USD value of a borrow.
+// When atLiquidationThreshold is set the borrowable value is calculated with liquidation thresholds instead of loan-to-values.
+func (k Keeper) calculateBorrowableAndBorrowedUSD(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow, atLiquidationThreshold bool) (sdk.Dec, sdk.Dec, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+
 	totalBorrowableUSDAmount := sdk.ZeroDec()
 	for _, depCoin := range deposit.Amount {
 		lData := liqMap[depCoin.Denom]
 		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
+		ltv := lData.ltv
+		if atLiquidationThreshold {
+			ltv = lData.liquidationThreshold
+		}
+		borrowableUSDAmountForDeposit := usdValue.Mul(ltv)
 		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(borrowableUSDAmountForDeposit)
 	}
 
@@ -316,12 +772,7 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
 	}
 
-	// Check if the user's has borrowed more than they're allowed to
-	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
-		return false, nil
-	}
-
-	return true, nil
+	return totalBorrowableUSDAmount, totalBorrowedUSDAmount, nil
 }
 
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
@@ -377,10 +828,17 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 	return borrowCoinValues.Sum().Quo(sumDeposits), nil
 }
 
-// LoadLiquidationData returns liquidation data, deposit, borrow
+// LoadLiquidationData returns liquidation data, deposit, borrow.
+// Assets in the owner's efficiency category use the category's loan-to-value and liquidation threshold.
 func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (map[string]LiqData, error) {
 	liqMap := make(map[string]LiqData)
 
+	owner := deposit.Depositor
+	if owner.Empty() {
+		owner = borrow.Borrower
+	}
+	category, hasCategory := k.GetEfficiencyCategory(ctx, owner)
+
 	borrowDenoms := getDenoms(borrow.Amount)
 	depositDenoms := getDenoms(deposit.Amount)
 	denoms := removeDuplicates(borrowDenoms, depositDenoms)
@@ -397,7 +855,12 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		ltv, liquidationThreshold := mm.BorrowLimit.LoanToValue, mm.BorrowLimit.LoanToValue
+		if hasCategory && category.HasDenom(denom) {
+			ltv, liquidationThreshold = category.LoanToValue, category.LiquidationThreshold
+		}
+
+		liqMap[denom] = LiqData{priceData.Price, ltv, liquidationThreshold, mm.ConversionFactor}
 	}
 
 	return liqMap, nil
@@ -554,57 +554,68 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				types.MoneyMarkets{
 					types.NewMoneyMarket("usdx",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdx:usd",                   // Market ID
-						sdkmath.NewInt(FURY_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdx:usd",                  // Market ID
+						sdkmath.NewInt(FURY_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("usdt",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdt:usd",                   // Market ID
-						sdkmath.NewInt(FURY_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdt:usd",                  // Market ID
+						sdkmath.NewInt(FURY_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("usdc",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdc:usd",                   // Market ID
-						sdkmath.NewInt(FURY_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdc:usd",                  // Market ID
+						sdkmath.NewInt(FURY_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("dai",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"dai:usd",                    // Market ID
-						sdkmath.NewInt(FURY_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"dai:usd",                   // Market ID
+						sdkmath.NewInt(FURY_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("ufury",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"fury:usd",                   // Market ID
-						sdkmath.NewInt(FURY_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"fury:usd",                  // Market ID
+						sdkmath.NewInt(FURY_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("bnb",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"bnb:usd",                    // Market ID
-						sdkmath.NewInt(BNB_CF),       // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"bnb:usd",                   // Market ID
+						sdkmath.NewInt(BNB_CF),      // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("btc",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BTCB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"btc:usd",                    // Market ID
-						sdkmath.NewInt(BTCB_CF),      // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"btc:usd",                   // Market ID
+						sdkmath.NewInt(BTCB_CF),     // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 				},
 				sdk.NewDec(10),
+				0,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 			)
 
 			// Pricefeed module genesis state
@@ -763,3 +774,256 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestLiquidateBorrowers() {
+	type args struct {
+		checkLtvIndexCount uint64
+		expectLiquidation  bool
+	}
+
+	testCases := []struct {
+		name string
+		args args
+	}{
+		{
+			"valid: underwater borrower is liquidated",
+			args{
+				checkLtvIndexCount: 10,
+				expectLiquidation:  true,
+			},
+		},
+		{
+			"valid: riskiest borrower is checked first",
+			args{
+				checkLtvIndexCount: 1,
+				expectLiquidation:  true,
+			},
+		},
+		{
+			"valid: scanning disabled",
+			args{
+				checkLtvIndexCount: 0,
+				expectLiquidation:  false,
+			},
+		},
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	healthyBorrower := sdk.AccAddress(crypto.AddressHash([]byte("testhealthyborrower")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+			coins := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF)))
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{coins, coins, coins},
+				[]sdk.AccAddress{borrower, healthyBorrower, depositor},
+			)
+
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{
+					types.NewMoneyMarket("ufury",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.8")),
+						"fury:usd",
+						sdkmath.NewInt(FURY_CF),
+						model,
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.05"), types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus),
+				},
+				sdk.NewDec(10),
+				tc.args.checkLtvIndexCount,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
+			)
+
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "fury:usd", BaseAsset: "fury", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{
+						MarketID:      "fury:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, coins))
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, healthyBorrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(20*FURY_CF)))))
+			suite.Require().NoError(suite.keeper.Borrow(suite.ctx, healthyBorrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(6*FURY_CF)))))
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(10*FURY_CF)))))
+			suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(8*FURY_CF)))))
+
+			// borrowers are indexed from the lowest to the highest score
+			var indexed []sdk.AccAddress
+			suite.keeper.IterateLtvIndexByPair(suite.ctx, "ufury", "ufury", sdk.MaxSortableDec, func(addr sdk.AccAddress) bool {
+				indexed = append(indexed, addr)
+				return false
+			})
+			suite.Require().Equal([]sdk.AccAddress{borrower, healthyBorrower}, indexed)
+
+			// accumulated interest pushes the borrower outside of the valid LTV range
+			liqCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 30 * 24 * 3600))
+			hard.BeginBlocker(liqCtx, suite.keeper)
+
+			_, foundBorrow := suite.keeper.GetBorrow(liqCtx, borrower)
+			_, foundDeposit := suite.keeper.GetDeposit(liqCtx, borrower)
+			suite.Require().Equal(!tc.args.expectLiquidation, foundBorrow)
+			suite.Require().Equal(!tc.args.expectLiquidation, foundDeposit)
+
+			_, foundHealthyBorrow := suite.keeper.GetBorrow(liqCtx, healthyBorrower)
+			suite.Require().True(foundHealthyBorrow)
+
+			auctions := suite.auctionKeeper.GetAllAuctions(liqCtx)
+			if !tc.args.expectLiquidation {
+				suite.Require().Empty(auctions)
+				return
+			}
+			suite.Require().Len(auctions, 1)
+
+			// no keeper reward is seized, the whole deposit goes to auction
+			suite.Require().Equal(sdk.NewInt64Coin("ufury", 10000901), auctions[0].GetLot())
+
+			indexed = nil
+			suite.keeper.IterateLtvIndexByPair(liqCtx, "ufury", "ufury", sdk.MaxSortableDec, func(addr sdk.AccAddress) bool {
+				indexed = append(indexed, addr)
+				return false
+			})
+			suite.Require().Equal([]sdk.AccAddress{healthyBorrower}, indexed)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLiquidateBorrowers_PriceChange() {
+	addrs := suite.setupIsolationMode()
+	borrower, furyDepositor := addrs[1], addrs[2]
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CheckLtvIndexCount = 10
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, furyDepositor, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(500*FURY_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(
+		sdk.NewCoin("busd", sdkmath.NewInt(500*BUSD_CF)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)),
+	)))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(200*FURY_CF)))))
+
+	// the borrow of 400 USD is within the liquidation value of 480 USD
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+
+	// the index finds the borrower after a price change without the position being re-indexed
+	suite.setPrice("fury:usd", sdk.MustNewDecFromStr("2.5"))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+
+	var scores int
+	suite.keeper.IterateBorrowerLtvScores(suite.ctx, borrower, func(_, _ string, _ sdk.Dec) bool {
+		scores++
+		return false
+	})
+	suite.Require().Zero(scores)
+}
+
+func (suite *KeeperTestSuite) TestLiquidateBorrowers_Budget() {
+	addrs := suite.setupIsolationMode()
+	busdBorrower, furyBorrower := addrs[1], addrs[2]
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CheckLtvIndexCount = 1
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// the borrowers are indexed under different pairs
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, busdBorrower, sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(100*BUSD_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, busdBorrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(70*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, furyBorrower, sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(100*FURY_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, furyBorrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF)))))
+
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.5"))
+	suite.setPrice("fury:usd", sdk.OneDec())
+
+	// the budget is shared by all pairs, so only one of the borrowers is liquidated each block
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, busdFound := suite.keeper.GetBorrow(suite.ctx, busdBorrower)
+	_, furyFound := suite.keeper.GetBorrow(suite.ctx, furyBorrower)
+	suite.Require().True(busdFound != furyFound)
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, busdFound = suite.keeper.GetBorrow(suite.ctx, busdBorrower)
+	_, furyFound = suite.keeper.GetBorrow(suite.ctx, furyBorrower)
+	suite.Require().False(busdFound)
+	suite.Require().False(furyFound)
+}
+
+func (suite *KeeperTestSuite) TestPartialLiquidate() {
+	addrs := suite.setupIsolationMode()
+	borrower, liquidator := addrs[1], addrs[2]
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(100*BUSD_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(70*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// healthy positions cannot be liquidated
+	_, _, err = suite.keeper.PartialLiquidate(suite.ctx, liquidator, borrower, sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF)), "busd")
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.8"))
+	_, _, err = suite.keeper.PartialLiquidate(suite.ctx, liquidator, borrower, sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF)), "ufury")
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralDenom)
+	_, _, err = suite.keeper.PartialLiquidate(suite.ctx, liquidator, borrower, sdk.NewCoin("busd", sdkmath.NewInt(10*BUSD_CF)), "busd")
+	suite.Require().ErrorIs(err, types.ErrInvalidRepaymentDenom)
+
+	// the repayment is capped at the close factor, the seized collateral includes the liquidation bonus
+	repaid, seized, err := suite.keeper.PartialLiquidate(suite.ctx, liquidator, borrower, sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF)), "busd")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(35_000_000)), repaid)
+	suite.Equal(sdk.NewCoin("busd", sdkmath.NewInt(4_593_750_000)), seized)
+	suite.Equal(sdkmath.NewInt(965_000_000), bk.GetBalance(suite.ctx, liquidator, "usdx").Amount)
+	suite.Equal(sdkmath.NewInt(104_593_750_000), bk.GetBalance(suite.ctx, liquidator, "busd").Amount)
+
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(35_000_000))), borrow.Amount)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(5_406_250_000))), deposit.Amount)
+
+	// the repayment is reduced when there isn't enough collateral to cover it plus the bonus
+	suite.setPrice("busd:usd", sdk.MustNewDecFromStr("0.3"))
+	repaid, seized, err = suite.keeper.PartialLiquidate(suite.ctx, liquidator, borrower, sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF)), "busd")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(15_446_429)), repaid)
+	suite.Equal(sdk.NewCoin("busd", sdkmath.NewInt(5_406_250_000)), seized)
+
+	borrow, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(19_553_571))), borrow.Amount)
+	_, found = suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.False(found)
+}
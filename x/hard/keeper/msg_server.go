@@ -28,7 +28,12 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	receipt := sdk.NewCoins()
+	if msg.Receipt {
+		receipt, err = k.keeper.DepositForReceipt(ctx, depositor, msg.Amount)
+	} else {
+		err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +45,7 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
 		),
 	)
-	return &types.MsgDepositResponse{}, nil
+	return &types.MsgDepositResponse{Receipt: receipt}, nil
 }
 
 func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
@@ -51,9 +56,27 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
-	if err != nil {
-		return nil, err
+	// receipt tokens are redeemed separately from the depositor's deposit
+	var coins, receipts sdk.Coins
+	for _, coin := range msg.Amount {
+		if _, isReceipt := types.ParseReceiptDenom(coin.Denom); isReceipt {
+			receipts = append(receipts, coin)
+		} else {
+			coins = append(coins, coin)
+		}
+	}
+
+	if !coins.Empty() {
+		err = k.keeper.Withdraw(ctx, depositor, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !receipts.Empty() {
+		_, err = k.keeper.WithdrawReceipt(ctx, depositor, receipts)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -74,7 +97,15 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.M
 		return nil, err
 	}
 
-	err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	onBehalfOf := borrower
+	if len(msg.OnBehalfOf) > 0 {
+		onBehalfOf, err = sdk.AccAddressFromBech32(msg.OnBehalfOf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.keeper.BorrowOnBehalfOf(ctx, borrower, onBehalfOf, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -144,3 +175,136 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) SetEfficiencyCategory(goCtx context.Context, msg *types.MsgSetEfficiencyCategory) (*types.MsgSetEfficiencyCategoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetEfficiencyCategory(ctx, owner, msg.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgSetEfficiencyCategoryResponse{}, nil
+}
+
+func (k msgServer) FlashLoan(goCtx context.Context, msg *types.MsgFlashLoan) (*types.MsgFlashLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.keeper.FlashLoan(ctx, borrower, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Borrower),
+		),
+	)
+	return &types.MsgFlashLoanResponse{Results: results}, nil
+}
+
+func (k msgServer) PartialLiquidate(goCtx context.Context, msg *types.MsgPartialLiquidate) (*types.MsgPartialLiquidateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	repaid, seized, err := k.keeper.PartialLiquidate(ctx, keeper, borrower, msg.Repay, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Keeper),
+		),
+	)
+	return &types.MsgPartialLiquidateResponse{Repaid: repaid, Seized: seized}, nil
+}
+
+func (k msgServer) GrantCreditDelegation(goCtx context.Context, msg *types.MsgGrantCreditDelegation) (*types.MsgGrantCreditDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.GrantCreditDelegation(ctx, delegator, delegatee, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+	return &types.MsgGrantCreditDelegationResponse{}, nil
+}
+
+func (k msgServer) RevokeCreditDelegation(goCtx context.Context, msg *types.MsgRevokeCreditDelegation) (*types.MsgRevokeCreditDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevokeCreditDelegation(ctx, delegator, delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+	return &types.MsgRevokeCreditDelegationResponse{}, nil
+}
@@ -429,7 +429,7 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper, lega
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY, err := CalculateBorrowRate(k.GetInterestRateModel(ctx, moneyMarket), sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+// DepositForReceipt deposits coins and mints hard/<denom> receipt tokens to the depositor in place of recording a deposit.
+// One receipt token is worth the supply interest factor of its denom, so receipt tokens earn the same interest as deposits,
+// but they cannot be used as collateral.
+func (k Keeper) DepositForReceipt(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	// Set any new denoms' global supply index to 1.0
+	for _, coin := range coins {
+		_, foundInterestFactor := k.GetSupplyInterestFactor(ctx, coin.Denom)
+		if !foundInterestFactor {
+			_, foundMm := k.GetMoneyMarket(ctx, coin.Denom)
+			if foundMm {
+				k.SetSupplyInterestFactor(ctx, coin.Denom, sdk.OneDec())
+			}
+		}
+	}
+
+	err := k.ValidateDeposit(ctx, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := sdk.NewCoins()
+	for _, coin := range coins {
+		supplyFactor, _ := k.GetSupplyInterestFactor(ctx, coin.Denom)
+		amount := sdk.NewDecFromInt(coin.Amount).Quo(supplyFactor).TruncateInt()
+		if amount.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidReceiptAmount, "deposit of %s is worth less than one receipt token", coin)
+		}
+		receipts = receipts.Add(sdk.NewCoin(types.ReceiptDenom(coin.Denom), amount))
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleAccountName, receipts)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	k.IncrementSuppliedCoins(ctx, coins)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptCoins, receipts.String()),
+		),
+	)
+	return receipts, nil
+}
+
+// WithdrawReceipt burns hard/<denom> receipt tokens and sends their value in the underlying denoms to the owner
+func (k Keeper) WithdrawReceipt(ctx sdk.Context, owner sdk.AccAddress, receipts sdk.Coins) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, receipt := range receipts {
+		denom, isReceipt := types.ParseReceiptDenom(receipt.Denom)
+		if !isReceipt {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWithdrawDenom, "%s is not a receipt denom", receipt.Denom)
+		}
+		supplyFactor, found := k.GetSupplyInterestFactor(ctx, denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWithdrawDenom, "no supply interest factor found for %s", denom)
+		}
+		amount := sdk.NewDecFromInt(receipt.Amount).Mul(supplyFactor).TruncateInt()
+		if amount.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidReceiptAmount, "%s is worth less than one %s", receipt, denom)
+		}
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, receipts)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleAccountName, receipts)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DecrementSuppliedCoins(ctx, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptCoins, receipts.String()),
+		),
+	)
+	return coins, nil
+}
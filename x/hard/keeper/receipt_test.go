@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/hard"
+	"github.com/mage-coven/fury/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestDepositReceipt() {
+	addrs := suite.setupIsolationMode()
+	depositor, holder := addrs[1], addrs[2]
+	bk := suite.app.GetBankKeeper()
+
+	receipts, err := suite.keeper.DepositForReceipt(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("hard/usdx", sdkmath.NewInt(100*USDX_CF))), receipts)
+	suite.Equal(sdkmath.NewInt(100*USDX_CF), bk.GetBalance(suite.ctx, depositor, "hard/usdx").Amount)
+	_, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.False(found)
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Equal(sdkmath.NewInt(1100*USDX_CF), supplied.AmountOf("usdx"))
+
+	// receipt tokens earn supply interest while borrowed against
+	err = suite.keeper.Borrow(suite.ctx, addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(800*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	supplyFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "usdx")
+	suite.True(supplyFactor.GT(sdk.OneDec()))
+
+	// receipt tokens are transferable and redeemable by any holder
+	transferred := sdk.NewCoins(sdk.NewCoin("hard/usdx", sdkmath.NewInt(40*USDX_CF)))
+	err = bk.SendCoins(suite.ctx, depositor, holder, transferred)
+	suite.Require().NoError(err)
+	coins, err := suite.keeper.WithdrawReceipt(suite.ctx, holder, transferred)
+	suite.Require().NoError(err)
+	expected := sdk.NewDec(40 * USDX_CF).Mul(supplyFactor).TruncateInt()
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", expected)), coins)
+	suite.Equal(sdkmath.NewInt(1000*USDX_CF).Add(expected), bk.GetBalance(suite.ctx, holder, "usdx").Amount)
+	suite.Equal(sdkmath.NewInt(60*USDX_CF), bk.GetSupply(suite.ctx, "hard/usdx").Amount)
+
+	_, err = suite.keeper.WithdrawReceipt(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawDenom)
+}
@@ -65,9 +65,11 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 	if err != nil {
 		return err
 	}
+	k.DecrementIsolatedDebt(ctx, owner, payment)
 
 	// Call incentive hook
 	k.AfterBorrowModified(ctx, borrow)
+	k.UpdateLtvIndex(ctx, owner)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -228,22 +228,28 @@ func (suite *KeeperTestSuite) TestRepay() {
 				types.MoneyMarkets{
 					types.NewMoneyMarket("usdx",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
-						"usdx:usd",                     // Market ID
-						sdkmath.NewInt(USDX_CF),        // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+						"usdx:usd",                    // Market ID
+						sdkmath.NewInt(USDX_CF),       // Conversion Factor
+						model,                         // Interest Rate Model
+						sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+						sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 					types.NewMoneyMarket("ufury",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*FURY_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"fury:usd",                     // Market ID
-						sdkmath.NewInt(FURY_CF),        // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+						"fury:usd",                    // Market ID
+						sdkmath.NewInt(FURY_CF),       // Conversion Factor
+						model,                         // Interest Rate Model
+						sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+						sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+						types.NewSupplyLimit(false, sdk.ZeroDec()), types.NewIsolationMode(false, sdk.ZeroDec(), false), types.DefaultLiquidationBonus), // Supply Limit
 				},
 				sdk.NewDec(10),
+				0,
+				nil,
+				types.DefaultFlashLoanFee,
+				types.DefaultCloseFactor,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultAccountEfficiencyCategories, types.DefaultIsolatedDebts, types.DefaultAdaptiveRates, types.DefaultCreditDelegations,
 			)
 
 			// Pricefeed module genesis state
@@ -75,9 +75,11 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	if err != nil {
 		return err
 	}
+	k.UpdateIsolatedDebtCollateral(ctx, depositor, existingDeposit.Amount)
 
 	// Call incentive hook
 	k.AfterDepositModified(ctx, deposit)
+	k.UpdateLtvIndex(ctx, depositor)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgSetEfficiencyCategory{}, "hard/MsgSetEfficiencyCategory", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "hard/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgPartialLiquidate{}, "hard/MsgPartialLiquidate", nil)
+	cdc.RegisterConcrete(&MsgGrantCreditDelegation{}, "hard/MsgGrantCreditDelegation", nil)
+	cdc.RegisterConcrete(&MsgRevokeCreditDelegation{}, "hard/MsgRevokeCreditDelegation", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +29,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgSetEfficiencyCategory{},
+		&MsgFlashLoan{},
+		&MsgPartialLiquidate{},
+		&MsgGrantCreditDelegation{},
+		&MsgRevokeCreditDelegation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
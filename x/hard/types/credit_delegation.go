@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCreditDelegation returns a new CreditDelegation
+func NewCreditDelegation(delegator, delegatee sdk.AccAddress, allowance sdk.Coins) CreditDelegation {
+	return CreditDelegation{
+		Delegator: delegator,
+		Delegatee: delegatee,
+		Allowance: allowance,
+	}
+}
+
+// Validate performs validation of CreditDelegation
+func (cd CreditDelegation) Validate() error {
+	if cd.Delegator.Empty() {
+		return fmt.Errorf("delegator cannot be empty")
+	}
+	if cd.Delegatee.Empty() {
+		return fmt.Errorf("delegatee cannot be empty")
+	}
+	if cd.Delegator.Equals(cd.Delegatee) {
+		return fmt.Errorf("delegator and delegatee cannot be the same: %s", cd.Delegator)
+	}
+	if !cd.Allowance.IsValid() || cd.Allowance.Empty() {
+		return fmt.Errorf("invalid credit delegation allowance: %s", cd.Allowance)
+	}
+	return nil
+}
+
+// ToResponse converts CreditDelegation to CreditDelegationResponse
+func (cd CreditDelegation) ToResponse() CreditDelegationResponse {
+	return NewCreditDelegationResponse(cd.Delegator, cd.Delegatee, cd.Allowance)
+}
+
+// CreditDelegations slice of CreditDelegation
+type CreditDelegations []CreditDelegation
+
+// Validate performs validation of CreditDelegations
+func (cds CreditDelegations) Validate() error {
+	seen := make(map[string]bool)
+	for _, cd := range cds {
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		key := cd.Delegator.String() + "/" + cd.Delegatee.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate credit delegation from %s to %s", cd.Delegator, cd.Delegatee)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ToResponse converts CreditDelegations to CreditDelegationResponses
+func (cds CreditDelegations) ToResponse() CreditDelegationResponses {
+	cdResponses := CreditDelegationResponses{}
+	for _, cd := range cds {
+		cdResponses = append(cdResponses, cd.ToResponse())
+	}
+	return cdResponses
+}
+
+// NewCreditDelegationResponse returns a new CreditDelegationResponse
+func NewCreditDelegationResponse(delegator, delegatee sdk.AccAddress, allowance sdk.Coins) CreditDelegationResponse {
+	return CreditDelegationResponse{
+		Delegator: delegator.String(),
+		Delegatee: delegatee.String(),
+		Allowance: allowance,
+	}
+}
+
+// CreditDelegationResponses is a slice of CreditDelegationResponse
+type CreditDelegationResponses []CreditDelegationResponse
@@ -67,4 +67,28 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrGreaterThanAssetSupplyLimit error for when a proposed deposit would increase supplied amount over the asset's global supply limit
+	ErrGreaterThanAssetSupplyLimit = errorsmod.Register(ModuleName, 33, "fails global asset supply limit validation")
+	// ErrEfficiencyCategoryNotFound error for when an efficiency category cannot be found
+	ErrEfficiencyCategoryNotFound = errorsmod.Register(ModuleName, 34, "efficiency category not found")
+	// ErrInvalidEfficiencyCategoryBorrow error for when an account borrows an asset outside of its efficiency category
+	ErrInvalidEfficiencyCategoryBorrow = errorsmod.Register(ModuleName, 35, "borrow denom not in efficiency category")
+	// ErrNotBorrowableInIsolation error for when an account with isolated collateral borrows an asset that is not borrowable in isolation
+	ErrNotBorrowableInIsolation = errorsmod.Register(ModuleName, 36, "asset is not borrowable in isolation")
+	// ErrExceedsDebtCeiling error for when a borrow would increase the debt backed by an isolated asset over its debt ceiling
+	ErrExceedsDebtCeiling = errorsmod.Register(ModuleName, 37, "fails isolated asset debt ceiling validation")
+	// ErrInvalidIsolatedCollateral error for when a deposit would add more than one isolated asset, or an isolated asset that cannot back an existing borrow
+	ErrInvalidIsolatedCollateral = errorsmod.Register(ModuleName, 38, "invalid isolated collateral")
+	// ErrFlashLoanNotRepaid error for when a flash loan plus fee is not repaid by the end of the message
+	ErrFlashLoanNotRepaid = errorsmod.Register(ModuleName, 39, "flash loan not repaid")
+	// ErrInvalidCollateralDenom error for when a keeper attempts to seize a non-deposited coin type
+	ErrInvalidCollateralDenom = errorsmod.Register(ModuleName, 40, "no coins of this type deposited")
+	// ErrInvalidLiquidationAmount error for when a partial liquidation would repay or seize zero coins
+	ErrInvalidLiquidationAmount = errorsmod.Register(ModuleName, 41, "invalid partial liquidation amount")
+	// ErrCreditDelegationNotFound error for when a borrow on behalf of another account has no credit delegation from it
+	ErrCreditDelegationNotFound = errorsmod.Register(ModuleName, 42, "credit delegation not found")
+	// ErrExceedsCreditAllowance error for when a borrow on behalf of another account exceeds its credit delegation allowance
+	ErrExceedsCreditAllowance = errorsmod.Register(ModuleName, 43, "borrow exceeds credit delegation allowance")
+	// ErrInvalidReceiptAmount error for when a deposit or withdrawal of receipt tokens rounds to zero
+	ErrInvalidReceiptAmount = errorsmod.Register(ModuleName, 44, "invalid receipt amount")
 )
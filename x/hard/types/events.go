@@ -2,24 +2,35 @@ package types
 
 // Event types for hard module
 const (
-	EventTypeHardDeposit          = "hard_deposit"
-	EventTypeHardWithdrawal       = "hard_withdrawal"
-	EventTypeHardBorrow           = "hard_borrow"
-	EventTypeHardLiquidation      = "hard_liquidation"
-	EventTypeHardRepay            = "hard_repay"
-	AttributeValueCategory        = ModuleName
-	AttributeKeyDeposit           = "deposit"
-	AttributeKeyDepositDenom      = "deposit_denom"
-	AttributeKeyDepositCoins      = "deposit_coins"
-	AttributeKeyDepositor         = "depositor"
-	AttributeKeyBorrow            = "borrow"
-	AttributeKeyBorrower          = "borrower"
-	AttributeKeyBorrowCoins       = "borrow_coins"
-	AttributeKeySender            = "sender"
-	AttributeKeyRepayCoins        = "repay_coins"
-	AttributeKeyLiquidatedOwner   = "liquidated_owner"
-	AttributeKeyLiquidatedCoins   = "liquidated_coins"
-	AttributeKeyKeeper            = "keeper"
-	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
-	AttributeKeyOwner             = "owner"
+	EventTypeHardDeposit                = "hard_deposit"
+	EventTypeHardWithdrawal             = "hard_withdrawal"
+	EventTypeHardBorrow                 = "hard_borrow"
+	EventTypeHardLiquidation            = "hard_liquidation"
+	EventTypeHardRepay                  = "hard_repay"
+	EventTypeHardSetEfficiencyCategory  = "hard_set_efficiency_category"
+	EventTypeHardFlashLoan              = "hard_flash_loan"
+	EventTypeHardPartialLiquidation     = "hard_partial_liquidation"
+	EventTypeHardGrantCreditDelegation  = "hard_grant_credit_delegation"
+	EventTypeHardRevokeCreditDelegation = "hard_revoke_credit_delegation"
+	AttributeValueCategory              = ModuleName
+	AttributeKeyDeposit                 = "deposit"
+	AttributeKeyDepositDenom            = "deposit_denom"
+	AttributeKeyDepositCoins            = "deposit_coins"
+	AttributeKeyDepositor               = "depositor"
+	AttributeKeyBorrow                  = "borrow"
+	AttributeKeyBorrower                = "borrower"
+	AttributeKeyBorrowCoins             = "borrow_coins"
+	AttributeKeySender                  = "sender"
+	AttributeKeyRepayCoins              = "repay_coins"
+	AttributeKeyLiquidatedOwner         = "liquidated_owner"
+	AttributeKeyLiquidatedCoins         = "liquidated_coins"
+	AttributeKeyKeeper                  = "keeper"
+	AttributeKeyKeeperRewardCoins       = "keeper_reward_coins"
+	AttributeKeyOwner                   = "owner"
+	AttributeKeyEfficiencyCategory      = "efficiency_category"
+	AttributeKeyFlashLoanFee            = "flash_loan_fee"
+	AttributeKeyDelegator               = "delegator"
+	AttributeKeyDelegatee               = "delegatee"
+	AttributeKeyAllowance               = "allowance"
+	AttributeKeyReceiptCoins            = "receipt_coins"
 )
@@ -11,28 +11,38 @@ import (
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
 	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins,
+	accountEfficiencyCategories AccountEfficiencyCategories, isolatedDebts IsolatedDebts, adaptiveRates AdaptiveRates,
+	creditDelegations CreditDelegations,
 ) GenesisState {
 	return GenesisState{
-		Params:                    params,
-		PreviousAccumulationTimes: prevAccumulationTimes,
-		Deposits:                  deposits,
-		Borrows:                   borrows,
-		TotalSupplied:             totalSupplied,
-		TotalBorrowed:             totalBorrowed,
-		TotalReserves:             totalReserves,
+		Params:                      params,
+		PreviousAccumulationTimes:   prevAccumulationTimes,
+		Deposits:                    deposits,
+		Borrows:                     borrows,
+		TotalSupplied:               totalSupplied,
+		TotalBorrowed:               totalBorrowed,
+		TotalReserves:               totalReserves,
+		AccountEfficiencyCategories: accountEfficiencyCategories,
+		IsolatedDebts:               isolatedDebts,
+		AdaptiveRates:               adaptiveRates,
+		CreditDelegations:           creditDelegations,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:                    DefaultParams(),
-		PreviousAccumulationTimes: DefaultAccumulationTimes,
-		Deposits:                  DefaultDeposits,
-		Borrows:                   DefaultBorrows,
-		TotalSupplied:             DefaultTotalSupplied,
-		TotalBorrowed:             DefaultTotalBorrowed,
-		TotalReserves:             DefaultTotalReserves,
+		Params:                      DefaultParams(),
+		PreviousAccumulationTimes:   DefaultAccumulationTimes,
+		Deposits:                    DefaultDeposits,
+		Borrows:                     DefaultBorrows,
+		TotalSupplied:               DefaultTotalSupplied,
+		TotalBorrowed:               DefaultTotalBorrowed,
+		TotalReserves:               DefaultTotalReserves,
+		AccountEfficiencyCategories: DefaultAccountEfficiencyCategories,
+		IsolatedDebts:               DefaultIsolatedDebts,
+		AdaptiveRates:               DefaultAdaptiveRates,
+		CreditDelegations:           DefaultCreditDelegations,
 	}
 }
 
@@ -61,7 +71,21 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
-	return nil
+	if err := gs.AccountEfficiencyCategories.Validate(); err != nil {
+		return err
+	}
+	for _, aec := range gs.AccountEfficiencyCategories {
+		if _, found := gs.Params.EfficiencyCategories.Get(aec.Category); !found {
+			return fmt.Errorf("efficiency category %s of %s not found in params", aec.Category, aec.Owner)
+		}
+	}
+	if err := gs.IsolatedDebts.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AdaptiveRates.Validate(); err != nil {
+		return err
+	}
+	return gs.CreditDelegations.Validate()
 }
 
 // NewGenesisAccumulationTime returns a new GenesisAccumulationTime
@@ -97,3 +121,114 @@ func (gat GenesisAccumulationTime) Validate() error {
 	}
 	return nil
 }
+
+// NewAccountEfficiencyCategory returns a new AccountEfficiencyCategory
+func NewAccountEfficiencyCategory(owner sdk.AccAddress, category string) AccountEfficiencyCategory {
+	return AccountEfficiencyCategory{
+		Owner:    owner,
+		Category: category,
+	}
+}
+
+// Validate performs validation of AccountEfficiencyCategory
+func (aec AccountEfficiencyCategory) Validate() error {
+	if aec.Owner.Empty() {
+		return fmt.Errorf("efficiency category owner cannot be empty")
+	}
+	if aec.Category == "" {
+		return fmt.Errorf("efficiency category of %s cannot be blank", aec.Owner)
+	}
+	return nil
+}
+
+// AccountEfficiencyCategories slice of AccountEfficiencyCategory
+type AccountEfficiencyCategories []AccountEfficiencyCategory
+
+// Validate performs validation of AccountEfficiencyCategories
+func (aecs AccountEfficiencyCategories) Validate() error {
+	seenOwners := make(map[string]bool)
+	for _, aec := range aecs {
+		if err := aec.Validate(); err != nil {
+			return err
+		}
+		if seenOwners[aec.Owner.String()] {
+			return fmt.Errorf("duplicate efficiency category for %s", aec.Owner)
+		}
+		seenOwners[aec.Owner.String()] = true
+	}
+	return nil
+}
+
+// NewIsolatedDebt returns a new IsolatedDebt
+func NewIsolatedDebt(denom string, principal sdk.DecCoins) IsolatedDebt {
+	return IsolatedDebt{
+		Denom:     denom,
+		Principal: principal,
+	}
+}
+
+// Validate performs validation of IsolatedDebt
+func (id IsolatedDebt) Validate() error {
+	if err := sdk.ValidateDenom(id.Denom); err != nil {
+		return err
+	}
+	if err := id.Principal.Validate(); err != nil {
+		return fmt.Errorf("invalid isolated debt principal of %s: %w", id.Denom, err)
+	}
+	return nil
+}
+
+// IsolatedDebts slice of IsolatedDebt
+type IsolatedDebts []IsolatedDebt
+
+// Validate performs validation of IsolatedDebts
+func (ids IsolatedDebts) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[id.Denom] {
+			return fmt.Errorf("duplicate isolated debt for %s", id.Denom)
+		}
+		seenDenoms[id.Denom] = true
+	}
+	return nil
+}
+
+// NewAdaptiveRate returns a new AdaptiveRate
+func NewAdaptiveRate(denom string, rateAtTarget sdk.Dec) AdaptiveRate {
+	return AdaptiveRate{
+		Denom:        denom,
+		RateAtTarget: rateAtTarget,
+	}
+}
+
+// Validate performs validation of AdaptiveRate
+func (ar AdaptiveRate) Validate() error {
+	if err := sdk.ValidateDenom(ar.Denom); err != nil {
+		return err
+	}
+	if ar.RateAtTarget.IsNil() || !ar.RateAtTarget.IsPositive() {
+		return fmt.Errorf("adaptive rate at target of %s must be positive: %s", ar.Denom, ar.RateAtTarget)
+	}
+	return nil
+}
+
+// AdaptiveRates slice of AdaptiveRate
+type AdaptiveRates []AdaptiveRate
+
+// Validate performs validation of AdaptiveRates
+func (ars AdaptiveRates) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, ar := range ars {
+		if err := ar.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[ar.Denom] {
+			return fmt.Errorf("duplicate adaptive rate for %s", ar.Denom)
+		}
+		seenDenoms[ar.Denom] = true
+	}
+	return nil
+}
@@ -32,20 +32,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the hard module's genesis state.
 type GenesisState struct {
-	Params                    Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PreviousAccumulationTimes GenesisAccumulationTimes                 `protobuf:"bytes,2,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
-	Deposits                  Deposits                                 `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
-	Borrows                   Borrows                                  `protobuf:"bytes,4,rep,name=borrows,proto3,castrepeated=Borrows" json:"borrows"`
-	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
-	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
-	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	Params                      Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PreviousAccumulationTimes   GenesisAccumulationTimes                 `protobuf:"bytes,2,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
+	Deposits                    Deposits                                 `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Borrows                     Borrows                                  `protobuf:"bytes,4,rep,name=borrows,proto3,castrepeated=Borrows" json:"borrows"`
+	TotalSupplied               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
+	TotalBorrowed               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
+	TotalReserves               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	AccountEfficiencyCategories AccountEfficiencyCategories              `protobuf:"bytes,8,rep,name=account_efficiency_categories,json=accountEfficiencyCategories,proto3,castrepeated=AccountEfficiencyCategories" json:"account_efficiency_categories"`
+	IsolatedDebts               IsolatedDebts                            `protobuf:"bytes,9,rep,name=isolated_debts,json=isolatedDebts,proto3,castrepeated=IsolatedDebts" json:"isolated_debts"`
+	AdaptiveRates               AdaptiveRates                            `protobuf:"bytes,10,rep,name=adaptive_rates,json=adaptiveRates,proto3,castrepeated=AdaptiveRates" json:"adaptive_rates"`
+	CreditDelegations           CreditDelegations                        `protobuf:"bytes,11,rep,name=credit_delegations,json=creditDelegations,proto3,castrepeated=CreditDelegations" json:"credit_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{0}
+	return fileDescriptor_770e279a4224a6a0, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +127,34 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetAccountEfficiencyCategories() AccountEfficiencyCategories {
+	if m != nil {
+		return m.AccountEfficiencyCategories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIsolatedDebts() IsolatedDebts {
+	if m != nil {
+		return m.IsolatedDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdaptiveRates() AdaptiveRates {
+	if m != nil {
+		return m.AdaptiveRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditDelegations() CreditDelegations {
+	if m != nil {
+		return m.CreditDelegations
+	}
+	return nil
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -135,7 +167,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{1}
+	return fileDescriptor_770e279a4224a6a0, []int{1}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,47 +215,57 @@ func init() {
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "fury.hard.v1beta1.GenesisAccumulationTime")
 }
 
-func init() { proto.RegisterFile("fury/hard/v1beta1/genesis.proto", fileDescriptor_20a1f6c2cf728e74) }
+func init() { proto.RegisterFile("fury/hard/v1beta1/genesis.proto", fileDescriptor_770e279a4224a6a0) }
 
-var fileDescriptor_20a1f6c2cf728e74 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x6d, 0xc2, 0x16, 0x5a, 0xb0, 0x2a, 0x70, 0x02, 0xb2, 0xa3, 0x1e, 0x20,
-	0x42, 0x8a, 0x4d, 0xcb, 0x81, 0x0b, 0x07, 0x30, 0x11, 0x1f, 0x37, 0xe4, 0xe6, 0xc4, 0xc5, 0x5a,
-	0x3b, 0x5b, 0xd7, 0xaa, 0x9d, 0xb5, 0x76, 0xd6, 0x81, 0xbc, 0x03, 0x42, 0x7d, 0x0e, 0xce, 0x3c,
-	0x01, 0xa7, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x17, 0x41, 0xfb, 0x91, 0xa4, 0x28, 0x89,
-	0xc4, 0x81, 0x9e, 0xe2, 0xdd, 0xfd, 0xcf, 0xff, 0x37, 0x3b, 0x3b, 0x13, 0xe4, 0x9c, 0xe0, 0x21,
-	0xf6, 0x8e, 0x31, 0xeb, 0x7b, 0xc3, 0xfd, 0x88, 0x70, 0xbc, 0xef, 0x25, 0x64, 0x40, 0x20, 0x05,
-	0xb7, 0x60, 0x94, 0x53, 0xf3, 0x8e, 0x10, 0xb8, 0x42, 0xe0, 0x6a, 0x41, 0xd3, 0x8e, 0x29, 0xe4,
-	0x14, 0xbc, 0x08, 0x03, 0x99, 0x45, 0xc5, 0x34, 0x1d, 0xa8, 0x90, 0x66, 0x43, 0x9d, 0x87, 0x72,
-	0xe5, 0xa9, 0x85, 0x3e, 0xda, 0x4d, 0x68, 0x42, 0xd5, 0xbe, 0xf8, 0xd2, 0xbb, 0x4e, 0x42, 0x69,
-	0x92, 0x11, 0x4f, 0xae, 0xa2, 0xf2, 0xc8, 0xe3, 0x69, 0x4e, 0x80, 0xe3, 0xbc, 0xd0, 0x82, 0x07,
-	0x8b, 0x59, 0xca, 0x8c, 0xe4, 0xe9, 0xde, 0xf7, 0x0d, 0x74, 0xf3, 0x8d, 0x4a, 0xfa, 0x90, 0x63,
-	0x4e, 0xcc, 0x67, 0x68, 0xb3, 0xc0, 0x0c, 0xe7, 0x60, 0x19, 0x2d, 0xa3, 0xbd, 0x75, 0xd0, 0x70,
-	0x17, 0x2e, 0xe1, 0xbe, 0x97, 0x02, 0x7f, 0xfd, 0xec, 0xc2, 0xa9, 0x04, 0x5a, 0x6e, 0x7e, 0x36,
-	0xd0, 0xfd, 0x82, 0x91, 0x61, 0x4a, 0x4b, 0x08, 0x71, 0x1c, 0x97, 0x79, 0x99, 0x61, 0x9e, 0xd2,
-	0x41, 0x28, 0x33, 0xb2, 0xd6, 0x5a, 0xd5, 0xf6, 0xd6, 0xc1, 0xe3, 0x25, 0x76, 0x9a, 0xff, 0xf2,
-	0x4a, 0x4c, 0x2f, 0xcd, 0x89, 0xdf, 0x12, 0xfe, 0x5f, 0x2f, 0x1d, 0x6b, 0x85, 0x00, 0x82, 0xc6,
-	0x14, 0xb8, 0x70, 0x64, 0xbe, 0x45, 0xf5, 0x3e, 0x29, 0x28, 0xa4, 0x1c, 0xac, 0xaa, 0x44, 0x37,
-	0x97, 0xa0, 0xbb, 0x4a, 0xe2, 0xdf, 0xd6, 0xa8, 0xba, 0xde, 0x80, 0x60, 0x16, 0x6d, 0x76, 0x51,
-	0x2d, 0xa2, 0x8c, 0xd1, 0x8f, 0x60, 0xad, 0x4b, 0xa3, 0x65, 0x25, 0xf1, 0xa5, 0xc2, 0xdf, 0xd1,
-	0x3e, 0x35, 0xb5, 0x86, 0x60, 0x1a, 0x6a, 0x32, 0xb4, 0xcd, 0x29, 0xc7, 0x59, 0x08, 0x65, 0x51,
-	0x64, 0x29, 0xe9, 0x5b, 0x1b, 0xda, 0x4c, 0x3f, 0xb2, 0xe8, 0x88, 0x99, 0xdd, 0x2b, 0x9a, 0x0e,
-	0xfc, 0x27, 0xda, 0xac, 0x9d, 0xa4, 0xfc, 0xb8, 0x8c, 0xdc, 0x98, 0xe6, 0xba, 0x23, 0xf4, 0x4f,
-	0x07, 0xfa, 0x27, 0x1e, 0x1f, 0x15, 0x04, 0x64, 0x00, 0x04, 0xb7, 0x24, 0xe2, 0x50, 0x13, 0xe6,
-	0x4c, 0x95, 0x04, 0xe9, 0x5b, 0x9b, 0xd7, 0xc5, 0xf4, 0x35, 0x61, 0xce, 0x64, 0x04, 0x08, 0x1b,
-	0x12, 0xb0, 0x6a, 0xd7, 0xc5, 0x0c, 0x34, 0x61, 0xef, 0x4b, 0x15, 0xdd, 0x5b, 0xd1, 0x23, 0xe6,
-	0x23, 0xb4, 0x13, 0xd3, 0x2c, 0xc3, 0x9c, 0x30, 0x9c, 0x85, 0xc2, 0x44, 0x36, 0xf6, 0x8d, 0x60,
-	0x7b, 0xbe, 0xdd, 0x1b, 0x15, 0xc4, 0x8c, 0x50, 0x73, 0x75, 0xfb, 0x5a, 0x6b, 0x72, 0x18, 0x9a,
-	0xae, 0x9a, 0x36, 0x77, 0x3a, 0x6d, 0x6e, 0x6f, 0x3a, 0x6d, 0x7e, 0x5d, 0xdc, 0xe2, 0xf4, 0xd2,
-	0x31, 0x02, 0x6b, 0x55, 0x57, 0x9a, 0x0c, 0xdd, 0x95, 0xcf, 0x3f, 0x0a, 0xd3, 0x01, 0x27, 0x8c,
-	0x00, 0x0f, 0x8f, 0x70, 0xcc, 0x29, 0xb3, 0xaa, 0x22, 0x27, 0xff, 0xb9, 0xf0, 0xf8, 0x75, 0xe1,
-	0x3c, 0xfc, 0x87, 0x4a, 0x74, 0x49, 0xfc, 0xe3, 0x5b, 0x07, 0xe9, 0xaa, 0x76, 0x49, 0x1c, 0xec,
-	0x2a, 0xef, 0x77, 0xda, 0xfa, 0xb5, 0x74, 0x16, 0x4c, 0xf5, 0xfc, 0x0b, 0xcc, 0xf5, 0xff, 0xc1,
-	0x54, 0xde, 0x7f, 0x33, 0xfd, 0x17, 0x67, 0x63, 0xdb, 0x38, 0x1f, 0xdb, 0xc6, 0xef, 0xb1, 0x6d,
-	0x9c, 0x4e, 0xec, 0xca, 0xf9, 0xc4, 0xae, 0xfc, 0x9c, 0xd8, 0x95, 0x0f, 0x57, 0x29, 0x62, 0x8a,
-	0x3a, 0x19, 0x8e, 0x40, 0x7e, 0x79, 0x9f, 0xd4, 0x9f, 0x94, 0x24, 0x45, 0x9b, 0xb2, 0xc2, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0xde, 0x3f, 0x0d, 0x64, 0x05, 0x00, 0x00,
+var fileDescriptor_770e279a4224a6a0 = []byte{
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xc7, 0xe3, 0x9b, 0xd2, 0xa6, 0x53, 0xd2, 0x4b, 0xad, 0x0b, 0x38, 0x29, 0x24, 0xd1, 0xad,
+	0x44, 0x2b, 0x44, 0x6d, 0x5a, 0x16, 0x6c, 0xd8, 0xc4, 0x0d, 0x1f, 0xdd, 0x21, 0xb7, 0x2b, 0x24,
+	0x64, 0x8d, 0xc7, 0x27, 0xee, 0x08, 0xdb, 0x63, 0xcd, 0x8c, 0x03, 0x79, 0x02, 0x36, 0x08, 0x75,
+	0xc3, 0x4b, 0xb0, 0xe6, 0x21, 0xba, 0xac, 0x58, 0x21, 0x16, 0x2d, 0x6a, 0x5f, 0x04, 0x79, 0x66,
+	0xf2, 0x41, 0x13, 0x4b, 0x2c, 0x6e, 0x57, 0xed, 0x39, 0xe7, 0x7f, 0xfe, 0xbf, 0x13, 0x7b, 0xe6,
+	0x18, 0xf5, 0xc7, 0x25, 0x9f, 0x7a, 0x57, 0x98, 0xc7, 0xde, 0xe4, 0x24, 0x02, 0x89, 0x4f, 0xbc,
+	0x04, 0x72, 0x10, 0x54, 0xb8, 0x05, 0x67, 0x92, 0xd9, 0x7b, 0x95, 0xc0, 0xad, 0x04, 0xae, 0x11,
+	0x74, 0x7b, 0x84, 0x89, 0x8c, 0x09, 0x2f, 0xc2, 0x02, 0xe6, 0x5d, 0x84, 0xd1, 0x5c, 0xb7, 0x74,
+	0x3b, 0xba, 0x1e, 0xaa, 0xc8, 0xd3, 0x81, 0x29, 0xbd, 0x4a, 0x58, 0xc2, 0x74, 0xbe, 0xfa, 0xcf,
+	0x64, 0xfb, 0x09, 0x63, 0x49, 0x0a, 0x9e, 0x8a, 0xa2, 0x72, 0xec, 0x49, 0x9a, 0x81, 0x90, 0x38,
+	0x2b, 0x8c, 0xe0, 0x83, 0xd5, 0x29, 0xd5, 0x44, 0xaa, 0xfa, 0xfa, 0xe7, 0x6d, 0xf4, 0xf6, 0xd7,
+	0x7a, 0xe8, 0x0b, 0x89, 0x25, 0xd8, 0x9f, 0xa3, 0xcd, 0x02, 0x73, 0x9c, 0x09, 0xc7, 0x1a, 0x58,
+	0x47, 0x3b, 0xa7, 0x1d, 0x77, 0xe5, 0x47, 0xb8, 0xdf, 0x2a, 0x81, 0xbf, 0x71, 0x73, 0xd7, 0x6f,
+	0x04, 0x46, 0x6e, 0xff, 0x62, 0xa1, 0xfd, 0x82, 0xc3, 0x84, 0xb2, 0x52, 0x84, 0x98, 0x90, 0x32,
+	0x2b, 0x53, 0x2c, 0x29, 0xcb, 0x43, 0x35, 0x91, 0xf3, 0x62, 0xd0, 0x3c, 0xda, 0x39, 0xfd, 0x78,
+	0x8d, 0x9d, 0xe1, 0x0f, 0x97, 0x7a, 0x2e, 0x69, 0x06, 0xfe, 0xa0, 0xf2, 0xff, 0xfd, 0xbe, 0xef,
+	0xd4, 0x08, 0x44, 0xd0, 0x99, 0x01, 0x57, 0x4a, 0xf6, 0x37, 0xa8, 0x15, 0x43, 0xc1, 0x04, 0x95,
+	0xc2, 0x69, 0x2a, 0x74, 0x77, 0x0d, 0x7a, 0xa4, 0x25, 0xfe, 0x3b, 0x06, 0xd5, 0x32, 0x09, 0x11,
+	0xcc, 0xbb, 0xed, 0x11, 0xda, 0x8a, 0x18, 0xe7, 0xec, 0x47, 0xe1, 0x6c, 0x0c, 0x9a, 0x35, 0x8f,
+	0xc4, 0x57, 0x0a, 0xff, 0xa5, 0xf1, 0xd9, 0xd2, 0xb1, 0x08, 0x66, 0xad, 0x36, 0x47, 0xbb, 0x92,
+	0x49, 0x9c, 0x86, 0xa2, 0x2c, 0x8a, 0x94, 0x42, 0xec, 0xbc, 0x65, 0xcc, 0xcc, 0x4b, 0xae, 0x4e,
+	0xc4, 0xdc, 0xee, 0x8c, 0xd1, 0xdc, 0xff, 0xd4, 0x98, 0x1d, 0x25, 0x54, 0x5e, 0x95, 0x91, 0x4b,
+	0x58, 0x66, 0x4e, 0x84, 0xf9, 0x73, 0x2c, 0xe2, 0x1f, 0x3c, 0x39, 0x2d, 0x40, 0xa8, 0x06, 0x11,
+	0xb4, 0x15, 0xe2, 0xc2, 0x10, 0x16, 0x4c, 0x3d, 0x04, 0xc4, 0xce, 0xe6, 0x73, 0x31, 0x7d, 0x43,
+	0x58, 0x30, 0x39, 0x08, 0xe0, 0x13, 0x10, 0xce, 0xd6, 0x73, 0x31, 0x03, 0x43, 0xb0, 0x7f, 0xb3,
+	0xd0, 0x87, 0x98, 0x10, 0x56, 0xe6, 0x32, 0x84, 0xf1, 0x98, 0x12, 0x0a, 0x39, 0x99, 0x86, 0x04,
+	0x4b, 0x48, 0x18, 0xa7, 0x20, 0x9c, 0x96, 0x9a, 0xe1, 0x93, 0x35, 0x2f, 0x6e, 0xa8, 0xfb, 0xbe,
+	0x9c, 0xb7, 0x9d, 0xe9, 0xae, 0xa9, 0x7f, 0x60, 0xc6, 0xda, 0xaf, 0x93, 0x50, 0x10, 0xc1, 0x3e,
+	0xae, 0x2f, 0xda, 0xdf, 0xa3, 0x5d, 0x2a, 0x58, 0x8a, 0x25, 0xc4, 0x61, 0x0c, 0x91, 0x14, 0xce,
+	0xb6, 0x9a, 0xa3, 0xbf, 0x66, 0x8e, 0x73, 0x23, 0x1c, 0x41, 0x24, 0xfd, 0x77, 0x0d, 0xba, 0xbd,
+	0x9c, 0x15, 0x41, 0x9b, 0x2e, 0x87, 0x95, 0x3d, 0x8e, 0x71, 0x21, 0xe9, 0x04, 0x42, 0x8e, 0x25,
+	0x08, 0x07, 0xd5, 0xda, 0x0f, 0x8d, 0x30, 0xc0, 0x12, 0x16, 0xf6, 0xcb, 0x59, 0x11, 0xb4, 0xf1,
+	0x72, 0x68, 0x67, 0xc8, 0x26, 0x1c, 0x62, 0x2a, 0xc3, 0x18, 0x52, 0x48, 0xd4, 0xdd, 0x12, 0xce,
+	0x8e, 0x42, 0x1c, 0xac, 0x41, 0x9c, 0x29, 0xf1, 0x68, 0xae, 0xf5, 0x3b, 0x06, 0xb3, 0xf7, 0xb4,
+	0x22, 0x82, 0x3d, 0xf2, 0x34, 0xf5, 0xfa, 0xd7, 0x26, 0x7a, 0xbf, 0xe6, 0xa2, 0xdb, 0x87, 0xe8,
+	0x25, 0x61, 0x69, 0xf5, 0xd3, 0x39, 0x4e, 0xc3, 0xea, 0x24, 0xa8, 0xed, 0xb4, 0x1d, 0xec, 0x2e,
+	0xd2, 0x97, 0xd3, 0x02, 0xec, 0x08, 0x75, 0xeb, 0x77, 0x90, 0xf3, 0x42, 0x6d, 0xb4, 0xae, 0xab,
+	0x57, 0xa6, 0x3b, 0x5b, 0x99, 0xee, 0xe5, 0x6c, 0x65, 0xfa, 0xad, 0x6a, 0xe4, 0xeb, 0xfb, 0xbe,
+	0x15, 0x38, 0x75, 0xab, 0xc5, 0xe6, 0xe8, 0x3d, 0x75, 0x87, 0xa7, 0x21, 0xcd, 0x25, 0x70, 0x10,
+	0x32, 0x1c, 0x63, 0x22, 0x19, 0x77, 0x9a, 0xd5, 0x4c, 0xfe, 0x17, 0x95, 0xc7, 0xdf, 0x77, 0xfd,
+	0x8f, 0xfe, 0xc7, 0x71, 0x1e, 0x01, 0xf9, 0xf3, 0x8f, 0x63, 0xa4, 0xf3, 0x55, 0x14, 0xbc, 0xd2,
+	0xde, 0xe7, 0xc6, 0xfa, 0x2b, 0xe5, 0x5c, 0x31, 0xf5, 0x1d, 0x5e, 0x61, 0x6e, 0xbc, 0x09, 0xa6,
+	0xf6, 0xfe, 0x2f, 0xd3, 0x1f, 0xde, 0x3c, 0xf4, 0xac, 0xdb, 0x87, 0x9e, 0xf5, 0xcf, 0x43, 0xcf,
+	0xba, 0x7e, 0xec, 0x35, 0x6e, 0x1f, 0x7b, 0x8d, 0xbf, 0x1e, 0x7b, 0x8d, 0xef, 0x0e, 0x97, 0x28,
+	0x19, 0x4e, 0xe0, 0x98, 0xb0, 0x09, 0xe4, 0x9e, 0xfa, 0xd0, 0xfc, 0xa4, 0x3f, 0x35, 0x0a, 0x15,
+	0x6d, 0xaa, 0x47, 0xfc, 0xd9, 0xbf, 0x03, 0x00, 0x92, 0x14, 0x6f, 0xba, 0x2a, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +288,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreditDelegations) > 0 {
+		for iNdEx := len(m.CreditDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AdaptiveRates) > 0 {
+		for iNdEx := len(m.AdaptiveRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdaptiveRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.IsolatedDebts) > 0 {
+		for iNdEx := len(m.IsolatedDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccountEfficiencyCategories) > 0 {
+		for iNdEx := len(m.AccountEfficiencyCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountEfficiencyCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -456,6 +554,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountEfficiencyCategories) > 0 {
+		for _, e := range m.AccountEfficiencyCategories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IsolatedDebts) > 0 {
+		for _, e := range m.IsolatedDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdaptiveRates) > 0 {
+		for _, e := range m.AdaptiveRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for _, e := range m.CreditDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -750,6 +872,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountEfficiencyCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountEfficiencyCategories = append(m.AccountEfficiencyCategories, AccountEfficiencyCategory{})
+			if err := m.AccountEfficiencyCategories[len(m.AccountEfficiencyCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedDebts = append(m.IsolatedDebts, IsolatedDebt{})
+			if err := m.IsolatedDebts[len(m.IsolatedDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptiveRates = append(m.AdaptiveRates, AdaptiveRate{})
+			if err := m.AdaptiveRates[len(m.AdaptiveRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDelegations = append(m.CreditDelegations, CreditDelegation{})
+			if err := m.CreditDelegations[len(m.CreditDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
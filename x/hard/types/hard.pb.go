@@ -26,17 +26,60 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InterestRateModelType is the type of curve used to calculate a money market's borrow rate.
+type InterestRateModelType int32
+
+const (
+	// INTEREST_RATE_MODEL_TYPE_JUMP_RATE is a single kink curve defined by the base rate, base multiplier, kink and
+	// jump multiplier.
+	INTEREST_RATE_MODEL_TYPE_JUMP_RATE InterestRateModelType = 0
+	// INTEREST_RATE_MODEL_TYPE_MULTI_KINK is a piecewise-linear curve from the base rate through each kink.
+	INTEREST_RATE_MODEL_TYPE_MULTI_KINK InterestRateModelType = 1
+	// INTEREST_RATE_MODEL_TYPE_ADAPTIVE is a curve around a rate at target utilization that adapts over time to move
+	// utilization toward the target.
+	INTEREST_RATE_MODEL_TYPE_ADAPTIVE InterestRateModelType = 2
+)
+
+var InterestRateModelType_name = map[int32]string{
+	0: "INTEREST_RATE_MODEL_TYPE_JUMP_RATE",
+	1: "INTEREST_RATE_MODEL_TYPE_MULTI_KINK",
+	2: "INTEREST_RATE_MODEL_TYPE_ADAPTIVE",
+}
+
+var InterestRateModelType_value = map[string]int32{
+	"INTEREST_RATE_MODEL_TYPE_JUMP_RATE":  0,
+	"INTEREST_RATE_MODEL_TYPE_MULTI_KINK": 1,
+	"INTEREST_RATE_MODEL_TYPE_ADAPTIVE":   2,
+}
+
+func (x InterestRateModelType) String() string {
+	return proto.EnumName(InterestRateModelType_name, int32(x))
+}
+
+func (InterestRateModelType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{0}
+}
+
 // Params defines the parameters for the hard module.
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	// check_ltv_index_count is the total number of borrowers checked for liquidation each block, taken in turn from each
+	// pair of deposit and borrow markets.
+	CheckLtvIndexCount uint64 `protobuf:"varint,3,opt,name=check_ltv_index_count,json=checkLtvIndexCount,proto3" json:"check_ltv_index_count,omitempty"`
+	// efficiency_categories are groups of correlated assets that can be borrowed against each other with higher limits.
+	EfficiencyCategories EfficiencyCategories `protobuf:"bytes,4,rep,name=efficiency_categories,json=efficiencyCategories,proto3,castrepeated=EfficiencyCategories" json:"efficiency_categories"`
+	// flash_loan_fee is the fraction of a flash loan paid by the borrower to the protocol reserves.
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// close_factor is the maximum fraction of a borrower's debt in one denom that can be repaid in a partial liquidation.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{0}
+	return fileDescriptor_ca59072e0228ae54, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -74,13 +117,17 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	SupplyLimit            SupplyLimit                            `protobuf:"bytes,8,opt,name=supply_limit,json=supplyLimit,proto3" json:"supply_limit"`
+	IsolationMode          IsolationMode                          `protobuf:"bytes,9,opt,name=isolation_mode,json=isolationMode,proto3" json:"isolation_mode"`
+	// liquidation_bonus is the discount on this market's collateral received by keepers in partial liquidations.
+	LiquidationBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=liquidation_bonus,json=liquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_bonus"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
 func (m *MoneyMarket) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarket) ProtoMessage()    {}
 func (*MoneyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{1}
+	return fileDescriptor_ca59072e0228ae54, []int{1}
 }
 func (m *MoneyMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +167,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_ca59072e0228ae54, []int{2}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,19 +196,228 @@ func (m *BorrowLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowLimit proto.InternalMessageInfo
 
+// SupplyLimit caps the amount of an asset that can be deposited into a money market.
+type SupplyLimit struct {
+	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
+	MaximumLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maximum_limit,json=maximumLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_limit"`
+}
+
+func (m *SupplyLimit) Reset()         { *m = SupplyLimit{} }
+func (m *SupplyLimit) String() string { return proto.CompactTextString(m) }
+func (*SupplyLimit) ProtoMessage()    {}
+func (*SupplyLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{3}
+}
+func (m *SupplyLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyLimit.Merge(m, src)
+}
+func (m *SupplyLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyLimit proto.InternalMessageInfo
+
+// IsolationMode limits the risk of a money market's asset as collateral. Accounts with deposits of an isolated asset can
+// only borrow assets that are borrowable in isolation, and the total debt backed by the isolated asset is capped.
+type IsolationMode struct {
+	Isolated bool `protobuf:"varint,1,opt,name=isolated,proto3" json:"isolated"`
+	// debt_ceiling is the maximum USD value of debt that can be backed by the isolated asset.
+	DebtCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=debt_ceiling,json=debtCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"debt_ceiling"`
+	// borrowable_in_isolation is set if the asset can be borrowed against isolated collateral.
+	BorrowableInIsolation bool `protobuf:"varint,3,opt,name=borrowable_in_isolation,json=borrowableInIsolation,proto3" json:"borrowable_in_isolation"`
+}
+
+func (m *IsolationMode) Reset()         { *m = IsolationMode{} }
+func (m *IsolationMode) String() string { return proto.CompactTextString(m) }
+func (*IsolationMode) ProtoMessage()    {}
+func (*IsolationMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{4}
+}
+func (m *IsolationMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolationMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolationMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolationMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolationMode.Merge(m, src)
+}
+func (m *IsolationMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolationMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolationMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolationMode proto.InternalMessageInfo
+
+// IsolatedDebt is the debt backed by an isolated asset in principal units: each borrowed amount divided by the borrow
+// interest factor of its denom. It is valued at current interest factors and prices when checked against the debt ceiling.
+type IsolatedDebt struct {
+	Denom     string                                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Principal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=principal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"principal"`
+}
+
+func (m *IsolatedDebt) Reset()         { *m = IsolatedDebt{} }
+func (m *IsolatedDebt) String() string { return proto.CompactTextString(m) }
+func (*IsolatedDebt) ProtoMessage()    {}
+func (*IsolatedDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{5}
+}
+func (m *IsolatedDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedDebt.Merge(m, src)
+}
+func (m *IsolatedDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedDebt proto.InternalMessageInfo
+
+// EfficiencyCategory is a group of correlated assets. Accounts that opt into a category use its loan-to-value and
+// liquidation threshold for deposits of the category's assets, and can only borrow assets in the category.
+type EfficiencyCategory struct {
+	Name                 string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Denoms               []string                               `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	LoanToValue          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold"`
+}
+
+func (m *EfficiencyCategory) Reset()         { *m = EfficiencyCategory{} }
+func (m *EfficiencyCategory) String() string { return proto.CompactTextString(m) }
+func (*EfficiencyCategory) ProtoMessage()    {}
+func (*EfficiencyCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{6}
+}
+func (m *EfficiencyCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EfficiencyCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EfficiencyCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EfficiencyCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EfficiencyCategory.Merge(m, src)
+}
+func (m *EfficiencyCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EfficiencyCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EfficiencyCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EfficiencyCategory proto.InternalMessageInfo
+
+// AccountEfficiencyCategory is the efficiency category an account has opted into.
+type AccountEfficiencyCategory struct {
+	Owner    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Category string                                        `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *AccountEfficiencyCategory) Reset()         { *m = AccountEfficiencyCategory{} }
+func (m *AccountEfficiencyCategory) String() string { return proto.CompactTextString(m) }
+func (*AccountEfficiencyCategory) ProtoMessage()    {}
+func (*AccountEfficiencyCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{7}
+}
+func (m *AccountEfficiencyCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountEfficiencyCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountEfficiencyCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountEfficiencyCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountEfficiencyCategory.Merge(m, src)
+}
+func (m *AccountEfficiencyCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountEfficiencyCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountEfficiencyCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountEfficiencyCategory proto.InternalMessageInfo
+
 // InterestRateModel contains information about an asset's interest rate.
 type InterestRateModel struct {
 	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
 	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
 	Kink           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
 	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+	// model_type selects the curve used to calculate the borrow rate from utilization.
+	ModelType InterestRateModelType `protobuf:"varint,5,opt,name=model_type,json=modelType,proto3,enum=fury.hard.v1beta1.InterestRateModelType" json:"model_type,omitempty"`
+	// kinks are the points of a multi-kink curve, used with the multi-kink model type.
+	Kinks InterestRateKinks `protobuf:"bytes,6,rep,name=kinks,proto3,castrepeated=InterestRateKinks" json:"kinks"`
+	// adaptive is the configuration of an adaptive curve, used with the adaptive model type.
+	Adaptive *AdaptiveRateModel `protobuf:"bytes,7,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
 }
 
 func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_ca59072e0228ae54, []int{8}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,6 +446,173 @@ func (m *InterestRateModel) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestRateModel proto.InternalMessageInfo
 
+// InterestRateKink is a point of a multi-kink interest rate curve.
+type InterestRateKink struct {
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization"`
+	RateAPY     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_apy,json=rateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_apy"`
+}
+
+func (m *InterestRateKink) Reset()         { *m = InterestRateKink{} }
+func (m *InterestRateKink) String() string { return proto.CompactTextString(m) }
+func (*InterestRateKink) ProtoMessage()    {}
+func (*InterestRateKink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{9}
+}
+func (m *InterestRateKink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestRateKink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestRateKink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestRateKink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestRateKink.Merge(m, src)
+}
+func (m *InterestRateKink) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestRateKink) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestRateKink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestRateKink proto.InternalMessageInfo
+
+// AdaptiveRateModel is an interest rate curve around a rate at target utilization. The rate at target moves toward
+// higher rates while utilization is above the target and toward lower rates while it is below.
+type AdaptiveRateModel struct {
+	TargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_utilization,json=targetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_utilization"`
+	// rate_at_target is the initial borrow APY at the target utilization.
+	RateAtTarget    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
+	MinRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_rate_at_target,json=minRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rate_at_target"`
+	MaxRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_rate_at_target,json=maxRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_at_target"`
+	// adjustment_speed is the fraction the rate at target changes by per year at 0% or 100% utilization.
+	AdjustmentSpeed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed"`
+	// curve_steepness is the ratio of the rate at 100% utilization to the rate at target, and of the rate at target to
+	// the rate at 0% utilization.
+	CurveSteepness github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=curve_steepness,json=curveSteepness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curve_steepness"`
+}
+
+func (m *AdaptiveRateModel) Reset()         { *m = AdaptiveRateModel{} }
+func (m *AdaptiveRateModel) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRateModel) ProtoMessage()    {}
+func (*AdaptiveRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{10}
+}
+func (m *AdaptiveRateModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptiveRateModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdaptiveRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRateModel.Merge(m, src)
+}
+func (m *AdaptiveRateModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRateModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveRateModel proto.InternalMessageInfo
+
+// AdaptiveRate is the current rate at target utilization of a money market with an adaptive interest rate model.
+type AdaptiveRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
+}
+
+func (m *AdaptiveRate) Reset()         { *m = AdaptiveRate{} }
+func (m *AdaptiveRate) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRate) ProtoMessage()    {}
+func (*AdaptiveRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{11}
+}
+func (m *AdaptiveRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptiveRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdaptiveRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRate.Merge(m, src)
+}
+func (m *AdaptiveRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveRate proto.InternalMessageInfo
+
+// CreditDelegation is an allowance for a delegatee to borrow against a delegator's deposit. The debt is recorded on
+// the delegator's borrow.
+type CreditDelegation struct {
+	Delegator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegatee github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegatee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegatee,omitempty"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+}
+
+func (m *CreditDelegation) Reset()         { *m = CreditDelegation{} }
+func (m *CreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*CreditDelegation) ProtoMessage()    {}
+func (*CreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{12}
+}
+func (m *CreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDelegation.Merge(m, src)
+}
+func (m *CreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditDelegation proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a hard module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
@@ -201,7 +624,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_ca59072e0228ae54, []int{13}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +664,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_ca59072e0228ae54, []int{14}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +703,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_ca59072e0228ae54, []int{15}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +742,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_ca59072e0228ae54, []int{16}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +780,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_ca59072e0228ae54, []int{17}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,10 +810,20 @@ func (m *CoinsProto) XXX_DiscardUnknown() {
 var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("fury.hard.v1beta1.InterestRateModelType", InterestRateModelType_name, InterestRateModelType_value)
 	proto.RegisterType((*Params)(nil), "fury.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "fury.hard.v1beta1.MoneyMarket")
 	proto.RegisterType((*BorrowLimit)(nil), "fury.hard.v1beta1.BorrowLimit")
+	proto.RegisterType((*SupplyLimit)(nil), "fury.hard.v1beta1.SupplyLimit")
+	proto.RegisterType((*IsolationMode)(nil), "fury.hard.v1beta1.IsolationMode")
+	proto.RegisterType((*IsolatedDebt)(nil), "fury.hard.v1beta1.IsolatedDebt")
+	proto.RegisterType((*EfficiencyCategory)(nil), "fury.hard.v1beta1.EfficiencyCategory")
+	proto.RegisterType((*AccountEfficiencyCategory)(nil), "fury.hard.v1beta1.AccountEfficiencyCategory")
 	proto.RegisterType((*InterestRateModel)(nil), "fury.hard.v1beta1.InterestRateModel")
+	proto.RegisterType((*InterestRateKink)(nil), "fury.hard.v1beta1.InterestRateKink")
+	proto.RegisterType((*AdaptiveRateModel)(nil), "fury.hard.v1beta1.AdaptiveRateModel")
+	proto.RegisterType((*AdaptiveRate)(nil), "fury.hard.v1beta1.AdaptiveRate")
+	proto.RegisterType((*CreditDelegation)(nil), "fury.hard.v1beta1.CreditDelegation")
 	proto.RegisterType((*Deposit)(nil), "fury.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "fury.hard.v1beta1.Borrow")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "fury.hard.v1beta1.SupplyInterestFactor")
@@ -398,67 +831,117 @@ func init() {
 	proto.RegisterType((*CoinsProto)(nil), "fury.hard.v1beta1.CoinsProto")
 }
 
-func init() { proto.RegisterFile("fury/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
-
-var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/hard/v1beta1/hard.proto", fileDescriptor_ca59072e0228ae54) }
+
+var fileDescriptor_ca59072e0228ae54 = []byte{
+	// 1706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0x7b, 0xec, 0x4c, 0xf2, 0xec, 0x78, 0x9c, 0xda, 0x64, 0xf0, 0x0c, 0x83, 0x3d, 0x78,
+	0xd9, 0x9d, 0x08, 0x94, 0x84, 0x9d, 0x15, 0x9c, 0x38, 0x90, 0x8e, 0xb3, 0x83, 0x99, 0x78, 0x89,
+	0x3a, 0xce, 0x4a, 0xb3, 0x42, 0xdb, 0x94, 0xbb, 0x2b, 0x4e, 0x6d, 0xba, 0xbb, 0x7a, 0xbb, 0xca,
+	0x9e, 0x98, 0x13, 0x17, 0xa4, 0x85, 0x03, 0xe2, 0x32, 0xe2, 0x03, 0x70, 0x40, 0xe2, 0x86, 0x34,
+	0x1f, 0x62, 0x24, 0x84, 0xb4, 0xda, 0x03, 0x42, 0x1c, 0x0c, 0x64, 0x6e, 0x73, 0xe1, 0xce, 0x01,
+	0xa1, 0xfa, 0x63, 0xbb, 0x93, 0xd8, 0x62, 0x46, 0xdb, 0xb3, 0xda, 0x93, 0x5d, 0xf5, 0x5e, 0xff,
+	0xde, 0x9f, 0xfa, 0xd5, 0xab, 0x57, 0x05, 0x77, 0x8e, 0xfb, 0xc9, 0x70, 0xfb, 0x04, 0x27, 0xfe,
+	0xf6, 0xe0, 0x9d, 0x2e, 0x11, 0xf8, 0x1d, 0x35, 0xd8, 0x8a, 0x13, 0x26, 0x18, 0x5a, 0x95, 0xd2,
+	0x2d, 0x35, 0x61, 0xa4, 0xb7, 0x6b, 0x1e, 0xe3, 0x21, 0xe3, 0xdb, 0x5d, 0xcc, 0xc9, 0xe4, 0x13,
+	0x8f, 0xd1, 0x48, 0x7f, 0x72, 0xfb, 0x96, 0x96, 0xbb, 0x6a, 0xb4, 0xad, 0x07, 0x46, 0xb4, 0xd6,
+	0x63, 0x3d, 0xa6, 0xe7, 0xe5, 0x3f, 0x3d, 0xdb, 0xf8, 0x6f, 0x1e, 0x16, 0x0f, 0x70, 0x82, 0x43,
+	0x8e, 0x1e, 0xc1, 0x4a, 0xc8, 0x22, 0x32, 0x74, 0x43, 0x9c, 0x9c, 0x12, 0xc1, 0xab, 0xd6, 0xdd,
+	0x6b, 0x1b, 0xc5, 0xfb, 0xb5, 0xad, 0x2b, 0x6e, 0x6c, 0xb5, 0xa5, 0x5e, 0x5b, 0xa9, 0xd9, 0x6b,
+	0xcf, 0x46, 0xf5, 0x85, 0x3f, 0xfe, 0xa3, 0x5e, 0x4a, 0x4d, 0x72, 0xa7, 0x14, 0xa6, 0x46, 0xe8,
+	0x37, 0x16, 0x54, 0x43, 0x1a, 0xd1, 0xb0, 0x1f, 0xba, 0x5d, 0x96, 0x24, 0xec, 0xb1, 0xdb, 0xe7,
+	0xbe, 0x3b, 0xc0, 0x41, 0x9f, 0x54, 0x73, 0x77, 0xad, 0x8d, 0x65, 0xfb, 0x48, 0xc2, 0xfc, 0x7d,
+	0x54, 0x7f, 0xbb, 0x47, 0xc5, 0x49, 0xbf, 0xbb, 0xe5, 0xb1, 0xd0, 0xf8, 0x6f, 0x7e, 0x36, 0xb9,
+	0x7f, 0xba, 0x2d, 0x86, 0x31, 0xe1, 0x5b, 0x4d, 0xe2, 0x9d, 0x8f, 0xea, 0xeb, 0x6d, 0x8d, 0x68,
+	0x2b, 0xc0, 0xa3, 0xc3, 0xe6, 0x07, 0x12, 0xee, 0xf3, 0xa7, 0x9b, 0x60, 0xe2, 0x6e, 0x12, 0xcf,
+	0x59, 0x0f, 0x2f, 0x28, 0x71, 0x5f, 0x29, 0xa1, 0x16, 0xac, 0x7b, 0x27, 0xc4, 0x3b, 0x75, 0x03,
+	0x31, 0x70, 0x69, 0xe4, 0x93, 0x33, 0xd7, 0x63, 0xfd, 0x48, 0x54, 0xaf, 0xdd, 0xb5, 0x36, 0xf2,
+	0xf6, 0xcd, 0xf3, 0x51, 0x1d, 0xed, 0x4a, 0x85, 0x7d, 0x31, 0x68, 0x49, 0xf1, 0xae, 0x94, 0x3a,
+	0xc8, 0xbb, 0x32, 0x87, 0xce, 0x60, 0x9d, 0x1c, 0x1f, 0x53, 0x8f, 0x92, 0xc8, 0x1b, 0xba, 0x1e,
+	0x16, 0xa4, 0xc7, 0x12, 0x4a, 0x78, 0x35, 0xaf, 0xd2, 0xf7, 0xd6, 0x8c, 0xf4, 0xed, 0x4d, 0xf4,
+	0x77, 0xb5, 0xfa, 0xd0, 0xbe, 0x63, 0xb2, 0xb8, 0x76, 0x45, 0x46, 0x09, 0x77, 0xd6, 0xc8, 0x8c,
+	0x59, 0xd4, 0x85, 0xf2, 0x71, 0x80, 0xf9, 0x89, 0x1b, 0x30, 0x1c, 0xb9, 0xc7, 0x84, 0x54, 0x0b,
+	0x2a, 0x95, 0x3f, 0x78, 0xb5, 0x54, 0x5e, 0xca, 0x58, 0x49, 0x61, 0xee, 0x33, 0x1c, 0xbd, 0x47,
+	0x08, 0x72, 0xa1, 0xe4, 0x05, 0x8c, 0x13, 0xf7, 0x18, 0x7b, 0x82, 0x25, 0xd5, 0xc5, 0x0c, 0x2c,
+	0x14, 0x15, 0xe2, 0x7b, 0x0a, 0xb0, 0xf1, 0xe7, 0x45, 0x28, 0xa6, 0x98, 0x83, 0xd6, 0xa0, 0xe0,
+	0x93, 0x88, 0x85, 0x55, 0x4b, 0x5a, 0x72, 0xf4, 0x00, 0x3d, 0x80, 0x92, 0xe1, 0x4d, 0x40, 0x43,
+	0x2a, 0x14, 0x67, 0x66, 0x53, 0x53, 0x2f, 0xf4, 0xbe, 0xd4, 0xb2, 0xf3, 0xd2, 0x4d, 0xa7, 0xd8,
+	0x9d, 0x4e, 0xa1, 0xef, 0x43, 0x99, 0xc7, 0x4c, 0x18, 0x8e, 0xbb, 0xd4, 0x57, 0x2b, 0xbe, 0x6c,
+	0x57, 0xce, 0x47, 0xf5, 0xd2, 0x61, 0xcc, 0x84, 0x76, 0xa3, 0xd5, 0x74, 0x4a, 0x7c, 0x3a, 0xf2,
+	0x11, 0x85, 0x55, 0x8f, 0x45, 0x03, 0x92, 0x70, 0xca, 0xa2, 0x71, 0x32, 0xf2, 0xaf, 0x9c, 0x8c,
+	0x56, 0x24, 0x52, 0xc9, 0x68, 0x45, 0xc2, 0xa9, 0x4c, 0x61, 0x75, 0x46, 0xd0, 0x87, 0xf0, 0x06,
+	0x8d, 0x04, 0x49, 0x08, 0x17, 0x6e, 0x82, 0x05, 0x71, 0x43, 0xe6, 0x93, 0x40, 0xad, 0x6d, 0xf1,
+	0xfe, 0xb7, 0x66, 0x84, 0xdc, 0x32, 0xda, 0x0e, 0x16, 0xa4, 0x2d, 0x75, 0x4d, 0xe0, 0xab, 0xf4,
+	0xb2, 0x00, 0x79, 0x50, 0x4e, 0x08, 0x27, 0xc9, 0x20, 0xd3, 0x05, 0x5d, 0x31, 0x98, 0x26, 0x80,
+	0x01, 0x54, 0x4f, 0x09, 0x89, 0x49, 0xe2, 0x26, 0xe4, 0x31, 0x4e, 0x7c, 0x37, 0x26, 0x89, 0x47,
+	0x22, 0x81, 0x7b, 0xa4, 0x7a, 0x3d, 0x03, 0x73, 0x37, 0x35, 0xba, 0xa3, 0xc0, 0x0f, 0x26, 0xd8,
+	0x92, 0x24, 0xbc, 0x1f, 0xc7, 0xc1, 0xd0, 0x90, 0x64, 0x69, 0x2e, 0x49, 0x0e, 0x95, 0xda, 0x05,
+	0x92, 0xf0, 0xe9, 0x14, 0x6a, 0x43, 0x99, 0x72, 0x16, 0x60, 0x21, 0xd7, 0x5a, 0x66, 0xbf, 0xba,
+	0xac, 0xa0, 0xee, 0xce, 0x4a, 0xfe, 0x58, 0x51, 0x26, 0xd8, 0x80, 0xad, 0xd0, 0xf4, 0xa4, 0xe4,
+	0x4e, 0x40, 0x3f, 0xe9, 0x53, 0x5f, 0x03, 0x76, 0x59, 0xd4, 0xe7, 0x55, 0xc8, 0x20, 0x11, 0x95,
+	0x14, 0xac, 0x2d, 0x51, 0x1b, 0xbf, 0xca, 0x41, 0x31, 0xb5, 0x03, 0xd0, 0xf7, 0x60, 0xe5, 0x04,
+	0x73, 0x37, 0xc4, 0x67, 0x26, 0x27, 0x72, 0x57, 0x2d, 0xd9, 0xab, 0x2f, 0x46, 0xf5, 0x8b, 0x02,
+	0xa7, 0x78, 0x82, 0x79, 0x1b, 0x9f, 0xe9, 0xcf, 0x30, 0xac, 0x84, 0xf8, 0x4c, 0x95, 0xeb, 0xe9,
+	0x7e, 0xfb, 0xc2, 0x85, 0xc5, 0x40, 0x6a, 0x13, 0x3f, 0x83, 0x15, 0x55, 0xb6, 0x04, 0x33, 0xc7,
+	0xc0, 0xb5, 0x2c, 0x2a, 0x8b, 0x84, 0xec, 0x30, 0x55, 0xe3, 0x1b, 0x7f, 0xb0, 0xa0, 0x98, 0x5a,
+	0xe8, 0xaf, 0x6e, 0x2e, 0x1a, 0xff, 0xb6, 0x60, 0xe5, 0x02, 0x8f, 0xd0, 0x06, 0x2c, 0x69, 0x0e,
+	0x11, 0xdf, 0xb8, 0x59, 0x7a, 0x31, 0xaa, 0x4f, 0xe6, 0x9c, 0xc9, 0x3f, 0x59, 0xa0, 0x7d, 0xd2,
+	0x15, 0xae, 0x47, 0x68, 0x40, 0xa3, 0x5e, 0x26, 0xde, 0x15, 0x25, 0xe2, 0xae, 0x06, 0x44, 0x87,
+	0xf0, 0x35, 0x5d, 0x40, 0x71, 0x37, 0x20, 0x2e, 0x8d, 0xdc, 0x09, 0xb9, 0xd5, 0x92, 0x2d, 0xd9,
+	0x5f, 0x7f, 0x31, 0xaa, 0xcf, 0x53, 0x71, 0xd6, 0xa7, 0x82, 0x56, 0x34, 0x89, 0xb1, 0xf1, 0xc4,
+	0x82, 0x52, 0xcb, 0x84, 0xd0, 0x24, 0xdd, 0x79, 0x65, 0x9f, 0xc1, 0x72, 0x9c, 0xd0, 0xc8, 0xa3,
+	0x31, 0x0e, 0xaa, 0x39, 0x75, 0x9e, 0xde, 0xd9, 0x32, 0x8e, 0xca, 0x16, 0x68, 0xb2, 0x0b, 0x9b,
+	0xc4, 0xdb, 0x65, 0x34, 0xb2, 0xdf, 0x35, 0xc7, 0xe8, 0x77, 0x5e, 0x2e, 0x6e, 0xf9, 0x0d, 0x77,
+	0xa6, 0x36, 0x1a, 0x4f, 0x72, 0x80, 0xae, 0x9e, 0xce, 0x08, 0x41, 0x3e, 0xc2, 0x21, 0x31, 0xce,
+	0xa9, 0xff, 0xe8, 0x26, 0x2c, 0x2a, 0x27, 0xb9, 0x72, 0x6c, 0xd9, 0x31, 0xa3, 0xd7, 0x4f, 0x6c,
+	0xf4, 0x09, 0xac, 0xa7, 0xeb, 0x89, 0x38, 0x49, 0x08, 0x3f, 0x61, 0x81, 0x5f, 0xcd, 0x67, 0x60,
+	0x69, 0x2d, 0x05, 0xdd, 0x19, 0x23, 0x37, 0x7e, 0x67, 0xc1, 0xad, 0x1d, 0x4f, 0xb5, 0x48, 0x33,
+	0xd2, 0xf3, 0x11, 0x14, 0xd8, 0xe3, 0x88, 0x24, 0x3a, 0x3f, 0xf6, 0x8f, 0xfe, 0x33, 0xaa, 0x6f,
+	0xbe, 0x84, 0xf1, 0x1d, 0xcf, 0xdb, 0xf1, 0xfd, 0x84, 0x70, 0xfe, 0xf9, 0xd3, 0xcd, 0x37, 0x8c,
+	0x0f, 0x66, 0xc6, 0x1e, 0x0a, 0xc2, 0x1d, 0x0d, 0x8b, 0x6e, 0xc3, 0x92, 0xe9, 0xab, 0x86, 0x9a,
+	0xdf, 0xce, 0x64, 0xdc, 0xf8, 0x65, 0x01, 0x56, 0xaf, 0x1c, 0x80, 0x88, 0xc1, 0x8a, 0xe4, 0x87,
+	0x3e, 0x3f, 0x71, 0x3c, 0x34, 0x9e, 0x3d, 0x7c, 0xe5, 0x26, 0xb3, 0x68, 0x63, 0x4e, 0x24, 0xee,
+	0xce, 0xc1, 0xa3, 0xcb, 0x6b, 0xd2, 0x1d, 0x8b, 0xe2, 0x21, 0x22, 0x70, 0x43, 0x19, 0x0c, 0xfb,
+	0x81, 0xa0, 0x71, 0x40, 0x49, 0x92, 0xc9, 0x4e, 0x2c, 0x4b, 0xd0, 0xf6, 0x04, 0x13, 0x1d, 0x40,
+	0xfe, 0x94, 0x46, 0xa7, 0x99, 0x70, 0x4a, 0x21, 0x49, 0xc7, 0x3f, 0xee, 0x87, 0x71, 0xda, 0xf1,
+	0x2c, 0x68, 0x54, 0x96, 0xa0, 0x29, 0xc7, 0x1f, 0x00, 0xa8, 0x36, 0xc6, 0x95, 0x1f, 0xa8, 0x5e,
+	0xa6, 0x7c, 0x7f, 0xe3, 0x65, 0x7a, 0x99, 0xce, 0x30, 0x26, 0xce, 0x72, 0x38, 0xfe, 0x8b, 0x3a,
+	0x50, 0x90, 0x7e, 0xf3, 0xea, 0xa2, 0x2a, 0x07, 0x6f, 0xfe, 0x1f, 0x8c, 0x87, 0x34, 0x3a, 0xb5,
+	0x6f, 0x99, 0xaa, 0xb0, 0x7a, 0x59, 0xc2, 0x1d, 0x0d, 0x86, 0x7e, 0x08, 0x4b, 0xd8, 0xc7, 0xb1,
+	0xa0, 0x03, 0xdd, 0xa2, 0xcc, 0x6e, 0xb4, 0x76, 0x8c, 0xca, 0xc4, 0x39, 0x67, 0xf2, 0x55, 0xe3,
+	0xaf, 0x16, 0x54, 0x2e, 0xc3, 0xa3, 0x8f, 0xa0, 0xd8, 0x17, 0x34, 0xa0, 0x3f, 0xd7, 0xf5, 0xd2,
+	0xca, 0xa2, 0x12, 0xa4, 0x00, 0x51, 0x17, 0x96, 0x26, 0x0c, 0xd7, 0x74, 0x7b, 0xf0, 0xca, 0x0c,
+	0xbf, 0x3e, 0x9b, 0xdd, 0xd7, 0x13, 0xcd, 0xec, 0xc6, 0xaf, 0x0b, 0xb0, 0x7a, 0x25, 0x70, 0x74,
+	0x0a, 0x48, 0xe0, 0xa4, 0x47, 0x84, 0x9b, 0x75, 0x80, 0xab, 0x1a, 0xf7, 0xe8, 0x42, 0x98, 0x65,
+	0x1d, 0xa6, 0x70, 0xb5, 0x30, 0x9b, 0x33, 0x58, 0x45, 0x28, 0x3a, 0x0a, 0x11, 0x51, 0x40, 0x21,
+	0x8d, 0xdc, 0x4b, 0x76, 0xb2, 0xd8, 0x67, 0x37, 0x42, 0x1a, 0x39, 0x97, 0x4d, 0xe1, 0xb3, 0xcb,
+	0xa6, 0xf2, 0x99, 0x98, 0xc2, 0x67, 0x17, 0x4c, 0xf5, 0xa0, 0x82, 0xfd, 0x8f, 0xfb, 0x5c, 0x84,
+	0x24, 0x12, 0x2e, 0x8f, 0x09, 0xf1, 0x33, 0xb9, 0x24, 0xde, 0x98, 0xa2, 0x1e, 0x4a, 0x50, 0x59,
+	0x46, 0xbc, 0xbe, 0xbc, 0x56, 0x70, 0x41, 0x48, 0x1c, 0x11, 0xce, 0x33, 0xb9, 0x59, 0x94, 0x15,
+	0xe8, 0xe1, 0x18, 0xb3, 0xf1, 0xa9, 0x05, 0xa5, 0x34, 0x19, 0xe7, 0xf4, 0x0d, 0x5f, 0x02, 0x61,
+	0x1a, 0x7f, 0xc9, 0x41, 0x65, 0x37, 0x21, 0x3e, 0x15, 0x4d, 0x12, 0x90, 0x9e, 0x66, 0xea, 0x31,
+	0x2c, 0xfb, 0x7a, 0xc4, 0xb2, 0x3f, 0x0d, 0xa7, 0xd0, 0x29, 0x3b, 0x64, 0xfc, 0x80, 0x92, 0xbd,
+	0x1d, 0x22, 0xaf, 0x2e, 0xcb, 0x38, 0x08, 0xd8, 0x63, 0x1c, 0x79, 0xb2, 0x91, 0x91, 0x15, 0xf7,
+	0xd6, 0xcc, 0x06, 0x4c, 0x75, 0x5f, 0xdf, 0x35, 0x75, 0x76, 0xe3, 0x25, 0xdc, 0x30, 0xad, 0xd7,
+	0x04, 0xbd, 0xf1, 0x34, 0x07, 0xd7, 0x9b, 0x24, 0x66, 0x9c, 0x0a, 0x1d, 0x9e, 0xfa, 0xfb, 0x7a,
+	0xd2, 0x68, 0xa0, 0x91, 0x07, 0x8b, 0x38, 0x54, 0xef, 0x3e, 0xb9, 0xec, 0x63, 0x33, 0xd0, 0xe8,
+	0xa7, 0x50, 0x50, 0x2f, 0x4c, 0x26, 0x7f, 0xf7, 0xe6, 0xde, 0x47, 0xc7, 0xc7, 0x87, 0xbe, 0x46,
+	0xdb, 0xdf, 0x30, 0x16, 0xd7, 0x67, 0x49, 0xb9, 0xa3, 0x41, 0x1b, 0x7f, 0xca, 0xc1, 0xa2, 0xbe,
+	0xf1, 0x21, 0x1f, 0x96, 0x74, 0xb7, 0xfd, 0x1a, 0x3a, 0xb1, 0x09, 0xf2, 0x57, 0x26, 0x67, 0x3a,
+	0xe8, 0x79, 0x39, 0x9b, 0x25, 0x9d, 0xe4, 0xec, 0x17, 0x16, 0xac, 0xcd, 0x4a, 0xea, 0x9c, 0x6a,
+	0xe2, 0x40, 0x21, 0xfd, 0x52, 0xf9, 0xc5, 0x8a, 0x88, 0x86, 0x52, 0x2e, 0xcc, 0xf2, 0xf1, 0x4b,
+	0x74, 0x81, 0x01, 0xa8, 0xa4, 0x1f, 0xa8, 0xc7, 0x66, 0x0c, 0x05, 0xf9, 0x8e, 0x3c, 0x7e, 0xf5,
+	0xcd, 0x74, 0x55, 0x35, 0xf2, 0xb7, 0x9f, 0x58, 0xb0, 0x3e, 0xb3, 0xbf, 0x43, 0x6f, 0x43, 0xa3,
+	0xf5, 0x7e, 0x67, 0xcf, 0xd9, 0x3b, 0xec, 0xb8, 0xce, 0x4e, 0x67, 0xcf, 0x6d, 0xff, 0xa4, 0xb9,
+	0xb7, 0xef, 0x76, 0x1e, 0x1d, 0xec, 0xb9, 0x3f, 0x3e, 0x6a, 0x1f, 0xa8, 0xc9, 0xca, 0x02, 0xba,
+	0x07, 0x6f, 0xce, 0xd5, 0x6b, 0x1f, 0xed, 0x77, 0x5a, 0xee, 0xc3, 0xd6, 0xfb, 0x0f, 0x2b, 0x16,
+	0x7a, 0x0b, 0xbe, 0x39, 0x57, 0x71, 0xa7, 0xb9, 0x73, 0xd0, 0x69, 0x7d, 0xb0, 0x57, 0xc9, 0xdd,
+	0xce, 0x7f, 0xfa, 0xfb, 0xda, 0x82, 0xbd, 0xf7, 0xec, 0x5f, 0xb5, 0x85, 0x67, 0xe7, 0x35, 0xeb,
+	0xb3, 0xf3, 0x9a, 0xf5, 0xcf, 0xf3, 0x9a, 0xf5, 0xdb, 0xe7, 0xb5, 0x85, 0xcf, 0x9e, 0xd7, 0x16,
+	0xfe, 0xf6, 0xbc, 0xb6, 0xf0, 0xe1, 0xbd, 0x54, 0x98, 0x21, 0xee, 0x91, 0x4d, 0x8f, 0x0d, 0x48,
+	0xb4, 0xad, 0x5e, 0xee, 0xcf, 0xf4, 0xdb, 0xbd, 0x8a, 0xb5, 0xbb, 0xa8, 0x5e, 0xd4, 0xdf, 0xfd,
+	0xdf, 0x00, 0xfc, 0x7f, 0xe3, 0xae, 0xd5, 0x17, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -481,6 +964,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EfficiencyCategories) > 0 {
+		for iNdEx := len(m.EfficiencyCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EfficiencyCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CheckLtvIndexCount != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.CheckLtvIndexCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -528,6 +1050,36 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidationBonus.Size()
+		i -= size
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.IsolationMode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.SupplyLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -648,7 +1200,7 @@ func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+func (m *SupplyLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -658,60 +1210,40 @@ func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+func (m *SupplyLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SupplyLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.JumpMultiplier.Size()
-		i -= size
-		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Kink.Size()
-		i -= size
-		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseMultiplier.Size()
+		size := m.MaximumLimit.Size()
 		i -= size
-		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size := m.BaseRateAPY.Size()
-		i -= size
-		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.HasMaxLimit {
+		i--
+		if m.HasMaxLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *IsolationMode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -721,55 +1253,50 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsolationMode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IsolationMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	if m.BorrowableInIsolation {
+		i--
+		if m.BorrowableInIsolation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.DebtCeiling.Size()
+		i -= size
+		if _, err := m.DebtCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+	i--
+	dAtA[i] = 0x12
+	if m.Isolated {
 		i--
-		dAtA[i] = 0xa
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Borrow) Marshal() (dAtA []byte, err error) {
+func (m *IsolatedDebt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -779,34 +1306,20 @@ func (m *Borrow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsolatedDebt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IsolatedDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Principal) > 0 {
+		for iNdEx := len(m.Principal) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Principal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -817,17 +1330,17 @@ func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Borrower)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *EfficiencyCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -837,37 +1350,56 @@ func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *EfficiencyCategory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EfficiencyCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Value.Size()
+		size := m.LiquidationThreshold.Size()
 		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+	dAtA[i] = 0x22
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *AccountEfficiencyCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -877,37 +1409,34 @@ func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountEfficiencyCategory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountEfficiencyCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -917,20 +1446,32 @@ func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Adaptive != nil {
+		{
+			size, err := m.Adaptive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Kinks) > 0 {
+		for iNdEx := len(m.Kinks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Kinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -938,202 +1479,2565 @@ func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintHard(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x32
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHard(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.ModelType != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.ModelType))
+		i--
+		dAtA[i] = 0x28
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.JumpMultiplier.Size()
+		i -= size
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.MoneyMarkets) > 0 {
-		for _, e := range m.MoneyMarkets {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kink.Size()
+		i -= size
+		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.MinimumBorrowUSDValue.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseRateAPY.Size()
+		i -= size
+		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MoneyMarket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InterestRateKink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestRateKink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRateKink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	{
+		size := m.RateAPY.Size()
+		i -= size
+		if _, err := m.RateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.BorrowLimit.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = len(m.SpotMarketID)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.ConversionFactor.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.InterestRateModel.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.ReserveFactor.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.KeeperRewardPercentage.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BorrowLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdaptiveRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptiveRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasMaxLimit {
-		n += 2
+	{
+		size := m.CurveSteepness.Size()
+		i -= size
+		if _, err := m.CurveSteepness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.MaximumLimit.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.LoanToValue.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRateAtTarget.Size()
+		i -= size
+		if _, err := m.MaxRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinRateAtTarget.Size()
+		i -= size
+		if _, err := m.MinRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdaptiveRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptiveRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BaseRateAPY.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.BaseMultiplier.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.Kink.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.JumpMultiplier.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreditDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
-		}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Borrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Borrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHard(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MoneyMarkets) > 0 {
+		for _, e := range m.MoneyMarkets {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.MinimumBorrowUSDValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.CheckLtvIndexCount != 0 {
+		n += 1 + sovHard(uint64(m.CheckLtvIndexCount))
+	}
+	if len(m.EfficiencyCategories) > 0 {
+		for _, e := range m.EfficiencyCategories {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *MoneyMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.BorrowLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = len(m.SpotMarketID)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.InterestRateModel.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.KeeperRewardPercentage.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.SupplyLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.IsolationMode.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *BorrowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *SupplyLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *IsolationMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Isolated {
+		n += 2
+	}
+	l = m.DebtCeiling.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.BorrowableInIsolation {
+		n += 2
+	}
+	return n
+}
+
+func (m *IsolatedDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Principal) > 0 {
+		for _, e := range m.Principal {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EfficiencyCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AccountEfficiencyCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *InterestRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRateAPY.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.Kink.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.ModelType != 0 {
+		n += 1 + sovHard(uint64(m.ModelType))
+	}
+	if len(m.Kinks) > 0 {
+		for _, e := range m.Kinks {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if m.Adaptive != nil {
+		l = m.Adaptive.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *InterestRateKink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utilization.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.RateAPY.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AdaptiveRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MinRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MaxRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CurveSteepness.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AdaptiveRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *CreditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Borrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *BorrowInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHard(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHard(x uint64) (n int) {
+	return sovHard(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
+			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckLtvIndexCount", wireType)
+			}
+			m.CheckLtvIndexCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckLtvIndexCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EfficiencyCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EfficiencyCategories = append(m.EfficiencyCategories, EfficiencyCategory{})
+			if err := m.EfficiencyCategories[len(m.EfficiencyCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsolationMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsolationMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsolationMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsolationMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowableInIsolation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowableInIsolation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsolatedDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsolatedDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsolatedDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = append(m.Principal, types.DecCoin{})
+			if err := m.Principal[len(m.Principal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EfficiencyCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EfficiencyCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EfficiencyCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountEfficiencyCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountEfficiencyCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountEfficiencyCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelType", wireType)
+			}
+			m.ModelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModelType |= InterestRateModelType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kinks = append(m.Kinks, InterestRateKink{})
+			if err := m.Kinks[len(m.Kinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adaptive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Adaptive == nil {
+				m.Adaptive = &AdaptiveRateModel{}
+			}
+			if err := m.Adaptive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *SupplyInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
-}
-
-func (m *BorrowInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
-}
 
-func (m *CoinsProto) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovHard(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHard(x uint64) (n int) {
-	return sovHard(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *InterestRateKink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,17 +4060,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestRateKink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestRateKink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1176,29 +4080,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
-			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAPY", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,7 +4130,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1251,7 +4155,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+func (m *AdaptiveRateModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,15 +4178,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdaptiveRateModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdaptiveRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +4214,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1326,28 +4232,29 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,11 +4282,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.MinRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,46 +4316,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,13 +4350,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveSteepness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,7 +4384,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurveSteepness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1533,7 +4409,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+func (m *AdaptiveRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1556,35 +4432,15 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdaptiveRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdaptiveRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasMaxLimit = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1612,13 +4468,11 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,7 +4500,7 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1671,7 +4525,7 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+func (m *CreditDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1694,15 +4548,15 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,13 +4584,11 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,49 +4616,13 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegatee = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1816,23 +4632,23 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowance = append(m.Allowance, types.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
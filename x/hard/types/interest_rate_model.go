@@ -0,0 +1,241 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is the number of seconds in a year, used to scale the adaptive rate adjustment speed
+const SecondsPerYear = 31536000
+
+// InterestRateCurve calculates the borrow APY of a money market from its utilization
+type InterestRateCurve interface {
+	BorrowRate(utilization sdk.Dec) sdk.Dec
+	Validate() error
+}
+
+var (
+	_ InterestRateCurve = JumpRateCurve{}
+	_ InterestRateCurve = MultiKinkCurve{}
+	_ InterestRateCurve = AdaptiveRateModel{}
+)
+
+// Curve returns the interest rate curve selected by the model type
+func (irm InterestRateModel) Curve() (InterestRateCurve, error) {
+	switch irm.ModelType {
+	case INTEREST_RATE_MODEL_TYPE_JUMP_RATE:
+		return NewJumpRateCurve(irm.BaseRateAPY, irm.BaseMultiplier, irm.Kink, irm.JumpMultiplier), nil
+	case INTEREST_RATE_MODEL_TYPE_MULTI_KINK:
+		return NewMultiKinkCurve(irm.BaseRateAPY, irm.Kinks), nil
+	case INTEREST_RATE_MODEL_TYPE_ADAPTIVE:
+		if irm.Adaptive == nil {
+			return nil, fmt.Errorf("adaptive interest rate model must be set")
+		}
+		return *irm.Adaptive, nil
+	default:
+		return nil, fmt.Errorf("invalid interest rate model type: %s", irm.ModelType)
+	}
+}
+
+// JumpRateCurve is a single kink curve, the borrow rate increases by the jump multiplier above the kink
+type JumpRateCurve struct {
+	BaseRateAPY    sdk.Dec
+	BaseMultiplier sdk.Dec
+	Kink           sdk.Dec
+	JumpMultiplier sdk.Dec
+}
+
+// NewJumpRateCurve returns a new JumpRateCurve
+func NewJumpRateCurve(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) JumpRateCurve {
+	return JumpRateCurve{
+		BaseRateAPY:    baseRateAPY,
+		BaseMultiplier: baseMultiplier,
+		Kink:           kink,
+		JumpMultiplier: jumpMultiplier,
+	}
+}
+
+// BorrowRate returns the borrow APY at a utilization
+func (c JumpRateCurve) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under kink)
+	if utilization.LTE(c.Kink) {
+		return utilization.Mul(c.BaseMultiplier).Add(c.BaseRateAPY)
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := c.Kink.Mul(c.BaseMultiplier).Add(c.BaseRateAPY)
+	excessUtil := utilization.Sub(c.Kink)
+	return excessUtil.Mul(c.JumpMultiplier).Add(normalRate)
+}
+
+// Validate JumpRateCurve
+func (c JumpRateCurve) Validate() error {
+	if c.BaseRateAPY.IsNil() || c.BaseRateAPY.IsNegative() || c.BaseRateAPY.GT(sdk.OneDec()) {
+		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+	}
+
+	if c.BaseMultiplier.IsNil() || c.BaseMultiplier.IsNegative() {
+		return fmt.Errorf("base multiplier must not be negative")
+	}
+
+	if c.Kink.IsNil() || c.Kink.IsNegative() || c.Kink.GT(sdk.OneDec()) {
+		return fmt.Errorf("kink must be in the inclusive range 0.0-1.0")
+	}
+
+	if c.JumpMultiplier.IsNil() || c.JumpMultiplier.IsNegative() {
+		return fmt.Errorf("jump multiplier must not be negative")
+	}
+
+	return nil
+}
+
+// MultiKinkCurve is a piecewise-linear curve from the base rate at zero utilization through each kink
+type MultiKinkCurve struct {
+	BaseRateAPY sdk.Dec
+	Kinks       InterestRateKinks
+}
+
+// NewMultiKinkCurve returns a new MultiKinkCurve
+func NewMultiKinkCurve(baseRateAPY sdk.Dec, kinks InterestRateKinks) MultiKinkCurve {
+	return MultiKinkCurve{
+		BaseRateAPY: baseRateAPY,
+		Kinks:       kinks,
+	}
+}
+
+// BorrowRate returns the borrow APY at a utilization, interpolated between the kinks around it
+func (c MultiKinkCurve) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	prevUtilization, prevRate := sdk.ZeroDec(), c.BaseRateAPY
+	for _, kink := range c.Kinks {
+		if utilization.LTE(kink.Utilization) {
+			slope := kink.RateAPY.Sub(prevRate).Quo(kink.Utilization.Sub(prevUtilization))
+			return prevRate.Add(utilization.Sub(prevUtilization).Mul(slope))
+		}
+		prevUtilization, prevRate = kink.Utilization, kink.RateAPY
+	}
+	return prevRate
+}
+
+// Validate MultiKinkCurve
+func (c MultiKinkCurve) Validate() error {
+	if c.BaseRateAPY.IsNil() || c.BaseRateAPY.IsNegative() || c.BaseRateAPY.GT(sdk.OneDec()) {
+		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+	}
+	if len(c.Kinks) == 0 {
+		return fmt.Errorf("multi-kink interest rate model must have at least one kink")
+	}
+
+	prevUtilization, prevRate := sdk.ZeroDec(), c.BaseRateAPY
+	for _, kink := range c.Kinks {
+		if kink.Utilization.IsNil() || kink.Utilization.LTE(prevUtilization) || kink.Utilization.GT(sdk.OneDec()) {
+			return fmt.Errorf("kink utilizations must be increasing and at most 1.0: %s", kink.Utilization)
+		}
+		if kink.RateAPY.IsNil() || kink.RateAPY.LT(prevRate) {
+			return fmt.Errorf("kink rates must not decrease with utilization: %s", kink.RateAPY)
+		}
+		prevUtilization, prevRate = kink.Utilization, kink.RateAPY
+	}
+	if !prevUtilization.Equal(sdk.OneDec()) {
+		return fmt.Errorf("last kink must be at a utilization of 1.0: %s", prevUtilization)
+	}
+	return nil
+}
+
+// NewInterestRateKink returns a new InterestRateKink
+func NewInterestRateKink(utilization, rateAPY sdk.Dec) InterestRateKink {
+	return InterestRateKink{
+		Utilization: utilization,
+		RateAPY:     rateAPY,
+	}
+}
+
+// InterestRateKinks slice of InterestRateKink
+type InterestRateKinks []InterestRateKink
+
+// Equal returns a boolean indicating if two InterestRateKinks are equal
+func (irks InterestRateKinks) Equal(irksCompareTo InterestRateKinks) bool {
+	if len(irks) != len(irksCompareTo) {
+		return false
+	}
+	for i := range irks {
+		if !irks[i].Utilization.Equal(irksCompareTo[i].Utilization) || !irks[i].RateAPY.Equal(irksCompareTo[i].RateAPY) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewAdaptiveRateModel returns a new AdaptiveRateModel
+func NewAdaptiveRateModel(targetUtilization, rateAtTarget, minRateAtTarget, maxRateAtTarget, adjustmentSpeed, curveSteepness sdk.Dec) AdaptiveRateModel {
+	return AdaptiveRateModel{
+		TargetUtilization: targetUtilization,
+		RateAtTarget:      rateAtTarget,
+		MinRateAtTarget:   minRateAtTarget,
+		MaxRateAtTarget:   maxRateAtTarget,
+		AdjustmentSpeed:   adjustmentSpeed,
+		CurveSteepness:    curveSteepness,
+	}
+}
+
+// BorrowRate returns the borrow APY at a utilization. The rate is the rate at target divided by the curve steepness
+// at zero utilization, the rate at target at the target utilization and the rate at target times the curve steepness
+// at full utilization, linear in between.
+func (arm AdaptiveRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	distance := arm.targetDistance(utilization)
+	if distance.IsNegative() {
+		coefficient := sdk.OneDec().Sub(sdk.OneDec().Quo(arm.CurveSteepness))
+		return arm.RateAtTarget.Mul(sdk.OneDec().Add(coefficient.Mul(distance)))
+	}
+	coefficient := arm.CurveSteepness.Sub(sdk.OneDec())
+	return arm.RateAtTarget.Mul(sdk.OneDec().Add(coefficient.Mul(distance)))
+}
+
+// AdaptRateAtTarget returns the rate at target after a period of time at a utilization. The rate at target changes
+// by the adjustment speed per year, scaled by how far utilization is from the target, within its min and max.
+func (arm AdaptiveRateModel) AdaptRateAtTarget(utilization sdk.Dec, secondsElapsed int64) sdk.Dec {
+	change := arm.AdjustmentSpeed.Mul(arm.targetDistance(utilization)).MulInt64(secondsElapsed).QuoInt64(SecondsPerYear)
+	rateAtTarget := arm.RateAtTarget.Mul(sdk.OneDec().Add(change))
+	return sdk.MinDec(sdk.MaxDec(rateAtTarget, arm.MinRateAtTarget), arm.MaxRateAtTarget)
+}
+
+// targetDistance returns the distance of utilization from the target, scaled to -1 at zero and 1 at full utilization
+func (arm AdaptiveRateModel) targetDistance(utilization sdk.Dec) sdk.Dec {
+	if utilization.LTE(arm.TargetUtilization) {
+		return utilization.Sub(arm.TargetUtilization).Quo(arm.TargetUtilization)
+	}
+	return utilization.Sub(arm.TargetUtilization).Quo(sdk.OneDec().Sub(arm.TargetUtilization))
+}
+
+// Validate AdaptiveRateModel
+func (arm AdaptiveRateModel) Validate() error {
+	if arm.TargetUtilization.IsNil() || !arm.TargetUtilization.IsPositive() || arm.TargetUtilization.GTE(sdk.OneDec()) {
+		return fmt.Errorf("target utilization must be between 0.0 and 1.0 exclusive")
+	}
+	if arm.MinRateAtTarget.IsNil() || !arm.MinRateAtTarget.IsPositive() {
+		return fmt.Errorf("min rate at target must be positive")
+	}
+	if arm.MaxRateAtTarget.IsNil() || arm.MaxRateAtTarget.LT(arm.MinRateAtTarget) {
+		return fmt.Errorf("max rate at target must be ≥ min rate at target")
+	}
+	if arm.RateAtTarget.IsNil() || arm.RateAtTarget.LT(arm.MinRateAtTarget) || arm.RateAtTarget.GT(arm.MaxRateAtTarget) {
+		return fmt.Errorf("rate at target must be between min and max rate at target")
+	}
+	if arm.AdjustmentSpeed.IsNil() || arm.AdjustmentSpeed.IsNegative() {
+		return fmt.Errorf("adjustment speed must not be negative")
+	}
+	if arm.CurveSteepness.IsNil() || arm.CurveSteepness.LT(sdk.OneDec()) {
+		return fmt.Errorf("curve steepness must be ≥ one")
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if an AdaptiveRateModel is equal to another AdaptiveRateModel
+func (arm AdaptiveRateModel) Equal(armCompareTo AdaptiveRateModel) bool {
+	return arm.TargetUtilization.Equal(armCompareTo.TargetUtilization) &&
+		arm.RateAtTarget.Equal(armCompareTo.RateAtTarget) &&
+		arm.MinRateAtTarget.Equal(armCompareTo.MinRateAtTarget) &&
+		arm.MaxRateAtTarget.Equal(armCompareTo.MaxRateAtTarget) &&
+		arm.AdjustmentSpeed.Equal(armCompareTo.AdjustmentSpeed) &&
+		arm.CurveSteepness.Equal(armCompareTo.CurveSteepness)
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"bytes"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "hard"
@@ -18,6 +26,9 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// ReceiptDenomPrefix prefix of the denom of receipt tokens minted for deposits
+	ReceiptDenomPrefix = ModuleName + "/"
 )
 
 var (
@@ -31,13 +42,79 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	LtvIndexPrefix                = []byte{0x11} // deposit denom + borrow denom + sortable ltv score + borrower -> borrower
+	BorrowerLtvPrefix             = []byte{0x12} // length prefixed borrower + deposit denom + borrow denom -> sdk.Dec
+	EfficiencyCategoryPrefix      = []byte{0x13} // owner -> efficiency category name
+	IsolatedDebtPrefix            = []byte{0x14} // isolated denom -> sdk.Dec
+	AdaptiveRatePrefix            = []byte{0x15} // denom -> sdk.Dec
+	CreditDelegationPrefix        = []byte{0x16} // length prefixed delegator + delegatee -> CreditDelegation
 )
 
+var sep = []byte(":")
+
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
 func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
 }
 
+// LtvScoreBytes returns a borrower's LTV index score as sortable bytes
+func LtvScoreBytes(score sdk.Dec) []byte {
+	if !sdk.ValidSortableDec(score) {
+		// set to max sortable if input is too large.
+		score = sdk.MaxSortableDec
+	}
+	return sdk.SortableDecBytes(score)
+}
+
+// LtvIndexPairKey returns the prefix of the LTV index entries for a pair of deposit and borrow denoms
+func LtvIndexPairKey(depositDenom, borrowDenom string) []byte {
+	return createKey([]byte(depositDenom), sep, []byte(borrowDenom), sep)
+}
+
+// SplitLtvIndexPairKey returns the deposit and borrow denoms of an LTV index pair key
+func SplitLtvIndexPairKey(key []byte) (string, string) {
+	split := bytes.Split(key, sep)
+	return string(split[0]), string(split[1])
+}
+
+// LtvIndexKey returns the key for indexing a borrower by their score for a pair of deposit and borrow denoms
+func LtvIndexKey(pairKey []byte, score sdk.Dec, borrower sdk.AccAddress) []byte {
+	return createKey(pairKey, LtvScoreBytes(score), sep, borrower)
+}
+
+// LtvIndexIterKey returns the end key for iterating over the LTV index entries of a pair with a score below the input score
+func LtvIndexIterKey(pairKey []byte, score sdk.Dec) []byte {
+	return createKey(pairKey, LtvScoreBytes(score))
+}
+
+// BorrowerLtvIterKey returns the prefix for iterating over a borrower's scores in the LTV index
+func BorrowerLtvIterKey(borrower sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(borrower)
+}
+
+// BorrowerLtvKey returns the key for a borrower's score for a pair of deposit and borrow denoms
+func BorrowerLtvKey(borrower sdk.AccAddress, pairKey []byte) []byte {
+	return createKey(BorrowerLtvIterKey(borrower), pairKey)
+}
+
+// ReceiptDenom returns the denom of the receipt tokens minted for deposits of a denom
+func ReceiptDenom(denom string) string {
+	return ReceiptDenomPrefix + denom
+}
+
+// ParseReceiptDenom returns the deposit denom of a receipt token denom
+func ParseReceiptDenom(receiptDenom string) (string, bool) {
+	if !strings.HasPrefix(receiptDenom, ReceiptDenomPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(receiptDenom, ReceiptDenomPrefix), true
+}
+
+// CreditDelegationKey returns the key for a credit delegation from a delegator to a delegatee
+func CreditDelegationKey(delegator, delegatee sdk.AccAddress) []byte {
+	return createKey(address.MustLengthPrefix(delegator), delegatee)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
@@ -1,9 +1,15 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/mage-coven/fury/x/flash"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +19,13 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgSetEfficiencyCategory{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgPartialLiquidate{}
+	_ sdk.Msg = &MsgGrantCreditDelegation{}
+	_ sdk.Msg = &MsgRevokeCreditDelegation{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -106,6 +119,15 @@ func NewMsgBorrow(borrower sdk.AccAddress, amount sdk.Coins) MsgBorrow {
 	}
 }
 
+// NewMsgBorrowOnBehalfOf returns a new MsgBorrow that borrows against the deposit of another account
+func NewMsgBorrowOnBehalfOf(borrower, onBehalfOf sdk.AccAddress, amount sdk.Coins) MsgBorrow {
+	return MsgBorrow{
+		Borrower:   borrower.String(),
+		Amount:     amount,
+		OnBehalfOf: onBehalfOf.String(),
+	}
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgBorrow) Route() string { return RouterKey }
 
@@ -121,6 +143,11 @@ func (msg MsgBorrow) ValidateBasic() error {
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "borrow amount %s", msg.Amount)
 	}
+	if len(msg.OnBehalfOf) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.OnBehalfOf); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -226,3 +253,256 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgSetEfficiencyCategory returns a new MsgSetEfficiencyCategory
+func NewMsgSetEfficiencyCategory(owner sdk.AccAddress, category string) MsgSetEfficiencyCategory {
+	return MsgSetEfficiencyCategory{
+		Owner:    owner.String(),
+		Category: category,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetEfficiencyCategory) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetEfficiencyCategory) Type() string { return "hard_set_efficiency_category" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetEfficiencyCategory) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Category != strings.TrimSpace(msg.Category) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "efficiency category '%s' has leading or trailing whitespace", msg.Category)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetEfficiencyCategory) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetEfficiencyCategory) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (MsgFlashLoan, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgFlashLoan{}, err
+	}
+	return MsgFlashLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     anys,
+	}, nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "hard_flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "flash loan must contain at least one message")
+	}
+	for _, m := range msgs {
+		if err := flash.ValidateMsg(m, borrower); err != nil {
+			return err
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// GetMessages returns the cache values from the MsgFlashLoan.Msgs if present.
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "fury.hard.v1beta1.MsgFlashLoan")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// NewMsgPartialLiquidate returns a new MsgPartialLiquidate
+func NewMsgPartialLiquidate(keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) MsgPartialLiquidate {
+	return MsgPartialLiquidate{
+		Keeper:          keeper.String(),
+		Borrower:        borrower.String(),
+		Repay:           repay,
+		CollateralDenom: collateralDenom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPartialLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPartialLiquidate) Type() string { return "partial_liquidate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPartialLiquidate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Repay.IsValid() || !msg.Repay.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.Repay)
+	}
+	if err := sdk.ValidateDenom(msg.CollateralDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPartialLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPartialLiquidate) GetSigners() []sdk.AccAddress {
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{keeper}
+}
+
+// NewMsgGrantCreditDelegation returns a new MsgGrantCreditDelegation
+func NewMsgGrantCreditDelegation(delegator, delegatee sdk.AccAddress, allowance sdk.Coins) MsgGrantCreditDelegation {
+	return MsgGrantCreditDelegation{
+		Delegator: delegator.String(),
+		Delegatee: delegatee.String(),
+		Allowance: allowance,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgGrantCreditDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgGrantCreditDelegation) Type() string { return "hard_grant_credit_delegation" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgGrantCreditDelegation) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if delegator.Equals(delegatee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "delegator and delegatee cannot be the same")
+	}
+	if !msg.Allowance.IsValid() || msg.Allowance.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "credit delegation allowance %s", msg.Allowance)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgGrantCreditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgGrantCreditDelegation) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgRevokeCreditDelegation returns a new MsgRevokeCreditDelegation
+func NewMsgRevokeCreditDelegation(delegator, delegatee sdk.AccAddress) MsgRevokeCreditDelegation {
+	return MsgRevokeCreditDelegation{
+		Delegator: delegator.String(),
+		Delegatee: delegatee.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeCreditDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeCreditDelegation) Type() string { return "hard_revoke_credit_delegation" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeCreditDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Delegatee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeCreditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeCreditDelegation) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
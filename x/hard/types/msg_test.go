@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
 	"github.com/mage-coven/fury/x/hard/types"
 )
 
@@ -190,6 +193,210 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	amount := sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000)))
+	execFlashLoan := authz.NewMsgExec(addrs[0], []sdk.Msg{&types.MsgFlashLoan{Borrower: addrs[0].String(), Amount: amount}})
+	testCases := []struct {
+		name        string
+		borrower    sdk.AccAddress
+		amount      sdk.Coins
+		msgs        []sdk.Msg
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&types.MsgRepay{Sender: addrs[0].String(), Owner: addrs[1].String(), Amount: amount}},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: no amount",
+			borrower:    addrs[0],
+			amount:      sdk.NewCoins(),
+			msgs:        []sdk.Msg{&types.MsgRepay{Sender: addrs[0].String(), Owner: addrs[1].String(), Amount: amount}},
+			expectPass:  false,
+			expectedErr: "flash loan amount",
+		},
+		{
+			name:        "invalid: no messages",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{},
+			expectPass:  false,
+			expectedErr: "at least one message",
+		},
+		{
+			name:        "invalid: other signer",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&types.MsgRepay{Sender: addrs[1].String(), Owner: addrs[1].String(), Amount: amount}},
+			expectPass:  false,
+			expectedErr: "must only be signed by the sender",
+		},
+		{
+			name:        "invalid: nested flash loan",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&types.MsgFlashLoan{Borrower: addrs[0].String(), Amount: amount}},
+			expectPass:  false,
+			expectedErr: "cannot execute other messages",
+		},
+		{
+			name:        "invalid: flash mint",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&cdptypes.MsgFlashMint{Sender: addrs[0].String(), Amount: amount[0]}},
+			expectPass:  false,
+			expectedErr: "cannot execute other messages",
+		},
+		{
+			name:        "invalid: flash loan in authz exec",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&execFlashLoan},
+			expectPass:  false,
+			expectedErr: "cannot execute other messages",
+		},
+		{
+			name:        "invalid: msg type not allowed",
+			borrower:    addrs[0],
+			amount:      amount,
+			msgs:        []sdk.Msg{&banktypes.MsgMultiSend{Inputs: []banktypes.Input{banktypes.NewInput(addrs[0], amount)}}},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash mint or flash loan",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgFlashLoan(tc.borrower, tc.amount, tc.msgs)
+			suite.Require().NoError(err)
+			err = msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgPartialLiquidate() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name            string
+		repay           sdk.Coin
+		collateralDenom string
+		expectPass      bool
+		expectedErr     string
+	}{
+		{
+			name:            "valid",
+			repay:           sdk.NewCoin("usdx", sdkmath.NewInt(1000000)),
+			collateralDenom: "ufury",
+			expectPass:      true,
+			expectedErr:     "",
+		},
+		{
+			name:            "invalid: zero repay",
+			repay:           sdk.NewCoin("usdx", sdkmath.ZeroInt()),
+			collateralDenom: "ufury",
+			expectPass:      false,
+			expectedErr:     "repay amount",
+		},
+		{
+			name:            "invalid: collateral denom",
+			repay:           sdk.NewCoin("usdx", sdkmath.NewInt(1000000)),
+			collateralDenom: "",
+			expectPass:      false,
+			expectedErr:     "invalid denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgPartialLiquidate(addrs[0], addrs[1], tc.repay, tc.collateralDenom)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgGrantCreditDelegation() {
+	type args struct {
+		delegator sdk.AccAddress
+		delegatee sdk.AccAddress
+		allowance sdk.Coins
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				delegator: addrs[0],
+				delegatee: addrs[1],
+				allowance: sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: delegating to self",
+			args: args{
+				delegator: addrs[0],
+				delegatee: addrs[0],
+				allowance: sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+			},
+			expectPass:  false,
+			expectedErr: "delegator and delegatee cannot be the same",
+		},
+		{
+			name: "invalid: empty allowance",
+			args: args{
+				delegator: addrs[0],
+				delegatee: addrs[1],
+				allowance: sdk.NewCoins(),
+			},
+			expectPass:  false,
+			expectedErr: "credit delegation allowance",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgGrantCreditDelegation(tc.args.delegator, tc.args.delegatee, tc.args.allowance)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,16 +12,29 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyMoneyMarkets              = []byte("MoneyMarkets")
-	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
-	DefaultMoneyMarkets          = MoneyMarkets{}
-	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
-	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
-	DefaultTotalSupplied         = sdk.Coins{}
-	DefaultTotalBorrowed         = sdk.Coins{}
-	DefaultTotalReserves         = sdk.Coins{}
-	DefaultDeposits              = Deposits{}
-	DefaultBorrows               = Borrows{}
+	KeyMoneyMarkets                    = []byte("MoneyMarkets")
+	KeyMinimumBorrowUSDValue           = []byte("MinimumBorrowUSDValue")
+	KeyCheckLtvIndexCount              = []byte("CheckLtvIndexCount")
+	KeyEfficiencyCategories            = []byte("EfficiencyCategories")
+	KeyFlashLoanFee                    = []byte("FlashLoanFee")
+	KeyCloseFactor                     = []byte("CloseFactor")
+	DefaultMoneyMarkets                = MoneyMarkets{}
+	DefaultMinimumBorrowUSDValue       = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultCheckLtvIndexCount          = uint64(10)
+	DefaultEfficiencyCategories        = EfficiencyCategories{}
+	DefaultFlashLoanFee                = sdk.MustNewDecFromStr("0.001")
+	DefaultCloseFactor                 = sdk.MustNewDecFromStr("0.5")
+	DefaultLiquidationBonus            = sdk.MustNewDecFromStr("0.05")
+	DefaultAccumulationTimes           = GenesisAccumulationTimes{}
+	DefaultTotalSupplied               = sdk.Coins{}
+	DefaultTotalBorrowed               = sdk.Coins{}
+	DefaultTotalReserves               = sdk.Coins{}
+	DefaultDeposits                    = Deposits{}
+	DefaultBorrows                     = Borrows{}
+	DefaultAccountEfficiencyCategories = AccountEfficiencyCategories{}
+	DefaultIsolatedDebts               = IsolatedDebts{}
+	DefaultAdaptiveRates               = AdaptiveRates{}
+	DefaultCreditDelegations           = CreditDelegations{}
 )
 
 // NewBorrowLimit returns a new BorrowLimit
@@ -60,9 +74,74 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	return true
 }
 
+// NewSupplyLimit returns a new SupplyLimit
+func NewSupplyLimit(hasMaxLimit bool, maximumLimit sdk.Dec) SupplyLimit {
+	return SupplyLimit{
+		HasMaxLimit:  hasMaxLimit,
+		MaximumLimit: maximumLimit,
+	}
+}
+
+// Validate SupplyLimit
+func (sl SupplyLimit) Validate() error {
+	if sl.MaximumLimit.IsNil() {
+		return fmt.Errorf("maximum supply limit cannot be nil")
+	}
+	if sl.MaximumLimit.IsNegative() {
+		return fmt.Errorf("maximum supply limit cannot be negative: %s", sl.MaximumLimit)
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if a SupplyLimit is equal to another SupplyLimit
+func (sl SupplyLimit) Equal(slCompareTo SupplyLimit) bool {
+	if sl.HasMaxLimit != slCompareTo.HasMaxLimit {
+		return false
+	}
+	if !sl.MaximumLimit.Equal(slCompareTo.MaximumLimit) {
+		return false
+	}
+	return true
+}
+
+// NewIsolationMode returns a new IsolationMode
+func NewIsolationMode(isolated bool, debtCeiling sdk.Dec, borrowableInIsolation bool) IsolationMode {
+	return IsolationMode{
+		Isolated:              isolated,
+		DebtCeiling:           debtCeiling,
+		BorrowableInIsolation: borrowableInIsolation,
+	}
+}
+
+// Validate IsolationMode
+func (im IsolationMode) Validate() error {
+	if im.DebtCeiling.IsNil() {
+		return fmt.Errorf("debt ceiling cannot be nil")
+	}
+	if im.DebtCeiling.IsNegative() {
+		return fmt.Errorf("debt ceiling cannot be negative: %s", im.DebtCeiling)
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if an IsolationMode is equal to another IsolationMode
+func (im IsolationMode) Equal(imCompareTo IsolationMode) bool {
+	if im.Isolated != imCompareTo.Isolated {
+		return false
+	}
+	if !im.DebtCeiling.Equal(imCompareTo.DebtCeiling) {
+		return false
+	}
+	if im.BorrowableInIsolation != imCompareTo.BorrowableInIsolation {
+		return false
+	}
+	return true
+}
+
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdkmath.Int,
-	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
+	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec, supplyLimit SupplyLimit,
+	isolationMode IsolationMode, liquidationBonus sdk.Dec,
 ) MoneyMarket {
 	return MoneyMarket{
 		Denom:                  denom,
@@ -72,6 +151,9 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		SupplyLimit:            supplyLimit,
+		IsolationMode:          isolationMode,
+		LiquidationBonus:       liquidationBonus,
 	}
 }
 
@@ -80,6 +162,9 @@ func (mm MoneyMarket) Validate() error {
 	if err := sdk.ValidateDenom(mm.Denom); err != nil {
 		return err
 	}
+	if _, isReceipt := ParseReceiptDenom(mm.Denom); isReceipt {
+		return fmt.Errorf("money market denom cannot be a receipt denom: %s", mm.Denom)
+	}
 
 	if err := mm.BorrowLimit.Validate(); err != nil {
 		return err
@@ -101,6 +186,18 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if err := mm.SupplyLimit.Validate(); err != nil {
+		return err
+	}
+
+	if err := mm.IsolationMode.Validate(); err != nil {
+		return err
+	}
+
+	if mm.LiquidationBonus.IsNil() || mm.LiquidationBonus.IsNegative() || mm.LiquidationBonus.GTE(sdk.OneDec()) {
+		return fmt.Errorf("liquidation bonus must be ≥ 0.0 and < 1.0")
+	}
+
 	return nil
 }
 
@@ -127,6 +224,15 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if !mm.SupplyLimit.Equal(mmCompareTo.SupplyLimit) {
+		return false
+	}
+	if !mm.IsolationMode.Equal(mmCompareTo.IsolationMode) {
+		return false
+	}
+	if !mm.LiquidationBonus.Equal(mmCompareTo.LiquidationBonus) {
+		return false
+	}
 	return true
 }
 
@@ -153,29 +259,54 @@ func NewInterestRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.
 	}
 }
 
-// Validate InterestRateModel param
-func (irm InterestRateModel) Validate() error {
-	if irm.BaseRateAPY.IsNegative() || irm.BaseRateAPY.GT(sdk.OneDec()) {
-		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
-	}
-
-	if irm.BaseMultiplier.IsNegative() {
-		return fmt.Errorf("base multiplier must not be negative")
+// NewMultiKinkInterestRateModel returns a new InterestRateModel with a multi-kink curve
+func NewMultiKinkInterestRateModel(baseRateAPY sdk.Dec, kinks InterestRateKinks) InterestRateModel {
+	return InterestRateModel{
+		BaseRateAPY:    baseRateAPY,
+		BaseMultiplier: sdk.ZeroDec(),
+		Kink:           sdk.ZeroDec(),
+		JumpMultiplier: sdk.ZeroDec(),
+		ModelType:      INTEREST_RATE_MODEL_TYPE_MULTI_KINK,
+		Kinks:          kinks,
 	}
+}
 
-	if irm.Kink.IsNegative() || irm.Kink.GT(sdk.OneDec()) {
-		return fmt.Errorf("kink must be in the inclusive range 0.0-1.0")
+// NewAdaptiveInterestRateModel returns a new InterestRateModel with an adaptive curve
+func NewAdaptiveInterestRateModel(adaptive AdaptiveRateModel) InterestRateModel {
+	return InterestRateModel{
+		BaseRateAPY:    sdk.ZeroDec(),
+		BaseMultiplier: sdk.ZeroDec(),
+		Kink:           sdk.ZeroDec(),
+		JumpMultiplier: sdk.ZeroDec(),
+		ModelType:      INTEREST_RATE_MODEL_TYPE_ADAPTIVE,
+		Adaptive:       &adaptive,
 	}
+}
 
-	if irm.JumpMultiplier.IsNegative() {
-		return fmt.Errorf("jump multiplier must not be negative")
+// Validate InterestRateModel param
+func (irm InterestRateModel) Validate() error {
+	curve, err := irm.Curve()
+	if err != nil {
+		return err
 	}
-
-	return nil
+	return curve.Validate()
 }
 
-// Equal returns a boolean indicating if an InterestRateModel is equal to another InterestRateModel
+// Equal returns a boolean indicating if an InterestRateModel is equal to another InterestRateModel.
+// Only the fields used by the model type are compared.
 func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
+	if irm.ModelType != irmCompareTo.ModelType {
+		return false
+	}
+	switch irm.ModelType {
+	case INTEREST_RATE_MODEL_TYPE_MULTI_KINK:
+		return irm.BaseRateAPY.Equal(irmCompareTo.BaseRateAPY) && irm.Kinks.Equal(irmCompareTo.Kinks)
+	case INTEREST_RATE_MODEL_TYPE_ADAPTIVE:
+		if irm.Adaptive == nil || irmCompareTo.Adaptive == nil {
+			return irm.Adaptive == irmCompareTo.Adaptive
+		}
+		return irm.Adaptive.Equal(*irmCompareTo.Adaptive)
+	}
 	if !irm.BaseRateAPY.Equal(irmCompareTo.BaseRateAPY) {
 		return false
 	}
@@ -194,17 +325,98 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 // InterestRateModels slice of InterestRateModel
 type InterestRateModels []InterestRateModel
 
+// NewEfficiencyCategory returns a new EfficiencyCategory
+func NewEfficiencyCategory(name string, denoms []string, loanToValue, liquidationThreshold sdk.Dec) EfficiencyCategory {
+	return EfficiencyCategory{
+		Name:                 name,
+		Denoms:               denoms,
+		LoanToValue:          loanToValue,
+		LiquidationThreshold: liquidationThreshold,
+	}
+}
+
+// Validate EfficiencyCategory param
+func (ec EfficiencyCategory) Validate() error {
+	if strings.TrimSpace(ec.Name) == "" {
+		return fmt.Errorf("efficiency category name cannot be blank")
+	}
+	if len(ec.Denoms) == 0 {
+		return fmt.Errorf("efficiency category %s must contain at least one denom", ec.Name)
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range ec.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate denom %s in efficiency category %s", denom, ec.Name)
+		}
+		seenDenoms[denom] = true
+	}
+	if ec.LoanToValue.IsNil() || ec.LoanToValue.IsNegative() || ec.LoanToValue.GT(sdk.OneDec()) {
+		return fmt.Errorf("efficiency category loan-to-value must be between 0.0-1.0: %s", ec.LoanToValue)
+	}
+	if ec.LiquidationThreshold.IsNil() || ec.LiquidationThreshold.LT(ec.LoanToValue) || ec.LiquidationThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("efficiency category liquidation threshold must be between the loan-to-value and 1.0: %s", ec.LiquidationThreshold)
+	}
+	return nil
+}
+
+// HasDenom returns true if the denom is part of the efficiency category
+func (ec EfficiencyCategory) HasDenom(denom string) bool {
+	for _, d := range ec.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// EfficiencyCategories slice of EfficiencyCategory
+type EfficiencyCategories []EfficiencyCategory
+
+// Validate efficiency categories
+func (ecs EfficiencyCategories) Validate() error {
+	seenNames := make(map[string]bool)
+	for _, category := range ecs {
+		if err := category.Validate(); err != nil {
+			return err
+		}
+		if seenNames[category.Name] {
+			return fmt.Errorf("duplicate efficiency category %s", category.Name)
+		}
+		seenNames[category.Name] = true
+	}
+	return nil
+}
+
+// Get returns the efficiency category with the input name
+func (ecs EfficiencyCategories) Get(name string) (EfficiencyCategory, bool) {
+	for _, category := range ecs {
+		if category.Name == name {
+			return category, true
+		}
+	}
+	return EfficiencyCategory{}, false
+}
+
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, checkLtvIndexCount uint64,
+	efficiencyCategories EfficiencyCategories, flashLoanFee, closeFactor sdk.Dec,
+) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		CheckLtvIndexCount:    checkLtvIndexCount,
+		EfficiencyCategories:  efficiencyCategories,
+		FlashLoanFee:          flashLoanFee,
+		CloseFactor:           closeFactor,
 	}
 }
 
 // DefaultParams returns default params for hard module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultCheckLtvIndexCount, DefaultEfficiencyCategories, DefaultFlashLoanFee, DefaultCloseFactor)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -217,6 +429,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyCheckLtvIndexCount, &p.CheckLtvIndexCount, validateCheckLtvIndexCount),
+		paramtypes.NewParamSetPair(KeyEfficiencyCategories, &p.EfficiencyCategories, validateEfficiencyCategories),
+		paramtypes.NewParamSetPair(KeyFlashLoanFee, &p.FlashLoanFee, validateFlashLoanFee),
+		paramtypes.NewParamSetPair(KeyCloseFactor, &p.CloseFactor, validateCloseFactor),
 	}
 }
 
@@ -226,7 +442,44 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMoneyMarketParams(p.MoneyMarkets)
+	if err := validateCheckLtvIndexCount(p.CheckLtvIndexCount); err != nil {
+		return err
+	}
+
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	if err := validateEfficiencyCategories(p.EfficiencyCategories); err != nil {
+		return err
+	}
+
+	if err := validateFlashLoanFee(p.FlashLoanFee); err != nil {
+		return err
+	}
+
+	if err := validateCloseFactor(p.CloseFactor); err != nil {
+		return err
+	}
+
+	// efficiency categories can only contain assets with a money market
+	for _, category := range p.EfficiencyCategories {
+		for _, denom := range category.Denoms {
+			if !p.hasMoneyMarket(denom) {
+				return fmt.Errorf("efficiency category %s contains denom %s without a money market", category.Name, denom)
+			}
+		}
+	}
+	return nil
+}
+
+func (p Params) hasMoneyMarket(denom string) bool {
+	for _, mm := range p.MoneyMarkets {
+		if mm.Denom == denom {
+			return true
+		}
+	}
+	return false
 }
 
 func validateMinimumBorrowUSDValue(i interface{}) error {
@@ -242,6 +495,15 @@ func validateMinimumBorrowUSDValue(i interface{}) error {
 	return nil
 }
 
+func validateCheckLtvIndexCount(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateMoneyMarketParams(i interface{}) error {
 	mm, ok := i.(MoneyMarkets)
 	if !ok {
@@ -250,3 +512,46 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateEfficiencyCategories(i interface{}) error {
+	categories, ok := i.(EfficiencyCategories)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return categories.Validate()
+}
+
+func validateFlashLoanFee(i interface{}) error {
+	fee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fee.IsNil() {
+		return fmt.Errorf("flash loan fee cannot be nil")
+	}
+
+	if fee.IsNegative() || fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("flash loan fee should be between 0 and 1: %s", fee)
+	}
+
+	return nil
+}
+
+func validateCloseFactor(i interface{}) error {
+	closeFactor, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if closeFactor.IsNil() {
+		return fmt.Errorf("close factor cannot be nil")
+	}
+
+	if !closeFactor.IsPositive() || closeFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("close factor should be greater than 0 and at most 1: %s", closeFactor)
+	}
+
+	return nil
+}
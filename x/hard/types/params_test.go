@@ -15,9 +15,43 @@ type ParamTestSuite struct {
 }
 
 func (suite *ParamTestSuite) TestParamValidation() {
+	usdxMarket := types.NewMoneyMarket(
+		"usdx",
+		types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+		"usdx:usd",
+		sdkmath.NewInt(1000000),
+		types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.NewDec(5)),
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("0.05"),
+		types.NewSupplyLimit(false, sdk.ZeroDec()),
+		types.NewIsolationMode(false, sdk.ZeroDec(), false),
+		types.DefaultLiquidationBonus,
+	)
+	highBonusMarket := usdxMarket
+	highBonusMarket.LiquidationBonus = sdk.OneDec()
+	multiKinkMarket := usdxMarket
+	multiKinkMarket.InterestRateModel = types.NewMultiKinkInterestRateModel(sdk.ZeroDec(), types.InterestRateKinks{
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")),
+		types.NewInterestRateKink(sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.5")),
+	})
+	adaptiveModel := types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("2"), sdk.NewDec(50), sdk.NewDec(4),
+	)
+	adaptiveMarket := usdxMarket
+	adaptiveMarket.InterestRateModel = types.NewAdaptiveInterestRateModel(adaptiveModel)
+	flatAdaptiveModel := adaptiveModel
+	flatAdaptiveModel.CurveSteepness = sdk.MustNewDecFromStr("0.5")
+	flatAdaptiveMarket := usdxMarket
+	flatAdaptiveMarket.InterestRateModel = types.NewAdaptiveInterestRateModel(flatAdaptiveModel)
+	receiptMarket := usdxMarket
+	receiptMarket.Denom = types.ReceiptDenom("usdx")
 	type args struct {
 		minBorrowVal sdk.Dec
 		mms          types.MoneyMarkets
+		ecs          types.EfficiencyCategories
+		flashLoanFee sdk.Dec
+		closeFactor  sdk.Dec
 	}
 	testCases := []struct {
 		name        string
@@ -29,6 +63,8 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			name: "default",
 			args: args{
 				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
 				mms:          types.DefaultMoneyMarkets,
 			},
 			expectPass:  true,
@@ -38,6 +74,8 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			name: "invalid: conversion factor < one",
 			args: args{
 				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
 				mms: types.MoneyMarkets{
 					{
 						Denom: "btcb",
@@ -57,10 +95,166 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "valid efficiency category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{usdxMarket},
+				ecs: types.EfficiencyCategories{
+					types.NewEfficiencyCategory("stables", []string{"usdx"}, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.97")),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: efficiency category denom without money market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{usdxMarket},
+				ecs: types.EfficiencyCategories{
+					types.NewEfficiencyCategory("stables", []string{"usdx", "busd"}, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.97")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "efficiency category stables contains denom busd without a money market",
+		},
+		{
+			name: "invalid: efficiency category liquidation threshold below loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{usdxMarket},
+				ecs: types.EfficiencyCategories{
+					types.NewEfficiencyCategory("stables", []string{"usdx"}, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.9")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "liquidation threshold must be between the loan-to-value and 1.0",
+		},
+		{
+			name: "invalid: duplicate efficiency category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{usdxMarket},
+				ecs: types.EfficiencyCategories{
+					types.NewEfficiencyCategory("stables", []string{"usdx"}, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.97")),
+					types.NewEfficiencyCategory("stables", []string{"usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.97")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate efficiency category stables",
+		},
+		{
+			name: "valid: zero flash loan fee",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: sdk.ZeroDec(),
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.DefaultMoneyMarkets,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: flash loan fee of one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: sdk.OneDec(),
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.DefaultMoneyMarkets,
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee should be between 0 and 1",
+		},
+		{
+			name: "valid: close factor of one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  sdk.OneDec(),
+				mms:          types.DefaultMoneyMarkets,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: zero close factor",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  sdk.ZeroDec(),
+				mms:          types.DefaultMoneyMarkets,
+			},
+			expectPass:  false,
+			expectedErr: "close factor should be greater than 0 and at most 1",
+		},
+		{
+			name: "invalid: liquidation bonus of one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{highBonusMarket},
+			},
+			expectPass:  false,
+			expectedErr: "liquidation bonus must be ≥ 0.0 and < 1.0",
+		},
+		{
+			name: "invalid: multi-kink model not ending at full utilization",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{multiKinkMarket},
+			},
+			expectPass:  false,
+			expectedErr: "last kink must be at a utilization of 1.0",
+		},
+		{
+			name: "valid adaptive model",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{adaptiveMarket},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: adaptive model curve steepness < one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{flatAdaptiveMarket},
+			},
+			expectPass:  false,
+			expectedErr: "curve steepness must be ≥ one",
+		},
+		{
+			name: "invalid: receipt denom money market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				flashLoanFee: types.DefaultFlashLoanFee,
+				closeFactor:  types.DefaultCloseFactor,
+				mms:          types.MoneyMarkets{receiptMarket},
+			},
+			expectPass:  false,
+			expectedErr: "money market denom cannot be a receipt denom",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, 0, tc.args.ecs, tc.args.flashLoanFee, tc.args.closeFactor)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -163,6 +164,24 @@ func NewMoneyMarketInterestRate(denom string, supplyInterestRate, borrowInterest
 // MoneyMarketInterestRates is a slice of MoneyMarketInterestRate
 type MoneyMarketInterestRates []MoneyMarketInterestRate
 
+// NewMoneyMarketSupplyLimit returns a new instance of MoneyMarketSupplyLimit
+func NewMoneyMarketSupplyLimit(denom string, supplyLimit SupplyLimit, totalSupplied sdkmath.Int) MoneyMarketSupplyLimit {
+	limit := MoneyMarketSupplyLimit{
+		Denom:         denom,
+		HasMaxLimit:   supplyLimit.HasMaxLimit,
+		MaximumLimit:  supplyLimit.MaximumLimit.String(),
+		TotalSupplied: totalSupplied.String(),
+	}
+	if supplyLimit.HasMaxLimit {
+		available := supplyLimit.MaximumLimit.TruncateInt().Sub(totalSupplied)
+		limit.AvailableToSupply = sdk.MaxInt(available, sdk.ZeroInt()).String()
+	}
+	return limit
+}
+
+// MoneyMarketSupplyLimits is a slice of MoneyMarketSupplyLimit
+type MoneyMarketSupplyLimits []MoneyMarketSupplyLimit
+
 // QueryReservesParams is the params for a filtered reserves query
 type QueryReservesParams struct {
 	Denom string `json:"denom" yaml:"denom"`
@@ -198,3 +217,48 @@ func NewInterestFactor(denom string, supplyInterestFactor, borrowInterestFactor
 
 // InterestFactors is a slice of InterestFactor
 type InterestFactors []InterestFactor
+
+// MoneyMarketIsolatedDebts is a slice of MoneyMarketIsolatedDebt
+type MoneyMarketIsolatedDebts []MoneyMarketIsolatedDebt
+
+// NewMoneyMarketIsolatedDebt returns a new MoneyMarketIsolatedDebt
+func NewMoneyMarketIsolatedDebt(denom string, debtCeiling, isolatedDebt sdk.Dec) MoneyMarketIsolatedDebt {
+	return MoneyMarketIsolatedDebt{
+		Denom:             denom,
+		DebtCeiling:       debtCeiling.String(),
+		IsolatedDebt:      isolatedDebt.String(),
+		AvailableToBorrow: sdk.MaxDec(debtCeiling.Sub(isolatedDebt), sdk.ZeroDec()).String(),
+	}
+}
+
+// NewAccountHealth returns a new AccountHealth
+func NewAccountHealth(owner sdk.AccAddress, deposit, borrow sdk.Coins, depositValue, borrowValue, borrowLimit, ltv, borrowLimitUsed sdk.Dec, liquidatable bool, collateral CollateralHealths, healthFactor sdk.Dec) AccountHealth {
+	return AccountHealth{
+		Owner:           owner.String(),
+		Deposit:         deposit,
+		Borrow:          borrow,
+		DepositValue:    depositValue.String(),
+		BorrowValue:     borrowValue.String(),
+		BorrowLimit:     borrowLimit.String(),
+		Ltv:             ltv.String(),
+		BorrowLimitUsed: borrowLimitUsed.String(),
+		Liquidatable:    liquidatable,
+		Collateral:      collateral,
+		HealthFactor:    healthFactor.String(),
+	}
+}
+
+// AccountHealths is a slice of AccountHealth
+type AccountHealths []AccountHealth
+
+// NewCollateralHealth returns a new CollateralHealth
+func NewCollateralHealth(denom string, liquidationPrice sdk.Dec, maxWithdraw sdkmath.Int) CollateralHealth {
+	return CollateralHealth{
+		Denom:            denom,
+		LiquidationPrice: liquidationPrice.String(),
+		MaxWithdraw:      maxWithdraw.String(),
+	}
+}
+
+// CollateralHealths is a slice of CollateralHealth
+type CollateralHealths []CollateralHealth
@@ -27,6 +27,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	for _, rp := range params.SwapRewardPeriods {
 		k.AccumulateSwapRewards(ctx, rp)
 	}
+	k.SynchronizeEarnSwapRewards(ctx)
 	for _, rp := range params.SavingsRewardPeriods {
 		k.AccumulateSavingsRewards(ctx, rp)
 	}
@@ -35,4 +36,5 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(fmt.Sprintf("failed to accumulate earn rewards: %s", err))
 		}
 	}
+	k.AccumulateGauges(ctx)
 }
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -19,6 +20,8 @@ const (
 	flagType     = "type"
 	flagUnsynced = "unsynced"
 	flagDenom    = "denom"
+	flagFunder   = "funder"
+	flagSource   = "collateral-type"
 
 	typeDelegator   = "delegator"
 	typeHard        = "hard"
@@ -41,6 +44,8 @@ func GetQueryCmd() *cobra.Command {
 		queryParamsCmd(),
 		queryRewardsCmd(),
 		queryRewardFactorsCmd(),
+		queryAutoStakeSettingCmd(),
+		queryGaugesCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -268,6 +273,75 @@ func queryRewardFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func queryAutoStakeSettingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "auto-stake [owner]",
+		Short:   "get the auto-stake setting of an owner",
+		Long:    `Get how an owner's claimed delegator rewards are staked by default.`,
+		Example: fmt.Sprintf(`  $ %s q %s auto-stake fury1...`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.AutoStakeSetting(context.Background(), &types.QueryAutoStakeSettingRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryGaugesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges",
+		Short: "get externally funded reward gauges",
+		Long:  `Get the reward gauges that are funded by accounts, optionally filtered by rewarded source or funder.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s q %s gauges`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s q %s gauges --collateral-type busd:ufury`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s q %s gauges --funder fury1...`, version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateralType, err := cmd.Flags().GetString(flagSource)
+			if err != nil {
+				return err
+			}
+			funder, err := cmd.Flags().GetString(flagFunder)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Gauges(context.Background(), &types.QueryGaugesRequest{
+				CollateralType: collateralType,
+				Funder:         funder,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSource, "", "(optional) filter by rewarded swap pool ID, earn vault or hard market denom")
+	cmd.Flags().String(flagFunder, "", "(optional) filter by funder address")
+	return cmd
+}
+
 func executeHardRewardsQuery(cliCtx client.Context, params types.QueryRewardsParams) (types.HardLiquidityProviderClaims, error) {
 	bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 	if err != nil {
@@ -3,12 +3,14 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/mage-coven/fury/x/incentive/types"
@@ -17,6 +19,8 @@ import (
 const (
 	multiplierFlag      = "multiplier"
 	multiplierFlagShort = "m"
+	receiverFlag        = "receiver"
+	autoStakeFlag       = "auto-stake"
 )
 
 // GetTxCmd returns the transaction cli commands for the incentive module
@@ -33,6 +37,9 @@ func GetTxCmd() *cobra.Command {
 		getCmdClaimSwap(),
 		getCmdClaimSavings(),
 		getCmdClaimEarn(),
+		getCmdClaimAll(),
+		getCmdSetAutoStake(),
+		getCmdCreateGauge(),
 	}
 
 	for _, cmd := range cmds {
@@ -108,6 +115,7 @@ func getCmdClaimHard() *cobra.Command {
 
 func getCmdClaimDelegator() *cobra.Command {
 	var denomsToClaim map[string]string
+	var autoStake string
 
 	cmd := &cobra.Command{
 		Use:   "claim-delegator",
@@ -116,6 +124,7 @@ func getCmdClaimDelegator() *cobra.Command {
 		Example: strings.Join([]string{
 			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s hard=large --%s swp=small`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
 			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s hard=large,swp=small`, version.AppName, types.ModuleName, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s ufury=small --%s delegate`, version.AppName, types.ModuleName, multiplierFlag, autoStakeFlag),
 		}, "\n"),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,7 +136,15 @@ func getCmdClaimDelegator() *cobra.Command {
 			sender := cliCtx.GetFromAddress()
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
-			msg := types.NewMsgClaimDelegatorReward(sender.String(), selections)
+			mode := types.AUTO_STAKE_MODE_UNSPECIFIED
+			if autoStake != "" {
+				mode, err = types.ParseAutoStakeMode(autoStake)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClaimDelegatorReward(sender.String(), selections, mode)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -135,6 +152,7 @@ func getCmdClaimDelegator() *cobra.Command {
 		},
 	}
 	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	cmd.Flags().StringVar(&autoStake, autoStakeFlag, "", "(optional) stake claimed ufury rewards: none|delegate|liquid, defaults to the sender's auto-stake setting")
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
@@ -242,3 +260,118 @@ func getCmdClaimEarn() *cobra.Command {
 	}
 	return cmd
 }
+
+func getCmdClaimAll() *cobra.Command {
+	var denomsToClaim map[string]string
+	var receiver string
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "claim all of sender's rewards using given multipliers",
+		Long: `Claim sender's outstanding rewards of every claim type using given multipliers.
+Rewards of each denom are summed across claim types and paid to a single receiver.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large --%s ufury=small`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=small,ufury=small --%s fury1...`, version.AppName, types.ModuleName, multiplierFlag, receiverFlag),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections := types.NewSelectionsFromMap(denomsToClaim)
+
+			msg := types.NewMsgClaimAllRewards(sender.String(), receiver, selections)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	cmd.Flags().StringVar(&receiver, receiverFlag, "", "(optional) address paid the rewards, defaults to the sender")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func getCmdSetAutoStake() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-stake [none|delegate|liquid]",
+		Short: "set how sender's claimed delegator rewards are staked",
+		Long: `Set how sender's claimed ufury delegator rewards are staked by default.
+Rewards are either paid out (none), delegated to the sender's current validators pro rata (delegate),
+or delegated and converted into liquid staking derivatives (liquid).`,
+		Example: fmt.Sprintf(`  $ %s tx %s set-auto-stake delegate`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mode, err := types.ParseAutoStakeMode(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoStake(cliCtx.GetFromAddress().String(), mode)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdCreateGauge() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-gauge [source-type] [collateral-type] [budget] [start] [end]",
+		Short: "fund rewards for the participants of a swap pool, earn vault or hard market",
+		Long: `Escrow a reward budget from the sender that is distributed to the participants of a reward source
+between start and end. The source type is one of swap, earn, hard-supply or hard-borrow, and the collateral type
+is the swap pool ID, earn vault denom or hard market denom. Start and end are RFC3339 timestamps.
+Any budget that is not distributed by the end time is refunded to the sender.`,
+		Example: fmt.Sprintf(
+			`  $ %s tx %s create-gauge swap busd:ufury 1000000000hard 2023-01-01T00:00:00Z 2023-02-01T00:00:00Z`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceType, err := types.ParseGaugeSourceType(args[0])
+			if err != nil {
+				return err
+			}
+
+			budget, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			start, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			end, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGauge(cliCtx.GetFromAddress().String(), sourceType, args[1], start.UTC(), end.UTC(), budget)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
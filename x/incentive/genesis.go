@@ -134,6 +134,19 @@ func InitGenesis(
 	for _, mri := range gs.EarnRewardState.MultiRewardIndexes {
 		k.SetEarnRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+
+	// Auto-stake
+	for _, setting := range gs.AutoStakeSettings {
+		k.SetAutoStakeSetting(ctx, setting)
+	}
+
+	// Gauges
+	for _, gauge := range gs.Gauges {
+		k.SetGauge(ctx, gauge)
+	}
+	if gs.NextGaugeID != 0 {
+		k.SetNextGaugeID(ctx, gs.NextGaugeID)
+	}
 }
 
 // ExportGenesis export genesis state for incentive module
@@ -159,12 +172,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	earnClaims := k.GetAllEarnClaims(ctx)
 	earnRewardState := getEarnGenesisRewardState(ctx, k)
 
+	autoStakeSettings := k.GetAllAutoStakeSettings(ctx)
+
+	gauges := k.GetAllGauges(ctx)
+	nextGaugeID := k.GetNextGaugeID(ctx)
+
 	return types.NewGenesisState(
 		params,
 		// Reward states
 		usdxRewardState, hardSupplyRewardState, hardBorrowRewardState, delegatorRewardState, swapRewardState, savingsRewardState, earnRewardState,
 		// Claims
 		usdxClaims, hardClaims, delegatorClaims, swapClaims, savingsClaims, earnClaims,
+		autoStakeSettings,
+		// Gauges
+		gauges, nextGaugeID,
 	)
 }
 
@@ -51,10 +51,14 @@ func (suite *GenesisTestSuite) SetupTest() {
 	hardGS := hardtypes.NewGenesisState(
 		hardtypes.NewParams(
 			hardtypes.MoneyMarkets{
-				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), hardtypes.NewSupplyLimit(false, sdk.ZeroDec()), hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false), hardtypes.DefaultLiquidationBonus),
+				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), hardtypes.NewSupplyLimit(false, sdk.ZeroDec()), hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false), hardtypes.DefaultLiquidationBonus),
 			},
 			sdk.NewDec(10),
+			0,
+			nil,
+			hardtypes.DefaultFlashLoanFee,
+			hardtypes.DefaultCloseFactor,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -62,6 +66,9 @@ func (suite *GenesisTestSuite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEfficiencyCategories,
+		hardtypes.DefaultIsolatedDebts,
+		hardtypes.DefaultAdaptiveRates, hardtypes.DefaultCreditDelegations,
 	)
 	incentiveGS := types.NewGenesisState(
 		types.NewParams(
@@ -109,6 +116,9 @@ func (suite *GenesisTestSuite) SetupTest() {
 		types.DefaultSwapClaims,
 		types.DefaultSavingsClaims,
 		types.DefaultEarnClaims,
+		types.DefaultAutoStakeSettings,
+		types.DefaultGauges,
+		types.DefaultNextGaugeID,
 	)
 
 	cdc := suite.app.AppCodec()
@@ -274,6 +284,23 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 				types.MultiRewardIndexes{{CollateralType: "usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "earn", RewardFactor: d("0.0")}}}},
 			),
 		},
+		types.AutoStakeSettings{
+			types.NewAutoStakeSetting(suite.addrs[2], types.AUTO_STAKE_MODE_DELEGATE),
+		},
+		types.Gauges{
+			{
+				ID:                  2,
+				Funder:              suite.addrs[3],
+				SourceType:          types.GAUGE_SOURCE_TYPE_SWAP,
+				CollateralType:      "busd:ufury",
+				Start:               genesisTime.Add(-1 * oneYear),
+				End:                 genesisTime.Add(oneYear),
+				Budget:              c("hard", 1e9),
+				Distributed:         d("250000000.5"),
+				PreviousAccrualTime: genesisTime,
+			},
+		},
+		3,
 	)
 
 	tApp := app.NewTestApp()
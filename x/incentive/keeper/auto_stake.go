@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/mage-coven/fury/x/incentive/types"
+)
+
+// GetAutoStakeSetting returns the auto-stake setting of an owner
+func (k Keeper) GetAutoStakeSetting(ctx sdk.Context, owner sdk.AccAddress) (types.AutoStakeSetting, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoStakeSettingKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return types.AutoStakeSetting{}, false
+	}
+	var s types.AutoStakeSetting
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+// SetAutoStakeSetting sets the auto-stake setting of an owner
+func (k Keeper) SetAutoStakeSetting(ctx sdk.Context, s types.AutoStakeSetting) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoStakeSettingKeyPrefix)
+	bz := k.cdc.MustMarshal(&s)
+	store.Set(s.Owner, bz)
+}
+
+// DeleteAutoStakeSetting deletes the auto-stake setting of an owner
+func (k Keeper) DeleteAutoStakeSetting(ctx sdk.Context, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoStakeSettingKeyPrefix)
+	store.Delete(owner)
+}
+
+// IterateAutoStakeSettings iterates over all auto-stake settings in the store and preforms a callback function
+func (k Keeper) IterateAutoStakeSettings(ctx sdk.Context, cb func(s types.AutoStakeSetting) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoStakeSettingKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.AutoStakeSetting
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		if cb(s) {
+			break
+		}
+	}
+}
+
+// GetAllAutoStakeSettings returns all AutoStakeSetting objects in the store
+func (k Keeper) GetAllAutoStakeSettings(ctx sdk.Context) types.AutoStakeSettings {
+	ss := types.AutoStakeSettings{}
+	k.IterateAutoStakeSettings(ctx, func(s types.AutoStakeSetting) (stop bool) {
+		ss = append(ss, s)
+		return false
+	})
+	return ss
+}
+
+// SetAutoStake sets the standing auto-stake mode of an owner. Setting the mode
+// to none removes the owner's setting.
+func (k Keeper) SetAutoStake(ctx sdk.Context, owner sdk.AccAddress, mode types.AutoStakeMode) error {
+	switch mode {
+	case types.AUTO_STAKE_MODE_NONE:
+		k.DeleteAutoStakeSetting(ctx, owner)
+		return nil
+	case types.AUTO_STAKE_MODE_DELEGATE, types.AUTO_STAKE_MODE_LIQUID:
+		k.SetAutoStakeSetting(ctx, types.NewAutoStakeSetting(owner, mode))
+		return nil
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidAutoStake, "cannot set auto-stake mode %s", mode)
+	}
+}
+
+// GetAutoStakeMode returns the auto-stake mode used for a claim, falling back
+// to the owner's setting when the claim does not specify one.
+func (k Keeper) GetAutoStakeMode(ctx sdk.Context, owner sdk.AccAddress, mode types.AutoStakeMode) types.AutoStakeMode {
+	if mode != types.AUTO_STAKE_MODE_UNSPECIFIED {
+		return mode
+	}
+	setting, found := k.GetAutoStakeSetting(ctx, owner)
+	if !found {
+		return types.AUTO_STAKE_MODE_NONE
+	}
+	return setting.Mode
+}
+
+// multiplierAutoStakeMode returns the auto-stake mode for rewards paid out
+// with a multiplier. Time locked rewards cannot be transferred into liquid
+// staking derivatives, so liquid staking them is downgraded to delegating
+// them, and an event records the downgrade.
+func (k Keeper) multiplierAutoStakeMode(
+	ctx sdk.Context, owner sdk.AccAddress, mode types.AutoStakeMode, multiplier types.Multiplier,
+) types.AutoStakeMode {
+	if mode != types.AUTO_STAKE_MODE_LIQUID || multiplier.MonthsLockup == 0 {
+		return mode
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoStakeDowngrade,
+			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyMultiplier, multiplier.Name),
+			sdk.NewAttribute(types.AttributeKeyAutoStake, types.AUTO_STAKE_MODE_DELEGATE.String()),
+		),
+	)
+	return types.AUTO_STAKE_MODE_DELEGATE
+}
+
+// autoStakeRewards delegates staking denom rewards held by the owner to the
+// owner's current validators, split pro rata to the tokens of their existing
+// delegations. In liquid mode the new delegations are converted into liquid
+// staking derivatives. Nothing is staked if the owner has no delegations.
+func (k Keeper) autoStakeRewards(ctx sdk.Context, owner sdk.AccAddress, amount sdkmath.Int, mode types.AutoStakeMode) error {
+	if mode != types.AUTO_STAKE_MODE_DELEGATE && mode != types.AUTO_STAKE_MODE_LIQUID {
+		return nil
+	}
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	var validators []stakingtypes.Validator
+	var tokens []sdk.Dec
+	totalTokens := sdk.ZeroDec()
+	largest := 0
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, owner, types.MaxAutoStakeDelegations) {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		delegated := validator.TokensFromShares(delegation.GetShares())
+		if !delegated.IsPositive() {
+			continue
+		}
+		if len(tokens) > 0 && delegated.GT(tokens[largest]) {
+			largest = len(tokens)
+		}
+		validators = append(validators, validator)
+		tokens = append(tokens, delegated)
+		totalTokens = totalTokens.Add(delegated)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	// Rounding remainders are staked with the largest delegation
+	amounts := make([]sdkmath.Int, len(validators))
+	remaining := amount
+	for i := range validators {
+		amounts[i] = sdk.NewDecFromInt(amount).Mul(tokens[i]).Quo(totalTokens).TruncateInt()
+		remaining = remaining.Sub(amounts[i])
+	}
+	amounts[largest] = amounts[largest].Add(remaining)
+
+	for i, validator := range validators {
+		if !amounts[i].IsPositive() {
+			continue
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, owner, amounts[i], stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+		if mode == types.AUTO_STAKE_MODE_LIQUID {
+			stake := sdk.NewCoin(types.BondDenom, amounts[i])
+			if _, err := k.liquidKeeper.MintDerivative(ctx, owner, validator.GetOperator(), stake); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoStake,
+			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(types.BondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyAutoStake, mode.String()),
+		),
+	)
+	return nil
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/mage-coven/fury/x/incentive/types"
@@ -108,7 +111,11 @@ func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 
 // ClaimDelegatorReward pays out funds from a claim to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
+// Staking denom rewards paid to the owner are then staked according to the auto-stake mode, or the owner's auto-stake setting if it is unspecified.
+// Time locked rewards are delegated instead of liquid staked.
+func (k Keeper) ClaimDelegatorReward(
+	ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string, autoStake types.AutoStakeMode,
+) error {
 	claim, found := k.GetDelegatorClaim(ctx, owner)
 	if !found {
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
@@ -119,6 +126,12 @@ func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAdd
 		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
 	}
 
+	// Rewards are only staked on behalf of the owner
+	autoStakeMode := types.AUTO_STAKE_MODE_NONE
+	if receiver.Equals(owner) {
+		autoStakeMode = k.GetAutoStakeMode(ctx, owner, autoStake)
+	}
+
 	claimEnd := k.GetClaimEnd(ctx)
 
 	if ctx.BlockTime().After(claimEnd) {
@@ -145,6 +158,13 @@ func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAdd
 		return err
 	}
 
+	if stakeAmount := rewardCoins.AmountOf(types.BondDenom); stakeAmount.IsPositive() {
+		autoStakeMode = k.multiplierAutoStakeMode(ctx, owner, autoStakeMode, multiplier)
+		if err := k.autoStakeRewards(ctx, owner, stakeAmount, autoStakeMode); err != nil {
+			return err
+		}
+	}
+
 	// remove claimed coins (NOT reward coins)
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetDelegatorClaim(ctx, syncedClaim)
@@ -208,7 +228,8 @@ func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	return nil
 }
 
-// ClaimSavingsReward is a stub method for MsgServer interface compliance
+// ClaimSavingsReward pays out funds from a savings claim to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
 	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
 	if !found {
@@ -304,3 +325,207 @@ func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	)
 	return nil
 }
+
+// ClaimUnlockedEarnRewards claims every earn reward denom of an owner that has
+// a multiplier without a lockup, paying the rewards out liquid to the receiver.
+// Denoms without such a multiplier are left in the claim. It returns the coins
+// paid out.
+func (k Keeper) ClaimUnlockedEarnRewards(ctx sdk.Context, owner, receiver sdk.AccAddress) (sdk.Coins, error) {
+	syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	paid := sdk.NewCoins()
+	for _, reward := range syncedClaim.Reward {
+		multiplier, found := k.getUnlockedMultiplier(ctx, reward.Denom)
+		if !found {
+			continue
+		}
+
+		err := k.ClaimEarnReward(ctx, owner, receiver, reward.Denom, multiplier.Name)
+		if errors.Is(err, types.ErrZeroClaim) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		paid = paid.Add(sdk.NewCoin(reward.Denom, sdk.NewDecFromInt(reward.Amount).Mul(multiplier.Factor).RoundInt()))
+	}
+
+	return paid, nil
+}
+
+// getUnlockedMultiplier returns the multiplier of a denom with no lockup
+func (k Keeper) getUnlockedMultiplier(ctx sdk.Context, denom string) (types.Multiplier, bool) {
+	params := k.GetParams(ctx)
+
+	for _, dm := range params.ClaimMultipliers {
+		if dm.Denom != denom {
+			continue
+		}
+		for _, m := range dm.Multipliers {
+			if m.MonthsLockup == 0 {
+				return m, true
+			}
+		}
+	}
+	return types.Multiplier{}, false
+}
+
+// ClaimAllRewards syncs every claim of an owner and pays out the rewards of
+// each selected denom, summed across all claim types, to a single receiver.
+// Each denom is paid according to its selected multiplier. Claim types without
+// rewards in a selected denom are skipped. Rewards that are time locked can
+// only be paid to the owner. Delegator rewards paid to the owner are staked
+// according to the owner's auto-stake setting. It returns the coins paid out.
+func (k Keeper) ClaimAllRewards(ctx sdk.Context, owner, receiver sdk.AccAddress, selections types.Selections) (sdk.Coins, error) {
+	multipliers := make(map[string]types.Multiplier, len(selections))
+	for _, selection := range selections {
+		multiplier, found := k.GetMultiplierByDenom(ctx, selection.Denom, selection.MultiplierName)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", selection.Denom, selection.MultiplierName)
+		}
+		if multiplier.MonthsLockup > 0 && !receiver.Equals(owner) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidMultiplier, "denom '%s' multiplier '%s' is time locked and can only be paid to the owner",
+				selection.Denom, selection.MultiplierName,
+			)
+		}
+		multipliers[selection.Denom] = multiplier
+	}
+
+	claimEnd := k.GetClaimEnd(ctx)
+
+	if ctx.BlockTime().After(claimEnd) {
+		return nil, errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	}
+
+	selected := func(rewards sdk.Coins) sdk.Coins {
+		claiming := sdk.NewCoins()
+		for _, selection := range selections {
+			claiming = claiming.Add(sdk.NewCoin(selection.Denom, rewards.AmountOf(selection.Denom)))
+		}
+		return claiming
+	}
+
+	claimed := sdk.NewCoins()
+	delegatorClaimed := sdk.NewCoins()
+	emitClaim := func(claimingCoins sdk.Coins, claimType string) {
+		claimed = claimed.Add(claimingCoins...)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+				sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyClaimType, claimType),
+			),
+		)
+	}
+
+	if claim, found := k.GetUSDXMintingClaim(ctx, owner); found {
+		syncedClaim, err := k.SynchronizeUSDXMintingClaim(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		if claimingCoins := selected(sdk.NewCoins(syncedClaim.Reward)); !claimingCoins.IsZero() {
+			k.ZeroUSDXMintingClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+		}
+	}
+
+	k.SynchronizeHardLiquidityProviderClaim(ctx, owner)
+	if syncedClaim, found := k.GetHardLiquidityProviderClaim(ctx, owner); found {
+		if claimingCoins := selected(syncedClaim.Reward); !claimingCoins.IsZero() {
+			syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
+			k.SetHardLiquidityProviderClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+		}
+	}
+
+	if claim, found := k.GetDelegatorClaim(ctx, owner); found {
+		syncedClaim, err := k.SynchronizeDelegatorClaim(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		if claimingCoins := selected(syncedClaim.Reward); !claimingCoins.IsZero() {
+			syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
+			k.SetDelegatorClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+			delegatorClaimed = claimingCoins
+		}
+	}
+
+	if syncedClaim, found := k.GetSynchronizedSwapClaim(ctx, owner); found {
+		if claimingCoins := selected(syncedClaim.Reward); !claimingCoins.IsZero() {
+			syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
+			k.SetSwapClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+		}
+	}
+
+	if syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner); found {
+		if claimingCoins := selected(syncedClaim.Reward); !claimingCoins.IsZero() {
+			syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
+			k.SetEarnClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+		}
+	}
+
+	k.SynchronizeSavingsClaim(ctx, owner)
+	if syncedClaim, found := k.GetSavingsClaim(ctx, owner); found {
+		if claimingCoins := selected(syncedClaim.Reward); !claimingCoins.IsZero() {
+			syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
+			k.SetSavingsClaim(ctx, syncedClaim)
+			emitClaim(claimingCoins, syncedClaim.GetType())
+		}
+	}
+
+	// Rewards with the same lockup are paid out together so the receiver gets
+	// a single vesting period per lockup length
+	rewardsByLength := make(map[int64]sdk.Coins)
+	var lengths []int64
+	paid := sdk.NewCoins()
+	for _, coin := range claimed {
+		multiplier := multipliers[coin.Denom]
+		rewardCoin := sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(multiplier.Factor).RoundInt())
+		if rewardCoin.IsZero() {
+			continue
+		}
+
+		length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+		if _, found := rewardsByLength[length]; !found {
+			lengths = append(lengths, length)
+		}
+		rewardsByLength[length] = rewardsByLength[length].Add(rewardCoin)
+		paid = paid.Add(rewardCoin)
+	}
+
+	if paid.IsZero() {
+		return nil, types.ErrZeroClaim
+	}
+
+	for _, length := range lengths {
+		err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardsByLength[length], length)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Delegator rewards are auto-staked according to the owner's standing
+	// setting, the same as when they are claimed on their own
+	if receiver.Equals(owner) && delegatorClaimed.AmountOf(types.BondDenom).IsPositive() {
+		multiplier := multipliers[types.BondDenom]
+		autoStakeMode := k.multiplierAutoStakeMode(
+			ctx, owner, k.GetAutoStakeMode(ctx, owner, types.AUTO_STAKE_MODE_UNSPECIFIED), multiplier,
+		)
+		stakeAmount := sdk.NewDecFromInt(delegatorClaimed.AmountOf(types.BondDenom)).Mul(multiplier.Factor).RoundInt()
+		stakeAmount = sdkmath.MinInt(stakeAmount, paid.AmountOf(types.BondDenom))
+		if err := k.autoStakeRewards(ctx, owner, stakeAmount, autoStakeMode); err != nil {
+			return nil, err
+		}
+	}
+
+	return paid, nil
+}
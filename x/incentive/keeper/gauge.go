@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/incentive/types"
+)
+
+// CreateGauge escrows a reward budget from the funder and schedules it to be
+// distributed to the participants of a reward source between start and end.
+func (k Keeper) CreateGauge(
+	ctx sdk.Context,
+	funder sdk.AccAddress,
+	sourceType types.GaugeSourceType,
+	collateralType string,
+	start, end time.Time,
+	budget sdk.Coin,
+) (types.Gauge, error) {
+	if start.Before(ctx.BlockTime()) {
+		return types.Gauge{}, errorsmod.Wrapf(types.ErrInvalidGauge, "start time %s is before the current block time %s", start, ctx.BlockTime())
+	}
+	if start.After(ctx.BlockTime().Add(types.MaxGaugeStartDelay)) {
+		return types.Gauge{}, errorsmod.Wrapf(
+			types.ErrInvalidGauge, "start time %s is more than %s after the current block time %s",
+			start, types.MaxGaugeStartDelay, ctx.BlockTime(),
+		)
+	}
+	// Gauge rewards are claimed like native rewards, so the reward denom needs claim multipliers
+	if _, found := k.GetMultipliersByDenom(ctx, budget.Denom); !found {
+		return types.Gauge{}, errorsmod.Wrapf(types.ErrInvalidGauge, "no claim multipliers found for reward denom %s", budget.Denom)
+	}
+
+	id := k.GetNextGaugeID(ctx)
+	gauge := types.NewGauge(id, funder, sourceType, collateralType, start, end, budget, ctx.BlockTime())
+	if err := gauge.Validate(); err != nil {
+		return types.Gauge{}, errorsmod.Wrap(types.ErrInvalidGauge, err.Error())
+	}
+	if err := gauge.ValidateLimits(); err != nil {
+		return types.Gauge{}, errorsmod.Wrap(types.ErrInvalidGauge, err.Error())
+	}
+	if count := k.countGaugesBySource(ctx, sourceType, collateralType); count >= types.MaxActiveGaugesPerSource {
+		return types.Gauge{}, errorsmod.Wrapf(
+			types.ErrInvalidGauge, "source %s %s already has the maximum of %d gauges",
+			sourceType, collateralType, types.MaxActiveGaugesPerSource,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.IncentiveMacc, sdk.NewCoins(budget)); err != nil {
+		return types.Gauge{}, err
+	}
+
+	k.SetGauge(ctx, gauge)
+	k.SetNextGaugeID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGauge,
+			sdk.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFunder, funder.String()),
+			sdk.NewAttribute(types.AttributeKeySourceType, sourceType.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, budget.String()),
+		),
+	)
+	return gauge, nil
+}
+
+// AccumulateGauges adds the rewards of every started gauge since the last block
+// to the global reward indexes of the gauge's source. Gauges that have ended
+// are removed and any undistributed budget is refunded to the funder. A gauge
+// that fails to accumulate is logged and skipped so it cannot halt the chain.
+func (k Keeper) AccumulateGauges(ctx sdk.Context) {
+	// Gauges that started since the last block are moved to the active index
+	for _, id := range k.getGaugeIDsByTime(ctx, types.PendingGaugeKeyPrefix, ctx.BlockTime()) {
+		if gauge, found := k.GetGauge(ctx, id); found {
+			k.SetGauge(ctx, gauge)
+		}
+	}
+
+	for _, id := range k.getGaugeIDsByTime(ctx, types.ActiveGaugeKeyPrefix, time.Time{}) {
+		gauge, found := k.GetGauge(ctx, id)
+		if !found {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.accumulateGauge(cacheCtx, gauge); err != nil {
+			k.Logger(ctx).Error("failed to accumulate gauge rewards", "gauge", id, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// accumulateGauge accumulates the rewards of a single gauge
+func (k Keeper) accumulateGauge(ctx sdk.Context, gauge types.Gauge) error {
+	rewards, upTo := types.CalculatePerSecondRewards(
+		gauge.Start,
+		gauge.End,
+		gauge.RewardsPerSecond(),
+		gauge.PreviousAccrualTime,
+		ctx.BlockTime(),
+	)
+	gauge.PreviousAccrualTime = upTo
+
+	// Rewards are not distributed while the source is empty, they are refunded when the gauge ends
+	totalSource := k.getGaugeTotalSourceShares(ctx, gauge)
+	if !rewards.IsZero() && totalSource.IsPositive() {
+		increment := types.NewRewardIndexesFromCoins(rewards).Quo(totalSource)
+		k.addGaugeRewardIndexes(ctx, gauge, increment)
+		gauge.Distributed = gauge.Distributed.Add(rewards.AmountOf(gauge.Budget.Denom))
+	}
+
+	if ctx.BlockTime().Before(gauge.End) {
+		k.SetGauge(ctx, gauge)
+		return nil
+	}
+
+	refund := gauge.Undistributed()
+	if refund.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentiveMacc, gauge.Funder, sdk.NewCoins(refund)); err != nil {
+			return err
+		}
+	}
+	k.DeleteGauge(ctx, gauge.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGaugeEnded,
+			sdk.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(gauge.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyFunder, gauge.Funder.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+	return nil
+}
+
+// getGaugeTotalSourceShares fetches the sum of all source shares of the source rewarded by a gauge
+func (k Keeper) getGaugeTotalSourceShares(ctx sdk.Context, gauge types.Gauge) sdk.Dec {
+	switch gauge.SourceType {
+	case types.GAUGE_SOURCE_TYPE_SWAP:
+		return k.getSwapTotalSourceShares(ctx, gauge.CollateralType)
+	case types.GAUGE_SOURCE_TYPE_EARN:
+		return k.getEarnTotalSourceShares(ctx, gauge.CollateralType)
+	case types.GAUGE_SOURCE_TYPE_HARD_SUPPLY:
+		return k.getHardSupplyTotalSourceShares(ctx, gauge.CollateralType)
+	case types.GAUGE_SOURCE_TYPE_HARD_BORROW:
+		return k.getHardBorrowTotalSourceShares(ctx, gauge.CollateralType)
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// addGaugeRewardIndexes adds an increment to the global reward indexes of the source rewarded by a gauge
+func (k Keeper) addGaugeRewardIndexes(ctx sdk.Context, gauge types.Gauge, increment types.RewardIndexes) {
+	switch gauge.SourceType {
+	case types.GAUGE_SOURCE_TYPE_SWAP:
+		indexes, _ := k.GetSwapRewardIndexes(ctx, gauge.CollateralType)
+		k.SetSwapRewardIndexes(ctx, gauge.CollateralType, indexes.Add(increment))
+	case types.GAUGE_SOURCE_TYPE_EARN:
+		indexes, _ := k.GetEarnRewardIndexes(ctx, gauge.CollateralType)
+		k.SetEarnRewardIndexes(ctx, gauge.CollateralType, indexes.Add(increment))
+	case types.GAUGE_SOURCE_TYPE_HARD_SUPPLY:
+		indexes, _ := k.GetHardSupplyRewardIndexes(ctx, gauge.CollateralType)
+		k.SetHardSupplyRewardIndexes(ctx, gauge.CollateralType, indexes.Add(increment))
+	case types.GAUGE_SOURCE_TYPE_HARD_BORROW:
+		indexes, _ := k.GetHardBorrowRewardIndexes(ctx, gauge.CollateralType)
+		k.SetHardBorrowRewardIndexes(ctx, gauge.CollateralType, indexes.Add(increment))
+	}
+}
+
+// GetGauge returns a gauge from the store
+func (k Keeper) GetGauge(ctx sdk.Context, id uint64) (types.Gauge, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GaugeKeyPrefix)
+	bz := store.Get(types.GaugeKey(id))
+	if bz == nil {
+		return types.Gauge{}, false
+	}
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(bz, &gauge)
+	return gauge, true
+}
+
+// SetGauge sets a gauge in the store, and updates the gauge indexes. Gauges
+// that have not started are indexed by start time, started gauges by end time.
+func (k Keeper) SetGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GaugeKeyPrefix)
+	bz := k.cdc.MustMarshal(&gauge)
+	store.Set(types.GaugeKey(gauge.ID), bz)
+
+	pendingStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingGaugeKeyPrefix)
+	activeStore := prefix.NewStore(ctx.KVStore(k.key), types.ActiveGaugeKeyPrefix)
+	if gauge.Start.After(ctx.BlockTime()) {
+		pendingStore.Set(types.GaugeByTimeKey(gauge.Start, gauge.ID), []byte{})
+	} else {
+		pendingStore.Delete(types.GaugeByTimeKey(gauge.Start, gauge.ID))
+		activeStore.Set(types.GaugeByTimeKey(gauge.End, gauge.ID), []byte{})
+	}
+
+	sourceStore := prefix.NewStore(ctx.KVStore(k.key), types.GaugeBySourceKeyPrefix)
+	sourceStore.Set(types.GaugeBySourceKey(gauge.SourceType, gauge.CollateralType, gauge.ID), []byte{})
+}
+
+// DeleteGauge deletes a gauge from the store, and from the gauge indexes
+func (k Keeper) DeleteGauge(ctx sdk.Context, id uint64) {
+	gauge, found := k.GetGauge(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GaugeKeyPrefix)
+	store.Delete(types.GaugeKey(id))
+
+	pendingStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingGaugeKeyPrefix)
+	pendingStore.Delete(types.GaugeByTimeKey(gauge.Start, id))
+	activeStore := prefix.NewStore(ctx.KVStore(k.key), types.ActiveGaugeKeyPrefix)
+	activeStore.Delete(types.GaugeByTimeKey(gauge.End, id))
+	sourceStore := prefix.NewStore(ctx.KVStore(k.key), types.GaugeBySourceKeyPrefix)
+	sourceStore.Delete(types.GaugeBySourceKey(gauge.SourceType, gauge.CollateralType, id))
+}
+
+// getGaugeIDsByTime returns the ids of the gauges in a time index, ordered by
+// time. A non zero cutoff limits the ids to those with times up to and
+// including the cutoff.
+func (k Keeper) getGaugeIDsByTime(ctx sdk.Context, indexPrefix []byte, inclusiveCutoff time.Time) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), indexPrefix)
+	var end []byte
+	if !inclusiveCutoff.IsZero() {
+		end = sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoff))
+	}
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	return ids
+}
+
+// countGaugesBySource returns the number of gauges that reward a source
+func (k Keeper) countGaugesBySource(ctx sdk.Context, sourceType types.GaugeSourceType, collateralType string) int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GaugeBySourceKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GaugesBySourceKey(sourceType, collateralType))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// IterateGauges iterates over all gauges in the store and preforms a callback function
+func (k Keeper) IterateGauges(ctx sdk.Context, cb func(gauge types.Gauge) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GaugeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var gauge types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &gauge)
+		if cb(gauge) {
+			break
+		}
+	}
+}
+
+// GetAllGauges returns all Gauge objects in the store
+func (k Keeper) GetAllGauges(ctx sdk.Context) types.Gauges {
+	gauges := types.Gauges{}
+	k.IterateGauges(ctx, func(gauge types.Gauge) (stop bool) {
+		gauges = append(gauges, gauge)
+		return false
+	})
+	return gauges
+}
+
+// GetNextGaugeID returns the id of the next gauge
+func (k Keeper) GetNextGaugeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextGaugeIDKey)
+	if bz == nil {
+		return types.DefaultNextGaugeID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextGaugeID sets the id of the next gauge
+func (k Keeper) SetNextGaugeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextGaugeIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -195,6 +195,67 @@ func (s queryServer) Apy(
 	}, nil
 }
 
+func (s queryServer) AutoStakeSetting(
+	ctx context.Context,
+	req *types.QueryAutoStakeSettingRequest,
+) (*types.QueryAutoStakeSettingResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	setting, found := s.keeper.GetAutoStakeSetting(sdkCtx, owner)
+	if !found {
+		setting = types.NewAutoStakeSetting(owner, types.AUTO_STAKE_MODE_NONE)
+	}
+
+	return &types.QueryAutoStakeSettingResponse{
+		Setting: setting,
+	}, nil
+}
+
+func (s queryServer) Gauges(
+	ctx context.Context,
+	req *types.QueryGaugesRequest,
+) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var funder sdk.AccAddress
+	if req.Funder != "" {
+		var err error
+		funder, err = sdk.AccAddressFromBech32(req.Funder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+	}
+
+	gauges := types.Gauges{}
+	s.keeper.IterateGauges(sdkCtx, func(gauge types.Gauge) (stop bool) {
+		if req.CollateralType != "" && gauge.CollateralType != req.CollateralType {
+			return false
+		}
+		if !funder.Empty() && !gauge.Funder.Equals(funder) {
+			return false
+		}
+		gauges = append(gauges, gauge)
+		return false
+	})
+
+	return &types.QueryGaugesResponse{
+		Gauges: gauges,
+	}, nil
+}
+
 // queryRewards queries the rewards for a given owner and reward type, updating
 // the response with the results in place.
 func (s queryServer) queryRewards(
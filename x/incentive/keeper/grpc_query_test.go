@@ -55,10 +55,14 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	hardGS := hardtypes.NewGenesisState(
 		hardtypes.NewParams(
 			hardtypes.MoneyMarkets{
-				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), hardtypes.NewSupplyLimit(false, sdk.ZeroDec()), hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false), hardtypes.DefaultLiquidationBonus),
+				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), hardtypes.NewSupplyLimit(false, sdk.ZeroDec()), hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false), hardtypes.DefaultLiquidationBonus),
 			},
 			sdk.NewDec(10),
+			0,
+			nil,
+			hardtypes.DefaultFlashLoanFee,
+			hardtypes.DefaultCloseFactor,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -66,6 +70,9 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultAccountEfficiencyCategories,
+		hardtypes.DefaultIsolatedDebts,
+		hardtypes.DefaultAdaptiveRates, hardtypes.DefaultCreditDelegations,
 	)
 
 	suite.genesisState = types.NewGenesisState(
@@ -211,6 +218,11 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				types.MultiRewardIndexes{{CollateralType: "usdx", RewardIndexes: types.RewardIndexes{{CollateralType: "usdx", RewardFactor: d("0.0")}}}},
 			),
 		},
+		types.AutoStakeSettings{
+			types.NewAutoStakeSetting(suite.addrs[2], types.AUTO_STAKE_MODE_LIQUID),
+		},
+		types.DefaultGauges,
+		types.DefaultNextGaugeID,
 	)
 
 	err := suite.genesisState.Validate()
@@ -34,6 +34,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			SurplusAuctionLot:       cdptypes.DefaultSurplusLot,
 			DebtAuctionThreshold:    cdptypes.DefaultDebtThreshold,
 			DebtAuctionLot:          cdptypes.DefaultDebtLot,
+			FlashMintCap:            cdptypes.DefaultFlashMintCap,
+			FlashMintFee:            cdptypes.DefaultFlashMintFee,
 			CollateralParams: cdptypes.CollateralParams{
 				{
 					Denom:               "xrp",
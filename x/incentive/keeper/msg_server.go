@@ -3,9 +3,7 @@ package keeper
 import (
 	"context"
 
-	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/mage-coven/fury/x/incentive/types"
 )
@@ -66,7 +64,7 @@ func (k msgServer) ClaimDelegatorReward(goCtx context.Context, msg *types.MsgCla
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimDelegatorReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimDelegatorReward(ctx, sender, sender, selection.Denom, selection.MultiplierName, msg.AutoStake)
 		if err != nil {
 			return nil, err
 		}
@@ -94,8 +92,21 @@ func (k msgServer) ClaimSwapReward(goCtx context.Context, msg *types.MsgClaimSwa
 }
 
 func (k msgServer) ClaimSavingsReward(goCtx context.Context, msg *types.MsgClaimSavingsReward) (*types.MsgClaimSavingsRewardResponse, error) {
-	err := errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "savings claims disabled")
-	return nil, err
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, selection := range msg.DenomsToClaim {
+		err := k.keeper.ClaimSavingsReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgClaimSavingsRewardResponse{}, nil
 }
 
 func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEarnReward) (*types.MsgClaimEarnRewardResponse, error) {
@@ -115,3 +126,60 @@ func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEar
 
 	return &types.MsgClaimEarnRewardResponse{}, nil
 }
+
+func (k msgServer) ClaimAllRewards(goCtx context.Context, msg *types.MsgClaimAllRewards) (*types.MsgClaimAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver := sender
+	if msg.Receiver != "" {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rewards, err := k.keeper.ClaimAllRewards(ctx, sender, receiver, msg.DenomsToClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAllRewardsResponse{Rewards: rewards}, nil
+}
+
+func (k msgServer) SetAutoStake(goCtx context.Context, msg *types.MsgSetAutoStake) (*types.MsgSetAutoStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.SetAutoStake(ctx, sender, msg.Mode); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoStakeResponse{}, nil
+}
+
+func (k msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateGauge) (*types.MsgCreateGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	funder, err := sdk.AccAddressFromBech32(msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
+	gauge, err := k.keeper.CreateGauge(ctx, funder, msg.SourceType, msg.CollateralType, msg.Start, msg.End, msg.Budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{
+		GaugeID: gauge.ID,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/mage-coven/fury/x/incentive/testutil"
+	"github.com/mage-coven/fury/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ufury", 1e12), c("busd", 1e12), c("usdx", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleBorrowRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleSwapRewardPeriod("busd:ufury", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleSavingsRewardPeriod("usdx", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("usdx")
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	// create hard, swap and savings deposits
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverHardMsgBorrow(userAddr, cs(c("bnb", 1e10))))
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ufury", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	suite.NoError(suite.DeliverSavingsMsgDeposit(userAddr, cs(c("usdx", 1e9))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		"",
+		types.Selections{
+			types.NewSelection("hard", "small"),
+			types.NewSelection("swap", "medium"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// Rewards of each denom are summed across the hard, swap and savings claims
+	expectedRewardsHard := c("hard", int64(0.2*float64(4*7*1e6)))
+	expectedRewardsSwap := c("swap", int64(0.5*float64(4*7*1e6)))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewardsHard, expectedRewardsSwap))
+
+	suite.VestingPeriodsEqual(userAddr, []vestingtypes.Period{
+		{Length: (17+31)*secondsPerDay - 7, Amount: cs(expectedRewardsHard)},
+		{Length: (28 + 31 + 30 + 31 + 30) * secondsPerDay, Amount: cs(expectedRewardsSwap)},
+	})
+
+	suite.HardRewardEquals(userAddr, nil)
+	suite.SwapRewardEquals(userAddr, nil)
+	suite.SavingsRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimsToReceiver() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12))).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithMultipliers(types.MultipliersPerDenoms{
+			{
+				Denom: "hard",
+				Multipliers: types.Multipliers{
+					types.NewMultiplier("liquid", 0, d("0.1")),
+					types.NewMultiplier("large", 12, d("1.0")),
+				},
+			},
+		}).
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	// Time locked rewards cannot be paid to another account
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		receiverAddr.String(),
+		types.Selections{types.NewSelection("hard", "large")},
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+
+	msg = types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		receiverAddr.String(),
+		types.Selections{types.NewSelection("hard", "liquid")},
+	)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	suite.BalanceEquals(receiverAddr, cs(c("hard", int64(0.1*float64(7*1e6)))))
+	_, isVesting := suite.GetAccount(receiverAddr).(*vestingtypes.PeriodicVestingAccount)
+	suite.False(isVesting)
+
+	// Denoms that are not selected are left in the claim
+	suite.HardRewardEquals(userAddr, cs(c("swap", 7*1e6)))
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimsZero() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		"",
+		types.Selections{types.NewSelection("hard", "small")},
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrZeroClaim)
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/app"
+	furydisttypes "github.com/mage-coven/fury/x/furydist/types"
+	"github.com/mage-coven/fury/x/incentive/keeper"
+	"github.com/mage-coven/fury/x/incentive/types"
+	liquidtypes "github.com/mage-coven/fury/x/liquid/types"
+)
+
+// setupAutoStake starts a chain where the user delegates to two validators in
+// a 1:3 ratio and earns ufury delegator rewards.
+func (suite *HandlerTestSuite) setupAutoStake() (sdk.AccAddress, sdk.ValAddress, sdk.ValAddress) {
+	userAddr, otherAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(furydisttypes.ModuleName, cs(c("ufury", 1e18))).
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12))).
+		WithSimpleAccount(otherAddr, cs(c("ufury", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithMultipliers(types.MultipliersPerDenoms{
+			{
+				Denom: "ufury",
+				Multipliers: types.Multipliers{
+					types.NewMultiplier("liquid", 0, d("0.5")),
+					types.NewMultiplier("large", 12, d("1.0")),
+				},
+			},
+		}).
+		WithSimpleDelegatorRewardPeriod(types.BondDenom, cs(c("ufury", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	userVal, otherVal := sdk.ValAddress(userAddr), sdk.ValAddress(otherAddr)
+	suite.NoError(suite.DeliverMsgCreateValidator(userVal, c("ufury", 1e9)))
+	suite.NoError(suite.DeliverMsgCreateValidator(otherVal, c("ufury", 1e9)))
+	suite.NoError(suite.DeliverMsgDelegate(userAddr, otherVal, c("ufury", 3e9)))
+
+	// Delete genesis validator to not influence rewards
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+
+	// new block required to bond validators
+	suite.NextBlockAfter(7 * time.Second)
+	// Now the delegations are bonded, accumulate some delegator rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	return userAddr, userVal, otherVal
+}
+
+// delegatedTokens returns the tokens of a delegation, or zero if it does not exist
+func (suite *HandlerTestSuite) delegatedTokens(delegator sdk.AccAddress, valAddr sdk.ValAddress) sdkmath.Int {
+	sk := suite.App.GetStakingKeeper()
+	delegation, found := sk.GetDelegation(suite.Ctx, delegator, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	validator, found := sk.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func (suite *HandlerTestSuite) TestPayoutDelegatorClaimAutoStakeDelegate() {
+	userAddr, userVal, otherVal := suite.setupAutoStake()
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimDelegatorReward(
+		userAddr.String(),
+		types.Selections{types.NewSelection("ufury", "large")},
+		types.AUTO_STAKE_MODE_DELEGATE,
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// Time locked rewards are delegated rather than left in the account
+	suite.BalanceEquals(userAddr, preClaimBal)
+	suite.DelegatorRewardEquals(userAddr, nil)
+
+	userValStake := suite.delegatedTokens(userAddr, userVal).SubRaw(1e9)
+	otherValStake := suite.delegatedTokens(userAddr, otherVal).SubRaw(3e9)
+	suite.True(userValStake.IsPositive())
+
+	// Rewards are split pro rata to the existing delegations
+	total := userValStake.Add(otherValStake)
+	suite.Equal(total.QuoRaw(4), userValStake)
+}
+
+func (suite *HandlerTestSuite) TestPayoutDelegatorClaimAutoStakeSetting() {
+	userAddr, userVal, otherVal := suite.setupAutoStake()
+
+	setMsg := types.NewMsgSetAutoStake(userAddr.String(), types.AUTO_STAKE_MODE_LIQUID)
+	suite.NoError(suite.DeliverIncentiveMsg(&setMsg))
+
+	queryServer := keeper.NewQueryServerImpl(suite.App.GetIncentiveKeeper())
+	res, err := queryServer.AutoStakeSetting(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryAutoStakeSettingRequest{Owner: userAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Equal(types.AUTO_STAKE_MODE_LIQUID, res.Setting.Mode)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	// Unspecified claims use the setting and mint derivatives from the new delegations
+	msg := types.NewMsgClaimDelegatorReward(
+		userAddr.String(),
+		types.Selections{types.NewSelection("ufury", "liquid")},
+		types.AUTO_STAKE_MODE_UNSPECIFIED,
+	)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	suite.Equal(sdkmath.NewInt(1e9), suite.delegatedTokens(userAddr, userVal))
+	suite.Equal(sdkmath.NewInt(3e9), suite.delegatedTokens(userAddr, otherVal))
+
+	balance := suite.GetBalance(userAddr)
+	suite.Equal(preClaimBal.AmountOf("ufury"), balance.AmountOf("ufury"))
+	userDerivative := balance.AmountOf(liquidtypes.GetLiquidStakingTokenDenom(liquidtypes.DefaultDerivativeDenom, userVal))
+	otherDerivative := balance.AmountOf(liquidtypes.GetLiquidStakingTokenDenom(liquidtypes.DefaultDerivativeDenom, otherVal))
+	suite.True(userDerivative.IsPositive())
+	suite.Equal(userDerivative.Add(otherDerivative).QuoRaw(4), userDerivative)
+
+	// Setting the mode to none removes the setting
+	setMsg = types.NewMsgSetAutoStake(userAddr.String(), types.AUTO_STAKE_MODE_NONE)
+	suite.NoError(suite.DeliverIncentiveMsg(&setMsg))
+	_, found := suite.App.GetIncentiveKeeper().GetAutoStakeSetting(suite.Ctx, userAddr)
+	suite.False(found)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimsAutoStakeSetting() {
+	userAddr, userVal, otherVal := suite.setupAutoStake()
+
+	setMsg := types.NewMsgSetAutoStake(userAddr.String(), types.AUTO_STAKE_MODE_DELEGATE)
+	suite.NoError(suite.DeliverIncentiveMsg(&setMsg))
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		"",
+		types.Selections{types.NewSelection("ufury", "liquid")},
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// Delegator rewards claimed together with other claims also use the setting
+	suite.BalanceEquals(userAddr, preClaimBal)
+	suite.DelegatorRewardEquals(userAddr, nil)
+
+	userValStake := suite.delegatedTokens(userAddr, userVal).SubRaw(1e9)
+	otherValStake := suite.delegatedTokens(userAddr, otherVal).SubRaw(3e9)
+	suite.True(userValStake.IsPositive())
+	suite.Equal(userValStake.Add(otherValStake).QuoRaw(4), userValStake)
+}
+
+func (suite *HandlerTestSuite) TestPayoutClaimsAutoStakeTimeLocked() {
+	userAddr, userVal, otherVal := suite.setupAutoStake()
+
+	setMsg := types.NewMsgSetAutoStake(userAddr.String(), types.AUTO_STAKE_MODE_LIQUID)
+	suite.NoError(suite.DeliverIncentiveMsg(&setMsg))
+
+	downgrade := sdk.NewEvent(
+		types.EventTypeAutoStakeDowngrade,
+		sdk.NewAttribute(types.AttributeKeyClaimedBy, userAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyMultiplier, "large"),
+		sdk.NewAttribute(types.AttributeKeyAutoStake, types.AUTO_STAKE_MODE_DELEGATE.String()),
+	)
+	derivativeDenoms := []string{
+		liquidtypes.GetLiquidStakingTokenDenom(liquidtypes.DefaultDerivativeDenom, userVal),
+		liquidtypes.GetLiquidStakingTokenDenom(liquidtypes.DefaultDerivativeDenom, otherVal),
+	}
+
+	// Time locked rewards are delegated instead of liquid staked, whether the
+	// liquid mode is explicit or from the setting, and for any claim msg
+	selections := types.Selections{types.NewSelection("ufury", "large")}
+	explicitMsg := types.NewMsgClaimDelegatorReward(userAddr.String(), selections, types.AUTO_STAKE_MODE_LIQUID)
+	settingMsg := types.NewMsgClaimDelegatorReward(userAddr.String(), selections, types.AUTO_STAKE_MODE_UNSPECIFIED)
+	allMsg := types.NewMsgClaimAllRewards(userAddr.String(), "", selections)
+
+	for _, msg := range []sdk.Msg{&explicitMsg, &settingMsg, &allMsg} {
+		suite.NextBlockAfter(7 * time.Second)
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+		stakeBefore := suite.delegatedTokens(userAddr, userVal).Add(suite.delegatedTokens(userAddr, otherVal))
+
+		suite.NoError(suite.DeliverIncentiveMsg(msg))
+
+		stakeAfter := suite.delegatedTokens(userAddr, userVal).Add(suite.delegatedTokens(userAddr, otherVal))
+		suite.True(stakeAfter.GT(stakeBefore))
+		for _, denom := range derivativeDenoms {
+			suite.True(suite.GetBalance(userAddr).AmountOf(denom).IsZero())
+		}
+		suite.Contains(suite.Ctx.EventManager().Events(), downgrade)
+	}
+}
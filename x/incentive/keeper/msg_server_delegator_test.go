@@ -43,6 +43,7 @@ func (suite *HandlerTestSuite) TestPayoutDelegatorClaimMultiDenom() {
 			types.NewSelection("hard", "small"),
 			types.NewSelection("swap", "medium"),
 		},
+		types.AUTO_STAKE_MODE_UNSPECIFIED,
 	)
 
 	// Claim denoms
@@ -93,6 +94,7 @@ func (suite *HandlerTestSuite) TestPayoutDelegatorClaimSingleDenom() {
 		types.Selections{
 			types.NewSelection("swap", "large"),
 		},
+		types.AUTO_STAKE_MODE_UNSPECIFIED,
 	)
 
 	// Claim rewards
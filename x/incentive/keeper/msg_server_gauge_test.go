@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/incentive/keeper"
+	"github.com/mage-coven/fury/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestCreateGaugeAndClaim() {
+	userAddr, funderAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9)))
+
+	// The pool has no native reward period
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ufury", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	start := suite.Ctx.BlockTime()
+	msg := types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, start.Add(100*time.Second), c("hard", 100e6),
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.BalanceEquals(funderAddr, cs(c("hard", 900e6)))
+
+	// accumulate some gauge rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	claimMsg := types.NewMsgClaimSwapReward(
+		userAddr.String(),
+		types.Selections{types.NewSelection("hard", "small")},
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&claimMsg))
+
+	suite.BalanceEquals(userAddr, preClaimBal.Add(c("hard", int64(0.2*float64(7*1e6)))))
+	suite.SwapRewardEquals(userAddr, nil)
+
+	gauge, found := suite.App.GetIncentiveKeeper().GetGauge(suite.Ctx, types.DefaultNextGaugeID)
+	suite.Require().True(found)
+	suite.Equal(d("7000000"), gauge.Distributed)
+}
+
+func (suite *HandlerTestSuite) TestGaugeRefundsUndistributedBudget() {
+	userAddr, funderAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	start := suite.Ctx.BlockTime()
+	msg := types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, start.Add(100*time.Second), c("hard", 100e6),
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// Rewards are not distributed while the pool is empty
+	suite.NextBlockAfter(10 * time.Second)
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ufury", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	// The gauge ends and the budget of the first 10 seconds is refunded
+	suite.NextBlockAfter(100 * time.Second)
+
+	suite.BalanceEquals(funderAddr, cs(c("hard", 910e6)))
+	_, found := suite.App.GetIncentiveKeeper().GetGauge(suite.Ctx, types.DefaultNextGaugeID)
+	suite.False(found)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	claimMsg := types.NewMsgClaimSwapReward(
+		userAddr.String(),
+		types.Selections{types.NewSelection("hard", "large")},
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&claimMsg))
+
+	suite.BalanceEquals(userAddr, preClaimBal.Add(c("hard", 90e6)))
+}
+
+func (suite *HandlerTestSuite) TestCreateGauge_Invalid() {
+	funderAddr := suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9), c("busd", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	now := suite.Ctx.BlockTime()
+
+	msg := types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now.Add(-time.Second), now.Add(time.Hour), c("hard", 1e6),
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidGauge)
+
+	// Rewards can only be paid in denoms that have claim multipliers
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now, now.Add(time.Hour), c("busd", 1e6),
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidGauge)
+
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now, now.Add(time.Hour), c("hard", 2e9),
+	)
+	suite.Error(suite.DeliverIncentiveMsg(&msg))
+
+	// Gauges cannot be scheduled far ahead
+	start := now.Add(types.MaxGaugeStartDelay + time.Second)
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, start.Add(time.Hour), c("hard", 1e6),
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidGauge)
+
+	// Gauges must distribute a minimum budget per second
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now, now.Add(time.Hour), c("hard", 1),
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidGauge)
+
+	suite.BalanceEquals(funderAddr, cs(c("hard", 1e9), c("busd", 1e9)))
+	suite.Empty(suite.App.GetIncentiveKeeper().GetAllGauges(suite.Ctx))
+	suite.Equal(types.DefaultNextGaugeID, suite.App.GetIncentiveKeeper().GetNextGaugeID(suite.Ctx))
+}
+
+func (suite *HandlerTestSuite) TestGrpcQueryGauges() {
+	funderAddr := suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	now := suite.Ctx.BlockTime()
+	for _, collateralType := range []string{"busd:ufury", "bnb"} {
+		msg := types.NewMsgCreateGauge(
+			funderAddr.String(), types.GAUGE_SOURCE_TYPE_HARD_SUPPLY, collateralType, now, now.Add(time.Hour), c("hard", 1e6),
+		)
+		suite.NoError(suite.DeliverIncentiveMsg(&msg))
+	}
+
+	queryServer := keeper.NewQueryServerImpl(suite.App.GetIncentiveKeeper())
+
+	res, err := queryServer.Gauges(sdk.WrapSDKContext(suite.Ctx), &types.QueryGaugesRequest{CollateralType: "bnb"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Gauges, 1)
+	suite.Equal(uint64(2), res.Gauges[0].ID)
+
+	res, err = queryServer.Gauges(sdk.WrapSDKContext(suite.Ctx), &types.QueryGaugesRequest{Funder: funderAddr.String()})
+	suite.Require().NoError(err)
+	suite.Len(res.Gauges, 2)
+
+	res, err = queryServer.Gauges(sdk.WrapSDKContext(suite.Ctx), &types.QueryGaugesRequest{Funder: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Empty(res.Gauges)
+}
+
+func (suite *HandlerTestSuite) TestCreateGauge_MaxPerSource() {
+	funderAddr := suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	now := suite.Ctx.BlockTime()
+	for i := 0; i < types.MaxActiveGaugesPerSource; i++ {
+		msg := types.NewMsgCreateGauge(
+			funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now, now.Add(time.Hour), c("hard", 1e6),
+		)
+		suite.NoError(suite.DeliverIncentiveMsg(&msg))
+	}
+
+	msg := types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", now, now.Add(time.Hour), c("hard", 1e6),
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidGauge)
+
+	// Other sources have their own limit
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_HARD_SUPPLY, "busd:ufury", now, now.Add(time.Hour), c("hard", 1e6),
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// Gauges that have ended no longer count towards the limit
+	suite.NextBlockAfter(time.Hour)
+
+	msg = types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", suite.Ctx.BlockTime(), suite.Ctx.BlockTime().Add(time.Hour), c("hard", 1e6),
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.Len(suite.App.GetIncentiveKeeper().GetAllGauges(suite.Ctx), 1)
+}
+
+func (suite *HandlerTestSuite) TestGaugeStartsLater() {
+	userAddr, funderAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(funderAddr, cs(c("hard", 1e9)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ufury", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	start := suite.Ctx.BlockTime().Add(10 * time.Second)
+	msg := types.NewMsgCreateGauge(
+		funderAddr.String(), types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, start.Add(100*time.Second), c("hard", 100e6),
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// The gauge does not distribute rewards before it starts
+	suite.NextBlockAfter(7 * time.Second)
+	gauge, found := suite.App.GetIncentiveKeeper().GetGauge(suite.Ctx, types.DefaultNextGaugeID)
+	suite.Require().True(found)
+	suite.True(gauge.Distributed.IsZero())
+
+	// Rewards are distributed from the start time once it has passed
+	suite.NextBlockAfter(10 * time.Second)
+	gauge, found = suite.App.GetIncentiveKeeper().GetGauge(suite.Ctx, types.DefaultNextGaugeID)
+	suite.Require().True(found)
+	suite.Equal(d("7000000"), gauge.Distributed)
+}
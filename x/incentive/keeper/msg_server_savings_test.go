@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/mage-coven/fury/x/incentive/testutil"
+	"github.com/mage-coven/fury/x/incentive/types"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutSavingsClaimBoostedByLockup() {
+	lockedAddr, unlockedAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(lockedAddr, cs(c("usdx", 1e12))).
+		WithSimpleAccount(unlockedAddr, cs(c("usdx", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSavingsRewardPeriod("usdx", cs(c("hard", 1e6)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("usdx").
+		WithLockupTiers(savingstypes.NewLockupTier(12, d("3.0")))
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	suite.NoError(suite.DeliverSavingsMsgDeposit(lockedAddr, cs(c("usdx", 1e9))))
+	suite.NoError(suite.DeliverSavingsMsgDeposit(unlockedAddr, cs(c("usdx", 1e9))))
+
+	// Locking the deposit triples its reward shares
+	suite.NoError(suite.DeliverSavingsMsgLockDeposit(lockedAddr, c("usdx", 1e9), 12))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	suite.SavingsRewardEquals(lockedAddr, cs(c("hard", 0.75*7*1e6)))
+	suite.SavingsRewardEquals(unlockedAddr, cs(c("hard", 0.25*7*1e6)))
+
+	preClaimBal := suite.GetBalance(lockedAddr)
+
+	msg := types.NewMsgClaimSavingsReward(
+		lockedAddr.String(),
+		types.Selections{types.NewSelection("hard", "large")},
+	)
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// The boosted rewards are paid out
+	suite.BalanceEquals(lockedAddr, preClaimBal.Add(c("hard", 0.75*7*1e6)))
+	suite.SavingsRewardEquals(lockedAddr, nil)
+}
@@ -88,6 +88,18 @@ func (k Keeper) GetMultiplierByDenom(ctx sdk.Context, denom string, name string)
 	return types.Multiplier{}, false
 }
 
+// GetMultipliersByDenom fetches the claim multipliers from the params for a reward denom.
+func (k Keeper) GetMultipliersByDenom(ctx sdk.Context, denom string) (types.Multipliers, bool) {
+	params := k.GetParams(ctx)
+
+	for _, dm := range params.ClaimMultipliers {
+		if dm.Denom == denom {
+			return dm.Multipliers, true
+		}
+	}
+	return nil, false
+}
+
 // GetClaimEnd returns the claim end time for the params
 func (k Keeper) GetClaimEnd(ctx sdk.Context) time.Time {
 	params := k.GetParams(ctx)
@@ -347,10 +347,7 @@ func (k Keeper) GetSynchronizedEarnClaim(ctx sdk.Context, owner sdk.AccAddress)
 		return types.EarnClaim{}, false
 	}
 
-	shares, found := k.earnKeeper.GetVaultAccountShares(ctx, owner)
-	if !found {
-		shares = earntypes.NewVaultShares()
-	}
+	shares := k.earnKeeper.GetVaultAccountRewardShares(ctx, owner)
 
 	k.IterateEarnRewardIndexes(ctx, func(vaultDenom string, _ types.RewardIndexes) bool {
 		vaultAmount := shares.AmountOf(vaultDenom)
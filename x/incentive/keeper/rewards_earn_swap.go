@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	earntypes "github.com/mage-coven/fury/x/earn/types"
+	"github.com/mage-coven/fury/x/incentive/types"
+)
+
+// SynchronizeEarnSwapRewards syncs the swap claim of the earn module account for all rewarded pools. Rewards from
+// pools that earn vaults provide liquidity to with the swap strategy are passed through to the vault depositors.
+func (k Keeper) SynchronizeEarnSwapRewards(ctx sdk.Context) {
+	owner := authtypes.NewModuleAddress(earntypes.ModuleName)
+
+	claim, found := k.GetSynchronizedSwapClaim(ctx, owner)
+	if !found {
+		return
+	}
+
+	k.SetSwapClaim(ctx, claim)
+}
+
+// distributeEarnSwapRewards adds swap rewards earned by the earn module account in a pool to the reward indexes of
+// the vault that provides liquidity to the pool. It returns false if the rewards could not be distributed, either
+// because no vault uses the pool or the vault has no shares.
+func (k Keeper) distributeEarnSwapRewards(ctx sdk.Context, poolID string, rewards sdk.Coins) bool {
+	vaultDenom, found := k.earnKeeper.GetSwapStrategyVaultDenom(ctx, poolID)
+	if !found {
+		return false
+	}
+
+	totalSourceShares := k.getEarnTotalSourceShares(ctx, vaultDenom)
+	if !totalSourceShares.IsPositive() {
+		return false
+	}
+
+	if rewards.IsZero() {
+		return true
+	}
+
+	indexes, found := k.GetEarnRewardIndexes(ctx, vaultDenom)
+	if !found {
+		indexes = types.RewardIndexes{}
+	}
+
+	// Divide total rewards by total shares to get the reward **per share**
+	increment := types.NewRewardIndexesFromCoins(sdk.NewDecCoinsFromCoins(rewards...)).Quo(totalSourceShares)
+	k.SetEarnRewardIndexes(ctx, vaultDenom, indexes.Add(increment))
+
+	return true
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/mage-coven/fury/app"
+	earntypes "github.com/mage-coven/fury/x/earn/types"
+	"github.com/mage-coven/fury/x/incentive/testutil"
+)
+
+type EarnTokenizedSharesIntegrationTests struct {
+	testutil.IntegrationTester
+
+	userAddrs []sdk.AccAddress
+}
+
+func TestEarnTokenizedSharesIntegrationTests(t *testing.T) {
+	suite.Run(t, new(EarnTokenizedSharesIntegrationTests))
+}
+
+func (suite *EarnTokenizedSharesIntegrationTests) SetupTest() {
+	suite.IntegrationTester.SetupTest()
+
+	_, suite.userAddrs = app.GeneratePrivKeyAddressPairs(2)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(suite.userAddrs[0], cs(c("usdx", 1e9))).
+		WithSimpleAccount(suite.userAddrs[1], cs(c("usdx", 1e9)))
+
+	incentiveBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.GenesisTime).
+		WithSimpleEarnRewardPeriod("usdx", cs(c("earn", 1000)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("usdx")
+
+	earnBuilder := testutil.NewEarnGenesisBuilder().
+		WithAllowedVaults(earntypes.AllowedVault{
+			Denom:      "usdx",
+			Strategies: earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS},
+		})
+
+	suite.StartChainWithBuilders(authBuilder, incentiveBuilder, savingsBuilder, earnBuilder)
+}
+
+// earnReward returns the synced earn reward of an account
+func (suite *EarnTokenizedSharesIntegrationTests) earnReward(owner sdk.AccAddress) sdk.Coins {
+	claim, found := suite.App.GetIncentiveKeeper().GetSynchronizedEarnClaim(suite.Ctx, owner)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	return claim.Reward
+}
+
+func (suite *EarnTokenizedSharesIntegrationTests) TestTransferredTokenizedSharesAccrueToHolder() {
+	earnKeeper := suite.App.GetEarnKeeper()
+
+	err := suite.DeliverEarnMsgDeposit(suite.userAddrs[0], c("usdx", 1e6), earntypes.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	tokens, err := earnKeeper.TokenizeShares(suite.Ctx, suite.userAddrs[0], c("usdx", 1e6))
+	suite.Require().NoError(err)
+
+	// The holder of the tokens accrues the rewards of the tokenized shares
+	suite.NextBlockAfter(10 * time.Second)
+	suite.Equal(cs(c("earn", 10000)), suite.earnReward(suite.userAddrs[0]))
+
+	err = suite.App.GetBankKeeper().SendCoins(suite.Ctx, suite.userAddrs[0], suite.userAddrs[1], cs(tokens))
+	suite.Require().NoError(err)
+
+	// After the transfer, the rewards accrue to the receiver
+	suite.NextBlockAfter(10 * time.Second)
+	suite.Equal(cs(c("earn", 10000)), suite.earnReward(suite.userAddrs[0]))
+	suite.Equal(cs(c("earn", 10000)), suite.earnReward(suite.userAddrs[1]))
+
+	// The module account holding the tokenized shares accrues nothing
+	moduleAddr := suite.App.GetAccountKeeper().GetModuleAddress(earntypes.ModuleName)
+	suite.True(suite.earnReward(moduleAddr).IsZero())
+
+	// Redeeming the tokens keeps the rewards accruing to the receiver
+	_, err = earnKeeper.RedeemTokenizedShares(suite.Ctx, suite.userAddrs[1], tokens)
+	suite.Require().NoError(err)
+
+	suite.NextBlockAfter(10 * time.Second)
+	suite.Equal(cs(c("earn", 10000)), suite.earnReward(suite.userAddrs[0]))
+	suite.Equal(cs(c("earn", 20000)), suite.earnReward(suite.userAddrs[1]))
+}
+
+func (suite *EarnTokenizedSharesIntegrationTests) TestFailedTransferDoesNotSyncRewards() {
+	earnKeeper := suite.App.GetEarnKeeper()
+
+	err := suite.DeliverEarnMsgDeposit(suite.userAddrs[0], c("usdx", 1e6), earntypes.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	tokens, err := earnKeeper.TokenizeShares(suite.Ctx, suite.userAddrs[0], c("usdx", 1e6))
+	suite.Require().NoError(err)
+
+	// Sending more tokens than the sender holds fails without creating a claim
+	// for the receiver
+	err = suite.App.GetBankKeeper().SendCoins(suite.Ctx, suite.userAddrs[0], suite.userAddrs[1], cs(tokens.AddAmount(sdk.OneInt())))
+	suite.Require().Error(err)
+
+	_, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, suite.userAddrs[1])
+	suite.False(found)
+
+	// An invalid multi-send address is returned as an error
+	err = suite.App.GetBankKeeper().InputOutputCoins(
+		suite.Ctx,
+		[]banktypes.Input{{Address: "invalid", Coins: cs(tokens)}},
+		[]banktypes.Output{banktypes.NewOutput(suite.userAddrs[1], cs(tokens))},
+	)
+	suite.Require().Error(err)
+
+	_, found = suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, suite.userAddrs[1])
+	suite.False(found)
+}
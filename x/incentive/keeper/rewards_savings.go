@@ -23,11 +23,10 @@ func (k Keeper) AccumulateSavingsRewards(ctx sdk.Context, rewardPeriod types.Mul
 
 	acc := types.NewAccumulator(previousAccrualTime, indexes)
 
-	savingsMacc := k.accountKeeper.GetModuleAccount(ctx, savingstypes.ModuleName)
-	maccCoins := k.bankKeeper.GetAllBalances(ctx, savingsMacc.GetAddress())
-	denomBalance := maccCoins.AmountOf(rewardPeriod.CollateralType)
+	// Locked deposits hold boosted reward shares in addition to the deposited amount
+	totalShares := k.savingsKeeper.GetTotalRewardShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, sdk.NewDecFromInt(denomBalance), ctx.BlockTime())
+	acc.Accumulate(rewardPeriod, totalShares, ctx.BlockTime())
 
 	k.SetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 
@@ -77,8 +76,9 @@ func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.D
 
 	// Existing denoms have their reward indexes + reward amount synced
 	existingDenoms := setDifference(getDenoms(deposit.Amount), incomingDenoms)
+	shares := k.savingsKeeper.GetDepositRewardShares(ctx, deposit)
 	for _, denom := range existingDenoms {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, sdk.NewDecFromInt(deposit.Amount.AmountOf(denom)))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, shares.AmountOf(denom))
 	}
 
 	k.SetSavingsClaim(ctx, claim)
@@ -132,8 +132,9 @@ func (k Keeper) GetSynchronizedSavingsClaim(ctx sdk.Context, owner sdk.AccAddres
 		return types.SavingsClaim{}, false
 	}
 
+	shares := k.savingsKeeper.GetDepositRewardShares(ctx, deposit)
 	for _, coin := range deposit.Amount {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, sdk.NewDecFromInt(coin.Amount))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, shares.AmountOf(coin.Denom))
 	}
 
 	return claim, true
@@ -125,6 +125,8 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 		suite.Run(tc.name, func() {
 			params := savingstypes.NewParams(
 				[]string{"ufury"},
+				sdk.ZeroDec(),
+				savingstypes.LockupTiers{},
 			)
 			deposits := savingstypes.Deposits{
 				savingstypes.NewDeposit(
@@ -132,7 +134,7 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 					sdk.NewCoins(tc.args.deposit),
 				),
 			}
-			savingsGenesis := savingstypes.NewGenesisState(params, deposits)
+			savingsGenesis := savingstypes.NewGenesisState(params, deposits, nil, nil, savingstypes.DefaultNextLockedDepositID)
 
 			authBuilder := app.NewAuthBankGenesisBuilder().
 				WithSimpleAccount(suite.addrs[0], cs(c("ufury", 1e9))).
@@ -15,6 +15,11 @@ type SynchronizeSavingsRewardTests struct {
 	unitTester
 }
 
+func (suite *SynchronizeSavingsRewardTests) SetupTest() {
+	suite.unitTester.SetupTest()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, newFakeSavingsKeeper(), nil, nil)
+}
+
 func TestSynchronizeSavingsReward(t *testing.T) {
 	suite.Run(t, new(SynchronizeSavingsRewardTests))
 }
@@ -236,6 +241,57 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAd
 	)
 }
 
+func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWithLockedRewardBonus() {
+	// Given a deposit with coins locked in a lockup tier
+	// When the claim is synced
+	// The user earns rewards on the deposit plus the locked reward bonus
+
+	denom := "test"
+	owner := arbitraryAddress()
+
+	claim := types.SavingsClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: sdk.NewCoins(),
+		},
+		RewardIndexes: types.MultiRewardIndexes{
+			{
+				CollateralType: denom,
+				RewardIndexes: types.RewardIndexes{
+					{
+						CollateralType: "rewarddenom",
+						RewardFactor:   d("1000.001"),
+					},
+				},
+			},
+		},
+	}
+	suite.storeSavingsClaim(claim)
+
+	globalIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: denom,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "rewarddenom",
+					RewardFactor:   d("2000.002"),
+				},
+			},
+		},
+	}
+	suite.storeGlobalSavingsIndexes(globalIndexes)
+
+	savingsKeeper := newFakeSavingsKeeper().addLockedRewardBonus(owner, sdk.NewDecCoin(denom, i(5e8)))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, savingsKeeper, nil, nil)
+
+	deposit := savingstypes.NewDeposit(owner, sdk.NewCoins(sdk.NewCoin(denom, i(1e9))))
+	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{})
+
+	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, owner)
+	// new reward is (new index - old index) * (deposit + bonus shares)
+	suite.Equal(cs(c("rewarddenom", 1_500_001_500_000)), syncedClaim.Reward)
+}
+
 func getDenoms(coins sdk.Coins) []string {
 	denoms := []string{}
 	for _, coin := range coins {
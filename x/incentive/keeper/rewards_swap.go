@@ -5,7 +5,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	earntypes "github.com/mage-coven/fury/x/earn/types"
 	"github.com/mage-coven/fury/x/incentive/types"
 )
 
@@ -102,7 +104,10 @@ func (k *Keeper) synchronizeSwapReward(ctx sdk.Context, claim types.SwapClaim, p
 		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
 	}
 
-	claim.Reward = claim.Reward.Add(newRewards...)
+	// Rewards earned by earn vaults with the swap strategy are passed through to the vault depositors
+	if !owner.Equals(authtypes.NewModuleAddress(earntypes.ModuleName)) || !k.distributeEarnSwapRewards(ctx, poolID, newRewards) {
+		claim.Reward = claim.Reward.Add(newRewards...)
+	}
 	claim.RewardIndexes = claim.RewardIndexes.With(poolID, globalRewardIndexes)
 
 	return claim
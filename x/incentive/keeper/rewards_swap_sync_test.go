@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
+	earntypes "github.com/mage-coven/fury/x/earn/types"
 	"github.com/mage-coven/fury/x/incentive/types"
 )
 
@@ -468,3 +471,68 @@ func (suite *SynchronizeSwapRewardTests) TestGetSyncedClaim_ClaimUpdatedWhenMiss
 	expectedReward := cs(c("rewarddenom1", 1_000_001_000_000), c("rewarddenom2", 2_000_002_000_000))
 	suite.Equal(claim.Reward.Add(expectedReward...), syncedClaim.Reward)
 }
+
+func (suite *SynchronizeSwapRewardTests) TestEarnVaultRewardsPassedThroughToVaultIndexes() {
+	// Given the earn module account provides liquidity to a pool for a vault with the swap strategy
+	// When the claim is synced
+	// The rewards are added to the vault's earn reward indexes instead of the swap claim
+
+	poolID := "base:quote"
+	vaultDenom := "base"
+
+	earnKeeper := newFakeEarnKeeper().
+		addSwapStrategyPool(poolID, vaultDenom).
+		addVault(vaultDenom, earntypes.NewVaultShare(vaultDenom, d("1000000000")))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, earnKeeper)
+
+	claim := types.SwapClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  authtypes.NewModuleAddress(earntypes.ModuleName),
+			Reward: sdk.NewCoins(),
+		},
+		RewardIndexes: types.MultiRewardIndexes{
+			{
+				CollateralType: poolID,
+				RewardIndexes: types.RewardIndexes{
+					{
+						CollateralType: "rewarddenom",
+						RewardFactor:   d("1000.001"),
+					},
+				},
+			},
+		},
+	}
+	suite.storeSwapClaim(claim)
+
+	globalIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: poolID,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "rewarddenom",
+					RewardFactor:   d("2000.002"),
+				},
+			},
+		},
+	}
+	suite.storeGlobalSwapIndexes(globalIndexes)
+
+	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, i(1e9))
+
+	syncedClaim, _ := suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	suite.Empty(syncedClaim.Reward)
+
+	// vault index increment is the new reward divided by the vault shares
+	vaultIndexes, found := suite.keeper.GetEarnRewardIndexes(suite.ctx, vaultDenom)
+	suite.True(found)
+	suite.Equal(types.RewardIndexes{types.NewRewardIndex("rewarddenom", d("1000.001"))}, vaultIndexes)
+
+	// rewards from pools not used by a vault are kept in the claim
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, newFakeEarnKeeper())
+	suite.storeSwapClaim(claim)
+	suite.keeper.SynchronizeSwapReward(suite.ctx, poolID, claim.Owner, i(1e9))
+
+	syncedClaim, _ = suite.keeper.GetSwapClaim(suite.ctx, claim.Owner)
+	suite.Equal(cs(c("rewarddenom", 1_000_001_000_000)), syncedClaim.Reward)
+}
@@ -26,6 +26,7 @@ import (
 	hardtypes "github.com/mage-coven/fury/x/hard/types"
 	"github.com/mage-coven/fury/x/incentive/keeper"
 	"github.com/mage-coven/fury/x/incentive/types"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
 )
 
 // NewTestContext sets up a basic context with an in-memory db
@@ -293,6 +294,52 @@ func (k *fakeSwapKeeper) GetDepositorSharesAmount(_ sdk.Context, depositor sdk.A
 	return shares, found
 }
 
+// fakeSavingsKeeper is a stub savings keeper.
+// It can be used to return values to the incentive keeper without having to initialize a full savings keeper.
+type fakeSavingsKeeper struct {
+	deposits    map[string]savingstypes.Deposit
+	rewardBonus map[string]sdk.DecCoins
+}
+
+var _ types.SavingsKeeper = newFakeSavingsKeeper()
+
+func newFakeSavingsKeeper() *fakeSavingsKeeper {
+	return &fakeSavingsKeeper{
+		deposits:    map[string]savingstypes.Deposit{},
+		rewardBonus: map[string]sdk.DecCoins{},
+	}
+}
+
+func (k *fakeSavingsKeeper) addLockedRewardBonus(depositor sdk.AccAddress, bonus sdk.DecCoin) *fakeSavingsKeeper {
+	k.rewardBonus[depositor.String()] = k.rewardBonus[depositor.String()].Add(bonus)
+	return k
+}
+
+func (k *fakeSavingsKeeper) GetDeposit(_ sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool) {
+	deposit, found := k.deposits[depositor.String()]
+	return deposit, found
+}
+
+func (k *fakeSavingsKeeper) GetSavingsModuleAccountBalances(_ sdk.Context) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, deposit := range k.deposits {
+		total = total.Add(deposit.Amount...)
+	}
+	return total
+}
+
+func (k *fakeSavingsKeeper) GetDepositRewardShares(_ sdk.Context, deposit savingstypes.Deposit) sdk.DecCoins {
+	return sdk.NewDecCoinsFromCoins(deposit.Amount...).Add(k.rewardBonus[deposit.Depositor.String()]...)
+}
+
+func (k *fakeSavingsKeeper) GetTotalRewardShares(ctx sdk.Context, denom string) sdk.Dec {
+	total := sdk.NewDecFromInt(k.GetSavingsModuleAccountBalances(ctx).AmountOf(denom))
+	for _, bonus := range k.rewardBonus {
+		total = total.Add(bonus.AmountOf(denom))
+	}
+	return total
+}
+
 // fakeHardKeeper is a stub hard keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full hard keeper.
 type fakeHardKeeper struct {
@@ -411,6 +458,12 @@ func (k *fakeStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (st
 	return stakingtypes.Validator{}, false
 }
 
+func (k *fakeStakingKeeper) Delegate(
+	_ sdk.Context, _ sdk.AccAddress, _ sdkmath.Int, _ stakingtypes.BondStatus, _ stakingtypes.Validator, _ bool,
+) (sdk.Dec, error) {
+	panic("unimplemented")
+}
+
 func (k *fakeStakingKeeper) GetValidatorDelegations(_ sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Delegation {
 	var delegations stakingtypes.Delegations
 	for _, d := range k.delegations {
@@ -471,6 +524,7 @@ func (k *fakeCDPKeeper) GetCollateral(_ sdk.Context, collateralType string) (cdp
 type fakeEarnKeeper struct {
 	vaultShares   map[string]earntypes.VaultShare
 	depositShares map[string]earntypes.VaultShares
+	swapPools     map[string]string
 }
 
 var _ types.EarnKeeper = newFakeEarnKeeper()
@@ -479,9 +533,15 @@ func newFakeEarnKeeper() *fakeEarnKeeper {
 	return &fakeEarnKeeper{
 		vaultShares:   map[string]earntypes.VaultShare{},
 		depositShares: map[string]earntypes.VaultShares{},
+		swapPools:     map[string]string{},
 	}
 }
 
+func (k *fakeEarnKeeper) addSwapStrategyPool(poolID, vaultDenom string) *fakeEarnKeeper {
+	k.swapPools[poolID] = vaultDenom
+	return k
+}
+
 func (k *fakeEarnKeeper) addVault(vaultDenom string, shares earntypes.VaultShare) *fakeEarnKeeper {
 	k.vaultShares[vaultDenom] = shares
 	return k
@@ -517,12 +577,16 @@ func (k *fakeEarnKeeper) GetVaultTotalValue(ctx sdk.Context, denom string) (sdk.
 	return sdk.NewCoin(denom, vaultShares.Amount.RoundInt()), nil
 }
 
-func (k *fakeEarnKeeper) GetVaultAccountShares(
+func (k *fakeEarnKeeper) GetVaultAccountRewardShares(
 	ctx sdk.Context,
 	acc sdk.AccAddress,
-) (shares earntypes.VaultShares, found bool) {
-	accShares, found := k.depositShares[acc.String()]
-	return accShares, found
+) earntypes.VaultShares {
+	return k.depositShares[acc.String()]
+}
+
+func (k *fakeEarnKeeper) GetSwapStrategyVaultDenom(ctx sdk.Context, poolID string) (string, bool) {
+	vaultDenom, found := k.swapPools[poolID]
+	return vaultDenom, found
 }
 
 func (k *fakeEarnKeeper) IterateVaultRecords(
@@ -602,6 +666,10 @@ func (k *fakeLiquidKeeper) CollectStakingRewardsByDenom(
 	return sdk.NewCoins(sdk.NewCoin("ufury", amt)), nil
 }
 
+func (k *fakeLiquidKeeper) MintDerivative(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coin) (sdk.Coin, error) {
+	panic("unimplemented")
+}
+
 func (k *fakeLiquidKeeper) getRewardAmount(
 	ctx sdk.Context,
 	derivativeDenom string,
@@ -719,6 +787,15 @@ func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 	panic("not implemented")
 }
 
+func (k *fakeBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	panic("not implemented")
+}
+
 func (k *fakeBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	panic("not implemented")
 }
@@ -280,6 +280,9 @@ func NewStandardMoneyMarket(denom string) hardtypes.MoneyMarket {
 		),
 		sdk.MustNewDecFromStr("0.05"),
 		sdk.ZeroDec(),
+		hardtypes.NewSupplyLimit(false, sdk.ZeroDec()),
+		hardtypes.NewIsolationMode(false, sdk.ZeroDec(), false),
+		hardtypes.DefaultLiquidationBonus,
 	)
 }
 
@@ -341,3 +344,8 @@ func (builder SavingsGenesisBuilder) WithSupportedDenoms(denoms ...string) Savin
 	builder.Params.SupportedDenoms = append(builder.Params.SupportedDenoms, denoms...)
 	return builder
 }
+
+func (builder SavingsGenesisBuilder) WithLockupTiers(tiers ...savingstypes.LockupTier) SavingsGenesisBuilder {
+	builder.Params.LockupTiers = append(builder.Params.LockupTiers, tiers...)
+	return builder
+}
@@ -34,6 +34,8 @@ import (
 	liquidtypes "github.com/mage-coven/fury/x/liquid/types"
 	routerkeeper "github.com/mage-coven/fury/x/router/keeper"
 	routertypes "github.com/mage-coven/fury/x/router/types"
+	savingskeeper "github.com/mage-coven/fury/x/savings/keeper"
+	savingstypes "github.com/mage-coven/fury/x/savings/types"
 	swapkeeper "github.com/mage-coven/fury/x/swap/keeper"
 	swaptypes "github.com/mage-coven/fury/x/swap/types"
 )
@@ -154,6 +156,14 @@ func (suite *IntegrationTester) DeliverIncentiveMsg(msg sdk.Msg) error {
 		_, err = msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimEarnReward:
 		_, err = msgServer.ClaimEarnReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimSavingsReward:
+		_, err = msgServer.ClaimSavingsReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimAllRewards:
+		_, err = msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgSetAutoStake:
+		_, err = msgServer.SetAutoStake(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgCreateGauge:
+		_, err = msgServer.CreateGauge(sdk.WrapSDKContext(suite.Ctx), msg)
 	default:
 		panic("unhandled incentive msg")
 	}
@@ -330,6 +340,22 @@ func (suite *IntegrationTester) DeliverEarnMsgDeposit(
 	return err
 }
 
+func (suite *IntegrationTester) DeliverSavingsMsgDeposit(depositor sdk.AccAddress, deposit sdk.Coins) error {
+	msg := savingstypes.NewMsgDeposit(depositor, deposit)
+	msgServer := savingskeeper.NewMsgServerImpl(suite.App.GetSavingsKeeper())
+
+	_, err := msgServer.Deposit(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
+func (suite *IntegrationTester) DeliverSavingsMsgLockDeposit(depositor sdk.AccAddress, amount sdk.Coin, monthsLockup int64) error {
+	msg := savingstypes.NewMsgLockDeposit(depositor, amount, monthsLockup)
+	msgServer := savingskeeper.NewMsgServerImpl(suite.App.GetSavingsKeeper())
+
+	_, err := msgServer.LockDeposit(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
 func (suite *IntegrationTester) ProposeAndVoteOnNewParams(voter sdk.AccAddress, committeeID uint64, changes []proposaltypes.ParamChange) {
 	propose, err := committeetypes.NewMsgSubmitProposal(
 		proposaltypes.NewParameterChangeProposal(
@@ -438,6 +464,12 @@ func (suite *IntegrationTester) EarnRewardEquals(owner sdk.AccAddress, expected
 	suite.Truef(expected.IsEqual(claim.Reward), "expected earn claim reward to be %s, but got %s", expected, claim.Reward)
 }
 
+func (suite *IntegrationTester) SavingsRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
+	claim, found := suite.App.GetIncentiveKeeper().GetSynchronizedSavingsClaim(suite.Ctx, owner)
+	suite.Require().Truef(found, "expected savings claim to be found for %s", owner)
+	suite.Truef(expected.IsEqual(claim.Reward), "expected savings claim reward to be %s, but got %s", expected, claim.Reward)
+}
+
 // AddTestAddrsFromPubKeys adds the addresses into the SimApp providing only the public keys.
 func (suite *IntegrationTester) AddTestAddrsFromPubKeys(ctx sdk.Context, pubKeys []cryptotypes.PubKey, accAmt sdkmath.Int) {
 	initCoins := sdk.NewCoins(sdk.NewCoin(suite.App.GetStakingKeeper().BondDenom(ctx), accAmt))
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAutoStakeDelegations is the maximum number of an owner's delegations that
+// auto-staked rewards are split across
+const MaxAutoStakeDelegations = 200
+
+// ParseAutoStakeMode returns the auto-stake mode for a short name: none,
+// delegate or liquid
+func ParseAutoStakeMode(name string) (AutoStakeMode, error) {
+	mode, found := AutoStakeMode_value["AUTO_STAKE_MODE_"+strings.ToUpper(name)]
+	if !found || mode == int32(AUTO_STAKE_MODE_UNSPECIFIED) {
+		return AUTO_STAKE_MODE_UNSPECIFIED, fmt.Errorf("invalid auto-stake mode '%s', expected none, delegate or liquid", name)
+	}
+	return AutoStakeMode(mode), nil
+}
+
+// Validate returns an error if the mode is not a known auto-stake mode
+func (m AutoStakeMode) Validate() error {
+	if _, found := AutoStakeMode_name[int32(m)]; !found {
+		return fmt.Errorf("invalid auto-stake mode: %d", m)
+	}
+	return nil
+}
+
+// NewAutoStakeSetting returns a new AutoStakeSetting
+func NewAutoStakeSetting(owner sdk.AccAddress, mode AutoStakeMode) AutoStakeSetting {
+	return AutoStakeSetting{
+		Owner: owner,
+		Mode:  mode,
+	}
+}
+
+// Validate performs a basic check of an AutoStakeSetting fields.
+func (s AutoStakeSetting) Validate() error {
+	if s.Owner.Empty() {
+		return fmt.Errorf("auto-stake setting owner cannot be empty")
+	}
+	if err := s.Mode.Validate(); err != nil {
+		return err
+	}
+	if s.Mode == AUTO_STAKE_MODE_UNSPECIFIED || s.Mode == AUTO_STAKE_MODE_NONE {
+		return fmt.Errorf("auto-stake setting must delegate or mint derivatives, got %s", s.Mode)
+	}
+	return nil
+}
+
+// AutoStakeSettings is a slice of AutoStakeSetting
+type AutoStakeSettings []AutoStakeSetting
+
+// Validate checks if all the AutoStakeSettings are valid and there are no duplicated
+// entries.
+func (ss AutoStakeSettings) Validate() error {
+	seenOwners := make(map[string]bool)
+	for _, s := range ss {
+		if seenOwners[s.Owner.String()] {
+			return fmt.Errorf("duplicated auto-stake setting for owner %s", s.Owner)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seenOwners[s.Owner.String()] = true
+	}
+	return nil
+}
@@ -26,6 +26,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AutoStakeMode defines how claimed delegator rewards in the staking denom are
+// staked.
+type AutoStakeMode int32
+
+const (
+	// AUTO_STAKE_MODE_UNSPECIFIED defers to the owner's auto-stake setting when
+	// claiming.
+	AUTO_STAKE_MODE_UNSPECIFIED AutoStakeMode = 0
+	// AUTO_STAKE_MODE_NONE pays out rewards without staking them.
+	AUTO_STAKE_MODE_NONE AutoStakeMode = 1
+	// AUTO_STAKE_MODE_DELEGATE delegates rewards to the owner's current
+	// validators, pro rata to their existing delegations.
+	AUTO_STAKE_MODE_DELEGATE AutoStakeMode = 2
+	// AUTO_STAKE_MODE_LIQUID delegates rewards like AUTO_STAKE_MODE_DELEGATE and
+	// converts the new delegations into liquid staking derivatives. Rewards paid
+	// with a time locked multiplier are delegated instead.
+	AUTO_STAKE_MODE_LIQUID AutoStakeMode = 3
+)
+
+var AutoStakeMode_name = map[int32]string{
+	0: "AUTO_STAKE_MODE_UNSPECIFIED",
+	1: "AUTO_STAKE_MODE_NONE",
+	2: "AUTO_STAKE_MODE_DELEGATE",
+	3: "AUTO_STAKE_MODE_LIQUID",
+}
+
+var AutoStakeMode_value = map[string]int32{
+	"AUTO_STAKE_MODE_UNSPECIFIED": 0,
+	"AUTO_STAKE_MODE_NONE":        1,
+	"AUTO_STAKE_MODE_DELEGATE":    2,
+	"AUTO_STAKE_MODE_LIQUID":      3,
+}
+
+func (x AutoStakeMode) String() string {
+	return proto.EnumName(AutoStakeMode_name, int32(x))
+}
+
+func (AutoStakeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef6fd6bfd393e290, []int{0}
+}
+
 // BaseClaim is a claim with a single reward coin types
 type BaseClaim struct {
 	Owner  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
@@ -36,7 +77,7 @@ func (m *BaseClaim) Reset()         { *m = BaseClaim{} }
 func (m *BaseClaim) String() string { return proto.CompactTextString(m) }
 func (*BaseClaim) ProtoMessage()    {}
 func (*BaseClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{0}
+	return fileDescriptor_ef6fd6bfd393e290, []int{0}
 }
 func (m *BaseClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,7 +116,7 @@ func (m *BaseMultiClaim) Reset()         { *m = BaseMultiClaim{} }
 func (m *BaseMultiClaim) String() string { return proto.CompactTextString(m) }
 func (*BaseMultiClaim) ProtoMessage()    {}
 func (*BaseMultiClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{1}
+	return fileDescriptor_ef6fd6bfd393e290, []int{1}
 }
 func (m *BaseMultiClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,7 +155,7 @@ func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
 func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
 func (*RewardIndex) ProtoMessage()    {}
 func (*RewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{2}
+	return fileDescriptor_ef6fd6bfd393e290, []int{2}
 }
 func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +193,7 @@ func (m *RewardIndexesProto) Reset()         { *m = RewardIndexesProto{} }
 func (m *RewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*RewardIndexesProto) ProtoMessage()    {}
 func (*RewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{3}
+	return fileDescriptor_ef6fd6bfd393e290, []int{3}
 }
 func (m *RewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +232,7 @@ func (m *MultiRewardIndex) Reset()         { *m = MultiRewardIndex{} }
 func (m *MultiRewardIndex) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndex) ProtoMessage()    {}
 func (*MultiRewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{4}
+	return fileDescriptor_ef6fd6bfd393e290, []int{4}
 }
 func (m *MultiRewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +270,7 @@ func (m *MultiRewardIndexesProto) Reset()         { *m = MultiRewardIndexesProto
 func (m *MultiRewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndexesProto) ProtoMessage()    {}
 func (*MultiRewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{5}
+	return fileDescriptor_ef6fd6bfd393e290, []int{5}
 }
 func (m *MultiRewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +309,7 @@ func (m *USDXMintingClaim) Reset()         { *m = USDXMintingClaim{} }
 func (m *USDXMintingClaim) String() string { return proto.CompactTextString(m) }
 func (*USDXMintingClaim) ProtoMessage()    {}
 func (*USDXMintingClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{6}
+	return fileDescriptor_ef6fd6bfd393e290, []int{6}
 }
 func (m *USDXMintingClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +349,7 @@ func (m *HardLiquidityProviderClaim) Reset()         { *m = HardLiquidityProvide
 func (m *HardLiquidityProviderClaim) String() string { return proto.CompactTextString(m) }
 func (*HardLiquidityProviderClaim) ProtoMessage()    {}
 func (*HardLiquidityProviderClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{7}
+	return fileDescriptor_ef6fd6bfd393e290, []int{7}
 }
 func (m *HardLiquidityProviderClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +388,7 @@ func (m *DelegatorClaim) Reset()         { *m = DelegatorClaim{} }
 func (m *DelegatorClaim) String() string { return proto.CompactTextString(m) }
 func (*DelegatorClaim) ProtoMessage()    {}
 func (*DelegatorClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{8}
+	return fileDescriptor_ef6fd6bfd393e290, []int{8}
 }
 func (m *DelegatorClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +427,7 @@ func (m *SwapClaim) Reset()         { *m = SwapClaim{} }
 func (m *SwapClaim) String() string { return proto.CompactTextString(m) }
 func (*SwapClaim) ProtoMessage()    {}
 func (*SwapClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{9}
+	return fileDescriptor_ef6fd6bfd393e290, []int{9}
 }
 func (m *SwapClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +466,7 @@ func (m *SavingsClaim) Reset()         { *m = SavingsClaim{} }
 func (m *SavingsClaim) String() string { return proto.CompactTextString(m) }
 func (*SavingsClaim) ProtoMessage()    {}
 func (*SavingsClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{10}
+	return fileDescriptor_ef6fd6bfd393e290, []int{10}
 }
 func (m *SavingsClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +505,7 @@ func (m *EarnClaim) Reset()         { *m = EarnClaim{} }
 func (m *EarnClaim) String() string { return proto.CompactTextString(m) }
 func (*EarnClaim) ProtoMessage()    {}
 func (*EarnClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{11}
+	return fileDescriptor_ef6fd6bfd393e290, []int{11}
 }
 func (m *EarnClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +534,48 @@ func (m *EarnClaim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EarnClaim proto.InternalMessageInfo
 
+// AutoStakeSetting stores an owner's standing preference for staking claimed
+// delegator rewards.
+type AutoStakeSetting struct {
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Mode  AutoStakeMode                                 `protobuf:"varint,2,opt,name=mode,proto3,enum=fury.incentive.v1beta1.AutoStakeMode" json:"mode,omitempty"`
+}
+
+func (m *AutoStakeSetting) Reset()         { *m = AutoStakeSetting{} }
+func (m *AutoStakeSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoStakeSetting) ProtoMessage()    {}
+func (*AutoStakeSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6fd6bfd393e290, []int{12}
+}
+func (m *AutoStakeSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoStakeSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoStakeSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoStakeSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoStakeSetting.Merge(m, src)
+}
+func (m *AutoStakeSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoStakeSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoStakeSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoStakeSetting proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("fury.incentive.v1beta1.AutoStakeMode", AutoStakeMode_name, AutoStakeMode_value)
 	proto.RegisterType((*BaseClaim)(nil), "fury.incentive.v1beta1.BaseClaim")
 	proto.RegisterType((*BaseMultiClaim)(nil), "fury.incentive.v1beta1.BaseMultiClaim")
 	proto.RegisterType((*RewardIndex)(nil), "fury.incentive.v1beta1.RewardIndex")
@@ -506,58 +588,67 @@ func init() {
 	proto.RegisterType((*SwapClaim)(nil), "fury.incentive.v1beta1.SwapClaim")
 	proto.RegisterType((*SavingsClaim)(nil), "fury.incentive.v1beta1.SavingsClaim")
 	proto.RegisterType((*EarnClaim)(nil), "fury.incentive.v1beta1.EarnClaim")
+	proto.RegisterType((*AutoStakeSetting)(nil), "fury.incentive.v1beta1.AutoStakeSetting")
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/claims.proto", fileDescriptor_5f7515029623a895)
-}
-
-var fileDescriptor_5f7515029623a895 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0xc0, 0x0b, 0x79, 0x3b, 0x94, 0xbe, 0x64, 0x81, 0x57, 0xe8, 0x61, 0x8b, 0x25, 0xc1,
-	0x26, 0xa6, 0xbb, 0x82, 0x07, 0x13, 0x6f, 0x2c, 0x68, 0xc0, 0x48, 0x24, 0x5b, 0x4d, 0x8c, 0x07,
-	0x9b, 0xd9, 0xdd, 0xb1, 0x4e, 0xd8, 0xee, 0xd4, 0x99, 0x6d, 0x4b, 0x3f, 0x83, 0x17, 0xfd, 0x02,
-	0x7e, 0x00, 0x2f, 0x5e, 0xf8, 0x10, 0xc4, 0x78, 0x20, 0xc6, 0xc4, 0x3f, 0x87, 0x8a, 0x70, 0xf5,
-	0x13, 0x78, 0x32, 0xf3, 0x07, 0x58, 0xa0, 0x25, 0xc4, 0x14, 0x0f, 0x9c, 0x76, 0xe7, 0x99, 0x67,
-	0x9e, 0xdf, 0x9f, 0x79, 0x76, 0x76, 0xe0, 0xec, 0x06, 0x6a, 0x22, 0x9b, 0x44, 0x3e, 0x8e, 0x62,
-	0xd2, 0xc4, 0x76, 0x73, 0xde, 0xc3, 0x31, 0x9a, 0xb7, 0xfd, 0x10, 0x91, 0x1a, 0xb7, 0xea, 0x8c,
-	0xc6, 0xd4, 0xf8, 0x5f, 0x24, 0x59, 0x87, 0x49, 0x96, 0x4e, 0xca, 0x99, 0x3e, 0xe5, 0x35, 0xca,
-	0x6d, 0x0f, 0xf1, 0xc4, 0x4a, 0x4a, 0x22, 0xb5, 0x2e, 0x37, 0xad, 0xe6, 0x2b, 0x72, 0x64, 0xab,
-	0x81, 0x9e, 0x9a, 0xa8, 0xd2, 0x2a, 0x55, 0x71, 0xf1, 0xa6, 0xa2, 0x85, 0x77, 0x00, 0xa6, 0x1d,
-	0xc4, 0xf1, 0x92, 0x40, 0x37, 0x9e, 0xc2, 0x21, 0xda, 0x8a, 0x30, 0x9b, 0x02, 0x33, 0xa0, 0x98,
-	0x71, 0x56, 0x7e, 0x75, 0xf2, 0xa5, 0x2a, 0x89, 0x9f, 0x37, 0x3c, 0xcb, 0xa7, 0x35, 0x5d, 0x4f,
-	0x3f, 0x4a, 0x3c, 0xd8, 0xb0, 0xe3, 0x76, 0x1d, 0x73, 0x6b, 0xd1, 0xf7, 0x17, 0x83, 0x80, 0x61,
-	0xce, 0x3f, 0x6e, 0x95, 0xc6, 0x35, 0xaa, 0x8e, 0x38, 0xed, 0x18, 0x73, 0x57, 0x95, 0x35, 0x6e,
-	0xc1, 0x61, 0x86, 0x5b, 0x88, 0x05, 0x53, 0x03, 0x33, 0xa0, 0x38, 0xb2, 0x30, 0x6d, 0xe9, 0x64,
-	0xa1, 0xe7, 0x40, 0xa4, 0xb5, 0x44, 0x49, 0xe4, 0xfc, 0xb3, 0xdd, 0xc9, 0xa7, 0x5c, 0x9d, 0x7e,
-	0x3b, 0xfd, 0x7e, 0xab, 0x34, 0x24, 0x39, 0x16, 0x76, 0x01, 0xcc, 0x0a, 0xc6, 0x6b, 0x8d, 0x30,
-	0x26, 0x7f, 0x87, 0xb6, 0x9f, 0xa0, 0x3d, 0x78, 0x36, 0xed, 0x1b, 0x82, 0xf6, 0xdb, 0xef, 0xf9,
-	0xe2, 0x39, 0xf0, 0xc5, 0x02, 0xde, 0x4d, 0xe2, 0x4b, 0x00, 0x47, 0x5c, 0x19, 0x5d, 0x8d, 0x02,
-	0xbc, 0x69, 0x5c, 0x83, 0xff, 0xf9, 0x34, 0x0c, 0x51, 0x8c, 0x19, 0x0a, 0x2b, 0x62, 0xb1, 0x54,
-	0x9a, 0x76, 0xb3, 0x47, 0xe1, 0x87, 0xed, 0x3a, 0x36, 0xca, 0x70, 0x54, 0x55, 0xab, 0x3c, 0x43,
-	0x7e, 0x4c, 0x99, 0xb4, 0x39, 0xe3, 0x58, 0x82, 0xd4, 0xb7, 0x4e, 0x7e, 0xee, 0x1c, 0xa4, 0x96,
-	0xb1, 0xef, 0x66, 0x54, 0x91, 0xbb, 0xb2, 0x46, 0xa1, 0x05, 0x8d, 0x04, 0x19, 0xcc, 0xd7, 0x65,
-	0x87, 0x22, 0x98, 0xd5, 0x50, 0x44, 0x85, 0xa7, 0x80, 0xf4, 0x66, 0xd6, 0xea, 0xde, 0xba, 0x56,
-	0xa2, 0x86, 0x33, 0xa9, 0x5d, 0x1a, 0x3d, 0x56, 0xd8, 0xd5, 0xe4, 0xf5, 0xb0, 0xf0, 0x06, 0xc0,
-	0x31, 0xb9, 0xcb, 0x7f, 0xe4, 0xc5, 0x69, 0x82, 0x03, 0xfd, 0x26, 0xf8, 0x1a, 0xc0, 0x2b, 0x27,
-	0x09, 0x1e, 0xf8, 0xd3, 0x84, 0x13, 0x35, 0x31, 0x55, 0xe9, 0xea, 0x52, 0xb1, 0x17, 0x89, 0x93,
-	0xe5, 0x9c, 0x9c, 0x66, 0x62, 0x9c, 0x06, 0x72, 0x8d, 0xda, 0xa9, 0x58, 0xe1, 0x03, 0x80, 0x63,
-	0x8f, 0xca, 0xcb, 0x8f, 0xd7, 0x48, 0x14, 0x93, 0xa8, 0xaa, 0x3e, 0x90, 0x7b, 0x10, 0x8a, 0x56,
-	0xad, 0xc8, 0x33, 0x46, 0xfa, 0x35, 0xb2, 0x70, 0xb5, 0x17, 0x85, 0xc3, 0xe3, 0xc0, 0xf9, 0x57,
-	0x60, 0xef, 0x74, 0xf2, 0xc0, 0x4d, 0x7b, 0x87, 0x67, 0xc4, 0xc5, 0xfb, 0x9a, 0xfc, 0x14, 0x7e,
-	0x0e, 0xc0, 0xdc, 0x0a, 0x62, 0xc1, 0x7d, 0xf2, 0xa2, 0x41, 0x02, 0x12, 0xb7, 0xd7, 0x19, 0x6d,
-	0x92, 0x00, 0x33, 0x45, 0xe6, 0x41, 0x17, 0x61, 0x73, 0x67, 0x09, 0x3b, 0x3a, 0x35, 0xba, 0xab,
-	0xdb, 0x84, 0x93, 0xbc, 0x51, 0xaf, 0x87, 0xed, 0x4a, 0x57, 0x91, 0xfd, 0xd9, 0xb7, 0x71, 0x05,
-	0x71, 0x2c, 0x28, 0x90, 0x3d, 0xca, 0x18, 0x6d, 0x9d, 0x44, 0x1e, 0xec, 0x27, 0xb2, 0x82, 0x70,
-	0x7b, 0xd9, 0xfd, 0x15, 0xc0, 0xec, 0x32, 0x0e, 0x71, 0x15, 0xc5, 0xf4, 0xa2, 0x2c, 0xde, 0xe8,
-	0xd1, 0x40, 0xfd, 0x51, 0xd8, 0xbb, 0x95, 0x3e, 0x01, 0x98, 0x2e, 0xb7, 0x50, 0xfd, 0x92, 0xc9,
-	0xfa, 0x0c, 0x60, 0xa6, 0x8c, 0x9a, 0x24, 0xaa, 0xf2, 0x4b, 0xb8, 0x61, 0x77, 0x10, 0x8b, 0x2e,
-	0x97, 0x2c, 0x67, 0x75, 0xfb, 0x87, 0x99, 0xda, 0xde, 0x33, 0xc1, 0xce, 0x9e, 0x09, 0x76, 0xf7,
-	0x4c, 0xf0, 0x6a, 0xdf, 0x4c, 0xed, 0xec, 0x9b, 0xa9, 0x2f, 0xfb, 0x66, 0xea, 0xc9, 0xf5, 0xc4,
-	0x3f, 0x5a, 0xf0, 0x28, 0x85, 0xc8, 0xe3, 0xf2, 0xcd, 0xde, 0x4c, 0xdc, 0x1a, 0xe5, 0xcf, 0xda,
-	0x1b, 0x96, 0x97, 0xb8, 0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0xd7, 0x8a, 0x4f, 0x54,
-	0x0a, 0x00, 0x00,
+	proto.RegisterFile("fury/incentive/v1beta1/claims.proto", fileDescriptor_ef6fd6bfd393e290)
+}
+
+var fileDescriptor_ef6fd6bfd393e290 = []byte{
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xda, 0x48,
+	0x1c, 0x67, 0xc8, 0x87, 0x96, 0x09, 0xb0, 0xc8, 0xf9, 0x58, 0xc2, 0xae, 0x4c, 0x96, 0x68, 0xb3,
+	0x68, 0xb5, 0x98, 0x26, 0x3d, 0x54, 0xed, 0x0d, 0x07, 0xa7, 0x21, 0x0d, 0x21, 0xb5, 0x41, 0xaa,
+	0x7a, 0xa8, 0x35, 0xd8, 0x13, 0x6a, 0x05, 0x3c, 0xd4, 0x36, 0x10, 0xde, 0xa0, 0x6a, 0x2f, 0xed,
+	0x0b, 0xf4, 0xd2, 0x5b, 0xa5, 0xaa, 0x97, 0x3c, 0x44, 0x54, 0xf5, 0x10, 0x55, 0x95, 0xfa, 0x71,
+	0xa0, 0x69, 0x72, 0xed, 0x13, 0xf4, 0x54, 0x79, 0xec, 0x24, 0x0e, 0x81, 0x28, 0xaa, 0x48, 0x0e,
+	0x39, 0xc1, 0xfc, 0xe6, 0x3f, 0xff, 0xdf, 0xc7, 0x8c, 0x47, 0x03, 0x67, 0x37, 0x1a, 0x46, 0x3b,
+	0xad, 0xe9, 0x0a, 0xd6, 0x2d, 0xad, 0x89, 0xd3, 0xcd, 0xf9, 0x32, 0xb6, 0xd0, 0x7c, 0x5a, 0xa9,
+	0x22, 0xad, 0x66, 0x72, 0x75, 0x83, 0x58, 0x84, 0x99, 0xb2, 0x8b, 0xb8, 0xa3, 0x22, 0xce, 0x2d,
+	0x8a, 0xb1, 0x0a, 0x31, 0x6b, 0xc4, 0x4c, 0x97, 0x91, 0xe9, 0x59, 0x49, 0x34, 0xdd, 0x59, 0x17,
+	0x9b, 0x76, 0xe6, 0x65, 0x3a, 0x4a, 0x3b, 0x03, 0x77, 0x6a, 0xa2, 0x42, 0x2a, 0xc4, 0xc1, 0xed,
+	0x7f, 0x0e, 0x9a, 0x78, 0x03, 0x60, 0x80, 0x47, 0x26, 0x5e, 0xb4, 0xd9, 0x99, 0x07, 0x70, 0x84,
+	0xb4, 0x74, 0x6c, 0x44, 0xc1, 0x0c, 0x48, 0x06, 0xf9, 0xe5, 0x1f, 0x9d, 0x78, 0xaa, 0xa2, 0x59,
+	0x0f, 0x1b, 0x65, 0x4e, 0x21, 0x35, 0xb7, 0x9f, 0xfb, 0x93, 0x32, 0xd5, 0xcd, 0xb4, 0xd5, 0xae,
+	0x63, 0x93, 0xcb, 0x28, 0x4a, 0x46, 0x55, 0x0d, 0x6c, 0x9a, 0xef, 0xb7, 0x53, 0xe3, 0x2e, 0xab,
+	0x8b, 0xf0, 0x6d, 0x0b, 0x9b, 0xa2, 0xd3, 0x96, 0xb9, 0x01, 0x47, 0x0d, 0xdc, 0x42, 0x86, 0x1a,
+	0xf5, 0xcf, 0x80, 0xe4, 0xd8, 0xc2, 0x34, 0xe7, 0x16, 0xdb, 0x7e, 0x0e, 0x4d, 0x72, 0x8b, 0x44,
+	0xd3, 0xf9, 0xe1, 0x9d, 0x4e, 0xdc, 0x27, 0xba, 0xe5, 0xb7, 0x02, 0x6f, 0xb7, 0x53, 0x23, 0x54,
+	0x63, 0x62, 0x0f, 0xc0, 0xb0, 0xad, 0x38, 0xdf, 0xa8, 0x5a, 0xda, 0xe5, 0xc8, 0x56, 0x3c, 0xb2,
+	0x87, 0xce, 0x96, 0x7d, 0xcd, 0x96, 0xfd, 0xea, 0x6b, 0x3c, 0x79, 0x0e, 0x7e, 0x7b, 0x81, 0xd9,
+	0xcb, 0xe2, 0x53, 0x00, 0xc7, 0x44, 0x8a, 0xe6, 0x74, 0x15, 0x6f, 0x31, 0xff, 0xc2, 0xdf, 0x15,
+	0x52, 0xad, 0x22, 0x0b, 0x1b, 0xa8, 0x2a, 0xdb, 0x8b, 0xa9, 0xd3, 0x80, 0x18, 0x3e, 0x86, 0x8b,
+	0xed, 0x3a, 0x66, 0x24, 0x18, 0x72, 0xba, 0xc9, 0x1b, 0x48, 0xb1, 0x88, 0x41, 0x63, 0x0e, 0xf2,
+	0x9c, 0x2d, 0xea, 0x4b, 0x27, 0x3e, 0x77, 0x0e, 0x51, 0x59, 0xac, 0x88, 0x41, 0xa7, 0xc9, 0x12,
+	0xed, 0x91, 0x68, 0x41, 0xc6, 0x23, 0x06, 0x9b, 0xeb, 0xf4, 0x84, 0x22, 0x18, 0x76, 0xa9, 0x34,
+	0x07, 0x8e, 0x02, 0x9a, 0xcd, 0x2c, 0xd7, 0xfb, 0xe8, 0x72, 0x9e, 0x1e, 0xfc, 0xa4, 0x9b, 0x52,
+	0xe8, 0x44, 0x63, 0x31, 0x64, 0x78, 0x87, 0x89, 0x17, 0x00, 0x46, 0xe8, 0x2e, 0xff, 0x52, 0x16,
+	0xa7, 0x05, 0xfa, 0x07, 0x2d, 0xf0, 0x39, 0x80, 0x7f, 0x74, 0x0b, 0x3c, 0xcc, 0xa7, 0x09, 0x27,
+	0x6a, 0xf6, 0x94, 0xdc, 0x33, 0xa5, 0x64, 0x3f, 0x11, 0xdd, 0xed, 0xf8, 0x98, 0xab, 0x84, 0x39,
+	0x4d, 0x24, 0x32, 0xb5, 0x53, 0x58, 0xe2, 0x1d, 0x80, 0x91, 0x92, 0x94, 0xbd, 0x97, 0xd7, 0x74,
+	0x4b, 0xd3, 0x2b, 0xce, 0x07, 0xb2, 0x02, 0xa1, 0x7d, 0x54, 0x65, 0x7a, 0xc7, 0xd0, 0xbc, 0xc6,
+	0x16, 0xfe, 0xee, 0x27, 0xe1, 0xe8, 0x3a, 0xe0, 0x7f, 0xb3, 0xb9, 0x77, 0x3b, 0x71, 0x20, 0x06,
+	0xca, 0x87, 0xe0, 0x25, 0xe4, 0xea, 0xfd, 0x14, 0xbe, 0xfb, 0x61, 0x6c, 0x19, 0x19, 0xea, 0xaa,
+	0xf6, 0xa8, 0xa1, 0xa9, 0x9a, 0xd5, 0x5e, 0x37, 0x48, 0x53, 0x53, 0xb1, 0xe1, 0x88, 0x29, 0xf4,
+	0x30, 0x36, 0x77, 0x96, 0xb1, 0xe3, 0x5b, 0xa3, 0xb7, 0xbb, 0x2d, 0x38, 0x69, 0x36, 0xea, 0xf5,
+	0x6a, 0x5b, 0xee, 0x69, 0x72, 0x30, 0xfb, 0x36, 0xee, 0x50, 0x9c, 0x00, 0x6d, 0xe6, 0x32, 0x31,
+	0x0c, 0xd2, 0xea, 0x66, 0x1e, 0x1a, 0x24, 0xb3, 0x43, 0x21, 0xf6, 0x8b, 0xfb, 0x33, 0x80, 0xe1,
+	0x2c, 0xae, 0xe2, 0x0a, 0xb2, 0xc8, 0x45, 0x45, 0xbc, 0xd9, 0xe7, 0x00, 0x0d, 0xc6, 0x61, 0xff,
+	0xa3, 0xf4, 0x01, 0xc0, 0x80, 0xd4, 0x42, 0xf5, 0x2b, 0x66, 0xeb, 0x23, 0x80, 0x41, 0x09, 0x35,
+	0x35, 0xbd, 0x62, 0x5e, 0xc1, 0x0d, 0x13, 0x90, 0xa1, 0x5f, 0x31, 0x5b, 0xaf, 0x01, 0x8c, 0x64,
+	0x1a, 0x16, 0x91, 0x2c, 0xb4, 0x89, 0x25, 0x6c, 0xd9, 0xd7, 0xf4, 0x85, 0x3f, 0x61, 0x6e, 0xc2,
+	0xe1, 0x1a, 0x51, 0x31, 0x7d, 0x10, 0x84, 0x17, 0xfe, 0xe9, 0x67, 0xf1, 0x48, 0x57, 0x9e, 0xa8,
+	0x58, 0xa4, 0x4b, 0xfe, 0x7b, 0x02, 0x60, 0xe8, 0x04, 0xce, 0xc4, 0xe1, 0x9f, 0x99, 0x52, 0xb1,
+	0x20, 0x4b, 0xc5, 0xcc, 0x1d, 0x41, 0xce, 0x17, 0xb2, 0x82, 0x5c, 0x5a, 0x93, 0xd6, 0x85, 0xc5,
+	0xdc, 0x52, 0x4e, 0xc8, 0x46, 0x7c, 0x4c, 0x14, 0x4e, 0x74, 0x17, 0xac, 0x15, 0xd6, 0x84, 0x08,
+	0x60, 0xfe, 0x82, 0xd1, 0xee, 0x99, 0xac, 0xb0, 0x2a, 0xdc, 0xce, 0x14, 0x85, 0x88, 0x9f, 0x89,
+	0xc1, 0xa9, 0xee, 0xd9, 0xd5, 0xdc, 0xdd, 0x52, 0x2e, 0x1b, 0x19, 0x8a, 0x0d, 0x3f, 0x7e, 0xc9,
+	0xfa, 0xf8, 0x95, 0x9d, 0x6f, 0xac, 0x6f, 0x67, 0x9f, 0x05, 0xbb, 0xfb, 0x2c, 0xd8, 0xdb, 0x67,
+	0xc1, 0xb3, 0x03, 0xd6, 0xb7, 0x7b, 0xc0, 0xfa, 0x3e, 0x1d, 0xb0, 0xbe, 0xfb, 0xff, 0x7b, 0x22,
+	0xab, 0xa1, 0x0a, 0x4e, 0x29, 0xa4, 0x89, 0xf5, 0x34, 0x7d, 0x71, 0x6f, 0x79, 0xde, 0xdc, 0x34,
+	0xbc, 0xf2, 0x28, 0x7d, 0x02, 0x5f, 0xff, 0x39, 0x00, 0x17, 0xb1, 0x0b, 0x55, 0x92, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
@@ -1098,6 +1189,41 @@ func (m *EarnClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoStakeSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoStakeSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoStakeSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClaims(v)
 	base := offset
@@ -1315,6 +1441,22 @@ func (m *EarnClaim) Size() (n int) {
 	return n
 }
 
+func (m *AutoStakeSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovClaims(uint64(m.Mode))
+	}
+	return n
+}
+
 func sovClaims(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2691,6 +2833,109 @@ func (m *EarnClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoStakeSetting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoStakeSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoStakeSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AutoStakeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClaims(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
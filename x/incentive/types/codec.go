@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimSwapReward{}, "incentive/MsgClaimSwapReward", nil)
 	cdc.RegisterConcrete(&MsgClaimSavingsReward{}, "incentive/MsgClaimSavingsReward", nil)
 	cdc.RegisterConcrete(&MsgClaimEarnReward{}, "incentive/MsgClaimEarnReward", nil)
+	cdc.RegisterConcrete(&MsgClaimAllRewards{}, "incentive/MsgClaimAllRewards", nil)
+	cdc.RegisterConcrete(&MsgSetAutoStake{}, "incentive/MsgSetAutoStake", nil)
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "incentive/MsgCreateGauge", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClaimSwapReward{},
 		&MsgClaimSavingsReward{},
 		&MsgClaimEarnReward{},
+		&MsgClaimAllRewards{},
+		&MsgSetAutoStake{},
+		&MsgCreateGauge{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -18,4 +18,6 @@ var (
 	ErrInvalidClaimType              = errorsmod.Register(ModuleName, 11, "invalid claim type")
 	ErrDecreasingRewardFactor        = errorsmod.Register(ModuleName, 13, "found new reward factor less than an old reward factor")
 	ErrInvalidClaimDenoms            = errorsmod.Register(ModuleName, 14, "invalid claim denoms")
+	ErrInvalidAutoStake              = errorsmod.Register(ModuleName, 15, "invalid auto-stake mode")
+	ErrInvalidGauge                  = errorsmod.Register(ModuleName, 16, "invalid gauge")
 )
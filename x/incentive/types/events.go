@@ -2,15 +2,26 @@ package types
 
 // Events emitted by the incentive module
 const (
-	EventTypeClaim             = "claim_reward"
-	EventTypeRewardPeriod      = "new_reward_period"
-	EventTypeClaimPeriod       = "new_claim_period"
-	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeClaim              = "claim_reward"
+	EventTypeRewardPeriod       = "new_reward_period"
+	EventTypeClaimPeriod        = "new_claim_period"
+	EventTypeClaimPeriodExpiry  = "claim_period_expiry"
+	EventTypeAutoStake          = "auto_stake_reward"
+	EventTypeAutoStakeDowngrade = "auto_stake_downgrade"
+	EventTypeCreateGauge        = "create_gauge"
+	EventTypeGaugeEnded         = "gauge_ended"
 
-	AttributeValueCategory   = ModuleName
-	AttributeKeyClaimedBy    = "claimed_by"
-	AttributeKeyClaimAmount  = "claim_amount"
-	AttributeKeyClaimType    = "claim_type"
-	AttributeKeyRewardPeriod = "reward_period"
-	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyClaimedBy      = "claimed_by"
+	AttributeKeyClaimAmount    = "claim_amount"
+	AttributeKeyClaimType      = "claim_type"
+	AttributeKeyRewardPeriod   = "reward_period"
+	AttributeKeyClaimPeriod    = "claim_period"
+	AttributeKeyAutoStake      = "auto_stake_mode"
+	AttributeKeyMultiplier     = "multiplier"
+	AttributeKeyGaugeID        = "gauge_id"
+	AttributeKeyFunder         = "funder"
+	AttributeKeyRefund         = "refund"
+	AttributeKeySourceType     = "source_type"
+	AttributeKeyCollateralType = "collateral_type"
 )
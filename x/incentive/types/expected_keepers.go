@@ -25,6 +25,7 @@ type ParamSubspace interface {
 // BankKeeper defines the expected interface needed to send coins
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
@@ -35,6 +36,10 @@ type StakingKeeper interface {
 	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []stakingtypes.Delegation)
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
+	Delegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdkmath.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool,
+	) (newShares sdk.Dec, err error)
 }
 
 // CdpKeeper defines the expected cdp keeper for interacting with cdps
@@ -66,14 +71,17 @@ type SwapKeeper interface {
 type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 	GetSavingsModuleAccountBalances(ctx sdk.Context) sdk.Coins
+	GetDepositRewardShares(ctx sdk.Context, deposit savingstypes.Deposit) sdk.DecCoins
+	GetTotalRewardShares(ctx sdk.Context, denom string) sdk.Dec
 }
 
 // EarnKeeper defines the required methods needed by this modules keeper
 type EarnKeeper interface {
 	GetVaultTotalShares(ctx sdk.Context, denom string) (shares earntypes.VaultShare, found bool)
 	GetVaultTotalValue(ctx sdk.Context, denom string) (sdk.Coin, error)
-	GetVaultAccountShares(ctx sdk.Context, acc sdk.AccAddress) (shares earntypes.VaultShares, found bool)
+	GetVaultAccountRewardShares(ctx sdk.Context, acc sdk.AccAddress) earntypes.VaultShares
 	IterateVaultRecords(ctx sdk.Context, cb func(record earntypes.VaultRecord) (stop bool))
+	GetSwapStrategyVaultDenom(ctx sdk.Context, poolID string) (string, bool)
 }
 
 // LiquidKeeper defines the required methods needed by this modules keeper
@@ -86,6 +94,7 @@ type LiquidKeeper interface {
 		derivativeDenom string,
 		destinationModAccount string,
 	) (sdk.Coins, error)
+	MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error)
 }
 
 // AccountKeeper expected interface for the account keeper (noalias)
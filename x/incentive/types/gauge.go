@@ -0,0 +1,174 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextGaugeID is the ID of the first gauge
+const DefaultNextGaugeID uint64 = 1
+
+const (
+	// MaxGaugeDuration is the longest time a new gauge can distribute rewards for
+	MaxGaugeDuration = 365 * 24 * time.Hour
+	// MaxGaugeStartDelay is the longest time a new gauge can be scheduled ahead
+	MaxGaugeStartDelay = 30 * 24 * time.Hour
+	// MinGaugeRewardsPerSecond is the lowest rate a new gauge can distribute its budget at
+	MinGaugeRewardsPerSecond int64 = 100
+	// MaxActiveGaugesPerSource is the maximum number of gauges that can reward a single source
+	MaxActiveGaugesPerSource = 10
+)
+
+// ParseGaugeSourceType returns the gauge source type for a short name: swap,
+// earn, hard-supply or hard-borrow
+func ParseGaugeSourceType(name string) (GaugeSourceType, error) {
+	key := "GAUGE_SOURCE_TYPE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	sourceType, found := GaugeSourceType_value[key]
+	if !found || sourceType == int32(GAUGE_SOURCE_TYPE_UNSPECIFIED) {
+		return GAUGE_SOURCE_TYPE_UNSPECIFIED, fmt.Errorf(
+			"invalid gauge source type '%s', expected swap, earn, hard-supply or hard-borrow", name,
+		)
+	}
+	return GaugeSourceType(sourceType), nil
+}
+
+// Validate returns an error if the source type is not a known gauge source type
+func (t GaugeSourceType) Validate() error {
+	if _, found := GaugeSourceType_name[int32(t)]; !found || t == GAUGE_SOURCE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid gauge source type: %s", t)
+	}
+	return nil
+}
+
+// NewGauge returns a new Gauge that has not distributed any rewards
+func NewGauge(
+	id uint64,
+	funder sdk.AccAddress,
+	sourceType GaugeSourceType,
+	collateralType string,
+	start, end time.Time,
+	budget sdk.Coin,
+	previousAccrualTime time.Time,
+) Gauge {
+	return Gauge{
+		ID:                  id,
+		Funder:              funder,
+		SourceType:          sourceType,
+		CollateralType:      collateralType,
+		Start:               start,
+		End:                 end,
+		Budget:              budget,
+		Distributed:         sdk.ZeroDec(),
+		PreviousAccrualTime: previousAccrualTime,
+	}
+}
+
+// RewardsPerSecond returns the rate the budget is distributed at over the gauge's duration
+func (g Gauge) RewardsPerSecond() sdk.DecCoins {
+	seconds := int64(g.End.Sub(g.Start).Seconds())
+	if seconds <= 0 {
+		return nil
+	}
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(
+		g.Budget.Denom,
+		sdk.NewDecFromInt(g.Budget.Amount).QuoInt64(seconds),
+	))
+}
+
+// Undistributed returns the part of the budget that has not been added to reward indexes
+func (g Gauge) Undistributed() sdk.Coin {
+	remaining := g.Budget.Amount.Sub(g.Distributed.Ceil().TruncateInt())
+	if remaining.IsNegative() {
+		return sdk.NewCoin(g.Budget.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(g.Budget.Denom, remaining)
+}
+
+// Validate performs a basic check of a Gauge.
+func (g Gauge) Validate() error {
+	if g.Funder.Empty() {
+		return errors.New("gauge funder cannot be empty")
+	}
+	if err := validateGaugeSource(g.SourceType, g.CollateralType); err != nil {
+		return err
+	}
+	if err := validateGaugeSchedule(g.Start, g.End, g.Budget); err != nil {
+		return err
+	}
+	if g.Distributed.IsNil() || g.Distributed.IsNegative() {
+		return fmt.Errorf("invalid gauge distributed amount: %s", g.Distributed)
+	}
+	return nil
+}
+
+// ValidateLimits checks the schedule of a new gauge is within the limits for
+// creating gauges. Existing gauges are not required to be within the limits.
+func (g Gauge) ValidateLimits() error {
+	return validateGaugeLimits(g.Start, g.End, g.Budget)
+}
+
+// validateGaugeSource checks the rewarded source of a gauge
+func validateGaugeSource(sourceType GaugeSourceType, collateralType string) error {
+	if err := sourceType.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(collateralType) == "" {
+		return errors.New("gauge collateral type cannot be blank")
+	}
+	return nil
+}
+
+// validateGaugeSchedule checks the reward schedule of a gauge
+func validateGaugeSchedule(start, end time.Time, budget sdk.Coin) error {
+	if start.IsZero() {
+		return errors.New("gauge start time cannot be 0")
+	}
+	if end.IsZero() {
+		return errors.New("gauge end time cannot be 0")
+	}
+	if end.Sub(start) < time.Second {
+		return fmt.Errorf("gauge end time %s must be at least a second after start time %s", end, start)
+	}
+	if !budget.IsValid() || !budget.IsPositive() {
+		return fmt.Errorf("invalid gauge budget: %s", budget)
+	}
+	return nil
+}
+
+// validateGaugeLimits checks the schedule of a new gauge is within the limits
+// that bound the work gauges add to every block
+func validateGaugeLimits(start, end time.Time, budget sdk.Coin) error {
+	duration := end.Sub(start)
+	if duration > MaxGaugeDuration {
+		return fmt.Errorf("gauge duration %s is longer than the maximum %s", duration, MaxGaugeDuration)
+	}
+	minBudget := sdk.NewInt(int64(duration.Seconds())).MulRaw(MinGaugeRewardsPerSecond)
+	if budget.Amount.LT(minBudget) {
+		return fmt.Errorf(
+			"gauge budget %s is less than the minimum %s%s for its duration", budget, minBudget, budget.Denom,
+		)
+	}
+	return nil
+}
+
+// Gauges is a slice of Gauge
+type Gauges []Gauge
+
+// Validate checks if all the Gauges are valid and there are no duplicated ids.
+func (gs Gauges) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, g := range gs {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+		if seenIDs[g.ID] {
+			return fmt.Errorf("duplicate gauge id: %d", g.ID)
+		}
+		seenIDs[g.ID] = true
+	}
+	return nil
+}
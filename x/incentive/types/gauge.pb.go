@@ -0,0 +1,718 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fury/incentive/v1beta1/gauge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GaugeSourceType is the type of reward source a gauge distributes rewards to.
+type GaugeSourceType int32
+
+const (
+	// GAUGE_SOURCE_TYPE_UNSPECIFIED represents an unspecified or invalid source type.
+	GAUGE_SOURCE_TYPE_UNSPECIFIED GaugeSourceType = 0
+	// GAUGE_SOURCE_TYPE_SWAP rewards depositors of a swap pool, identified by its pool ID.
+	GAUGE_SOURCE_TYPE_SWAP GaugeSourceType = 1
+	// GAUGE_SOURCE_TYPE_EARN rewards depositors of an earn vault, identified by its vault denom.
+	GAUGE_SOURCE_TYPE_EARN GaugeSourceType = 2
+	// GAUGE_SOURCE_TYPE_HARD_SUPPLY rewards suppliers of a hard market, identified by its denom.
+	GAUGE_SOURCE_TYPE_HARD_SUPPLY GaugeSourceType = 3
+	// GAUGE_SOURCE_TYPE_HARD_BORROW rewards borrowers of a hard market, identified by its denom.
+	GAUGE_SOURCE_TYPE_HARD_BORROW GaugeSourceType = 4
+)
+
+var GaugeSourceType_name = map[int32]string{
+	0: "GAUGE_SOURCE_TYPE_UNSPECIFIED",
+	1: "GAUGE_SOURCE_TYPE_SWAP",
+	2: "GAUGE_SOURCE_TYPE_EARN",
+	3: "GAUGE_SOURCE_TYPE_HARD_SUPPLY",
+	4: "GAUGE_SOURCE_TYPE_HARD_BORROW",
+}
+
+var GaugeSourceType_value = map[string]int32{
+	"GAUGE_SOURCE_TYPE_UNSPECIFIED": 0,
+	"GAUGE_SOURCE_TYPE_SWAP":        1,
+	"GAUGE_SOURCE_TYPE_EARN":        2,
+	"GAUGE_SOURCE_TYPE_HARD_SUPPLY": 3,
+	"GAUGE_SOURCE_TYPE_HARD_BORROW": 4,
+}
+
+func (x GaugeSourceType) String() string {
+	return proto.EnumName(GaugeSourceType_name, int32(x))
+}
+
+func (GaugeSourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2773aa3f6459debd, []int{0}
+}
+
+// Gauge stores an externally funded reward budget that is distributed to a reward source between a start and end time.
+type Gauge struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// funder is the account that funded the budget and is refunded any undistributed rewards
+	Funder     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=funder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"funder,omitempty"`
+	SourceType GaugeSourceType                               `protobuf:"varint,3,opt,name=source_type,json=sourceType,proto3,enum=fury.incentive.v1beta1.GaugeSourceType" json:"source_type,omitempty"`
+	// collateral_type identifies the rewarded source, such as a swap pool ID or a vault denom
+	CollateralType string    `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Start          time.Time `protobuf:"bytes,5,opt,name=start,proto3,stdtime" json:"start"`
+	End            time.Time `protobuf:"bytes,6,opt,name=end,proto3,stdtime" json:"end"`
+	// budget is the total reward distributed evenly over the gauge's duration
+	Budget types.Coin `protobuf:"bytes,7,opt,name=budget,proto3" json:"budget"`
+	// distributed is the amount of the budget added to the source's reward indexes so far
+	Distributed         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=distributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"distributed"`
+	PreviousAccrualTime time.Time                              `protobuf:"bytes,9,opt,name=previous_accrual_time,json=previousAccrualTime,proto3,stdtime" json:"previous_accrual_time"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2773aa3f6459debd, []int{0}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("fury.incentive.v1beta1.GaugeSourceType", GaugeSourceType_name, GaugeSourceType_value)
+	proto.RegisterType((*Gauge)(nil), "fury.incentive.v1beta1.Gauge")
+}
+
+func init() {
+	proto.RegisterFile("fury/incentive/v1beta1/gauge.proto", fileDescriptor_2773aa3f6459debd)
+}
+
+var fileDescriptor_2773aa3f6459debd = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0x3d, 0x69, 0x9a, 0x7f, 0x3b, 0xfd, 0xab, 0x8d, 0x5c, 0xa8, 0xdc, 0x48, 0xd8, 0xa1,
+	0x0b, 0x1a, 0x21, 0x62, 0xab, 0x45, 0x02, 0x09, 0xb1, 0xb1, 0x1b, 0xd3, 0x16, 0xa1, 0x36, 0x9a,
+	0x34, 0x2a, 0x65, 0x81, 0xb1, 0xc7, 0x53, 0x33, 0x22, 0xf6, 0x44, 0x9e, 0x71, 0x44, 0xde, 0x80,
+	0x65, 0xdf, 0x81, 0x57, 0xe8, 0x82, 0x47, 0xe8, 0xb2, 0xea, 0x0a, 0x81, 0x14, 0x20, 0x7d, 0x0b,
+	0x56, 0xc8, 0x1f, 0xfd, 0x10, 0x44, 0x88, 0xae, 0x3c, 0x73, 0xe7, 0x77, 0x8e, 0xef, 0x9c, 0xab,
+	0x81, 0x2b, 0x87, 0x49, 0x3c, 0x34, 0x68, 0x84, 0x49, 0x24, 0xe8, 0x80, 0x18, 0x83, 0x35, 0x8f,
+	0x08, 0x77, 0xcd, 0x08, 0xdc, 0x24, 0x20, 0x7a, 0x3f, 0x66, 0x82, 0xc9, 0x4b, 0x29, 0xa3, 0x5f,
+	0x32, 0x7a, 0xc1, 0xd4, 0x54, 0xcc, 0x78, 0xc8, 0xb8, 0xe1, 0xb9, 0xfc, 0x4a, 0x88, 0x19, 0x8d,
+	0x72, 0x5d, 0x6d, 0x39, 0x3f, 0x77, 0xb2, 0x9d, 0x91, 0x6f, 0x8a, 0xa3, 0x5b, 0x01, 0x0b, 0x58,
+	0x5e, 0x4f, 0x57, 0x45, 0x55, 0x0b, 0x18, 0x0b, 0x7a, 0xc4, 0xc8, 0x76, 0x5e, 0x72, 0x68, 0x08,
+	0x1a, 0x12, 0x2e, 0xdc, 0xb0, 0x9f, 0x03, 0x2b, 0x5f, 0xcb, 0x70, 0x7a, 0x33, 0xed, 0x4c, 0x5e,
+	0x82, 0x25, 0xea, 0x2b, 0xa0, 0x0e, 0x1a, 0x65, 0xab, 0x32, 0x1e, 0x69, 0xa5, 0xed, 0x16, 0x2a,
+	0x51, 0x5f, 0x7e, 0x03, 0x2b, 0x87, 0x49, 0xe4, 0x93, 0x58, 0x29, 0xd5, 0x41, 0xe3, 0x7f, 0x6b,
+	0xeb, 0xe7, 0x48, 0x6b, 0x06, 0x54, 0xbc, 0x4d, 0x3c, 0x1d, 0xb3, 0xb0, 0xe8, 0xa2, 0xf8, 0x34,
+	0xb9, 0xff, 0xce, 0x10, 0xc3, 0x3e, 0xe1, 0xba, 0x89, 0xb1, 0xe9, 0xfb, 0x31, 0xe1, 0xfc, 0xec,
+	0xb8, 0xb9, 0x58, 0xf4, 0x5a, 0x54, 0xac, 0xa1, 0x20, 0x1c, 0x15, 0xbe, 0xf2, 0x16, 0x9c, 0xe3,
+	0x2c, 0x89, 0x31, 0x71, 0x52, 0xa5, 0x32, 0x55, 0x07, 0x8d, 0xf9, 0xf5, 0x55, 0x7d, 0x72, 0x46,
+	0x7a, 0xd6, 0x6d, 0x27, 0xe3, 0xf7, 0x86, 0x7d, 0x82, 0x20, 0xbf, 0x5c, 0xcb, 0xab, 0x70, 0x01,
+	0xb3, 0x5e, 0xcf, 0x15, 0x24, 0x76, 0x7b, 0xb9, 0x5b, 0xb9, 0x0e, 0x1a, 0xb3, 0x68, 0xfe, 0xaa,
+	0x9c, 0x81, 0x4f, 0xe0, 0x34, 0x17, 0x6e, 0x2c, 0x94, 0xe9, 0x3a, 0x68, 0xcc, 0xad, 0xd7, 0xf4,
+	0x3c, 0x27, 0xfd, 0x22, 0x27, 0x7d, 0xef, 0x22, 0x27, 0x6b, 0xe6, 0x64, 0xa4, 0x49, 0x47, 0xdf,
+	0x34, 0x80, 0x72, 0x89, 0xfc, 0x08, 0x4e, 0x91, 0xc8, 0x57, 0x2a, 0x37, 0x50, 0xa6, 0x02, 0xf9,
+	0x31, 0xac, 0x78, 0x89, 0x1f, 0x10, 0xa1, 0xfc, 0x97, 0x49, 0x97, 0xf5, 0x22, 0x94, 0x74, 0xda,
+	0x97, 0xd7, 0xdb, 0x60, 0x34, 0xb2, 0xca, 0xa9, 0x12, 0x15, 0xb8, 0xfc, 0x1a, 0xce, 0xf9, 0x94,
+	0x8b, 0x98, 0x7a, 0x89, 0x20, 0xbe, 0x32, 0x93, 0xde, 0xc8, 0x7a, 0x9a, 0x22, 0x5f, 0x46, 0xda,
+	0xbd, 0x7f, 0x18, 0x45, 0x8b, 0xe0, 0xb3, 0xe3, 0x26, 0x2c, 0x7e, 0xd7, 0x22, 0x18, 0x5d, 0x37,
+	0x94, 0x5f, 0xc2, 0xdb, 0xfd, 0x98, 0x0c, 0x28, 0x4b, 0xb8, 0xe3, 0x62, 0x1c, 0x27, 0x69, 0x76,
+	0x34, 0x24, 0xca, 0xec, 0x0d, 0xae, 0xb8, 0x78, 0x61, 0x61, 0xe6, 0x0e, 0x29, 0x73, 0xff, 0x13,
+	0x80, 0x0b, 0xbf, 0xcd, 0x4b, 0xbe, 0x0b, 0xef, 0x6c, 0x9a, 0xdd, 0x4d, 0xdb, 0xe9, 0xec, 0x76,
+	0xd1, 0x86, 0xed, 0xec, 0x1d, 0xb4, 0x6d, 0xa7, 0xbb, 0xd3, 0x69, 0xdb, 0x1b, 0xdb, 0xcf, 0xb6,
+	0xed, 0x56, 0x55, 0x92, 0x6b, 0x70, 0xe9, 0x4f, 0xa4, 0xb3, 0x6f, 0xb6, 0xab, 0x60, 0xf2, 0x99,
+	0x6d, 0xa2, 0x9d, 0x6a, 0x69, 0xb2, 0xf5, 0x96, 0x89, 0x5a, 0x4e, 0xa7, 0xdb, 0x6e, 0xbf, 0x38,
+	0xa8, 0x4e, 0xfd, 0x05, 0xb1, 0x76, 0x11, 0xda, 0xdd, 0xaf, 0x96, 0x6b, 0xe5, 0x0f, 0x1f, 0x55,
+	0xc9, 0x7a, 0x7e, 0xf2, 0x43, 0x95, 0x4e, 0xc6, 0x2a, 0x38, 0x1d, 0xab, 0xe0, 0xfb, 0x58, 0x05,
+	0x47, 0xe7, 0xaa, 0x74, 0x7a, 0xae, 0x4a, 0x9f, 0xcf, 0x55, 0xe9, 0xd5, 0x83, 0x6b, 0xa9, 0x87,
+	0x6e, 0x40, 0x9a, 0x98, 0x0d, 0x48, 0x64, 0x64, 0x4f, 0xff, 0xfd, 0xb5, 0xc7, 0x9f, 0xe5, 0xef,
+	0x55, 0xb2, 0xe4, 0x1e, 0xfe, 0x1a, 0x00, 0xd5, 0x11, 0x4c, 0x80, 0x1b, 0x04, 0x00, 0x00,
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccrualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Distributed.Size()
+		i -= size
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGauge(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGauge(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGauge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovGauge(uint64(m.ID))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovGauge(uint64(m.SourceType))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGauge(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovGauge(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	l = m.Distributed.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime)
+	n += 1 + l + sovGauge(uint64(l))
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = append(m.Funder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Funder == nil {
+				m.Funder = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= GaugeSourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGauge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGauge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGauge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGauge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGauge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGauge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGauge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,7 +15,9 @@ var (
 		AccumulationTimes{},
 		MultiRewardIndexes{},
 	)
-	DefaultEarnClaims = EarnClaims{}
+	DefaultEarnClaims        = EarnClaims{}
+	DefaultAutoStakeSettings = AutoStakeSettings{}
+	DefaultGauges            = Gauges{}
 )
 
 // NewGenesisState returns a new genesis state
@@ -23,7 +25,7 @@ func NewGenesisState(
 	params Params,
 	usdxState, hardSupplyState, hardBorrowState, delegatorState, swapState, savingsState, earnState GenesisRewardState,
 	c USDXMintingClaims, hc HardLiquidityProviderClaims, dc DelegatorClaims, sc SwapClaims, savingsc SavingsClaims,
-	earnc EarnClaims,
+	earnc EarnClaims, autoStakeSettings AutoStakeSettings, gauges Gauges, nextGaugeID uint64,
 ) GenesisState {
 	return GenesisState{
 		Params: params,
@@ -42,6 +44,11 @@ func NewGenesisState(
 		SwapClaims:                  sc,
 		SavingsClaims:               savingsc,
 		EarnClaims:                  earnc,
+
+		AutoStakeSettings: autoStakeSettings,
+
+		Gauges:      gauges,
+		NextGaugeID: nextGaugeID,
 	}
 }
 
@@ -62,6 +69,9 @@ func DefaultGenesisState() GenesisState {
 		SwapClaims:                  DefaultSwapClaims,
 		SavingsClaims:               DefaultSavingsClaims,
 		EarnClaims:                  DefaultEarnClaims,
+		AutoStakeSettings:           DefaultAutoStakeSettings,
+		Gauges:                      DefaultGauges,
+		NextGaugeID:                 DefaultNextGaugeID,
 	}
 }
 
@@ -111,7 +121,23 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.EarnClaims.Validate()
+	if err := gs.EarnClaims.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.AutoStakeSettings.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Gauges.Validate(); err != nil {
+		return err
+	}
+	for _, g := range gs.Gauges {
+		if g.ID >= gs.NextGaugeID {
+			return fmt.Errorf("gauge id %d must be less than the next gauge id %d", g.ID, gs.NextGaugeID)
+		}
+	}
+	return nil
 }
 
 // NewGenesisRewardState returns a new GenesisRewardState
@@ -37,7 +37,7 @@ func (m *AccumulationTime) Reset()         { *m = AccumulationTime{} }
 func (m *AccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*AccumulationTime) ProtoMessage()    {}
 func (*AccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{0}
+	return fileDescriptor_da10610f52b06a94, []int{0}
 }
 func (m *AccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +76,7 @@ func (m *GenesisRewardState) Reset()         { *m = GenesisRewardState{} }
 func (m *GenesisRewardState) String() string { return proto.CompactTextString(m) }
 func (*GenesisRewardState) ProtoMessage()    {}
 func (*GenesisRewardState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{1}
+	return fileDescriptor_da10610f52b06a94, []int{1}
 }
 func (m *GenesisRewardState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,13 +121,16 @@ type GenesisState struct {
 	SavingsClaims               SavingsClaims               `protobuf:"bytes,12,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"`
 	EarnRewardState             GenesisRewardState          `protobuf:"bytes,13,opt,name=earn_reward_state,json=earnRewardState,proto3" json:"earn_reward_state"`
 	EarnClaims                  EarnClaims                  `protobuf:"bytes,14,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"`
+	AutoStakeSettings           AutoStakeSettings           `protobuf:"bytes,15,rep,name=auto_stake_settings,json=autoStakeSettings,proto3,castrepeated=AutoStakeSettings" json:"auto_stake_settings"`
+	Gauges                      Gauges                      `protobuf:"bytes,16,rep,name=gauges,proto3,castrepeated=Gauges" json:"gauges"`
+	NextGaugeID                 uint64                      `protobuf:"varint,17,opt,name=next_gauge_id,json=nextGaugeId,proto3" json:"next_gauge_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{2}
+	return fileDescriptor_da10610f52b06a94, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,61 +166,67 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/genesis.proto", fileDescriptor_8b76737885d05afd)
+	proto.RegisterFile("fury/incentive/v1beta1/genesis.proto", fileDescriptor_da10610f52b06a94)
 }
 
-var fileDescriptor_8b76737885d05afd = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0x60, 0x59, 0x98, 0x00, 0x21, 0xb3, 0x01, 0xb2, 0x41, 0x72, 0x58, 0x40, 0xbb,
-	0xd1, 0x56, 0xb5, 0x45, 0x7a, 0xed, 0xa5, 0x2e, 0x55, 0x8b, 0x54, 0x24, 0xe4, 0x50, 0x54, 0x55,
-	0x95, 0xa2, 0x71, 0x3c, 0x98, 0x69, 0xfd, 0xab, 0x1e, 0x3b, 0x21, 0xb7, 0x1e, 0x7b, 0xe4, 0x0f,
-	0xa8, 0xd4, 0x7b, 0xff, 0x8f, 0x4a, 0x1c, 0x39, 0xf6, 0x04, 0x6d, 0xf8, 0x47, 0xaa, 0x19, 0x8f,
-	0x83, 0x9d, 0x60, 0xaa, 0xa6, 0xb7, 0xc9, 0x9b, 0x37, 0xdf, 0xcf, 0xf7, 0xcd, 0x7b, 0x13, 0x83,
-	0xed, 0xb7, 0xa8, 0x8b, 0x54, 0xe2, 0x76, 0xb0, 0x1b, 0x92, 0x2e, 0x56, 0xbb, 0x3b, 0x06, 0x0e,
-	0xd1, 0x8e, 0x6a, 0x61, 0x17, 0x53, 0x42, 0x15, 0x3f, 0xf0, 0x42, 0x0f, 0xae, 0xb2, 0x2c, 0x65,
-	0x98, 0xa5, 0x88, 0xac, 0x5a, 0xc5, 0xf2, 0x2c, 0x8f, 0xa7, 0xa8, 0x6c, 0x15, 0x67, 0xd7, 0xea,
-	0x96, 0xe7, 0x59, 0x36, 0x56, 0xf9, 0x2f, 0x23, 0x3a, 0x56, 0x43, 0xe2, 0x60, 0x1a, 0x22, 0xc7,
-	0x17, 0x09, 0x5b, 0x39, 0xd0, 0x8e, 0x8d, 0x88, 0x43, 0x7f, 0x92, 0xe4, 0xa3, 0x00, 0x25, 0x49,
-	0x9b, 0x9f, 0x24, 0xb0, 0xfc, 0xa8, 0xd3, 0x89, 0x9c, 0xc8, 0x46, 0x21, 0xf1, 0xdc, 0x43, 0xe2,
-	0x60, 0xf8, 0x1f, 0x28, 0x75, 0x3c, 0xdb, 0x46, 0x21, 0x0e, 0x90, 0xdd, 0x0e, 0xfb, 0x3e, 0xae,
-	0x4a, 0x1b, 0x52, 0x63, 0x5e, 0x5f, 0xba, 0x09, 0x1f, 0xf6, 0x7d, 0x0c, 0x0d, 0x50, 0xf3, 0x03,
-	0xdc, 0x25, 0x5e, 0x44, 0xdb, 0x28, 0xa5, 0xd2, 0x66, 0x86, 0xab, 0x53, 0x1b, 0x52, 0xa3, 0xd8,
-	0xac, 0x29, 0x71, 0x35, 0x4a, 0x52, 0x8d, 0x72, 0x98, 0x54, 0xa3, 0xcd, 0x9d, 0x5f, 0xd6, 0x0b,
-	0x67, 0x57, 0x75, 0x49, 0xaf, 0x26, 0x3a, 0xa3, 0x66, 0x36, 0xdf, 0x4f, 0x01, 0xf8, 0x34, 0xbe,
-	0x4c, 0x1d, 0xf7, 0x50, 0x60, 0xb6, 0x42, 0x14, 0x62, 0x18, 0x00, 0x38, 0x46, 0xa4, 0x55, 0x69,
-	0x63, 0xba, 0x51, 0x6c, 0x36, 0x94, 0xdb, 0xaf, 0x5b, 0x19, 0x15, 0xd7, 0xfe, 0x66, 0x06, 0x3e,
-	0x5f, 0xd5, 0xcb, 0xa3, 0x3b, 0x54, 0x2f, 0xa3, 0xd1, 0x10, 0xec, 0x82, 0x8a, 0x13, 0xd9, 0x21,
-	0x69, 0x07, 0xdc, 0x48, 0x9b, 0xb8, 0x26, 0x3e, 0xc5, 0xb4, 0x3a, 0x75, 0x37, 0x75, 0x9f, 0x9d,
-	0x89, 0xbd, 0xef, 0xb1, 0x13, 0x5a, 0x4d, 0x50, 0xe1, 0xe8, 0x0e, 0xa6, 0x3a, 0x74, 0xc6, 0x62,
-	0x9b, 0x5f, 0x8a, 0x60, 0x41, 0x5c, 0x41, 0x5c, 0xfc, 0x43, 0x30, 0x1b, 0x77, 0x91, 0xf7, 0xa5,
-	0xd8, 0x94, 0xf3, 0xd0, 0x07, 0x3c, 0x4b, 0x9b, 0x61, 0x40, 0x5d, 0x9c, 0x81, 0x1e, 0x28, 0x47,
-	0xd4, 0x3c, 0x4d, 0xaa, 0xa0, 0x4c, 0x52, 0x34, 0xeb, 0xff, 0x3c, 0xa1, 0xf1, 0x0e, 0x68, 0x6b,
-	0x4c, 0x74, 0x70, 0x59, 0x2f, 0xbd, 0x68, 0xed, 0xbe, 0x4c, 0x6d, 0xe8, 0x25, 0xa6, 0x9e, 0xee,
-	0x15, 0x01, 0xd5, 0x13, 0x4e, 0x8a, 0x7c, 0xdf, 0xee, 0x67, 0xb9, 0xd3, 0xbf, 0xcc, 0x8d, 0x8b,
-	0x59, 0x61, 0x8a, 0x2d, 0x2e, 0x78, 0x1b, 0xca, 0xf0, 0x82, 0xc0, 0xeb, 0x65, 0x51, 0x33, 0xbf,
-	0x83, 0xd2, 0xb8, 0x60, 0x1a, 0x75, 0x0c, 0x56, 0x4d, 0x6c, 0x63, 0x0b, 0x85, 0x5e, 0x90, 0x05,
-	0xfd, 0x31, 0x21, 0xa8, 0x32, 0xd4, 0x4b, 0x73, 0x5e, 0x83, 0x32, 0xed, 0x21, 0x3f, 0x8b, 0x98,
-	0x9d, 0x10, 0x51, 0x62, 0x52, 0x69, 0xf5, 0x0f, 0x12, 0xf8, 0x8b, 0x4f, 0x83, 0x43, 0xdc, 0x90,
-	0xb8, 0x56, 0x3b, 0xfe, 0x0f, 0xa9, 0xfe, 0x79, 0xf7, 0x4c, 0xb3, 0x9e, 0xef, 0xc7, 0x27, 0x1e,
-	0xb3, 0x03, 0x9a, 0x22, 0xa6, 0xa1, 0x3c, 0xba, 0x43, 0xd9, 0xf3, 0x1a, 0x0b, 0xea, 0x7c, 0x04,
-	0x33, 0x21, 0xf8, 0x51, 0x02, 0x32, 0x6f, 0x9e, 0x4d, 0xde, 0x45, 0xc4, 0x24, 0x61, 0xbf, 0xed,
-	0x07, 0x5e, 0x97, 0x98, 0x38, 0x48, 0x5c, 0xcd, 0x71, 0x57, 0xcd, 0x3c, 0x57, 0xcf, 0x50, 0x60,
-	0x3e, 0x4f, 0x0e, 0x1f, 0x88, 0xb3, 0xb1, 0xbf, 0x2d, 0xf1, 0xe6, 0xd6, 0xf3, 0x73, 0xa8, 0xbe,
-	0x7e, 0x92, 0xbf, 0x09, 0xdf, 0x80, 0xe5, 0x9b, 0x7e, 0x0b, 0x3f, 0xf3, 0xdc, 0xcf, 0xbf, 0x79,
-	0x7e, 0x76, 0x93, 0xfc, 0xd8, 0xc3, 0x9a, 0xf0, 0x50, 0xca, 0xc6, 0xa9, 0x5e, 0x32, 0xb3, 0x01,
-	0x78, 0x04, 0x8a, 0xbc, 0xe7, 0x02, 0x03, 0x38, 0xe6, 0x9f, 0x3c, 0x4c, 0xab, 0x87, 0xfc, 0x98,
-	0x00, 0x05, 0x01, 0x0c, 0x43, 0x54, 0x07, 0x74, 0xb8, 0x86, 0x06, 0xa8, 0x50, 0xd4, 0x25, 0xae,
-	0x45, 0xb3, 0xe3, 0x54, 0x9c, 0x70, 0x9c, 0xa0, 0x50, 0x4b, 0x4f, 0x94, 0x01, 0x96, 0x12, 0x86,
-	0xb0, 0xbf, 0xc0, 0xed, 0x6f, 0xe7, 0xda, 0x8f, 0xb3, 0xe3, 0x0a, 0x56, 0x44, 0x05, 0x8b, 0xe9,
-	0x28, 0xd5, 0x17, 0x69, 0xfa, 0x27, 0x7b, 0x13, 0x18, 0x05, 0x6e, 0xb6, 0x88, 0xc5, 0x49, 0xdf,
-	0x04, 0x93, 0x4a, 0x57, 0x70, 0x04, 0x8a, 0x5c, 0x5d, 0xd8, 0x5f, 0xba, 0xfb, 0xf6, 0x9f, 0xa0,
-	0xc0, 0x1d, 0xb9, 0xfd, 0x61, 0x88, 0xea, 0x00, 0x0f, 0xd7, 0xda, 0xde, 0xf9, 0x77, 0xb9, 0x70,
-	0x3e, 0x90, 0xa5, 0x8b, 0x81, 0x2c, 0x7d, 0x1b, 0xc8, 0xd2, 0xd9, 0xb5, 0x5c, 0xb8, 0xb8, 0x96,
-	0x0b, 0x5f, 0xaf, 0xe5, 0xc2, 0xab, 0x7b, 0x16, 0x09, 0x4f, 0x22, 0x43, 0xe9, 0x78, 0x8e, 0xca,
-	0x50, 0xf7, 0x6d, 0x64, 0x50, 0xbe, 0x52, 0x4f, 0x53, 0x9f, 0x71, 0xf6, 0x39, 0xa6, 0xc6, 0x2c,
-	0xff, 0x9a, 0x3e, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xa8, 0x1c, 0xb0, 0x7f, 0x08, 0x00,
-	0x00,
+var fileDescriptor_da10610f52b06a94 = []byte{
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0xa7, 0x61, 0x69, 0x67, 0xbb, 0xd9, 0xec, 0x34, 0x6d, 0xcd, 0x56, 0x78, 0x43, 0x5a,
+	0xc1, 0x0a, 0x81, 0x57, 0x4d, 0xaf, 0x5c, 0x30, 0xa9, 0xa0, 0x88, 0xa2, 0xca, 0x1b, 0x2a, 0x84,
+	0x90, 0xac, 0xf1, 0x7a, 0xe2, 0x0c, 0xd8, 0x1e, 0x33, 0x33, 0xde, 0xec, 0xde, 0x38, 0x72, 0xec,
+	0x0f, 0x40, 0xe2, 0xde, 0x1f, 0xc0, 0x6f, 0xc8, 0xb1, 0x47, 0x4e, 0x09, 0x6c, 0xfe, 0x08, 0x9a,
+	0xf1, 0x78, 0x6b, 0x3b, 0x71, 0x22, 0xc2, 0xcd, 0xf3, 0xe6, 0x7b, 0xdf, 0xf7, 0xbd, 0x79, 0xcf,
+	0xf6, 0x80, 0x47, 0x07, 0x19, 0x5b, 0x8c, 0x49, 0x32, 0xc5, 0x89, 0x20, 0x33, 0x3c, 0x9e, 0x3d,
+	0xf6, 0xb1, 0x40, 0x8f, 0xc7, 0x21, 0x4e, 0x30, 0x27, 0xdc, 0x4e, 0x19, 0x15, 0x14, 0xde, 0x93,
+	0x28, 0x7b, 0x85, 0xb2, 0x35, 0x6a, 0xb0, 0x15, 0xd2, 0x90, 0x2a, 0xc8, 0x58, 0x3e, 0xe5, 0xe8,
+	0xc1, 0x30, 0xa4, 0x34, 0x8c, 0xf0, 0x58, 0xad, 0xfc, 0xec, 0x60, 0x2c, 0x48, 0x8c, 0xb9, 0x40,
+	0x71, 0xaa, 0x01, 0x0f, 0x1b, 0x44, 0xa7, 0x11, 0x22, 0xb1, 0xd6, 0x1c, 0xec, 0x34, 0x39, 0x43,
+	0x59, 0x88, 0xaf, 0x20, 0x4a, 0x11, 0x43, 0x05, 0xd1, 0xce, 0x1f, 0x06, 0xd8, 0xfc, 0x7c, 0x3a,
+	0xcd, 0xe2, 0x2c, 0x42, 0x82, 0xd0, 0x64, 0x9f, 0xc4, 0x18, 0x7e, 0x04, 0x7a, 0x53, 0x1a, 0x45,
+	0x48, 0x60, 0x86, 0x22, 0x4f, 0x2c, 0x52, 0x6c, 0x1a, 0xdb, 0xc6, 0xe8, 0x96, 0xbb, 0xf1, 0x36,
+	0xbc, 0xbf, 0x48, 0x31, 0xf4, 0xc1, 0x20, 0x65, 0x78, 0x46, 0x68, 0xc6, 0x3d, 0x54, 0x62, 0xf1,
+	0x64, 0x51, 0xe6, 0xda, 0xb6, 0x31, 0xea, 0xec, 0x0e, 0xec, 0xbc, 0x62, 0xbb, 0xa8, 0xd8, 0xde,
+	0x2f, 0x2a, 0x76, 0x6e, 0x1e, 0x9f, 0x0c, 0x5b, 0xaf, 0x4e, 0x87, 0x86, 0x6b, 0x16, 0x3c, 0x75,
+	0x33, 0x3b, 0xbf, 0xae, 0x01, 0xf8, 0x65, 0x7e, 0xe0, 0x2e, 0x3e, 0x42, 0x2c, 0x98, 0x08, 0x24,
+	0x30, 0x64, 0x00, 0x9e, 0x53, 0xe4, 0xa6, 0xb1, 0x7d, 0x63, 0xd4, 0xd9, 0x1d, 0xd9, 0x17, 0xb7,
+	0xc4, 0xae, 0x93, 0x3b, 0xef, 0x49, 0x03, 0xaf, 0x4f, 0x87, 0xfd, 0xfa, 0x0e, 0x77, 0xfb, 0xa8,
+	0x1e, 0x82, 0x33, 0xb0, 0x15, 0x67, 0x91, 0x20, 0x1e, 0x53, 0x46, 0x3c, 0x92, 0x04, 0x78, 0x8e,
+	0xb9, 0xb9, 0x76, 0xb9, 0xea, 0x73, 0x99, 0x93, 0x7b, 0x7f, 0x26, 0x33, 0x9c, 0x81, 0x56, 0x85,
+	0xf5, 0x1d, 0xcc, 0x5d, 0x18, 0x9f, 0x8b, 0xed, 0xfc, 0xd9, 0x05, 0xb7, 0xf5, 0x11, 0xe4, 0xc5,
+	0x7f, 0x06, 0xda, 0x79, 0x17, 0x55, 0x5f, 0x3a, 0xbb, 0x56, 0x93, 0xf4, 0x0b, 0x85, 0x72, 0xd6,
+	0xa5, 0xa0, 0xab, 0x73, 0x20, 0x05, 0xfd, 0x8c, 0x07, 0xf3, 0xa2, 0x0a, 0x2e, 0x29, 0x75, 0xb3,
+	0x3e, 0x6e, 0x22, 0x3a, 0xdf, 0x01, 0xe7, 0xbe, 0x24, 0x5d, 0x9e, 0x0c, 0x7b, 0xdf, 0x4d, 0xf6,
+	0xbe, 0x2f, 0x6d, 0xb8, 0x3d, 0xc9, 0x5e, 0xee, 0x15, 0x01, 0xe6, 0xa1, 0x52, 0xca, 0xd2, 0x34,
+	0x5a, 0x54, 0x75, 0x6f, 0xfc, 0x67, 0xdd, 0xbc, 0x98, 0xbb, 0x92, 0x71, 0xa2, 0x08, 0x2f, 0x92,
+	0xf2, 0x29, 0x63, 0xf4, 0xa8, 0x2a, 0xb5, 0xfe, 0x7f, 0xa4, 0x1c, 0x45, 0x58, 0x96, 0x3a, 0x00,
+	0xf7, 0x02, 0x1c, 0xe1, 0x10, 0x09, 0xca, 0xaa, 0x42, 0xef, 0x5c, 0x53, 0x68, 0x6b, 0xc5, 0x57,
+	0xd6, 0xf9, 0x11, 0xf4, 0xf9, 0x11, 0x4a, 0xab, 0x12, 0xed, 0x6b, 0x4a, 0xf4, 0x24, 0x55, 0x99,
+	0xfd, 0x37, 0x03, 0xdc, 0x51, 0xd3, 0x10, 0x93, 0x44, 0x90, 0x24, 0xf4, 0xf2, 0xef, 0x8c, 0xf9,
+	0xee, 0xe5, 0x33, 0x2d, 0x7b, 0xfe, 0x3c, 0xcf, 0xf8, 0x42, 0x26, 0x38, 0xb6, 0x9e, 0x86, 0x7e,
+	0x7d, 0x87, 0xbf, 0x3e, 0xbd, 0x20, 0xe8, 0xaa, 0x11, 0xac, 0x84, 0xe0, 0xef, 0x06, 0xb0, 0x54,
+	0xf3, 0x22, 0xf2, 0x4b, 0x46, 0x02, 0x22, 0x16, 0x5e, 0xca, 0xe8, 0x8c, 0x04, 0x98, 0x15, 0xae,
+	0x6e, 0x2a, 0x57, 0xbb, 0x4d, 0xae, 0xbe, 0x42, 0x2c, 0xf8, 0xa6, 0x48, 0x7e, 0xa1, 0x73, 0x73,
+	0x7f, 0x0f, 0xf5, 0x3b, 0xf7, 0xa0, 0x19, 0xc3, 0xdd, 0x07, 0x87, 0xcd, 0x9b, 0xf0, 0x27, 0xb0,
+	0xf9, 0xb6, 0xdf, 0xda, 0xcf, 0x2d, 0xe5, 0xe7, 0xc3, 0x26, 0x3f, 0x7b, 0x05, 0x3e, 0xf7, 0x70,
+	0x5f, 0x7b, 0xe8, 0x55, 0xe3, 0xdc, 0xed, 0x05, 0xd5, 0x00, 0x7c, 0x09, 0x3a, 0xaa, 0xe7, 0x5a,
+	0x06, 0x28, 0x99, 0x0f, 0x9a, 0x64, 0x26, 0x47, 0x28, 0xcd, 0x15, 0xa0, 0x56, 0x00, 0xab, 0x10,
+	0x77, 0x01, 0x5f, 0x3d, 0x43, 0x1f, 0x6c, 0x71, 0x34, 0x23, 0x49, 0xc8, 0xab, 0xe3, 0xd4, 0xb9,
+	0xe6, 0x38, 0x41, 0xcd, 0x56, 0x9e, 0x28, 0x1f, 0x6c, 0x14, 0x1a, 0xda, 0xfe, 0x6d, 0x65, 0xff,
+	0x51, 0xa3, 0xfd, 0x1c, 0x9d, 0x57, 0x70, 0x57, 0x57, 0xd0, 0x2d, 0x47, 0xb9, 0xdb, 0xe5, 0xe5,
+	0xa5, 0x7c, 0x27, 0x30, 0x62, 0x49, 0xb5, 0x88, 0xee, 0x75, 0xdf, 0x09, 0x49, 0x55, 0xae, 0xe0,
+	0x25, 0xe8, 0x28, 0x76, 0x6d, 0x7f, 0xe3, 0xf2, 0xd3, 0x7f, 0x8a, 0x58, 0x52, 0x3b, 0xfd, 0x55,
+	0x88, 0xbb, 0x00, 0xaf, 0x9e, 0x21, 0x07, 0x77, 0x50, 0x26, 0xa8, 0xb4, 0xfb, 0x33, 0xf6, 0x38,
+	0x16, 0x72, 0xf8, 0xb9, 0xd9, 0xbb, 0xe2, 0xa7, 0x95, 0x09, 0x3a, 0x91, 0x19, 0x93, 0x3c, 0xa1,
+	0xf4, 0xd3, 0xaa, 0xed, 0xc8, 0x9f, 0x56, 0x3d, 0x04, 0x9f, 0x82, 0xb6, 0xba, 0x15, 0x70, 0x73,
+	0x53, 0xe9, 0xbc, 0xdf, 0x78, 0x3e, 0x12, 0xe5, 0x6c, 0x68, 0xf2, 0xb6, 0x5a, 0x72, 0x57, 0x27,
+	0xc3, 0x27, 0xa0, 0x9b, 0xe0, 0xb9, 0xf0, 0xd4, 0xd2, 0x23, 0x81, 0xd9, 0xdf, 0x36, 0x46, 0xeb,
+	0x4e, 0x6f, 0x79, 0x32, 0xec, 0x7c, 0x8b, 0xe7, 0x42, 0xc1, 0x9f, 0xed, 0xb9, 0x9d, 0x64, 0xb5,
+	0x08, 0x9c, 0xaf, 0x8f, 0xff, 0xb1, 0x5a, 0xc7, 0x4b, 0xcb, 0x78, 0xb3, 0xb4, 0x8c, 0xbf, 0x97,
+	0x96, 0xf1, 0xea, 0xcc, 0x6a, 0xbd, 0x39, 0xb3, 0x5a, 0x7f, 0x9d, 0x59, 0xad, 0x1f, 0x3e, 0x09,
+	0x89, 0x38, 0xcc, 0x7c, 0x7b, 0x4a, 0xe3, 0x71, 0x8c, 0x42, 0xfc, 0xe9, 0x94, 0xce, 0x70, 0x32,
+	0x56, 0xd7, 0x96, 0x79, 0xe9, 0xe2, 0x22, 0x2f, 0x20, 0xdc, 0x6f, 0xab, 0xfb, 0xc3, 0x93, 0x7f,
+	0x07, 0x00, 0x91, 0x0d, 0xc5, 0xe8, 0x95, 0x09, 0x00, 0x00,
 }
 
 func (m *AccumulationTime) Marshal() (dAtA []byte, err error) {
@@ -329,6 +338,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextGaugeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGaugeID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AutoStakeSettings) > 0 {
+		for iNdEx := len(m.AutoStakeSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoStakeSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.EarnClaims) > 0 {
 		for iNdEx := len(m.EarnClaims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -601,6 +647,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoStakeSettings) > 0 {
+		for _, e := range m.AutoStakeSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextGaugeID != 0 {
+		n += 2 + sovGenesis(uint64(m.NextGaugeID))
+	}
 	return n
 }
 
@@ -1340,6 +1401,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoStakeSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoStakeSettings = append(m.AutoStakeSettings, AutoStakeSetting{})
+			if err := m.AutoStakeSettings[len(m.AutoStakeSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGaugeID", wireType)
+			}
+			m.NextGaugeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGaugeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
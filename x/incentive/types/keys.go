@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -39,4 +46,30 @@ var (
 	EarnClaimKeyPrefix                            = []byte{0x18} // prefix for keys that store earn claims
 	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // prefix for key that stores earn reward indexes
 	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // prefix for key that stores the previous time earn rewards accrued
+	AutoStakeSettingKeyPrefix                     = []byte{0x21} // prefix for keys that store auto-stake settings
+	GaugeKeyPrefix                                = []byte{0x22} // prefix for keys that store externally funded gauges
+	NextGaugeIDKey                                = []byte{0x23} // key that stores the next gauge id
+	PendingGaugeKeyPrefix                         = []byte{0x24} // prefix for keys that index gauges that have not started by start time
+	ActiveGaugeKeyPrefix                          = []byte{0x25} // prefix for keys that index started gauges by end time
+	GaugeBySourceKeyPrefix                        = []byte{0x26} // prefix for keys that index gauges by rewarded source
 )
+
+// GaugeKey returns the key of a gauge from its id
+func GaugeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// GaugeByTimeKey returns the key of a gauge in a time index
+func GaugeByTimeKey(t time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), GaugeKey(id)...)
+}
+
+// GaugesBySourceKey returns the key prefix of the gauges rewarding a source
+func GaugesBySourceKey(sourceType GaugeSourceType, collateralType string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(sourceType)), address.MustLengthPrefix([]byte(collateralType))...)
+}
+
+// GaugeBySourceKey returns the key of a gauge in the source index
+func GaugeBySourceKey(sourceType GaugeSourceType, collateralType string, id uint64) []byte {
+	return append(GaugesBySourceKey(sourceType, collateralType), GaugeKey(id)...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,6 +19,9 @@ var (
 	_ sdk.Msg = &MsgClaimSwapReward{}
 	_ sdk.Msg = &MsgClaimSavingsReward{}
 	_ sdk.Msg = &MsgClaimEarnReward{}
+	_ sdk.Msg = &MsgClaimAllRewards{}
+	_ sdk.Msg = &MsgSetAutoStake{}
+	_ sdk.Msg = &MsgCreateGauge{}
 
 	_ legacytx.LegacyMsg = &MsgClaimUSDXMintingReward{}
 	_ legacytx.LegacyMsg = &MsgClaimHardReward{}
@@ -24,6 +29,9 @@ var (
 	_ legacytx.LegacyMsg = &MsgClaimSwapReward{}
 	_ legacytx.LegacyMsg = &MsgClaimSavingsReward{}
 	_ legacytx.LegacyMsg = &MsgClaimEarnReward{}
+	_ legacytx.LegacyMsg = &MsgClaimAllRewards{}
+	_ legacytx.LegacyMsg = &MsgSetAutoStake{}
+	_ legacytx.LegacyMsg = &MsgCreateGauge{}
 )
 
 const (
@@ -33,6 +41,9 @@ const (
 	TypeMsgClaimSwapReward        = "claim_swap_reward"
 	TypeMsgClaimSavingsReward     = "claim_savings_reward"
 	TypeMsgClaimEarnReward        = "claim_earn_reward"
+	TypeMsgClaimAllRewards        = "claim_all_rewards"
+	TypeMsgSetAutoStake           = "set_auto_stake"
+	TypeMsgCreateGauge            = "create_gauge"
 )
 
 // NewMsgClaimUSDXMintingReward returns a new MsgClaimUSDXMintingReward.
@@ -120,10 +131,11 @@ func (msg MsgClaimHardReward) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgClaimDelegatorReward returns a new MsgClaimDelegatorReward.
-func NewMsgClaimDelegatorReward(sender string, denomsToClaim Selections) MsgClaimDelegatorReward {
+func NewMsgClaimDelegatorReward(sender string, denomsToClaim Selections, autoStake AutoStakeMode) MsgClaimDelegatorReward {
 	return MsgClaimDelegatorReward{
 		Sender:        sender,
 		DenomsToClaim: denomsToClaim,
+		AutoStake:     autoStake,
 	}
 }
 
@@ -144,6 +156,9 @@ func (msg MsgClaimDelegatorReward) ValidateBasic() error {
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
+	if err := msg.AutoStake.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAutoStake, err.Error())
+	}
 	return nil
 }
 
@@ -290,3 +305,153 @@ func (msg MsgClaimEarnReward) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgClaimAllRewards returns a new MsgClaimAllRewards.
+func NewMsgClaimAllRewards(sender, receiver string, denomsToClaim Selections) MsgClaimAllRewards {
+	return MsgClaimAllRewards{
+		Sender:        sender,
+		Receiver:      receiver,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimAllRewards) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimAllRewards) Type() string {
+	return TypeMsgClaimAllRewards
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimAllRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if msg.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver address is invalid")
+		}
+	}
+	if err := msg.DenomsToClaim.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimAllRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetAutoStake returns a new MsgSetAutoStake.
+func NewMsgSetAutoStake(sender string, mode AutoStakeMode) MsgSetAutoStake {
+	return MsgSetAutoStake{
+		Sender: sender,
+		Mode:   mode,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetAutoStake) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetAutoStake) Type() string {
+	return TypeMsgSetAutoStake
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgSetAutoStake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if err := msg.Mode.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAutoStake, err.Error())
+	}
+	if msg.Mode == AUTO_STAKE_MODE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidAutoStake, "mode must be specified")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetAutoStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetAutoStake) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgCreateGauge returns a new MsgCreateGauge.
+func NewMsgCreateGauge(
+	funder string, sourceType GaugeSourceType, collateralType string, start, end time.Time, budget sdk.Coin,
+) MsgCreateGauge {
+	return MsgCreateGauge{
+		Funder:         funder,
+		SourceType:     sourceType,
+		CollateralType: collateralType,
+		Start:          start,
+		End:            end,
+		Budget:         budget,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCreateGauge) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCreateGauge) Type() string {
+	return TypeMsgCreateGauge
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCreateGauge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Funder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "funder address cannot be empty or invalid")
+	}
+	if err := validateGaugeSource(msg.SourceType, msg.CollateralType); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+	if err := validateGaugeSchedule(msg.Start, msg.End, msg.Budget); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+	if err := validateGaugeLimits(msg.Start, msg.End, msg.Budget); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCreateGauge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.Funder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
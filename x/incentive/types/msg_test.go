@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -142,10 +143,11 @@ func TestMsgClaim_Validate(t *testing.T) {
 
 	for _, tc := range tests {
 		msgClaimHardReward := types.NewMsgClaimHardReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
-		msgClaimDelegatorReward := types.NewMsgClaimDelegatorReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
+		msgClaimDelegatorReward := types.NewMsgClaimDelegatorReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim, types.AUTO_STAKE_MODE_UNSPECIFIED)
 		msgClaimSwapReward := types.NewMsgClaimSwapReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
 		msgClaimSavingsReward := types.NewMsgClaimSavingsReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
-		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward}
+		msgClaimAllRewards := types.NewMsgClaimAllRewards(tc.msgArgs.sender, "", tc.msgArgs.denomsToClaim)
+		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward, &msgClaimAllRewards}
 		for _, msg := range msgs {
 			t.Run(tc.name, func(t *testing.T) {
 				err := msg.ValidateBasic()
@@ -159,6 +161,80 @@ func TestMsgClaim_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgClaimAllRewards_ValidateReceiver(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
+	selections := types.Selections{types.NewSelection("hard", "large")}
+
+	msg := types.NewMsgClaimAllRewards(validAddress, validAddress, selections)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgClaimAllRewards(validAddress, "invalid", selections)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgSetAutoStake_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
+
+	msg := types.NewMsgSetAutoStake(validAddress, types.AUTO_STAKE_MODE_LIQUID)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgSetAutoStake(validAddress, types.AUTO_STAKE_MODE_NONE)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgSetAutoStake(validAddress, types.AUTO_STAKE_MODE_UNSPECIFIED)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAutoStake)
+
+	msg = types.NewMsgSetAutoStake(validAddress, types.AutoStakeMode(9))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAutoStake)
+
+	msg = types.NewMsgSetAutoStake("invalid", types.AUTO_STAKE_MODE_DELEGATE)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	claimMsg := types.NewMsgClaimDelegatorReward(
+		validAddress, types.Selections{types.NewSelection("ufury", "large")}, types.AutoStakeMode(9),
+	)
+	require.ErrorIs(t, claimMsg.ValidateBasic(), types.ErrInvalidAutoStake)
+}
+
+func TestMsgCreateGauge_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(30 * 24 * time.Hour)
+	budget := sdk.NewInt64Coin("hard", 1e9)
+
+	msg := types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, end, budget)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgCreateGauge("invalid", types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, end, budget)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_UNSPECIFIED, "busd:ufury", start, end, budget)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_EARN, " ", start, end, budget)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_HARD_SUPPLY, "bnb", end, start, budget)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_HARD_BORROW, "bnb", start, end, sdk.NewInt64Coin("hard", 0))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	// Gauges are limited in duration and must distribute a minimum budget per second
+	longEnd := start.Add(types.MaxGaugeDuration + time.Second)
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, longEnd, sdk.NewInt64Coin("hard", 1e12))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	msg = types.NewMsgCreateGauge(validAddress, types.GAUGE_SOURCE_TYPE_SWAP, "busd:ufury", start, end, sdk.NewInt64Coin("hard", 1))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGauge)
+
+	sourceType, err := types.ParseGaugeSourceType("hard-supply")
+	require.NoError(t, err)
+	require.Equal(t, types.GAUGE_SOURCE_TYPE_HARD_SUPPLY, sourceType)
+	_, err = types.ParseGaugeSourceType("unspecified")
+	require.Error(t, err)
+}
+
 func TestMsgClaimUSDXMintingReward_Validate(t *testing.T) {
 	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
 
@@ -37,7 +37,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{0}
+	return fileDescriptor_e725bd81fdde7795, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,7 +75,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{1}
+	return fileDescriptor_e725bd81fdde7795, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,7 +127,7 @@ func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
 func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsRequest) ProtoMessage()    {}
 func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{2}
+	return fileDescriptor_e725bd81fdde7795, []int{2}
 }
 func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +191,7 @@ func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
 func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsResponse) ProtoMessage()    {}
 func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{3}
+	return fileDescriptor_e725bd81fdde7795, []int{3}
 }
 func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +270,7 @@ func (m *QueryRewardFactorsRequest) Reset()         { *m = QueryRewardFactorsReq
 func (m *QueryRewardFactorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardFactorsRequest) ProtoMessage()    {}
 func (*QueryRewardFactorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{4}
+	return fileDescriptor_e725bd81fdde7795, []int{4}
 }
 func (m *QueryRewardFactorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +314,7 @@ func (m *QueryRewardFactorsResponse) Reset()         { *m = QueryRewardFactorsRe
 func (m *QueryRewardFactorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardFactorsResponse) ProtoMessage()    {}
 func (*QueryRewardFactorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{5}
+	return fileDescriptor_e725bd81fdde7795, []int{5}
 }
 func (m *QueryRewardFactorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +400,7 @@ func (m *QueryApyRequest) Reset()         { *m = QueryApyRequest{} }
 func (m *QueryApyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryApyRequest) ProtoMessage()    {}
 func (*QueryApyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{6}
+	return fileDescriptor_e725bd81fdde7795, []int{6}
 }
 func (m *QueryApyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +438,7 @@ func (m *QueryApyResponse) Reset()         { *m = QueryApyResponse{} }
 func (m *QueryApyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryApyResponse) ProtoMessage()    {}
 func (*QueryApyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a78d71d0cbe5e95a, []int{7}
+	return fileDescriptor_e725bd81fdde7795, []int{7}
 }
 func (m *QueryApyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,6 +474,197 @@ func (m *QueryApyResponse) GetEarn() []Apy {
 	return nil
 }
 
+// QueryAutoStakeSettingRequest is the request type for the Query/AutoStakeSetting RPC method.
+type QueryAutoStakeSettingRequest struct {
+	// owner is the address of the user to query the setting for.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryAutoStakeSettingRequest) Reset()         { *m = QueryAutoStakeSettingRequest{} }
+func (m *QueryAutoStakeSettingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoStakeSettingRequest) ProtoMessage()    {}
+func (*QueryAutoStakeSettingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e725bd81fdde7795, []int{8}
+}
+func (m *QueryAutoStakeSettingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoStakeSettingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoStakeSettingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoStakeSettingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoStakeSettingRequest.Merge(m, src)
+}
+func (m *QueryAutoStakeSettingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoStakeSettingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoStakeSettingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoStakeSettingRequest proto.InternalMessageInfo
+
+func (m *QueryAutoStakeSettingRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryAutoStakeSettingResponse is the response type for the Query/AutoStakeSetting RPC method.
+type QueryAutoStakeSettingResponse struct {
+	Setting AutoStakeSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (m *QueryAutoStakeSettingResponse) Reset()         { *m = QueryAutoStakeSettingResponse{} }
+func (m *QueryAutoStakeSettingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoStakeSettingResponse) ProtoMessage()    {}
+func (*QueryAutoStakeSettingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e725bd81fdde7795, []int{9}
+}
+func (m *QueryAutoStakeSettingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoStakeSettingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoStakeSettingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoStakeSettingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoStakeSettingResponse.Merge(m, src)
+}
+func (m *QueryAutoStakeSettingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoStakeSettingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoStakeSettingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoStakeSettingResponse proto.InternalMessageInfo
+
+func (m *QueryAutoStakeSettingResponse) GetSetting() AutoStakeSetting {
+	if m != nil {
+		return m.Setting
+	}
+	return AutoStakeSetting{}
+}
+
+// QueryGaugesRequest is the request type for the Query/Gauges RPC method.
+type QueryGaugesRequest struct {
+	// collateral_type optionally filters gauges by the rewarded source.
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// funder optionally filters gauges by the funding account.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+}
+
+func (m *QueryGaugesRequest) Reset()         { *m = QueryGaugesRequest{} }
+func (m *QueryGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesRequest) ProtoMessage()    {}
+func (*QueryGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e725bd81fdde7795, []int{10}
+}
+func (m *QueryGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesRequest.Merge(m, src)
+}
+func (m *QueryGaugesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugesRequest proto.InternalMessageInfo
+
+func (m *QueryGaugesRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
+}
+
+func (m *QueryGaugesRequest) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+// QueryGaugesResponse is the response type for the Query/Gauges RPC method.
+type QueryGaugesResponse struct {
+	Gauges Gauges `protobuf:"bytes,1,rep,name=gauges,proto3,castrepeated=Gauges" json:"gauges"`
+}
+
+func (m *QueryGaugesResponse) Reset()         { *m = QueryGaugesResponse{} }
+func (m *QueryGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesResponse) ProtoMessage()    {}
+func (*QueryGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e725bd81fdde7795, []int{11}
+}
+func (m *QueryGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesResponse.Merge(m, src)
+}
+func (m *QueryGaugesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugesResponse proto.InternalMessageInfo
+
+func (m *QueryGaugesResponse) GetGauges() Gauges {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "fury.incentive.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "fury.incentive.v1beta1.QueryParamsResponse")
@@ -483,71 +674,86 @@ func init() {
 	proto.RegisterType((*QueryRewardFactorsResponse)(nil), "fury.incentive.v1beta1.QueryRewardFactorsResponse")
 	proto.RegisterType((*QueryApyRequest)(nil), "fury.incentive.v1beta1.QueryApyRequest")
 	proto.RegisterType((*QueryApyResponse)(nil), "fury.incentive.v1beta1.QueryApyResponse")
+	proto.RegisterType((*QueryAutoStakeSettingRequest)(nil), "fury.incentive.v1beta1.QueryAutoStakeSettingRequest")
+	proto.RegisterType((*QueryAutoStakeSettingResponse)(nil), "fury.incentive.v1beta1.QueryAutoStakeSettingResponse")
+	proto.RegisterType((*QueryGaugesRequest)(nil), "fury.incentive.v1beta1.QueryGaugesRequest")
+	proto.RegisterType((*QueryGaugesResponse)(nil), "fury.incentive.v1beta1.QueryGaugesResponse")
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/query.proto", fileDescriptor_a78d71d0cbe5e95a)
-}
-
-var fileDescriptor_a78d71d0cbe5e95a = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xeb, 0xe6, 0x47, 0xe1, 0x55, 0xdd, 0x6e, 0xa7, 0xa1, 0x1b, 0x1c, 0x70, 0xb2, 0x29,
-	0xea, 0x46, 0x2c, 0xc4, 0x6a, 0x10, 0x37, 0x2e, 0x2d, 0xbb, 0x88, 0x95, 0x58, 0x69, 0x71, 0x01,
-	0x21, 0x2e, 0xd5, 0x24, 0x1e, 0x5c, 0x43, 0x3a, 0xe3, 0xce, 0xd8, 0x49, 0xbd, 0x12, 0x48, 0x70,
-	0x01, 0x0e, 0x48, 0x48, 0x5c, 0x39, 0x73, 0xd8, 0xbf, 0x82, 0xe3, 0x4a, 0x5c, 0x56, 0xe2, 0xc2,
-	0x69, 0x17, 0xb5, 0xfc, 0x21, 0xc8, 0x33, 0x63, 0x37, 0xf6, 0xd6, 0xd9, 0x22, 0xe5, 0xe6, 0xbc,
-	0xf9, 0xbe, 0xf7, 0xfd, 0x3c, 0xeb, 0xbd, 0x8c, 0xa1, 0xfb, 0x35, 0x9e, 0x60, 0xdb, 0xa7, 0x23,
-	0x42, 0x43, 0x7f, 0x42, 0xec, 0xc9, 0xee, 0x90, 0x84, 0x78, 0xd7, 0x3e, 0x89, 0x08, 0x8f, 0xfb,
-	0x01, 0x67, 0x21, 0x43, 0x5b, 0x89, 0xa6, 0x9f, 0x69, 0xfa, 0x5a, 0x63, 0x36, 0x3c, 0xe6, 0x31,
-	0x29, 0xb1, 0x93, 0x27, 0xa5, 0x36, 0x5f, 0xf3, 0x18, 0xf3, 0xc6, 0xc4, 0xc6, 0x81, 0x6f, 0x63,
-	0x4a, 0x59, 0x88, 0x43, 0x9f, 0x51, 0xa1, 0x4f, 0x3b, 0x25, 0x7e, 0x38, 0xd0, 0x6e, 0xe6, 0x76,
-	0x89, 0x62, 0x34, 0xc6, 0xfe, 0xb1, 0x78, 0x81, 0x28, 0xc0, 0x1c, 0xa7, 0xa2, 0x6e, 0x03, 0xd0,
-	0xc7, 0x49, 0x1b, 0x0f, 0x64, 0xd0, 0x21, 0x27, 0x11, 0x11, 0x61, 0xf7, 0x00, 0x36, 0x73, 0x51,
-	0x11, 0x30, 0x2a, 0x08, 0x7a, 0x0f, 0xea, 0x2a, 0xb9, 0x69, 0x74, 0x8c, 0xde, 0xea, 0xc0, 0xea,
-	0x5f, 0xde, 0x75, 0x5f, 0xe5, 0xed, 0x57, 0x1f, 0x3f, 0x6d, 0x2f, 0x39, 0x3a, 0xa7, 0x1b, 0xea,
-	0xa2, 0x0e, 0x99, 0x62, 0xee, 0xa6, 0x5e, 0xa8, 0x01, 0x35, 0x36, 0xa5, 0x84, 0xcb, 0x9a, 0x2f,
-	0x3b, 0xea, 0x07, 0x6a, 0xc3, 0x2a, 0x97, 0xba, 0xc3, 0x30, 0x0e, 0x48, 0x73, 0x59, 0x9e, 0x81,
-	0x0a, 0x7d, 0x12, 0x07, 0x04, 0xed, 0xc0, 0xb5, 0x88, 0x8a, 0x98, 0x8e, 0x8e, 0x38, 0xa3, 0xfe,
-	0x43, 0xe2, 0x36, 0x2b, 0x1d, 0xa3, 0xf7, 0x92, 0x53, 0x88, 0x76, 0xff, 0xa8, 0x41, 0x23, 0x6f,
-	0xab, 0x9b, 0xf9, 0xd1, 0x80, 0xcd, 0x48, 0xb8, 0xa7, 0x87, 0xc7, 0x3e, 0x0d, 0x7d, 0xea, 0x1d,
-	0xaa, 0x97, 0xd7, 0x34, 0x3a, 0x95, 0xde, 0xea, 0xa0, 0x57, 0xd6, 0xda, 0xa7, 0x07, 0x77, 0x3e,
-	0xbf, 0xaf, 0x32, 0xde, 0x4f, 0x12, 0xf6, 0xfb, 0x49, 0x93, 0x67, 0x4f, 0xdb, 0x1b, 0xc5, 0x13,
-	0xf1, 0xe8, 0xd9, 0x25, 0x41, 0x67, 0x23, 0x31, 0xcd, 0x85, 0xd0, 0x6f, 0x06, 0x58, 0x47, 0x49,
-	0xaf, 0x63, 0xff, 0x24, 0xf2, 0x5d, 0x3f, 0x8c, 0x0f, 0x03, 0xce, 0x26, 0xbe, 0x4b, 0x78, 0x4a,
-	0xb5, 0x2c, 0xa9, 0x06, 0x65, 0x54, 0x1f, 0x62, 0xee, 0x7e, 0x94, 0x26, 0x3f, 0xd0, 0xb9, 0x8a,
-	0x6f, 0x3b, 0xe1, 0x7b, 0xf4, 0xac, 0xdd, 0x2a, 0xd7, 0x08, 0xa7, 0x75, 0x54, 0x7e, 0x88, 0xbe,
-	0x82, 0xeb, 0x2e, 0x19, 0x13, 0x0f, 0x87, 0x2c, 0xe3, 0xa9, 0x48, 0x9e, 0x9d, 0x32, 0x9e, 0x3b,
-	0xa9, 0x5e, 0x31, 0xdc, 0xd0, 0x0c, 0xeb, 0xf9, 0xb8, 0x70, 0xd6, 0xdd, 0x7c, 0x00, 0x7d, 0x06,
-	0xab, 0x62, 0x8a, 0x83, 0xd4, 0xa6, 0x2a, 0x6d, 0x6e, 0x96, 0xd9, 0x1c, 0x4c, 0x71, 0xa0, 0x1c,
-	0x90, 0x76, 0x80, 0x2c, 0x24, 0x1c, 0x10, 0xd9, 0x33, 0x1a, 0xc2, 0x35, 0x81, 0x27, 0x3e, 0xf5,
-	0x44, 0x5a, 0xba, 0x26, 0x4b, 0xbf, 0x51, 0x5a, 0x5a, 0xa9, 0x55, 0xf5, 0x57, 0x74, 0xf5, 0xb5,
-	0xd9, 0xa8, 0x70, 0xd6, 0xc4, 0xec, 0xcf, 0x84, 0x9d, 0x60, 0x4e, 0x53, 0x83, 0xfa, 0x7c, 0xf6,
-	0xbb, 0x98, 0xd3, 0x02, 0x7b, 0x16, 0x12, 0x0e, 0x90, 0xec, 0xb9, 0xdb, 0x82, 0x57, 0x67, 0x26,
-	0xf8, 0x03, 0x3c, 0x0a, 0x19, 0xcf, 0x56, 0xf5, 0x87, 0x15, 0x30, 0x2f, 0x3b, 0xd5, 0x53, 0x1e,
-	0x43, 0x2b, 0x37, 0xe4, 0x7a, 0xa9, 0xbe, 0x54, 0x32, 0x3d, 0xec, 0xdb, 0x65, 0x8c, 0xaa, 0xe6,
-	0x3d, 0xea, 0x92, 0xd3, 0x8b, 0x77, 0x30, 0x13, 0x24, 0xc2, 0x69, 0xce, 0x8c, 0x73, 0x0e, 0x01,
-	0x7d, 0x67, 0x80, 0x29, 0xa7, 0x5a, 0x44, 0x41, 0x30, 0x8e, 0x8b, 0xd6, 0xcb, 0xf3, 0xf7, 0xec,
-	0x7e, 0x34, 0x0e, 0xfd, 0x59, 0x7f, 0x53, 0xfb, 0xa3, 0xe2, 0x09, 0x11, 0xce, 0x8d, 0xc4, 0xe7,
-	0x40, 0xda, 0x94, 0x30, 0x0c, 0x19, 0xe7, 0x6c, 0x5a, 0x64, 0xa8, 0x2c, 0x9a, 0x61, 0x5f, 0xda,
-	0xe4, 0x19, 0xbe, 0x85, 0xe6, 0xc5, 0xfa, 0x14, 0x00, 0xaa, 0x0b, 0x04, 0xd8, 0xca, 0x5c, 0xf2,
-	0xfe, 0x21, 0x6c, 0xca, 0x95, 0x2a, 0x58, 0xd7, 0x16, 0x68, 0xbd, 0x91, 0x18, 0xe4, 0x5d, 0x1f,
-	0xc2, 0x56, 0xba, 0x70, 0x05, 0xe3, 0xfa, 0x02, 0x8d, 0x1b, 0xda, 0xe3, 0xb9, 0x8e, 0xe5, 0x22,
-	0x16, 0x8c, 0x57, 0x16, 0xd9, 0x71, 0x62, 0x90, 0x73, 0xed, 0x6e, 0xc0, 0xba, 0x5c, 0xc4, 0xbd,
-	0x20, 0x4e, 0x97, 0xf3, 0x1e, 0x5c, 0xbf, 0x08, 0xe9, 0x8d, 0x7c, 0x17, 0xaa, 0x49, 0xae, 0x5e,
-	0xbd, 0x56, 0x19, 0xcd, 0x5e, 0x10, 0xeb, 0xfb, 0x53, 0xca, 0x07, 0x7f, 0x56, 0xa1, 0x26, 0x6b,
-	0xa1, 0x9f, 0x0c, 0xa8, 0xab, 0x0b, 0x16, 0xbd, 0x59, 0x96, 0xfd, 0xfc, 0x9d, 0x6e, 0xde, 0xbe,
-	0x92, 0x56, 0x41, 0x76, 0x77, 0xbe, 0xff, 0xeb, 0xdf, 0x5f, 0x97, 0x3b, 0xc8, 0xb2, 0xe7, 0x7e,
-	0x44, 0xa0, 0x9f, 0x0d, 0x58, 0xd1, 0x17, 0x2b, 0x9a, 0x6f, 0x90, 0xbf, 0xf5, 0xcd, 0xb7, 0xae,
-	0x26, 0xd6, 0x38, 0xb7, 0x24, 0xce, 0x4d, 0xd4, 0x2e, 0xc3, 0xe1, 0x9a, 0xe1, 0x77, 0x03, 0xd6,
-	0xf2, 0xb3, 0xb0, 0x7b, 0x05, 0xa3, 0xfc, 0x5f, 0xaa, 0x39, 0xf8, 0x3f, 0x29, 0x9a, 0xb0, 0x2f,
-	0x09, 0x7b, 0x68, 0x67, 0x3e, 0x61, 0x3a, 0x8b, 0xe8, 0x1b, 0xa8, 0xec, 0x05, 0x31, 0xba, 0x35,
-	0xd7, 0xea, 0x62, 0x92, 0xcc, 0xde, 0x8b, 0x85, 0x9a, 0x64, 0x5b, 0x92, 0xbc, 0x8e, 0x5a, 0x76,
-	0xf9, 0x67, 0xe4, 0xfe, 0xdd, 0xc7, 0x67, 0x96, 0xf1, 0xe4, 0xcc, 0x32, 0xfe, 0x39, 0xb3, 0x8c,
-	0x5f, 0xce, 0xad, 0xa5, 0x27, 0xe7, 0xd6, 0xd2, 0xdf, 0xe7, 0xd6, 0xd2, 0x17, 0xb7, 0x3d, 0x3f,
-	0x3c, 0x8a, 0x86, 0xfd, 0x11, 0x3b, 0x96, 0x05, 0xde, 0x1e, 0xe3, 0xa1, 0x50, 0xa5, 0x4e, 0x67,
-	0x8a, 0x25, 0x5f, 0x65, 0x62, 0x58, 0x97, 0x1f, 0x91, 0xef, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x8e, 0xce, 0x8c, 0x23, 0x22, 0x0b, 0x00, 0x00,
+	proto.RegisterFile("fury/incentive/v1beta1/query.proto", fileDescriptor_e725bd81fdde7795)
+}
+
+var fileDescriptor_e725bd81fdde7795 = []byte{
+	// 1080 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xfc, 0xd8, 0xc0, 0x8b, 0xf2, 0x6b, 0x12, 0xd2, 0xc5, 0xdb, 0x6c, 0x52, 0x07,
+	0x25, 0xab, 0x36, 0xac, 0x95, 0xa5, 0xbd, 0x71, 0x49, 0x68, 0x4b, 0x2b, 0x51, 0xa9, 0x78, 0x29,
+	0x42, 0x08, 0x29, 0x9a, 0xac, 0x27, 0x8e, 0x61, 0xe3, 0x71, 0x3c, 0xe3, 0x24, 0x2e, 0x2a, 0x12,
+	0x5c, 0x80, 0x43, 0x25, 0x24, 0xae, 0x9c, 0x39, 0xf4, 0xca, 0x3f, 0xc0, 0xb1, 0xc7, 0x4a, 0x5c,
+	0x38, 0xb5, 0x28, 0xe1, 0x0f, 0xa9, 0x3c, 0x33, 0xde, 0x5d, 0xbb, 0x99, 0xcd, 0x56, 0xda, 0xdb,
+	0xfa, 0xcd, 0x7b, 0xef, 0xfb, 0x19, 0x67, 0xbe, 0x9e, 0x17, 0xb0, 0xf6, 0xe3, 0x28, 0xb1, 0xfd,
+	0xa0, 0x45, 0x02, 0xee, 0x1f, 0x13, 0xfb, 0x78, 0x6b, 0x8f, 0x70, 0xbc, 0x65, 0x1f, 0xc5, 0x24,
+	0x4a, 0xea, 0x61, 0x44, 0x39, 0x45, 0x4b, 0x69, 0x4e, 0xbd, 0x93, 0x53, 0x57, 0x39, 0xe6, 0xa2,
+	0x47, 0x3d, 0x2a, 0x52, 0xec, 0xf4, 0x97, 0xcc, 0x36, 0xaf, 0x7a, 0x94, 0x7a, 0x6d, 0x62, 0xe3,
+	0xd0, 0xb7, 0x71, 0x10, 0x50, 0x8e, 0xb9, 0x4f, 0x03, 0xa6, 0x56, 0x57, 0x35, 0x7a, 0x38, 0x54,
+	0x6a, 0xe6, 0x9a, 0x26, 0xa3, 0xd5, 0xc6, 0xfe, 0x61, 0xd6, 0x46, 0x87, 0xed, 0xe1, 0xd8, 0x23,
+	0x97, 0x34, 0x0a, 0x71, 0x84, 0xb3, 0x46, 0xd6, 0x22, 0xa0, 0xcf, 0xd3, 0xad, 0x3e, 0x14, 0x41,
+	0x87, 0x1c, 0xc5, 0x84, 0x71, 0xab, 0x09, 0x0b, 0xb9, 0x28, 0x0b, 0x69, 0xc0, 0x08, 0xfa, 0x18,
+	0x4a, 0xb2, 0xb8, 0x6c, 0xac, 0x1a, 0xb5, 0xa9, 0x46, 0xb5, 0x7e, 0xf1, 0x9b, 0xa9, 0xcb, 0xba,
+	0x9d, 0xf1, 0xe7, 0x2f, 0x57, 0x46, 0x1c, 0x55, 0x63, 0x71, 0xd5, 0xd4, 0x21, 0x27, 0x38, 0x72,
+	0x33, 0x2d, 0xb4, 0x08, 0x13, 0xf4, 0x24, 0x20, 0x91, 0xe8, 0xf9, 0xae, 0x23, 0x1f, 0xd0, 0x0a,
+	0x4c, 0x45, 0x22, 0x6f, 0x97, 0x27, 0x21, 0x29, 0x8f, 0x8a, 0x35, 0x90, 0xa1, 0x2f, 0x92, 0x90,
+	0xa0, 0x75, 0x98, 0x89, 0x03, 0x96, 0x04, 0xad, 0x83, 0x88, 0x06, 0xfe, 0x63, 0xe2, 0x96, 0xc7,
+	0x56, 0x8d, 0xda, 0x3b, 0x4e, 0x21, 0x6a, 0xfd, 0x3d, 0x01, 0x8b, 0x79, 0x59, 0xb5, 0x99, 0x5f,
+	0x0c, 0x58, 0x88, 0x99, 0x7b, 0xba, 0x7b, 0xe8, 0x07, 0xdc, 0x0f, 0xbc, 0x5d, 0xf9, 0x82, 0xcb,
+	0xc6, 0xea, 0x58, 0x6d, 0xaa, 0x51, 0xd3, 0x6d, 0xed, 0x51, 0xf3, 0xf6, 0x57, 0x0f, 0x64, 0xc5,
+	0x27, 0x69, 0xc1, 0x4e, 0x3d, 0xdd, 0xe4, 0xd9, 0xcb, 0x95, 0xf9, 0xe2, 0x0a, 0x7b, 0xf6, 0xea,
+	0x82, 0xa0, 0x33, 0x9f, 0x8a, 0xe6, 0x42, 0xe8, 0x0f, 0x03, 0xaa, 0x07, 0xe9, 0x5e, 0xdb, 0xfe,
+	0x51, 0xec, 0xbb, 0x3e, 0x4f, 0x76, 0xc3, 0x88, 0x1e, 0xfb, 0x2e, 0x89, 0x32, 0xaa, 0x51, 0x41,
+	0xd5, 0xd0, 0x51, 0xdd, 0xc3, 0x91, 0xfb, 0x59, 0x56, 0xfc, 0x50, 0xd5, 0x4a, 0xbe, 0xb5, 0x94,
+	0xef, 0xd9, 0xab, 0x95, 0x8a, 0x3e, 0x87, 0x39, 0x95, 0x03, 0xfd, 0x22, 0xfa, 0x16, 0xe6, 0x5c,
+	0xd2, 0x26, 0x1e, 0xe6, 0xb4, 0xc3, 0x33, 0x26, 0x78, 0xd6, 0x75, 0x3c, 0xb7, 0xb3, 0x7c, 0xc9,
+	0x70, 0x45, 0x31, 0xcc, 0xe6, 0xe3, 0xcc, 0x99, 0x75, 0xf3, 0x01, 0xf4, 0x25, 0x4c, 0xb1, 0x13,
+	0x1c, 0x66, 0x32, 0xe3, 0x42, 0xe6, 0x9a, 0x4e, 0xa6, 0x79, 0x82, 0x43, 0xa9, 0x80, 0x94, 0x02,
+	0x74, 0x42, 0xcc, 0x01, 0xd6, 0xf9, 0x8d, 0xf6, 0x60, 0x86, 0xe1, 0x63, 0x3f, 0xf0, 0x58, 0xd6,
+	0x7a, 0x42, 0xb4, 0xfe, 0x40, 0xdb, 0x5a, 0x66, 0xcb, 0xee, 0xef, 0xa9, 0xee, 0xd3, 0xbd, 0x51,
+	0xe6, 0x4c, 0xb3, 0xde, 0xc7, 0x94, 0x9d, 0xe0, 0x28, 0xc8, 0x04, 0x4a, 0xfd, 0xd9, 0xef, 0xe0,
+	0x28, 0x28, 0xb0, 0x77, 0x42, 0xcc, 0x01, 0xd2, 0xf9, 0x6d, 0x55, 0xe0, 0xfd, 0x9e, 0x13, 0x7c,
+	0x17, 0xb7, 0x38, 0x8d, 0x3a, 0x56, 0xfd, 0x79, 0x12, 0xcc, 0x8b, 0x56, 0xd5, 0x29, 0x4f, 0xa0,
+	0x92, 0x3b, 0xe4, 0xca, 0x54, 0xfb, 0x32, 0x4d, 0x1d, 0xf6, 0x35, 0x1d, 0xa3, 0xec, 0x79, 0x3f,
+	0x70, 0xc9, 0x69, 0xf7, 0x1d, 0xf4, 0x04, 0x09, 0x73, 0xca, 0x3d, 0xc7, 0x39, 0x87, 0x80, 0x7e,
+	0x34, 0xc0, 0x14, 0xa7, 0x9a, 0xc5, 0x61, 0xd8, 0x4e, 0x8a, 0xd2, 0xa3, 0xfd, 0x7d, 0xf6, 0x20,
+	0x6e, 0x73, 0xbf, 0x57, 0xdf, 0x54, 0xfa, 0xa8, 0xb8, 0x42, 0x98, 0x73, 0x25, 0xd5, 0x69, 0x0a,
+	0x19, 0x0d, 0xc3, 0x1e, 0x8d, 0x22, 0x7a, 0x52, 0x64, 0x18, 0x1b, 0x36, 0xc3, 0x8e, 0x90, 0xc9,
+	0x33, 0xfc, 0x00, 0xe5, 0xae, 0x7d, 0x0a, 0x00, 0xe3, 0x43, 0x04, 0x58, 0xea, 0xa8, 0xe4, 0xf5,
+	0x39, 0x2c, 0x08, 0x4b, 0x15, 0xa4, 0x27, 0x86, 0x28, 0x3d, 0x9f, 0x0a, 0xe4, 0x55, 0x1f, 0xc3,
+	0x52, 0x66, 0xb8, 0x82, 0x70, 0x69, 0x88, 0xc2, 0x8b, 0x4a, 0xe3, 0x8d, 0x1d, 0x0b, 0x23, 0x16,
+	0x84, 0x27, 0x87, 0xb9, 0xe3, 0x54, 0x20, 0xa7, 0x6a, 0xcd, 0xc3, 0xac, 0x30, 0xe2, 0x76, 0x98,
+	0x64, 0xe6, 0xbc, 0x0f, 0x73, 0xdd, 0x90, 0x72, 0xe4, 0x2d, 0x18, 0x4f, 0x6b, 0x95, 0xf5, 0x2a,
+	0x3a, 0x9a, 0xed, 0x30, 0x51, 0xf7, 0xa7, 0x48, 0xb7, 0x6e, 0xc2, 0x55, 0xd9, 0x2a, 0xe6, 0xb4,
+	0xc9, 0xf1, 0x77, 0xa4, 0x49, 0xb8, 0xf4, 0x5c, 0x9f, 0x6b, 0xd4, 0xf2, 0x61, 0x59, 0x53, 0xa5,
+	0x68, 0xee, 0xc1, 0x24, 0x93, 0x21, 0x75, 0xa7, 0x6b, 0x5f, 0x4f, 0xb1, 0x85, 0xa2, 0xcb, 0xca,
+	0xad, 0x47, 0x6a, 0x92, 0xf8, 0x34, 0x1d, 0x41, 0x3a, 0xb7, 0xfb, 0x06, 0xcc, 0xb6, 0x68, 0xbb,
+	0x8d, 0x39, 0x89, 0x70, 0x5b, 0xde, 0xe5, 0x12, 0x70, 0xa6, 0x1b, 0x16, 0xf7, 0xf9, 0x12, 0x94,
+	0xf6, 0xe3, 0xc0, 0x25, 0x91, 0xba, 0xeb, 0xd5, 0x93, 0xf5, 0x0d, 0x2c, 0xe4, 0xda, 0x2a, 0xee,
+	0x3b, 0x50, 0x12, 0xb3, 0x4e, 0xf6, 0x09, 0x5b, 0xd6, 0x61, 0x8b, 0xba, 0x9d, 0x19, 0xf5, 0xa7,
+	0x2c, 0xa9, 0x36, 0xaa, 0xb8, 0xf1, 0x74, 0x12, 0x26, 0x44, 0x7b, 0xf4, 0xab, 0x01, 0x25, 0x39,
+	0xb6, 0xa0, 0xeb, 0xba, 0x5e, 0x6f, 0x4e, 0x4a, 0xe6, 0x8d, 0x81, 0x72, 0x25, 0xb4, 0xb5, 0xfe,
+	0xd3, 0x3f, 0xff, 0xff, 0x3e, 0xba, 0x8a, 0xaa, 0x76, 0xdf, 0xd1, 0x0c, 0x3d, 0x35, 0x60, 0x52,
+	0x8d, 0x2b, 0xa8, 0xbf, 0x40, 0x7e, 0x96, 0x32, 0x37, 0x07, 0x4b, 0x56, 0x38, 0x1b, 0x02, 0xe7,
+	0x1a, 0x5a, 0xd1, 0xe1, 0x44, 0x8a, 0xe1, 0x4f, 0x03, 0xa6, 0xf3, 0x0e, 0xdb, 0x1a, 0x40, 0x28,
+	0x7f, 0x51, 0x99, 0x8d, 0xb7, 0x29, 0x51, 0x84, 0x75, 0x41, 0x58, 0x43, 0xeb, 0xfd, 0x09, 0x33,
+	0x87, 0xa3, 0x27, 0x30, 0xb6, 0x1d, 0x26, 0x68, 0xa3, 0xaf, 0x54, 0xd7, 0x9f, 0x66, 0xed, 0xf2,
+	0x44, 0x45, 0xb2, 0x26, 0x48, 0x96, 0x51, 0xc5, 0xd6, 0x0f, 0xf0, 0xe8, 0x2f, 0x03, 0xe6, 0x8a,
+	0x36, 0x41, 0x37, 0xfb, 0x6b, 0x5c, 0x6c, 0x67, 0xf3, 0xd6, 0x5b, 0x56, 0x29, 0xcc, 0x86, 0xc0,
+	0xdc, 0x44, 0xd7, 0xb5, 0x98, 0x31, 0xa7, 0xbb, 0x2c, 0x2d, 0xb5, 0xbf, 0x17, 0x9f, 0x88, 0x27,
+	0xe2, 0xe4, 0x4b, 0x5b, 0x5c, 0x72, 0xf2, 0x73, 0xce, 0x36, 0x6f, 0x0c, 0x94, 0x3b, 0xe8, 0xc9,
+	0x97, 0x7e, 0xdc, 0xb9, 0xfb, 0xfc, 0xac, 0x6a, 0xbc, 0x38, 0xab, 0x1a, 0xff, 0x9d, 0x55, 0x8d,
+	0xdf, 0xce, 0xab, 0x23, 0x2f, 0xce, 0xab, 0x23, 0xff, 0x9e, 0x57, 0x47, 0xbe, 0xde, 0xf4, 0x7c,
+	0x7e, 0x10, 0xef, 0xd5, 0x5b, 0xf4, 0xd0, 0x3e, 0xc4, 0x1e, 0xf9, 0xb0, 0x45, 0x8f, 0x49, 0x20,
+	0xdb, 0x9d, 0xf6, 0x34, 0x4c, 0x3f, 0x31, 0x6c, 0xaf, 0x24, 0xfe, 0xbb, 0xf9, 0xe8, 0xf5, 0x00,
+	0x9b, 0x48, 0x37, 0xf8, 0xdf, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -570,6 +776,10 @@ type QueryClient interface {
 	RewardFactors(ctx context.Context, in *QueryRewardFactorsRequest, opts ...grpc.CallOption) (*QueryRewardFactorsResponse, error)
 	// Apy queries incentive reward apy for a reward.
 	Apy(ctx context.Context, in *QueryApyRequest, opts ...grpc.CallOption) (*QueryApyResponse, error)
+	// AutoStakeSetting queries the auto-stake setting of an owner.
+	AutoStakeSetting(ctx context.Context, in *QueryAutoStakeSettingRequest, opts ...grpc.CallOption) (*QueryAutoStakeSettingResponse, error)
+	// Gauges queries externally funded reward gauges.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
 }
 
 type queryClient struct {
@@ -616,6 +826,24 @@ func (c *queryClient) Apy(ctx context.Context, in *QueryApyRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) AutoStakeSetting(ctx context.Context, in *QueryAutoStakeSettingRequest, opts ...grpc.CallOption) (*QueryAutoStakeSettingResponse, error) {
+	out := new(QueryAutoStakeSettingResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Query/AutoStakeSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Query/Gauges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries module params.
@@ -626,6 +854,10 @@ type QueryServer interface {
 	RewardFactors(context.Context, *QueryRewardFactorsRequest) (*QueryRewardFactorsResponse, error)
 	// Apy queries incentive reward apy for a reward.
 	Apy(context.Context, *QueryApyRequest) (*QueryApyResponse, error)
+	// AutoStakeSetting queries the auto-stake setting of an owner.
+	AutoStakeSetting(context.Context, *QueryAutoStakeSettingRequest) (*QueryAutoStakeSettingResponse, error)
+	// Gauges queries externally funded reward gauges.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -644,6 +876,12 @@ func (*UnimplementedQueryServer) RewardFactors(ctx context.Context, req *QueryRe
 func (*UnimplementedQueryServer) Apy(ctx context.Context, req *QueryApyRequest) (*QueryApyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Apy not implemented")
 }
+func (*UnimplementedQueryServer) AutoStakeSetting(ctx context.Context, req *QueryAutoStakeSettingRequest) (*QueryAutoStakeSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoStakeSetting not implemented")
+}
+func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -721,6 +959,42 @@ func _Query_Apy_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoStakeSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoStakeSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoStakeSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Query/AutoStakeSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoStakeSetting(ctx, req.(*QueryAutoStakeSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Query/Gauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.incentive.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -741,6 +1015,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Apy",
 			Handler:    _Query_Apy_Handler,
 		},
+		{
+			MethodName: "AutoStakeSetting",
+			Handler:    _Query_AutoStakeSetting_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/incentive/v1beta1/query.proto",
@@ -1160,62 +1442,199 @@ func (m *QueryApyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAutoStakeSettingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAutoStakeSettingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoStakeSettingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoStakeSettingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoStakeSettingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoStakeSettingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Setting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RewardType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Unsynchronized {
-		n += 2
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RewardType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Unsynchronized {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.USDXMintingClaims) > 0 {
 		for _, e := range m.USDXMintingClaims {
@@ -1340,6 +1759,62 @@ func (m *QueryApyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoStakeSettingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoStakeSettingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Setting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2339,6 +2814,369 @@ func (m *QueryApyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoStakeSettingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoStakeSettingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoStakeSettingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoStakeSettingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoStakeSettingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoStakeSettingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Setting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
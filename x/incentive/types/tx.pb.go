@@ -6,21 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,7 +45,7 @@ func (m *Selection) Reset()         { *m = Selection{} }
 func (m *Selection) String() string { return proto.CompactTextString(m) }
 func (*Selection) ProtoMessage()    {}
 func (*Selection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{0}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{0}
 }
 func (m *Selection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +84,7 @@ func (m *MsgClaimUSDXMintingReward) Reset()         { *m = MsgClaimUSDXMintingRe
 func (m *MsgClaimUSDXMintingReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUSDXMintingReward) ProtoMessage()    {}
 func (*MsgClaimUSDXMintingReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{1}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{1}
 }
 func (m *MsgClaimUSDXMintingReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,7 +121,7 @@ func (m *MsgClaimUSDXMintingRewardResponse) Reset()         { *m = MsgClaimUSDXM
 func (m *MsgClaimUSDXMintingRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUSDXMintingRewardResponse) ProtoMessage()    {}
 func (*MsgClaimUSDXMintingRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{2}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{2}
 }
 func (m *MsgClaimUSDXMintingRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +160,7 @@ func (m *MsgClaimHardReward) Reset()         { *m = MsgClaimHardReward{} }
 func (m *MsgClaimHardReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimHardReward) ProtoMessage()    {}
 func (*MsgClaimHardReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{3}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{3}
 }
 func (m *MsgClaimHardReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +197,7 @@ func (m *MsgClaimHardRewardResponse) Reset()         { *m = MsgClaimHardRewardRe
 func (m *MsgClaimHardRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimHardRewardResponse) ProtoMessage()    {}
 func (*MsgClaimHardRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{4}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{4}
 }
 func (m *MsgClaimHardRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,13 +230,15 @@ var xxx_messageInfo_MsgClaimHardRewardResponse proto.InternalMessageInfo
 type MsgClaimDelegatorReward struct {
 	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	DenomsToClaim Selections `protobuf:"bytes,2,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+	// auto_stake overrides the sender's auto-stake setting for this claim
+	AutoStake AutoStakeMode `protobuf:"varint,3,opt,name=auto_stake,json=autoStake,proto3,enum=fury.incentive.v1beta1.AutoStakeMode" json:"auto_stake,omitempty"`
 }
 
 func (m *MsgClaimDelegatorReward) Reset()         { *m = MsgClaimDelegatorReward{} }
 func (m *MsgClaimDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimDelegatorReward) ProtoMessage()    {}
 func (*MsgClaimDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{5}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{5}
 }
 func (m *MsgClaimDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +275,7 @@ func (m *MsgClaimDelegatorRewardResponse) Reset()         { *m = MsgClaimDelegat
 func (m *MsgClaimDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimDelegatorRewardResponse) ProtoMessage()    {}
 func (*MsgClaimDelegatorRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{6}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{6}
 }
 func (m *MsgClaimDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +314,7 @@ func (m *MsgClaimSwapReward) Reset()         { *m = MsgClaimSwapReward{} }
 func (m *MsgClaimSwapReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSwapReward) ProtoMessage()    {}
 func (*MsgClaimSwapReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{7}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{7}
 }
 func (m *MsgClaimSwapReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +351,7 @@ func (m *MsgClaimSwapRewardResponse) Reset()         { *m = MsgClaimSwapRewardRe
 func (m *MsgClaimSwapRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSwapRewardResponse) ProtoMessage()    {}
 func (*MsgClaimSwapRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{8}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{8}
 }
 func (m *MsgClaimSwapRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +390,7 @@ func (m *MsgClaimSavingsReward) Reset()         { *m = MsgClaimSavingsReward{} }
 func (m *MsgClaimSavingsReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSavingsReward) ProtoMessage()    {}
 func (*MsgClaimSavingsReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{9}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{9}
 }
 func (m *MsgClaimSavingsReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +427,7 @@ func (m *MsgClaimSavingsRewardResponse) Reset()         { *m = MsgClaimSavingsRe
 func (m *MsgClaimSavingsRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSavingsRewardResponse) ProtoMessage()    {}
 func (*MsgClaimSavingsRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{10}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{10}
 }
 func (m *MsgClaimSavingsRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +466,7 @@ func (m *MsgClaimEarnReward) Reset()         { *m = MsgClaimEarnReward{} }
 func (m *MsgClaimEarnReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimEarnReward) ProtoMessage()    {}
 func (*MsgClaimEarnReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{11}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{11}
 }
 func (m *MsgClaimEarnReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +503,7 @@ func (m *MsgClaimEarnRewardResponse) Reset()         { *m = MsgClaimEarnRewardRe
 func (m *MsgClaimEarnRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimEarnRewardResponse) ProtoMessage()    {}
 func (*MsgClaimEarnRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{12}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{12}
 }
 func (m *MsgClaimEarnRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,6 +532,259 @@ func (m *MsgClaimEarnRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimEarnRewardResponse proto.InternalMessageInfo
 
+// MsgClaimAllRewards message type used to claim the rewards of every claim type at once
+type MsgClaimAllRewards struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver is the account paid the rewards, defaulting to the sender when empty
+	Receiver      string     `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	DenomsToClaim Selections `protobuf:"bytes,3,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+}
+
+func (m *MsgClaimAllRewards) Reset()         { *m = MsgClaimAllRewards{} }
+func (m *MsgClaimAllRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewards) ProtoMessage()    {}
+func (*MsgClaimAllRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{13}
+}
+func (m *MsgClaimAllRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewards.Merge(m, src)
+}
+func (m *MsgClaimAllRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewards proto.InternalMessageInfo
+
+// MsgClaimAllRewardsResponse defines the Msg/ClaimAllRewards response type.
+type MsgClaimAllRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimAllRewardsResponse) Reset()         { *m = MsgClaimAllRewardsResponse{} }
+func (m *MsgClaimAllRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimAllRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{14}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAllRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// MsgSetAutoStake message type used to set how claimed delegator rewards are staked by default
+type MsgSetAutoStake struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// mode is the auto-stake mode applied to the sender's delegator reward claims
+	Mode AutoStakeMode `protobuf:"varint,2,opt,name=mode,proto3,enum=fury.incentive.v1beta1.AutoStakeMode" json:"mode,omitempty"`
+}
+
+func (m *MsgSetAutoStake) Reset()         { *m = MsgSetAutoStake{} }
+func (m *MsgSetAutoStake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoStake) ProtoMessage()    {}
+func (*MsgSetAutoStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{15}
+}
+func (m *MsgSetAutoStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoStake.Merge(m, src)
+}
+func (m *MsgSetAutoStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoStake proto.InternalMessageInfo
+
+// MsgSetAutoStakeResponse defines the Msg/SetAutoStake response type.
+type MsgSetAutoStakeResponse struct {
+}
+
+func (m *MsgSetAutoStakeResponse) Reset()         { *m = MsgSetAutoStakeResponse{} }
+func (m *MsgSetAutoStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoStakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{16}
+}
+func (m *MsgSetAutoStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoStakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoStakeResponse proto.InternalMessageInfo
+
+// MsgCreateGauge message type used to fund rewards for a reward source without a governance proposal
+type MsgCreateGauge struct {
+	Funder     string          `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	SourceType GaugeSourceType `protobuf:"varint,2,opt,name=source_type,json=sourceType,proto3,enum=fury.incentive.v1beta1.GaugeSourceType" json:"source_type,omitempty"`
+	// collateral_type identifies the rewarded source, such as a swap pool ID or a vault denom
+	CollateralType string    `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Start          time.Time `protobuf:"bytes,4,opt,name=start,proto3,stdtime" json:"start"`
+	End            time.Time `protobuf:"bytes,5,opt,name=end,proto3,stdtime" json:"end"`
+	// budget is the total reward distributed evenly between start and end
+	Budget types.Coin `protobuf:"bytes,6,opt,name=budget,proto3" json:"budget"`
+}
+
+func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
+func (m *MsgCreateGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGauge) ProtoMessage()    {}
+func (*MsgCreateGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{17}
+}
+func (m *MsgCreateGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGauge.Merge(m, src)
+}
+func (m *MsgCreateGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGauge proto.InternalMessageInfo
+
+// MsgCreateGaugeResponse defines the Msg/CreateGauge response type.
+type MsgCreateGaugeResponse struct {
+	GaugeID uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCreateGaugeResponse) Reset()         { *m = MsgCreateGaugeResponse{} }
+func (m *MsgCreateGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGaugeResponse) ProtoMessage()    {}
+func (*MsgCreateGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{18}
+}
+func (m *MsgCreateGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGaugeResponse.Merge(m, src)
+}
+func (m *MsgCreateGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGaugeResponse) GetGaugeID() uint64 {
+	if m != nil {
+		return m.GaugeID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Selection)(nil), "fury.incentive.v1beta1.Selection")
 	proto.RegisterType((*MsgClaimUSDXMintingReward)(nil), "fury.incentive.v1beta1.MsgClaimUSDXMintingReward")
@@ -538,45 +799,77 @@ func init() {
 	proto.RegisterType((*MsgClaimSavingsRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimSavingsRewardResponse")
 	proto.RegisterType((*MsgClaimEarnReward)(nil), "fury.incentive.v1beta1.MsgClaimEarnReward")
 	proto.RegisterType((*MsgClaimEarnRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimEarnRewardResponse")
-}
-
-func init() { proto.RegisterFile("fury/incentive/v1beta1/tx.proto", fileDescriptor_b1cec058e3ff75d5) }
-
-var fileDescriptor_b1cec058e3ff75d5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0xad, 0x5a, 0xd1, 0x87, 0x20, 0xd2, 0x29, 0x84, 0x60, 0x81, 0xdd, 0x84, 0x81,
-	0x0a, 0x54, 0x5b, 0x09, 0x42, 0x08, 0xc6, 0xd2, 0x4a, 0x2c, 0x61, 0x48, 0x8a, 0x84, 0x90, 0x50,
-	0x74, 0x49, 0x0e, 0x73, 0xc2, 0xbe, 0x33, 0xbe, 0x6b, 0x5a, 0x98, 0x98, 0x10, 0x23, 0x0b, 0x12,
-	0x62, 0xea, 0xcc, 0x27, 0xe9, 0xd8, 0x91, 0x09, 0x50, 0x22, 0x21, 0x3e, 0x06, 0x8a, 0x93, 0xd8,
-	0x56, 0x6d, 0x63, 0xd2, 0x29, 0x9b, 0xed, 0xf7, 0x7f, 0xef, 0xfd, 0xfe, 0x4f, 0x7e, 0x77, 0x60,
-	0xbe, 0x26, 0x43, 0x62, 0x33, 0xde, 0xa7, 0x5c, 0xb1, 0x21, 0xb5, 0x87, 0x8d, 0x1e, 0x55, 0xa4,
-	0x61, 0xab, 0x23, 0xcb, 0x0f, 0x84, 0x12, 0xb8, 0x32, 0x11, 0x58, 0x91, 0xc0, 0x9a, 0x09, 0xf4,
-	0xb2, 0x23, 0x1c, 0x11, 0x4a, 0xec, 0xc9, 0xd3, 0x54, 0x5d, 0xdf, 0x87, 0x8d, 0x0e, 0x75, 0x69,
-	0x5f, 0x31, 0xc1, 0x71, 0x19, 0xd6, 0x06, 0x94, 0x0b, 0xaf, 0x8a, 0x36, 0xd1, 0xd6, 0x46, 0x7b,
-	0xfa, 0x82, 0x6f, 0x41, 0xc9, 0x3b, 0x70, 0x15, 0xf3, 0x5d, 0x46, 0x83, 0x2e, 0x27, 0x1e, 0xad,
-	0xae, 0x84, 0xf1, 0xcb, 0xf1, 0xe7, 0x27, 0xc4, 0xa3, 0x0f, 0x2f, 0x7c, 0x3c, 0x36, 0xb5, 0x3f,
-	0xc7, 0xa6, 0x56, 0x7f, 0x09, 0xd7, 0x5a, 0xd2, 0x79, 0xe4, 0x12, 0xe6, 0x3d, 0xed, 0xec, 0x3e,
-	0x6b, 0x31, 0xae, 0x18, 0x77, 0xda, 0xf4, 0x90, 0x04, 0x03, 0x5c, 0x81, 0x75, 0x49, 0xf9, 0x80,
-	0x06, 0xb3, 0x36, 0xb3, 0xb7, 0xf3, 0xf4, 0xb9, 0x09, 0xb5, 0xdc, 0x3e, 0x6d, 0x2a, 0x7d, 0xc1,
-	0x25, 0xad, 0x7f, 0x46, 0x80, 0xe7, 0xaa, 0xc7, 0x61, 0xe0, 0x9f, 0x18, 0x2f, 0xa0, 0x14, 0xfa,
-	0x96, 0x5d, 0x25, 0xba, 0xfd, 0x49, 0x52, 0x75, 0x65, 0x73, 0x75, 0xeb, 0x62, 0xb3, 0x66, 0x65,
-	0x4f, 0xd6, 0x8a, 0x06, 0xb8, 0x83, 0x4f, 0x7e, 0x98, 0xda, 0xb7, 0x9f, 0x26, 0x44, 0x9f, 0x64,
-	0xfb, 0xd2, 0xb4, 0xda, 0xbe, 0x08, 0x01, 0x12, 0xf0, 0xd7, 0x41, 0x4f, 0x63, 0x45, 0xd4, 0x5f,
-	0x11, 0x5c, 0x9d, 0x87, 0x77, 0xa9, 0x4b, 0x1d, 0xa2, 0x44, 0xb0, 0x2c, 0xe8, 0x35, 0x30, 0x73,
-	0xd8, 0x32, 0xa7, 0xde, 0x39, 0x24, 0xfe, 0x12, 0x4e, 0x3d, 0xc6, 0x8a, 0xa8, 0xbf, 0x20, 0xb8,
-	0x12, 0x85, 0xc9, 0x90, 0x71, 0x47, 0x2e, 0x0b, 0xb8, 0x09, 0x37, 0x32, 0xc9, 0x32, 0x27, 0xbe,
-	0x47, 0x02, 0xbe, 0x84, 0x13, 0x8f, 0xb1, 0xe6, 0xd4, 0xcd, 0xdf, 0x6b, 0xb0, 0xda, 0x92, 0x0e,
-	0xfe, 0x80, 0xa0, 0x92, 0x73, 0x60, 0x34, 0xf2, 0x80, 0x72, 0x77, 0x5f, 0x7f, 0xb0, 0x70, 0xca,
-	0x1c, 0x08, 0xbf, 0x81, 0xd2, 0xd9, 0xa3, 0xe2, 0x76, 0x51, 0xb5, 0x58, 0xab, 0x37, 0xff, 0x5f,
-	0x1b, 0xb5, 0x7c, 0x8f, 0xa0, 0x9c, 0xb9, 0xe8, 0x76, 0x51, 0xb1, 0x33, 0x09, 0xfa, 0xfd, 0x05,
-	0x13, 0x52, 0xae, 0x13, 0xab, 0x5a, 0xe8, 0x3a, 0xd6, 0x16, 0xbb, 0x4e, 0xef, 0x1a, 0x7e, 0x07,
-	0x38, 0x63, 0xcf, 0xb6, 0x0b, 0x2b, 0x25, 0xe5, 0xfa, 0xbd, 0x85, 0xe4, 0x29, 0xbb, 0x89, 0x3d,
-	0x29, 0xb4, 0x1b, 0x6b, 0x8b, 0xed, 0xa6, 0x7f, 0xf4, 0x9d, 0xbd, 0x93, 0x91, 0x81, 0x4e, 0x47,
-	0x06, 0xfa, 0x35, 0x32, 0xd0, 0xa7, 0xb1, 0xa1, 0x9d, 0x8e, 0x0d, 0xed, 0xfb, 0xd8, 0xd0, 0x9e,
-	0xdf, 0x71, 0x98, 0x7a, 0x75, 0xd0, 0xb3, 0xfa, 0xc2, 0xb3, 0x27, 0x75, 0xb7, 0x5d, 0xd2, 0x93,
-	0xe1, 0x93, 0x7d, 0x94, 0xb8, 0xe9, 0xd5, 0x5b, 0x9f, 0xca, 0xde, 0x7a, 0x78, 0x6f, 0xdf, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x28, 0x8b, 0x70, 0xee, 0x08, 0x08, 0x00, 0x00,
+	proto.RegisterType((*MsgClaimAllRewards)(nil), "fury.incentive.v1beta1.MsgClaimAllRewards")
+	proto.RegisterType((*MsgClaimAllRewardsResponse)(nil), "fury.incentive.v1beta1.MsgClaimAllRewardsResponse")
+	proto.RegisterType((*MsgSetAutoStake)(nil), "fury.incentive.v1beta1.MsgSetAutoStake")
+	proto.RegisterType((*MsgSetAutoStakeResponse)(nil), "fury.incentive.v1beta1.MsgSetAutoStakeResponse")
+	proto.RegisterType((*MsgCreateGauge)(nil), "fury.incentive.v1beta1.MsgCreateGauge")
+	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "fury.incentive.v1beta1.MsgCreateGaugeResponse")
+}
+
+func init() { proto.RegisterFile("fury/incentive/v1beta1/tx.proto", fileDescriptor_e6e1c6edfdd8e91a) }
+
+var fileDescriptor_e6e1c6edfdd8e91a = []byte{
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0x55,
+	0x14, 0xf5, 0xc4, 0xf9, 0x70, 0xae, 0x21, 0x91, 0x46, 0x21, 0x75, 0x46, 0xe0, 0x71, 0x5c, 0xd1,
+	0x58, 0x88, 0xcc, 0x10, 0x23, 0xa8, 0xda, 0x15, 0x75, 0x03, 0xb4, 0x0b, 0xb3, 0x18, 0x07, 0x09,
+	0x21, 0x21, 0xeb, 0x79, 0xe6, 0x66, 0x3a, 0xea, 0xcc, 0x3c, 0x33, 0xef, 0x8d, 0xdb, 0xb0, 0x42,
+	0x42, 0x42, 0x2c, 0xbb, 0x41, 0x62, 0xd9, 0x75, 0x77, 0xfc, 0x8b, 0x2e, 0x2b, 0x56, 0xac, 0xda,
+	0x2a, 0xd9, 0xf0, 0x33, 0xd0, 0xbc, 0xf9, 0x6c, 0xec, 0x89, 0x1d, 0x24, 0xd4, 0xac, 0xfc, 0x3e,
+	0xce, 0xbd, 0xe7, 0xdc, 0xfb, 0x66, 0xde, 0xf1, 0x80, 0x7a, 0x1c, 0x06, 0x27, 0xba, 0xe3, 0x9b,
+	0xe8, 0x73, 0x67, 0x82, 0xfa, 0xe4, 0x60, 0x84, 0x9c, 0x1c, 0xe8, 0xfc, 0xb1, 0x36, 0x0e, 0x28,
+	0xa7, 0xf2, 0x76, 0x04, 0xd0, 0x32, 0x80, 0x96, 0x00, 0x94, 0xa6, 0x49, 0x99, 0x47, 0x99, 0x3e,
+	0x22, 0x2c, 0x8f, 0x32, 0xa9, 0xe3, 0xc7, 0x71, 0xca, 0xf5, 0x92, 0xc4, 0xa6, 0x4b, 0x1c, 0x8f,
+	0x25, 0xa0, 0x76, 0x09, 0xc8, 0x26, 0xa1, 0x8d, 0x09, 0x66, 0xcb, 0xa6, 0x36, 0x15, 0x43, 0x3d,
+	0x1a, 0x25, 0xab, 0xaa, 0x4d, 0xa9, 0xed, 0xa2, 0x2e, 0x66, 0xa3, 0xf0, 0x58, 0xe7, 0x8e, 0x87,
+	0x8c, 0x13, 0x6f, 0x1c, 0x03, 0xda, 0x47, 0xb0, 0x3e, 0x40, 0x17, 0x4d, 0xee, 0x50, 0x5f, 0xde,
+	0x82, 0x15, 0x0b, 0x7d, 0xea, 0x35, 0xa4, 0x96, 0xd4, 0x59, 0x37, 0xe2, 0x89, 0xbc, 0x07, 0x9b,
+	0x5e, 0xe8, 0x72, 0x67, 0xec, 0x3a, 0x18, 0x0c, 0x7d, 0xe2, 0x61, 0x63, 0x49, 0xec, 0x6f, 0xe4,
+	0xcb, 0xdf, 0x10, 0x0f, 0x6f, 0xd7, 0x7e, 0x7b, 0xaa, 0x56, 0xfe, 0x79, 0xaa, 0x56, 0xda, 0xc7,
+	0xb0, 0xd3, 0x67, 0xf6, 0xdd, 0xa8, 0x86, 0x6f, 0x07, 0x87, 0xdf, 0xf5, 0x1d, 0x9f, 0x3b, 0xbe,
+	0x6d, 0xe0, 0x23, 0x12, 0x58, 0xf2, 0x36, 0xac, 0x32, 0xf4, 0x2d, 0x0c, 0x12, 0x9a, 0x64, 0xf6,
+	0x5f, 0x78, 0xae, 0xc3, 0x6e, 0x29, 0x8f, 0x81, 0x6c, 0x4c, 0x7d, 0x86, 0xed, 0xdf, 0x25, 0x90,
+	0x53, 0xd4, 0x3d, 0xb1, 0x71, 0xa1, 0x8c, 0x1f, 0x60, 0x53, 0xd4, 0xcd, 0x86, 0x9c, 0x0e, 0xc5,
+	0x31, 0x34, 0x96, 0x5a, 0xd5, 0x4e, 0xbd, 0xbb, 0xab, 0xcd, 0x3e, 0x63, 0x2d, 0x6b, 0x60, 0x4f,
+	0x7e, 0xfe, 0x52, 0xad, 0x3c, 0x7b, 0xa5, 0x42, 0xb6, 0xc4, 0x8c, 0x77, 0xe3, 0x6c, 0x47, 0x54,
+	0x08, 0x28, 0x88, 0x7f, 0x1f, 0x94, 0x69, 0x59, 0x99, 0xea, 0xd7, 0x12, 0x5c, 0x4b, 0xb7, 0x0f,
+	0xd1, 0x45, 0x9b, 0x70, 0x1a, 0xbc, 0x55, 0xe9, 0xf2, 0x21, 0x00, 0x09, 0x39, 0x1d, 0x32, 0x4e,
+	0x1e, 0x62, 0xa3, 0xda, 0x92, 0x3a, 0x1b, 0xdd, 0x0f, 0xcb, 0x32, 0xdf, 0x09, 0x39, 0x1d, 0x44,
+	0xc0, 0x3e, 0xb5, 0xd0, 0x58, 0x27, 0xe9, 0xb4, 0xd0, 0x80, 0x5d, 0x50, 0x4b, 0x2a, 0x9c, 0x79,
+	0x76, 0x83, 0x47, 0x64, 0x7c, 0x05, 0xcf, 0x2e, 0x97, 0x95, 0xa9, 0xfe, 0x43, 0x82, 0xf7, 0xb2,
+	0x6d, 0x32, 0x71, 0x7c, 0x9b, 0x5d, 0x15, 0xe1, 0x2a, 0x7c, 0x30, 0x53, 0xd9, 0xcc, 0x8e, 0x7f,
+	0x49, 0x02, 0xff, 0x0a, 0x76, 0x3c, 0x97, 0x95, 0xa9, 0xfe, 0xb3, 0xa0, 0xfa, 0x8e, 0xeb, 0xc6,
+	0xbb, 0xac, 0x54, 0xb5, 0x02, 0xb5, 0x00, 0x4d, 0x74, 0x26, 0x18, 0x24, 0x77, 0x4c, 0x36, 0x9f,
+	0x55, 0x51, 0xf5, 0x7f, 0xa9, 0xe8, 0x17, 0x09, 0x94, 0x69, 0xcd, 0x69, 0x49, 0x32, 0xc2, 0x5a,
+	0x10, 0x2f, 0x35, 0x24, 0xc1, 0xbf, 0xa3, 0xc5, 0x5e, 0xa2, 0x45, 0x5e, 0x92, 0x91, 0xdf, 0xa5,
+	0x8e, 0xdf, 0xfb, 0x24, 0xe1, 0xed, 0xd8, 0x0e, 0x7f, 0x10, 0x8e, 0x34, 0x93, 0x7a, 0x7a, 0x62,
+	0x3c, 0xf1, 0xcf, 0x3e, 0xb3, 0x1e, 0xea, 0xfc, 0x64, 0x8c, 0x4c, 0x04, 0x30, 0x23, 0xcd, 0xdd,
+	0xf6, 0x61, 0xb3, 0xcf, 0xec, 0x01, 0xf2, 0xec, 0x85, 0x2d, 0xed, 0xda, 0x2d, 0x58, 0xf6, 0xa8,
+	0x15, 0xdf, 0xca, 0x0b, 0xbf, 0xf9, 0x22, 0xa4, 0x50, 0xf5, 0x0e, 0x5c, 0x3b, 0xc7, 0x97, 0x1d,
+	0xe2, 0x5f, 0x4b, 0xb0, 0x11, 0x35, 0x24, 0x40, 0xc2, 0xf1, 0xeb, 0xc8, 0xdb, 0x22, 0x29, 0xc7,
+	0x61, 0x51, 0x4a, 0x3c, 0x93, 0xef, 0x41, 0x9d, 0xd1, 0x30, 0x30, 0x71, 0x18, 0x15, 0x95, 0x28,
+	0xda, 0x2b, 0x53, 0x24, 0x72, 0x0d, 0x04, 0xfe, 0xe8, 0x64, 0x8c, 0x06, 0xb0, 0x6c, 0x1c, 0xb9,
+	0x8e, 0x49, 0x5d, 0x97, 0x70, 0x0c, 0x88, 0x1b, 0x67, 0xab, 0xc6, 0xae, 0x93, 0x2f, 0x0b, 0xe0,
+	0x6d, 0x58, 0x61, 0x9c, 0x04, 0xbc, 0xb1, 0xdc, 0x92, 0x3a, 0xf5, 0xae, 0xa2, 0xc5, 0xd6, 0xaa,
+	0xa5, 0xd6, 0xaa, 0x1d, 0xa5, 0xd6, 0xda, 0xab, 0x45, 0xc7, 0xf1, 0xe4, 0x95, 0x2a, 0x19, 0x71,
+	0x88, 0xfc, 0x39, 0x54, 0xd1, 0xb7, 0x1a, 0x2b, 0x97, 0x88, 0x8c, 0x02, 0xe4, 0x9b, 0xb0, 0x3a,
+	0x0a, 0x2d, 0x1b, 0x79, 0x63, 0xb5, 0x25, 0x5d, 0xfc, 0x08, 0x2c, 0x47, 0x91, 0x46, 0x02, 0x2f,
+	0xf4, 0xfb, 0x0b, 0xd8, 0x7e, 0xb3, 0xa7, 0xd9, 0x03, 0x76, 0x03, 0x6a, 0xe2, 0x0f, 0xc4, 0xd0,
+	0xb1, 0x44, 0x77, 0x97, 0x7b, 0xf5, 0xd3, 0x97, 0xea, 0x9a, 0x00, 0xdd, 0x3f, 0x34, 0xd6, 0xc4,
+	0xe6, 0x7d, 0xab, 0xfb, 0xac, 0x06, 0xd5, 0x3e, 0xb3, 0xe5, 0x5f, 0x25, 0xd8, 0x2e, 0xb1, 0xf4,
+	0x83, 0xb2, 0xce, 0x97, 0xba, 0xb3, 0x72, 0xeb, 0xd2, 0x21, 0x99, 0xf0, 0x1f, 0x61, 0xf3, 0xbc,
+	0x99, 0x7f, 0x34, 0x2f, 0x5b, 0x8e, 0x55, 0xba, 0x8b, 0x63, 0x33, 0xca, 0x9f, 0x25, 0xd8, 0x9a,
+	0x69, 0xc5, 0xfa, 0xbc, 0x64, 0xe7, 0x02, 0x94, 0x9b, 0x97, 0x0c, 0x98, 0xaa, 0xba, 0x60, 0x83,
+	0x73, 0xab, 0xce, 0xb1, 0x4a, 0x77, 0x71, 0x6c, 0x46, 0xf9, 0x13, 0xc8, 0x33, 0x3c, 0x6c, 0x7f,
+	0x6e, 0xa6, 0x22, 0x5c, 0xf9, 0xec, 0x52, 0xf0, 0xa9, 0x72, 0x0b, 0x1e, 0x34, 0xb7, 0xdc, 0x1c,
+	0xab, 0x74, 0x17, 0xc7, 0x4e, 0x51, 0x16, 0x0c, 0x64, 0x2e, 0x65, 0x8e, 0x55, 0xba, 0x8b, 0x63,
+	0x33, 0xca, 0x07, 0xf0, 0xce, 0x1b, 0x57, 0xef, 0xde, 0x05, 0x39, 0x8a, 0x40, 0x45, 0x5f, 0x10,
+	0x58, 0xb0, 0x93, 0x7a, 0xf1, 0x62, 0xbd, 0x71, 0x91, 0xd8, 0x1c, 0xa7, 0x68, 0x8b, 0xe1, 0x52,
+	0x9a, 0xde, 0x57, 0xcf, 0x4f, 0x9b, 0xd2, 0x8b, 0xd3, 0xa6, 0xf4, 0xfa, 0xb4, 0x29, 0x3d, 0x39,
+	0x6b, 0x56, 0x5e, 0x9c, 0x35, 0x2b, 0x7f, 0x9f, 0x35, 0x2b, 0xdf, 0x7f, 0x5c, 0xf0, 0x26, 0x8f,
+	0xd8, 0xb8, 0x6f, 0xd2, 0x09, 0xfa, 0xba, 0xf8, 0xb4, 0x79, 0x5c, 0xf8, 0xb8, 0x11, 0x2e, 0x35,
+	0x5a, 0x15, 0x97, 0xe3, 0xa7, 0xff, 0x0e, 0x00, 0xf8, 0x58, 0x29, 0x46, 0x79, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -603,6 +896,12 @@ type MsgClient interface {
 	ClaimSavingsReward(ctx context.Context, in *MsgClaimSavingsReward, opts ...grpc.CallOption) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward, opts ...grpc.CallOption) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim the rewards of every claim type at once
+	ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error)
+	// SetAutoStake is a message type used to set how claimed delegator rewards are staked by default
+	SetAutoStake(ctx context.Context, in *MsgSetAutoStake, opts ...grpc.CallOption) (*MsgSetAutoStakeResponse, error)
+	// CreateGauge is a message type used to fund rewards for a reward source without a governance proposal
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 }
 
 type msgClient struct {
@@ -667,6 +966,33 @@ func (c *msgClient) ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward,
 	return out, nil
 }
 
+func (c *msgClient) ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error) {
+	out := new(MsgClaimAllRewardsResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Msg/ClaimAllRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoStake(ctx context.Context, in *MsgSetAutoStake, opts ...grpc.CallOption) (*MsgSetAutoStakeResponse, error) {
+	out := new(MsgSetAutoStakeResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Msg/SetAutoStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Msg/CreateGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ClaimUSDXMintingReward is a message type used to claim USDX minting rewards
@@ -681,6 +1007,12 @@ type MsgServer interface {
 	ClaimSavingsReward(context.Context, *MsgClaimSavingsReward) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(context.Context, *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim the rewards of every claim type at once
+	ClaimAllRewards(context.Context, *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error)
+	// SetAutoStake is a message type used to set how claimed delegator rewards are staked by default
+	SetAutoStake(context.Context, *MsgSetAutoStake) (*MsgSetAutoStakeResponse, error)
+	// CreateGauge is a message type used to fund rewards for a reward source without a governance proposal
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -705,6 +1037,15 @@ func (*UnimplementedMsgServer) ClaimSavingsReward(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) ClaimEarnReward(ctx context.Context, req *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimEarnReward not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAllRewards(ctx context.Context, req *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoStake(ctx context.Context, req *MsgSetAutoStake) (*MsgSetAutoStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoStake not implemented")
+}
+func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -818,6 +1159,60 @@ func _Msg_ClaimEarnReward_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Msg/ClaimAllRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllRewards(ctx, req.(*MsgClaimAllRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Msg/SetAutoStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoStake(ctx, req.(*MsgSetAutoStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Msg/CreateGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.incentive.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -846,6 +1241,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimEarnReward",
 			Handler:    _Msg_ClaimEarnReward_Handler,
 		},
+		{
+			MethodName: "ClaimAllRewards",
+			Handler:    _Msg_ClaimAllRewards_Handler,
+		},
+		{
+			MethodName: "SetAutoStake",
+			Handler:    _Msg_SetAutoStake_Handler,
+		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/incentive/v1beta1/tx.proto",
@@ -1035,6 +1442,11 @@ func (m *MsgClaimDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AutoStake != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AutoStake))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DenomsToClaim) > 0 {
 		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1283,54 +1695,296 @@ func (m *MsgClaimEarnRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimAllRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Selection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MultiplierName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgClaimAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimUSDXMintingReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MultiplierName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgClaimUSDXMintingRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DenomsToClaim) > 0 {
+		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsToClaim[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Selection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiplierName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUSDXMintingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiplierName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUSDXMintingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1381,109 +2035,657 @@ func (m *MsgClaimDelegatorReward) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	if m.AutoStake != 0 {
+		n += 1 + sovTx(uint64(m.AutoStake))
+	}
+	return n
+}
+
+func (m *MsgClaimDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimSwapReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimSwapRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimSavingsReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimSavingsRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimEarnReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimEarnRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAllRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAllRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgSetAutoStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovTx(uint64(m.SourceType))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeID != 0 {
+		n += 1 + sovTx(uint64(m.GaugeID))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Selection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Selection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiplierName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUSDXMintingReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUSDXMintingReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiplierName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUSDXMintingRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUSDXMintingRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUSDXMintingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimHardReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimHardReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimHardReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgClaimDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgClaimSwapReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomsToClaim) > 0 {
-		for _, e := range m.DenomsToClaim {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimHardRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *MsgClaimSwapRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgClaimSavingsReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomsToClaim) > 0 {
-		for _, e := range m.DenomsToClaim {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimHardRewardResponse: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *MsgClaimSavingsRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgClaimEarnReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomsToClaim) > 0 {
-		for _, e := range m.DenomsToClaim {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimHardRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgClaimEarnRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Selection) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,15 +2708,15 @@ func (m *Selection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Selection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1542,13 +2744,13 @@ func (m *Selection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1558,24 +2760,45 @@ func (m *Selection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiplierName = string(dAtA[iNdEx:postIndex])
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoStake", wireType)
+			}
+			m.AutoStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoStake |= AutoStakeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1597,7 +2820,7 @@ func (m *Selection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1620,10 +2843,60 @@ func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUSDXMintingReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDelegatorRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUSDXMintingReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimSwapReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimSwapReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimSwapReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1660,9 +2933,9 @@ func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1672,23 +2945,25 @@ func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiplierName = string(dAtA[iNdEx:postIndex])
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1711,7 +2986,7 @@ func (m *MsgClaimUSDXMintingReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUSDXMintingRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimSwapRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1734,10 +3009,10 @@ func (m *MsgClaimUSDXMintingRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUSDXMintingRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimSwapRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUSDXMintingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimSwapRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1761,7 +3036,7 @@ func (m *MsgClaimUSDXMintingRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimHardReward) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,10 +3059,10 @@ func (m *MsgClaimHardReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimHardReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimSavingsReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimHardReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimSavingsReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1877,7 +3152,7 @@ func (m *MsgClaimHardReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimHardRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimSavingsRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,10 +3175,10 @@ func (m *MsgClaimHardRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimHardRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimSavingsRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimHardRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimSavingsRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1927,7 +3202,7 @@ func (m *MsgClaimHardRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1950,10 +3225,10 @@ func (m *MsgClaimDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimEarnReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimEarnReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2043,7 +3318,7 @@ func (m *MsgClaimDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimEarnRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2066,10 +3341,10 @@ func (m *MsgClaimDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimEarnRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimEarnRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2093,7 +3368,7 @@ func (m *MsgClaimDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimSwapReward) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAllRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2116,10 +3391,10 @@ func (m *MsgClaimSwapReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimSwapReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAllRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimSwapReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAllRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2155,6 +3430,38 @@ func (m *MsgClaimSwapReward) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
 			}
@@ -2209,7 +3516,7 @@ func (m *MsgClaimSwapReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimSwapRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAllRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2232,12 +3539,46 @@ func (m *MsgClaimSwapRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimSwapRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimSwapRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2259,7 +3600,7 @@ func (m *MsgClaimSwapRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2282,10 +3623,10 @@ func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimSavingsReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimSavingsReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2321,10 +3662,10 @@ func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2334,26 +3675,11 @@ func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Mode |= AutoStakeMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
-			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2375,7 +3701,7 @@ func (m *MsgClaimSavingsReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimSavingsRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,10 +3724,10 @@ func (m *MsgClaimSavingsRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimSavingsRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimSavingsRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2425,7 +3751,7 @@ func (m *MsgClaimSavingsRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2448,15 +3774,15 @@ func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimEarnReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimEarnReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2484,11 +3810,62 @@ func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= GaugeSourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2515,8 +3892,73 @@ func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
-			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2541,7 +3983,7 @@ func (m *MsgClaimEarnReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimEarnRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2564,12 +4006,31 @@ func (m *MsgClaimEarnRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimEarnRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimEarnRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeID", wireType)
+			}
+			m.GaugeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,12 @@
+package savings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/savings/keeper"
+)
+
+// BeginBlocker unlocks all locked deposits that have reached their unlock time
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UnlockExpiredDeposits(ctx)
+}
@@ -34,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		queryDepositsCmd(),
 		GetCmdTotalSupply(),
+		queryLockedDepositsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -156,3 +157,64 @@ func GetCmdTotalSupply() *cobra.Command {
 		},
 	}
 }
+
+func queryLockedDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-deposits",
+		Short: "query savings module locked deposits with optional filters",
+		Long:  "query for all savings module locked deposits or the locked deposits of an owner using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s locked-deposits
+%[1]s q %[2]s locked-deposits --owner fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --denom usdx
+%[1]s q %[2]s locked-deposits --denom usdx`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLockedDepositsRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			}
+
+			if len(ownerBech) != 0 {
+				depositOwner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				req.Owner = depositOwner.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LockedDeposits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "locked-deposits")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for locked deposits by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for locked deposits by denom")
+
+	return cmd
+}
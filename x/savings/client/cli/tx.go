@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdLockDeposit(),
 	}
 
 	for _, cmd := range cmds {
@@ -89,3 +91,35 @@ func getCmdWithdraw() *cobra.Command {
 		},
 	}
 }
+
+func getCmdLockDeposit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock [amount] [months]",
+		Short: "lock deposited coins in savings for boosted rewards",
+		Long:  "Lock part of a savings deposit for the months of a lockup tier. Locked coins cannot be withdrawn until they unlock.",
+		Example: fmt.Sprintf(
+			`%s tx %s lock 100000000usdx 3 --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			monthsLockup, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLockDeposit(clientCtx.GetFromAddress(), amount, monthsLockup)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -21,6 +21,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 		k.SetDeposit(ctx, deposit)
 	}
 
+	for _, gat := range gs.AccrualTimes {
+		k.SetPreviousAccrualTime(ctx, gat.Denom, gat.PreviousAccrualTime)
+		k.SetInterestFactor(ctx, gat.Denom, gat.InterestFactor)
+	}
+
+	for _, lockedDeposit := range gs.LockedDeposits {
+		k.SetLockedDeposit(ctx, lockedDeposit)
+	}
+	if gs.NextLockedDepositID != 0 {
+		k.SetNextLockedDepositID(ctx, gs.NextLockedDepositID)
+	}
+
 	// check if the module account exists
 	SavingsModuleAccount := ak.GetModuleAccount(ctx, types.ModuleAccountName)
 	if SavingsModuleAccount == nil {
@@ -32,5 +44,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	deposits := k.GetAllDeposits(ctx)
-	return types.NewGenesisState(params, deposits)
+
+	accrualTimes := types.GenesisAccrualTimes{}
+	k.IterateInterestFactors(ctx, func(denom string, interestFactor sdk.Dec) bool {
+		previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
+		if !found {
+			previousAccrualTime = ctx.BlockTime()
+		}
+		accrualTimes = append(accrualTimes, types.NewGenesisAccrualTime(denom, previousAccrualTime, interestFactor))
+		return false
+	})
+
+	return types.NewGenesisState(
+		params,
+		deposits,
+		accrualTimes,
+		k.GetAllLockedDeposits(ctx),
+		k.GetNextLockedDepositID(ctx),
+	)
 }
@@ -41,6 +41,10 @@ func (suite *GenesisTestSuite) SetupTest() {
 func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	params := types.NewParams(
 		[]string{"btc", "ufury", "bnb"},
+		sdk.ZeroDec(),
+		types.LockupTiers{
+			types.NewLockupTier(3, sdk.MustNewDecFromStr("1.5")),
+		},
 	)
 
 	depositAmt := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(1e8)))
@@ -51,7 +55,24 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 			depositAmt, // 100 ufury
 		),
 	}
-	savingsGenesis := types.NewGenesisState(params, deposits)
+	savingsGenesis := types.NewGenesisState(
+		params,
+		deposits,
+		types.GenesisAccrualTimes{
+			types.NewGenesisAccrualTime("usdx", suite.genTime, sdk.MustNewDecFromStr("1.05")),
+		},
+		types.LockedDeposits{
+			types.NewLockedDeposit(
+				1,
+				suite.addrs[0],
+				sdk.NewCoin("ufury", sdkmath.NewInt(5e7)),
+				3,
+				suite.genTime.AddDate(0, 3, 0),
+				sdk.MustNewDecFromStr("1.5"),
+			),
+		},
+		2,
+	)
 
 	authBuilder := app.NewAuthBankGenesisBuilder().
 		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt)
@@ -20,7 +20,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
-	currDeposit, foundDeposit := k.GetDeposit(ctx, depositor)
+	currDeposit, foundDeposit := k.GetSyncedDeposit(ctx, depositor)
 
 	deposit := types.NewDeposit(depositor, coins)
 	if foundDeposit {
@@ -29,6 +29,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 
 	}
 
+	deposit.Index = k.getInterestFactors(ctx, deposit.Amount)
 	k.SetDeposit(ctx, deposit)
 
 	if !foundDeposit {
@@ -162,8 +162,11 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, sdk.ZeroDec(), types.LockupTiers{}),
 				types.Deposits{},
+				nil,
+				nil,
+				types.DefaultNextLockedDepositID,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
@@ -100,6 +100,10 @@ func (s queryServer) Deposits(ctx context.Context, req *types.QueryDepositsReque
 		deposits = deposits[start:end]
 	}
 
+	for i, deposit := range deposits {
+		deposits[i] = s.keeper.loadSyncedDeposit(sdkCtx, deposit)
+	}
+
 	return &types.QueryDepositsResponse{
 		Deposits:   deposits,
 		Pagination: nil,
@@ -112,7 +116,7 @@ func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
 	liquidStakedDerivatives := sdk.NewCoins()
 
 	s.keeper.IterateDeposits(sdkCtx, func(deposit types.Deposit) (stop bool) {
-		for _, c := range deposit.Amount {
+		for _, c := range s.keeper.loadSyncedDeposit(sdkCtx, deposit).Amount {
 			// separate out bfury denoms
 			if strings.HasPrefix(c.Denom, bfuryPrefix) {
 				liquidStakedDerivatives = liquidStakedDerivatives.Add(c)
@@ -140,3 +144,53 @@ func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
 		Result: totalSupply,
 	}, nil
 }
+
+func (s queryServer) LockedDeposits(ctx context.Context, req *types.QueryLockedDepositsRequest) (*types.QueryLockedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	hasDenom := len(req.Denom) > 0
+	hasOwner := len(req.Owner) > 0
+
+	var owner sdk.AccAddress
+	var err error
+	if hasOwner {
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	var lockedDeposits types.LockedDeposits
+	collect := func(lockedDeposit types.LockedDeposit) (stop bool) {
+		if !hasDenom || lockedDeposit.Amount.Denom == req.Denom {
+			lockedDeposits = append(lockedDeposits, lockedDeposit)
+		}
+		return false
+	}
+	if hasOwner {
+		s.keeper.IterateDepositorLockedDeposits(sdkCtx, owner, collect)
+	} else {
+		s.keeper.IterateLockedDeposits(sdkCtx, collect)
+	}
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(lockedDeposits), page, limit, 100)
+	if start < 0 || end < 0 {
+		lockedDeposits = types.LockedDeposits{}
+	} else {
+		lockedDeposits = lockedDeposits[start:end]
+	}
+
+	return &types.QueryLockedDepositsResponse{
+		LockedDeposits: lockedDeposits,
+		Pagination:     nil,
+	}, nil
+}
@@ -59,7 +59,11 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	suite.Require().NoError(err)
 
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}),
+		Params: types.NewParams(
+			[]string{"bnb", "busd", bfury1, bfury2},
+			sdk.ZeroDec(),
+			types.LockupTiers{types.NewLockupTier(3, sdk.MustNewDecFromStr("1.5"))},
+		),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 
@@ -82,7 +86,11 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 
 	var expected types.GenesisState
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}),
+		Params: types.NewParams(
+			[]string{"bnb", "busd", bfury1, bfury2},
+			sdk.ZeroDec(),
+			types.LockupTiers{types.NewLockupTier(3, sdk.MustNewDecFromStr("1.5"))},
+		),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 	suite.tApp.AppCodec().MustUnmarshalJSON(savingsGenState[types.ModuleName], &expected)
@@ -55,13 +55,27 @@ func SolvencyInvariant(k Keeper) sdk.Invariant {
 
 		deposited := sdk.Coins{}
 		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
-			for _, coin := range deposit.Amount {
+			for _, coin := range k.loadSyncedDeposit(ctx, deposit).Amount {
 				deposited = deposited.Add(coin)
 			}
 			return false
 		})
 
-		broken := !deposited.IsEqual(balance)
+		broken := false
+		for _, coin := range balance.Add(deposited...) {
+			depositedAmount := deposited.AmountOf(coin.Denom)
+			balanceAmount := balance.AmountOf(coin.Denom)
+
+			// Syncing savings rate interest truncates, leaving dust in the module account
+			if _, found := k.GetInterestFactor(ctx, coin.Denom); found {
+				if depositedAmount.GT(balanceAmount) {
+					broken = true
+				}
+			} else if !depositedAmount.Equal(balanceAmount) {
+				broken = true
+			}
+		}
+
 		return message, broken
 	}
 }
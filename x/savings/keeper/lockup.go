@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/savings/types"
+)
+
+// LockDeposit locks part of a deposit for the lockup period of a lockup tier.
+// Locked coins cannot be withdrawn until they unlock, and earn savings rewards
+// boosted by the reward multiplier of the tier.
+func (k Keeper) LockDeposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin, monthsLockup int64) (types.LockedDeposit, error) {
+	tier, found := k.GetParams(ctx).LockupTiers.Get(monthsLockup)
+	if !found {
+		return types.LockedDeposit{}, errorsmod.Wrapf(types.ErrInvalidLockupTier, "%d months", monthsLockup)
+	}
+
+	deposit, found := k.GetSyncedDeposit(ctx, depositor)
+	if !found {
+		return types.LockedDeposit{}, errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
+	}
+
+	unlocked := deposit.Amount.AmountOf(amount.Denom).Sub(k.GetLockedAmount(ctx, depositor).AmountOf(amount.Denom))
+	if amount.Amount.GT(unlocked) {
+		return types.LockedDeposit{}, errorsmod.Wrapf(
+			types.ErrInsufficientUnlockedDeposit, "%s%s unlocked, %s requested", unlocked, amount.Denom, amount,
+		)
+	}
+
+	// Sync savings rewards before the reward shares of the deposit change
+	k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+
+	id := k.GetNextLockedDepositID(ctx)
+	unlockTime := ctx.BlockTime().AddDate(0, int(monthsLockup), 0)
+	lockedDeposit := types.NewLockedDeposit(id, depositor, amount, monthsLockup, unlockTime, tier.RewardMultiplier)
+
+	k.SetLockedDeposit(ctx, lockedDeposit)
+	k.SetNextLockedDepositID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsLockDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyLockedDepositID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMonthsLockup, strconv.FormatInt(monthsLockup, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, unlockTime.String()),
+		),
+	)
+
+	return lockedDeposit, nil
+}
+
+// UnlockExpiredDeposits removes all locked deposits with an unlock time at or
+// before the current block time
+func (k Keeper) UnlockExpiredDeposits(ctx sdk.Context) {
+	var expired types.LockedDeposits
+	k.IterateLockedDepositsByTime(ctx, ctx.BlockTime(), func(lockedDeposit types.LockedDeposit) bool {
+		expired = append(expired, lockedDeposit)
+		return false
+	})
+
+	for _, lockedDeposit := range expired {
+		// Sync savings rewards before the reward shares of the deposit change
+		deposit, found := k.GetSyncedDeposit(ctx, lockedDeposit.Depositor)
+		if found {
+			k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+		}
+
+		k.deleteLockedDeposit(ctx, lockedDeposit)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsUnlockDeposit,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lockedDeposit.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositor, lockedDeposit.Depositor.String()),
+				sdk.NewAttribute(types.AttributeKeyLockedDepositID, strconv.FormatUint(lockedDeposit.ID, 10)),
+			),
+		)
+	}
+}
+
+// GetLockedAmount returns the total amount of a depositor's deposit that is locked
+func (k Keeper) GetLockedAmount(ctx sdk.Context, depositor sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+	k.IterateDepositorLockedDeposits(ctx, depositor, func(lockedDeposit types.LockedDeposit) bool {
+		locked = locked.Add(lockedDeposit.Amount)
+		return false
+	})
+	return locked
+}
+
+// GetDepositRewardShares returns the savings reward shares of a deposit, which
+// are its amount plus the reward bonus of its locked deposits
+func (k Keeper) GetDepositRewardShares(ctx sdk.Context, deposit types.Deposit) sdk.DecCoins {
+	shares := sdk.NewDecCoinsFromCoins(deposit.Amount...)
+	k.IterateDepositorLockedDeposits(ctx, deposit.Depositor, func(lockedDeposit types.LockedDeposit) bool {
+		bonus := lockedDeposit.RewardBonus()
+		if bonus.IsPositive() {
+			shares = shares.Add(sdk.NewDecCoinFromDec(lockedDeposit.Amount.Denom, bonus))
+		}
+		return false
+	})
+	return shares
+}
+
+// GetTotalRewardShares returns the total savings reward shares of a denom,
+// which are the amount held by the module account plus the reward bonus of
+// all locked deposits
+func (k Keeper) GetTotalRewardShares(ctx sdk.Context, denom string) sdk.Dec {
+	return sdk.NewDecFromInt(k.GetTotalDeposited(ctx, denom)).Add(k.GetLockedRewardBonus(ctx, denom))
+}
+
+// GetLockedDeposit returns a locked deposit from the store
+func (k Keeper) GetLockedDeposit(ctx sdk.Context, depositor sdk.AccAddress, id uint64) (types.LockedDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	bz := store.Get(types.LockedDepositKey(depositor, id))
+	if bz == nil {
+		return types.LockedDeposit{}, false
+	}
+	var lockedDeposit types.LockedDeposit
+	k.cdc.MustUnmarshal(bz, &lockedDeposit)
+	return lockedDeposit, true
+}
+
+// SetLockedDeposit sets a locked deposit in the store, along with its unlock
+// time index and reward bonus
+func (k Keeper) SetLockedDeposit(ctx sdk.Context, lockedDeposit types.LockedDeposit) {
+	key := types.LockedDepositKey(lockedDeposit.Depositor, lockedDeposit.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	store.Set(key, k.cdc.MustMarshal(&lockedDeposit))
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositByTimeKeyPrefix)
+	timeStore.Set(types.LockedDepositByTimeKey(lockedDeposit.UnlockTime, lockedDeposit.Depositor, lockedDeposit.ID), key)
+
+	bonus := k.GetLockedRewardBonus(ctx, lockedDeposit.Amount.Denom)
+	k.setLockedRewardBonus(ctx, lockedDeposit.Amount.Denom, bonus.Add(lockedDeposit.RewardBonus()))
+}
+
+func (k Keeper) deleteLockedDeposit(ctx sdk.Context, lockedDeposit types.LockedDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	store.Delete(types.LockedDepositKey(lockedDeposit.Depositor, lockedDeposit.ID))
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositByTimeKeyPrefix)
+	timeStore.Delete(types.LockedDepositByTimeKey(lockedDeposit.UnlockTime, lockedDeposit.Depositor, lockedDeposit.ID))
+
+	bonus := k.GetLockedRewardBonus(ctx, lockedDeposit.Amount.Denom)
+	k.setLockedRewardBonus(ctx, lockedDeposit.Amount.Denom, bonus.Sub(lockedDeposit.RewardBonus()))
+}
+
+// IterateLockedDeposits iterates over all locked deposits and performs a callback function
+func (k Keeper) IterateLockedDeposits(ctx sdk.Context, cb func(lockedDeposit types.LockedDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDeposit types.LockedDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &lockedDeposit)
+		if cb(lockedDeposit) {
+			break
+		}
+	}
+}
+
+// IterateDepositorLockedDeposits iterates over the locked deposits of a
+// depositor and performs a callback function
+func (k Keeper) IterateDepositorLockedDeposits(ctx sdk.Context, depositor sdk.AccAddress, cb func(lockedDeposit types.LockedDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositorLockedDepositsKey(depositor))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDeposit types.LockedDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &lockedDeposit)
+		if cb(lockedDeposit) {
+			break
+		}
+	}
+}
+
+// IterateLockedDepositsByTime iterates over the locked deposits that unlock at
+// or before the cutoff time, in order of unlock time, and performs a callback function
+func (k Keeper) IterateLockedDepositsByTime(ctx sdk.Context, cutoffTime time.Time, cb func(lockedDeposit types.LockedDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositByTimeKeyPrefix)
+	lockedDepositStore := prefix.NewStore(ctx.KVStore(k.key), types.LockedDepositKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(cutoffTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDeposit types.LockedDeposit
+		k.cdc.MustUnmarshal(lockedDepositStore.Get(iterator.Value()), &lockedDeposit)
+		if cb(lockedDeposit) {
+			break
+		}
+	}
+}
+
+// GetAllLockedDeposits returns all locked deposits from the store
+func (k Keeper) GetAllLockedDeposits(ctx sdk.Context) (lockedDeposits types.LockedDeposits) {
+	k.IterateLockedDeposits(ctx, func(lockedDeposit types.LockedDeposit) bool {
+		lockedDeposits = append(lockedDeposits, lockedDeposit)
+		return false
+	})
+	return
+}
+
+// GetLockedRewardBonus returns the total reward bonus of locked deposits of a denom
+func (k Keeper) GetLockedRewardBonus(ctx sdk.Context, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedRewardBonusKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var bonus sdk.Dec
+	if err := bonus.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return bonus
+}
+
+func (k Keeper) setLockedRewardBonus(ctx sdk.Context, denom string, bonus sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockedRewardBonusKeyPrefix)
+	if bonus.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+	bz, err := bonus.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// GetNextLockedDepositID returns the id of the next locked deposit
+func (k Keeper) GetNextLockedDepositID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextLockedDepositIDKey)
+	if bz == nil {
+		return types.DefaultNextLockedDepositID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextLockedDepositID sets the id of the next locked deposit
+func (k Keeper) SetNextLockedDepositID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextLockedDepositIDKey, sdk.Uint64ToBigEndian(id))
+}
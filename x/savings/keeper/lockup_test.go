@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/app"
+	"github.com/mage-coven/fury/x/savings/keeper"
+	"github.com/mage-coven/fury/x/savings/types"
+)
+
+// setupLockup sets lockup tiers for usdx deposits and deposits usdx from a
+// funded account
+func (suite *KeeperTestSuite) setupLockup(depositAmount int64) sdk.AccAddress {
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"usdx"},
+		sdk.ZeroDec(),
+		types.LockupTiers{
+			types.NewLockupTier(1, sdk.MustNewDecFromStr("1.1")),
+			types.NewLockupTier(3, sdk.MustNewDecFromStr("1.5")),
+			types.NewLockupTier(6, sdk.MustNewDecFromStr("2")),
+		},
+	))
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	err := suite.app.FundAccount(suite.ctx, addrs[0], cs(c("usdx", 2000e6)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Deposit(suite.ctx, addrs[0], cs(c("usdx", depositAmount)))
+	suite.Require().NoError(err)
+
+	return addrs[0]
+}
+
+func (suite *KeeperTestSuite) TestLockDeposit() {
+	depositor := suite.setupLockup(1000e6)
+
+	lockedDeposit, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 400e6), 3)
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultNextLockedDepositID, lockedDeposit.ID)
+	suite.Equal(suite.ctx.BlockTime().AddDate(0, 3, 0), lockedDeposit.UnlockTime)
+	suite.Equal(sdk.MustNewDecFromStr("1.5"), lockedDeposit.RewardMultiplier)
+
+	stored, found := suite.keeper.GetLockedDeposit(suite.ctx, depositor, lockedDeposit.ID)
+	suite.Require().True(found)
+	suite.Equal(lockedDeposit.Amount, stored.Amount)
+	suite.Equal(types.DefaultNextLockedDepositID+1, suite.keeper.GetNextLockedDepositID(suite.ctx))
+
+	suite.Equal(cs(c("usdx", 400e6)), suite.keeper.GetLockedAmount(suite.ctx, depositor))
+
+	// Locked coins earn reward shares boosted by the tier multiplier
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, depositor)
+	shares := suite.keeper.GetDepositRewardShares(suite.ctx, deposit)
+	suite.Equal(sdk.NewDec(1200e6), shares.AmountOf("usdx"))
+	suite.Equal(sdk.NewDec(1200e6), suite.keeper.GetTotalRewardShares(suite.ctx, "usdx"))
+}
+
+func (suite *KeeperTestSuite) TestLockDeposit_Invalid() {
+	depositor := suite.setupLockup(1000e6)
+
+	_, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 100e6), 2)
+	suite.ErrorIs(err, types.ErrInvalidLockupTier)
+
+	_, err = suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 1001e6), 1)
+	suite.ErrorIs(err, types.ErrInsufficientUnlockedDeposit)
+
+	// Coins that are already locked cannot be locked again
+	_, err = suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 600e6), 1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 500e6), 6)
+	suite.ErrorIs(err, types.ErrInsufficientUnlockedDeposit)
+
+	_, err = suite.keeper.LockDeposit(suite.ctx, sdk.AccAddress("no deposit"), c("usdx", 1e6), 1)
+	suite.ErrorIs(err, types.ErrNoDepositFound)
+}
+
+func (suite *KeeperTestSuite) TestWithdraw_Locked() {
+	depositor := suite.setupLockup(1000e6)
+
+	_, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 400e6), 1)
+	suite.Require().NoError(err)
+
+	// Withdrawals are limited to the unlocked part of the deposit
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 1000e6)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 1600e6)), suite.app.GetBankKeeper().GetAllBalances(suite.ctx, depositor))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 400e6)), deposit.Amount)
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 1e6)))
+	suite.ErrorIs(err, types.ErrInsufficientUnlockedDeposit)
+
+	// Deposits unlock once the lockup period has passed
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().AddDate(0, 1, 0))
+	suite.keeper.UnlockExpiredDeposits(suite.ctx)
+
+	suite.True(suite.keeper.GetLockedAmount(suite.ctx, depositor).Empty())
+	suite.True(suite.keeper.GetLockedRewardBonus(suite.ctx, "usdx").IsZero())
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 400e6)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 2000e6)), suite.app.GetBankKeeper().GetAllBalances(suite.ctx, depositor))
+}
+
+func (suite *KeeperTestSuite) TestUnlockExpiredDeposits() {
+	depositor := suite.setupLockup(1000e6)
+
+	short, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 100e6), 1)
+	suite.Require().NoError(err)
+	long, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 200e6), 6)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().AddDate(0, 3, 0))
+	suite.keeper.UnlockExpiredDeposits(suite.ctx)
+
+	_, found := suite.keeper.GetLockedDeposit(suite.ctx, depositor, short.ID)
+	suite.False(found)
+	_, found = suite.keeper.GetLockedDeposit(suite.ctx, depositor, long.ID)
+	suite.True(found)
+
+	suite.Equal(cs(c("usdx", 200e6)), suite.keeper.GetLockedAmount(suite.ctx, depositor))
+	suite.Equal(sdk.NewDec(200e6), suite.keeper.GetLockedRewardBonus(suite.ctx, "usdx"))
+	suite.Equal(sdk.NewDec(1200e6), suite.keeper.GetTotalRewardShares(suite.ctx, "usdx"))
+}
+
+func (suite *KeeperTestSuite) TestGrpcQueryLockedDeposits() {
+	depositor := suite.setupLockup(1000e6)
+
+	_, err := suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 100e6), 1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.LockDeposit(suite.ctx, depositor, c("usdx", 200e6), 6)
+	suite.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.LockedDeposits(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryLockedDepositsRequest{Owner: depositor.String(), Denom: "usdx"},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.LockedDeposits, 2)
+	suite.Equal(int64(1), res.LockedDeposits[0].MonthsLockup)
+	suite.Equal(int64(6), res.LockedDeposits[1].MonthsLockup)
+
+	res, err = queryServer.LockedDeposits(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryLockedDepositsRequest{Denom: "ufury"},
+	)
+	suite.Require().NoError(err)
+	suite.Empty(res.LockedDeposits)
+}
@@ -1,19 +1,48 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/savings/keeper"
 	"github.com/mage-coven/fury/x/savings/types"
 )
 
 func (suite *KeeperTestSuite) TestGetSetParams() {
 	params := suite.keeper.GetParams(suite.ctx)
 	suite.Require().Equal(
-		types.Params{SupportedDenoms: []string(nil)},
+		types.Params{SupportedDenoms: []string(nil), SavingsRate: sdk.ZeroDec()},
 		params,
 	)
 
-	newParams := types.NewParams([]string{"btc", "test"})
+	newParams := types.NewParams(
+		[]string{"btc", "test"},
+		sdk.MustNewDecFromStr("0.05"),
+		types.LockupTiers{types.NewLockupTier(6, sdk.MustNewDecFromStr("2"))},
+	)
 	suite.keeper.SetParams(suite.ctx, newParams)
 
 	fetchedParams := suite.keeper.GetParams(suite.ctx)
 	suite.Require().Equal(newParams, fetchedParams)
 }
+
+func (suite *KeeperTestSuite) TestGetParams_MissingParams() {
+	// setup a params store that lacks the savings rate and lockup tiers, as
+	// on a chain started before they were added
+	oldParamStore := suite.app.GetParamsKeeper().Subspace("test_subspace_for_savings")
+	oldParamStore.WithKeyTable(types.ParamKeyTable())
+	oldParamStore.Set(suite.ctx, types.KeySupportedDenoms, []string{"usdx"})
+
+	oldStateKeeper := keeper.NewKeeper(
+		suite.app.AppCodec(),
+		sdk.NewKVStoreKey(types.StoreKey),
+		oldParamStore,
+		suite.app.GetAccountKeeper(),
+		suite.app.GetBankKeeper(),
+		nil,
+	)
+
+	// Missing params are set by the upgrade handler, not defaulted by the keeper
+	suite.Require().Panics(func() {
+		oldStateKeeper.GetParams(suite.ctx)
+	})
+}
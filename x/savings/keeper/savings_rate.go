@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/x/savings/types"
+)
+
+const secondsPerYear = 31536000
+
+// AccrueSavingsRate pays the savings rate accrued on deposits of a denom since
+// the previous accrual. The interest is sent from the funding module account
+// to the savings module account, and is limited to the available amount.
+func (k Keeper) AccrueSavingsRate(ctx sdk.Context, fundingModule string, available sdk.Coin) error {
+	denom := available.Denom
+
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
+	if !found {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		k.SetInterestFactor(ctx, denom, sdk.OneDec())
+		return nil
+	}
+
+	timeElapsed := ctx.BlockTime().Unix() - previousAccrualTime.Unix()
+	if timeElapsed <= 0 {
+		return nil
+	}
+
+	savingsRate := k.GetParams(ctx).SavingsRate
+	totalDeposited := k.GetTotalDeposited(ctx, denom)
+	if !k.IsDenomSupported(ctx, denom) || savingsRate.IsZero() || totalDeposited.IsZero() {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		return nil
+	}
+
+	// Unsynced interest is held by the module account, so it is compounded
+	interest := sdk.NewDecFromInt(totalDeposited).
+		Mul(savingsRate).
+		MulInt64(timeElapsed).
+		QuoInt64(secondsPerYear).
+		TruncateInt()
+	if interest.IsZero() {
+		// Accrue again once the interest is at least one unit
+		return nil
+	}
+
+	interest = sdk.MinInt(interest, available.Amount)
+	if interest.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			fundingModule,
+			types.ModuleAccountName,
+			sdk.NewCoins(sdk.NewCoin(denom, interest)),
+		)
+		if err != nil {
+			return err
+		}
+
+		interestFactor, found := k.GetInterestFactor(ctx, denom)
+		if !found {
+			interestFactor = sdk.OneDec()
+		}
+
+		// The interest factor grows by the interest paid relative to the total deposited
+		interestFactor = interestFactor.Mul(
+			sdk.OneDec().Add(sdk.NewDecFromInt(interest).QuoInt(totalDeposited)),
+		)
+		k.SetInterestFactor(ctx, denom, interestFactor)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsRateAccrual,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(denom, interest).String()),
+				sdk.NewAttribute(types.AttributeKeyInterestFactor, interestFactor.String()),
+			),
+		)
+	}
+
+	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+	return nil
+}
+
+// GetSyncedDeposit returns a deposit with the savings rate interest accrued
+// since it was last synced, without updating state.
+func (k Keeper) GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return types.Deposit{}, false
+	}
+
+	return k.loadSyncedDeposit(ctx, deposit), true
+}
+
+// loadSyncedDeposit calculates a deposit with the savings rate interest
+// accrued since it was last synced, but does not update state
+func (k Keeper) loadSyncedDeposit(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	syncedAmount := sdk.Coins{}
+	for _, coin := range deposit.Amount {
+		interestFactor, found := k.GetInterestFactor(ctx, coin.Denom)
+		if !found {
+			syncedAmount = syncedAmount.Add(coin)
+			continue
+		}
+
+		// Deposits without an index for a denom were made before its first accrual
+		depositIndex, found := deposit.Index.Get(coin.Denom)
+		if !found {
+			depositIndex = sdk.OneDec()
+		}
+
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(interestFactor).Quo(depositIndex).TruncateInt()
+		syncedAmount = syncedAmount.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	deposit.Amount = syncedAmount
+	deposit.Index = k.getInterestFactors(ctx, syncedAmount)
+	return deposit
+}
+
+// getInterestFactors returns the current interest factors of the denoms of
+// coins that have one
+func (k Keeper) getInterestFactors(ctx sdk.Context, coins sdk.Coins) types.InterestFactors {
+	var interestFactors types.InterestFactors
+	for _, coin := range coins {
+		interestFactor, found := k.GetInterestFactor(ctx, coin.Denom)
+		if found {
+			interestFactors = append(interestFactors, types.NewInterestFactor(coin.Denom, interestFactor))
+		}
+	}
+	return interestFactors
+}
+
+// GetPreviousAccrualTime returns the last time the savings rate of a denom accrued
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, denom string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimeKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var previousAccrualTime time.Time
+	if err := previousAccrualTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return previousAccrualTime, true
+}
+
+// SetPreviousAccrualTime sets the last time the savings rate of a denom accrued
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAccrualTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimeKeyPrefix)
+	bz, err := previousAccrualTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// GetInterestFactor returns the current savings rate interest factor of a denom
+func (k Keeper) GetInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var interestFactor sdk.Dec
+	if err := interestFactor.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return interestFactor, true
+}
+
+// SetInterestFactor sets the current savings rate interest factor of a denom
+func (k Keeper) SetInterestFactor(ctx sdk.Context, denom string, interestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	bz, err := interestFactor.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IterateInterestFactors iterates over all savings rate interest factors and
+// performs a callback function
+func (k Keeper) IterateInterestFactors(ctx sdk.Context, cb func(denom string, interestFactor sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var interestFactor sdk.Dec
+		if err := interestFactor.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), interestFactor) {
+			break
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mage-coven/fury/app"
+	cdptypes "github.com/mage-coven/fury/x/cdp/types"
+	"github.com/mage-coven/fury/x/savings/keeper"
+	"github.com/mage-coven/fury/x/savings/types"
+)
+
+const secondsPerYear = 31536000
+
+// setupSavingsRate sets a savings rate on usdx deposits and funds accounts
+// and the liquidator module account with usdx
+func (suite *KeeperTestSuite) setupSavingsRate(rate sdk.Dec, surplus sdkmath.Int) []sdk.AccAddress {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"usdx"}, rate, types.LockupTiers{}))
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	for _, addr := range addrs {
+		err := suite.app.FundAccount(suite.ctx, addr, cs(c("usdx", 2000e6)))
+		suite.Require().NoError(err)
+	}
+
+	if surplus.IsPositive() {
+		err := suite.app.FundModuleAccount(suite.ctx, cdptypes.LiquidatorMacc, cs(sdk.NewCoin("usdx", surplus)))
+		suite.Require().NoError(err)
+	}
+
+	return addrs
+}
+
+// accrue accrues the savings rate with the usdx balance of the liquidator module
+// account available
+func (suite *KeeperTestSuite) accrue() {
+	available := suite.app.GetModuleAccountBalance(suite.ctx, cdptypes.LiquidatorMacc, "usdx")
+	err := suite.keeper.AccrueSavingsRate(suite.ctx, cdptypes.LiquidatorMacc, sdk.NewCoin("usdx", available))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) advanceTime(seconds int64) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(seconds) * time.Second))
+}
+
+func (suite *KeeperTestSuite) TestAccrueSavingsRate() {
+	addrs := suite.setupSavingsRate(sdk.MustNewDecFromStr("0.1"), sdkmath.NewInt(1000e6))
+
+	err := suite.keeper.Deposit(suite.ctx, addrs[0], cs(c("usdx", 1000e6)))
+	suite.Require().NoError(err)
+
+	// The first accrual starts the interest factor
+	suite.accrue()
+	interestFactor, found := suite.keeper.GetInterestFactor(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.OneDec(), interestFactor)
+
+	suite.advanceTime(secondsPerYear)
+	suite.accrue()
+
+	interestFactor, _ = suite.keeper.GetInterestFactor(suite.ctx, "usdx")
+	suite.Equal(sdk.MustNewDecFromStr("1.1"), interestFactor)
+	suite.Equal(sdkmath.NewInt(1100e6), suite.app.GetModuleAccountBalance(suite.ctx, types.ModuleAccountName, "usdx"))
+	suite.Equal(sdkmath.NewInt(900e6), suite.app.GetModuleAccountBalance(suite.ctx, cdptypes.LiquidatorMacc, "usdx"))
+
+	deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, addrs[0])
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 1100e6)), deposit.Amount)
+
+	// Later deposits do not earn interest accrued before them
+	err = suite.keeper.Deposit(suite.ctx, addrs[1], cs(c("usdx", 1100e6)))
+	suite.Require().NoError(err)
+
+	suite.advanceTime(secondsPerYear)
+	suite.accrue()
+
+	for _, addr := range addrs {
+		deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, addr)
+		suite.Require().True(found)
+		suite.Equal(cs(c("usdx", 1210e6)), deposit.Amount)
+	}
+
+	_, broken := keeper.SolvencyInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// The full deposit including interest can be withdrawn
+	err = suite.keeper.Withdraw(suite.ctx, addrs[0], cs(c("usdx", 1210e6)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 2210e6)), suite.app.GetBankKeeper().GetAllBalances(suite.ctx, addrs[0]))
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, addrs[0])
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestAccrueSavingsRate_LimitedBySurplus() {
+	addrs := suite.setupSavingsRate(sdk.MustNewDecFromStr("0.1"), sdkmath.NewInt(50e6))
+
+	err := suite.keeper.Deposit(suite.ctx, addrs[0], cs(c("usdx", 1000e6)))
+	suite.Require().NoError(err)
+
+	suite.accrue()
+	suite.advanceTime(secondsPerYear)
+	suite.accrue()
+
+	interestFactor, _ := suite.keeper.GetInterestFactor(suite.ctx, "usdx")
+	suite.Equal(sdk.MustNewDecFromStr("1.05"), interestFactor)
+	suite.True(suite.app.GetModuleAccountBalance(suite.ctx, cdptypes.LiquidatorMacc, "usdx").IsZero())
+
+	// Without surplus no interest is paid, and none is owed later
+	suite.advanceTime(secondsPerYear)
+	suite.accrue()
+
+	err = suite.app.FundModuleAccount(suite.ctx, cdptypes.LiquidatorMacc, cs(c("usdx", 1000e6)))
+	suite.Require().NoError(err)
+	suite.accrue()
+
+	interestFactor, _ = suite.keeper.GetInterestFactor(suite.ctx, "usdx")
+	suite.Equal(sdk.MustNewDecFromStr("1.05"), interestFactor)
+
+	deposit, _ := suite.keeper.GetSyncedDeposit(suite.ctx, addrs[0])
+	suite.Equal(cs(c("usdx", 1050e6)), deposit.Amount)
+}
+
+func (suite *KeeperTestSuite) TestAccrueSavingsRate_ZeroRate() {
+	addrs := suite.setupSavingsRate(sdk.ZeroDec(), sdkmath.NewInt(1000e6))
+
+	err := suite.keeper.Deposit(suite.ctx, addrs[0], cs(c("usdx", 1000e6)))
+	suite.Require().NoError(err)
+
+	suite.accrue()
+	suite.advanceTime(secondsPerYear)
+	suite.accrue()
+
+	previousAccrualTime, found := suite.keeper.GetPreviousAccrualTime(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(suite.ctx.BlockTime().Unix(), previousAccrualTime.Unix())
+
+	interestFactor, _ := suite.keeper.GetInterestFactor(suite.ctx, "usdx")
+	suite.Equal(sdk.OneDec(), interestFactor)
+	suite.Equal(sdkmath.NewInt(1000e6), suite.app.GetModuleAccountBalance(suite.ctx, cdptypes.LiquidatorMacc, "usdx"))
+}
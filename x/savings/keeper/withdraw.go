@@ -11,12 +11,24 @@ import (
 
 // Withdraw returns some or all of a deposit back to original depositor
 func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
-	deposit, found := k.GetDeposit(ctx, depositor)
+	deposit, found := k.GetSyncedDeposit(ctx, depositor)
 	if !found {
 		return errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
 	}
 
-	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
+	if !coins.DenomsSubsetOf(deposit.Amount) {
+		return types.ErrInvalidWithdrawDenom
+	}
+
+	// Locked coins cannot be withdrawn before they unlock
+	unlocked, _ := deposit.Amount.SafeSub(k.GetLockedAmount(ctx, depositor)...)
+	for _, coin := range coins {
+		if !unlocked.AmountOf(coin.Denom).IsPositive() {
+			return errorsmod.Wrapf(types.ErrInsufficientUnlockedDeposit, "no unlocked %s deposit", coin.Denom)
+		}
+	}
+
+	amount, err := k.CalculateWithdrawAmount(unlocked, coins)
 	if err != nil {
 		return err
 	}
@@ -27,6 +39,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	}
 
 	deposit.Amount = deposit.Amount.Sub(amount...)
+	deposit.Index = k.getInterestFactors(ctx, deposit.Amount)
 	if deposit.Amount.Empty() {
 		k.DeleteDeposit(ctx, deposit)
 	} else {
@@ -23,7 +23,7 @@ func (d Deposit) Validate() error {
 		return fmt.Errorf("invalid deposit coins: %s", d.Amount)
 	}
 
-	return nil
+	return d.Index.Validate()
 }
 
 // Deposits is a slice of Deposit
@@ -44,3 +44,51 @@ func (ds Deposits) Validate() error {
 	}
 	return nil
 }
+
+// NewInterestFactor returns a new interest factor
+func NewInterestFactor(denom string, value sdk.Dec) InterestFactor {
+	return InterestFactor{
+		Denom: denom,
+		Value: value,
+	}
+}
+
+// Validate validates interest factor
+func (f InterestFactor) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if f.Value.IsNil() || !f.Value.IsPositive() {
+		return fmt.Errorf("interest factor value must be positive: %s", f.Value)
+	}
+
+	return nil
+}
+
+// InterestFactors is a slice of InterestFactor
+type InterestFactors []InterestFactor
+
+// Get returns the interest factor of a denom
+func (fs InterestFactors) Get(denom string) (sdk.Dec, bool) {
+	for _, f := range fs {
+		if f.Denom == denom {
+			return f.Value, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// Validate validates InterestFactors
+func (fs InterestFactors) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, f := range fs {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[f.Denom] {
+			return fmt.Errorf("duplicate interest factor denom: %s", f.Denom)
+		}
+		seenDenoms[f.Denom] = true
+	}
+	return nil
+}
@@ -13,4 +13,8 @@ var (
 	ErrInvalidDepositDenom = errorsmod.Register(ModuleName, 4, "invalid deposit denom")
 	// ErrInvalidWithdrawDenom error for invalid withdraw denoms
 	ErrInvalidWithdrawDenom = errorsmod.Register(ModuleName, 5, "invalid withdraw denom")
+	// ErrInvalidLockupTier error for a lockup period without a lockup tier
+	ErrInvalidLockupTier = errorsmod.Register(ModuleName, 6, "invalid lockup tier")
+	// ErrInsufficientUnlockedDeposit error when a deposit has too few unlocked coins
+	ErrInsufficientUnlockedDeposit = errorsmod.Register(ModuleName, 7, "insufficient unlocked deposit")
 )
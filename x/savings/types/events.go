@@ -1,10 +1,17 @@
 package types
 
 const (
-	EventTypeSavingsDeposit    = "deposit_savings"
-	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeSavingsDeposit       = "deposit_savings"
+	EventTypeSavingsWithdrawal    = "withdraw_savings"
+	EventTypeSavingsRateAccrual   = "savings_rate_accrual"
+	EventTypeSavingsLockDeposit   = "lock_savings_deposit"
+	EventTypeSavingsUnlockDeposit = "unlock_savings_deposit"
 
-	AttributeValueCategory = ModuleName
-	AttributeKeyAmount     = "amount"
-	AttributeKeyDepositor  = "depositor"
+	AttributeValueCategory      = ModuleName
+	AttributeKeyAmount          = "amount"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeyInterestFactor  = "interest_factor"
+	AttributeKeyLockedDepositID = "locked_deposit_id"
+	AttributeKeyMonthsLockup    = "months_lockup"
+	AttributeKeyUnlockTime      = "unlock_time"
 )
@@ -1,10 +1,26 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state for the savings module
-func NewGenesisState(p Params, deposits Deposits) GenesisState {
+func NewGenesisState(
+	p Params,
+	deposits Deposits,
+	accrualTimes GenesisAccrualTimes,
+	lockedDeposits LockedDeposits,
+	nextLockedDepositID uint64,
+) GenesisState {
 	return GenesisState{
-		Params:   p,
-		Deposits: deposits,
+		Params:              p,
+		Deposits:            deposits,
+		AccrualTimes:        accrualTimes,
+		LockedDeposits:      lockedDeposits,
+		NextLockedDepositID: nextLockedDepositID,
 	}
 }
 
@@ -13,6 +29,9 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		Deposits{},
+		GenesisAccrualTimes{},
+		LockedDeposits{},
+		DefaultNextLockedDepositID,
 	)
 }
 
@@ -23,5 +42,64 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Deposits.Validate()
+	if err := gs.Deposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.AccrualTimes.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.LockedDeposits.Validate(); err != nil {
+		return err
+	}
+
+	for _, lockedDeposit := range gs.LockedDeposits {
+		if lockedDeposit.ID >= gs.NextLockedDepositID {
+			return fmt.Errorf(
+				"locked deposit id %d must be less than the next locked deposit id %d",
+				lockedDeposit.ID, gs.NextLockedDepositID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// NewGenesisAccrualTime returns a new GenesisAccrualTime
+func NewGenesisAccrualTime(denom string, prevAccrualTime time.Time, interestFactor sdk.Dec) GenesisAccrualTime {
+	return GenesisAccrualTime{
+		Denom:               denom,
+		PreviousAccrualTime: prevAccrualTime,
+		InterestFactor:      interestFactor,
+	}
+}
+
+// Validate performs validation of GenesisAccrualTime
+func (gat GenesisAccrualTime) Validate() error {
+	if err := sdk.ValidateDenom(gat.Denom); err != nil {
+		return err
+	}
+	if gat.InterestFactor.IsNil() || gat.InterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest factor should be ≥ 1.0, is %s for %s", gat.InterestFactor, gat.Denom)
+	}
+	return nil
+}
+
+// GenesisAccrualTimes slice of GenesisAccrualTime
+type GenesisAccrualTimes []GenesisAccrualTime
+
+// Validate performs validation of GenesisAccrualTimes
+func (gats GenesisAccrualTimes) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, gat := range gats {
+		if err := gat.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[gat.Denom] {
+			return fmt.Errorf("duplicate accrual time denom: %s", gat.Denom)
+		}
+		seenDenoms[gat.Denom] = true
+	}
+	return nil
 }
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,15 +32,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the savings module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Deposits Deposits `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Params              Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Deposits            Deposits            `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	AccrualTimes        GenesisAccrualTimes `protobuf:"bytes,3,rep,name=accrual_times,json=accrualTimes,proto3,castrepeated=GenesisAccrualTimes" json:"accrual_times"`
+	LockedDeposits      LockedDeposits      `protobuf:"bytes,4,rep,name=locked_deposits,json=lockedDeposits,proto3,castrepeated=LockedDeposits" json:"locked_deposits"`
+	NextLockedDepositID uint64              `protobuf:"varint,5,opt,name=next_locked_deposit_id,json=nextLockedDepositId,proto3" json:"next_locked_deposit_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5dcde4d417fcec8, []int{0}
+	return fileDescriptor_59721b55eaed036b, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,32 +86,125 @@ func (m *GenesisState) GetDeposits() Deposits {
 	return nil
 }
 
+func (m *GenesisState) GetAccrualTimes() GenesisAccrualTimes {
+	if m != nil {
+		return m.AccrualTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedDeposits() LockedDeposits {
+	if m != nil {
+		return m.LockedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockedDepositID() uint64 {
+	if m != nil {
+		return m.NextLockedDepositID
+	}
+	return 0
+}
+
+// GenesisAccrualTime stores the previous savings rate accrual time and the
+// interest factor of a denom.
+type GenesisAccrualTime struct {
+	Denom               string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousAccrualTime time.Time                              `protobuf:"bytes,2,opt,name=previous_accrual_time,json=previousAccrualTime,proto3,stdtime" json:"previous_accrual_time"`
+	InterestFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=interest_factor,json=interestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_factor"`
+}
+
+func (m *GenesisAccrualTime) Reset()         { *m = GenesisAccrualTime{} }
+func (m *GenesisAccrualTime) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccrualTime) ProtoMessage()    {}
+func (*GenesisAccrualTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59721b55eaed036b, []int{1}
+}
+func (m *GenesisAccrualTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccrualTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccrualTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccrualTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccrualTime.Merge(m, src)
+}
+func (m *GenesisAccrualTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccrualTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccrualTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccrualTime proto.InternalMessageInfo
+
+func (m *GenesisAccrualTime) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GenesisAccrualTime) GetPreviousAccrualTime() time.Time {
+	if m != nil {
+		return m.PreviousAccrualTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.savings.v1beta1.GenesisState")
+	proto.RegisterType((*GenesisAccrualTime)(nil), "fury.savings.v1beta1.GenesisAccrualTime")
 }
 
 func init() {
-	proto.RegisterFile("fury/savings/v1beta1/genesis.proto", fileDescriptor_f5dcde4d417fcec8)
-}
-
-var fileDescriptor_f5dcde4d417fcec8 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x4e, 0x2c, 0xcb, 0xcc, 0x4b, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x57, 0x5c, 0x92, 0x5f, 0x94, 0x0a, 0x51,
-	0xa1, 0x34, 0x9d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c,
-	0x1e, 0x36, 0xfb, 0xf4, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea,
-	0x10, 0xf2, 0xe6, 0xe2, 0x48, 0x49, 0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x29, 0x96, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x92, 0xc5, 0xae, 0xdb, 0x05, 0xa2, 0xca, 0x49, 0x00, 0xa4, 0x7d, 0xd5, 0x7d,
-	0x79, 0x0e, 0xa8, 0x40, 0x71, 0x10, 0xdc, 0x00, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x07, 0x19, 0xaf, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57, 0xc0, 0x3d, 0x5b, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x68, 0xf5,
-	0x36, 0x59, 0x01, 0x00, 0x00,
+	proto.RegisterFile("fury/savings/v1beta1/genesis.proto", fileDescriptor_59721b55eaed036b)
+}
+
+var fileDescriptor_59721b55eaed036b = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0x6f, 0xb6, 0xbb, 0x4b, 0x9d, 0x5d, 0xbb, 0x92, 0xd6, 0x35, 0x56, 0x4d, 0x4a, 0x05, 0x29,
+	0x42, 0x27, 0xec, 0x7a, 0x13, 0x2f, 0x86, 0xa2, 0x88, 0x8b, 0x48, 0xdc, 0x83, 0x78, 0x09, 0xd3,
+	0xe4, 0x6b, 0x8c, 0xdb, 0x64, 0x42, 0x66, 0x52, 0xba, 0x6f, 0xb1, 0xcf, 0xe1, 0x59, 0xf0, 0x15,
+	0xf6, 0xb8, 0x78, 0x12, 0x0f, 0x5d, 0x69, 0x8f, 0xbe, 0x84, 0x64, 0x66, 0x52, 0x5a, 0x9a, 0x53,
+	0xfb, 0x7d, 0xfd, 0xfd, 0xf9, 0x7e, 0x9d, 0x1f, 0xea, 0x8d, 0xf3, 0xec, 0xd2, 0x66, 0x64, 0x1a,
+	0x25, 0x21, 0xb3, 0xa7, 0x27, 0x23, 0xe0, 0xe4, 0xc4, 0x0e, 0x21, 0x01, 0x16, 0x31, 0x9c, 0x66,
+	0x94, 0x53, 0xbd, 0x5d, 0x60, 0xb0, 0xc2, 0x60, 0x85, 0xe9, 0x3c, 0xf4, 0x29, 0x8b, 0x29, 0xf3,
+	0x04, 0xc6, 0x96, 0x83, 0x24, 0x74, 0xda, 0x21, 0x0d, 0xa9, 0xdc, 0x17, 0xdf, 0xd4, 0xd6, 0x0a,
+	0x29, 0x0d, 0x27, 0x60, 0x8b, 0x69, 0x94, 0x8f, 0x6d, 0x1e, 0xc5, 0xc0, 0x38, 0x89, 0x53, 0x05,
+	0xe8, 0x56, 0xde, 0xc2, 0x38, 0xcd, 0x40, 0x22, 0x7a, 0x3f, 0xeb, 0xe8, 0xf0, 0xad, 0xbc, 0xed,
+	0x13, 0x27, 0x1c, 0xf4, 0x97, 0x68, 0x3f, 0x25, 0x19, 0x89, 0x99, 0xa1, 0x75, 0xb5, 0xfe, 0xc1,
+	0xe9, 0x63, 0x5c, 0x75, 0x2b, 0xfe, 0x28, 0x30, 0xce, 0xee, 0xf5, 0xdc, 0xaa, 0xb9, 0x8a, 0xa1,
+	0xbf, 0x47, 0x8d, 0x00, 0x52, 0xca, 0x22, 0xce, 0x8c, 0x9d, 0x6e, 0xbd, 0x7f, 0x70, 0xfa, 0xa4,
+	0x9a, 0x3d, 0x94, 0x28, 0xe7, 0x5e, 0x41, 0xff, 0x7e, 0x6b, 0x35, 0xd4, 0x82, 0xb9, 0x2b, 0x01,
+	0xfd, 0x1b, 0xba, 0x4b, 0x7c, 0x3f, 0xcb, 0xc9, 0xc4, 0x13, 0xb1, 0x8c, 0xba, 0x50, 0xec, 0x57,
+	0x2b, 0xaa, 0x0c, 0xaf, 0x25, 0xe3, 0x3c, 0x8a, 0xc1, 0x79, 0xa4, 0xc4, 0x5b, 0xdb, 0xbf, 0x31,
+	0xf7, 0x90, 0xac, 0x4d, 0x7a, 0x80, 0x8e, 0x26, 0xd4, 0xbf, 0x80, 0xc0, 0x5b, 0xdd, 0xbf, 0x2b,
+	0xdc, 0x9e, 0x56, 0xbb, 0x9d, 0x09, 0x70, 0x99, 0xe2, 0x58, 0x19, 0x35, 0x37, 0xd6, 0xcc, 0x6d,
+	0x4e, 0x36, 0x66, 0xfd, 0x0c, 0x1d, 0x27, 0x30, 0xe3, 0xde, 0xa6, 0x95, 0x17, 0x05, 0xc6, 0x5e,
+	0x57, 0xeb, 0xef, 0x3a, 0x0f, 0x16, 0x73, 0xab, 0xf5, 0x01, 0x66, 0x7c, 0x43, 0xe7, 0xdd, 0xd0,
+	0x6d, 0x25, 0x5b, 0xcb, 0xa0, 0xf7, 0x4f, 0x43, 0xfa, 0x76, 0x32, 0xbd, 0x8d, 0xf6, 0x02, 0x48,
+	0x68, 0x2c, 0x9e, 0xef, 0x8e, 0x2b, 0x07, 0xfd, 0x33, 0xba, 0x9f, 0x66, 0x30, 0x8d, 0x68, 0xce,
+	0xbc, 0xf5, 0x7f, 0xd5, 0xd8, 0x11, 0x8f, 0xdc, 0xc1, 0xb2, 0x49, 0xb8, 0x6c, 0x12, 0x3e, 0x2f,
+	0x9b, 0xe4, 0x34, 0x8a, 0x74, 0x57, 0xb7, 0x96, 0xe6, 0xb6, 0x4a, 0x89, 0x75, 0x3f, 0x40, 0x47,
+	0x51, 0xc2, 0x21, 0x03, 0xc6, 0xbd, 0x31, 0xf1, 0x39, 0xcd, 0x8c, 0x7a, 0xe1, 0xec, 0xbc, 0x2a,
+	0x78, 0x7f, 0xe6, 0xd6, 0xb3, 0x30, 0xe2, 0x5f, 0xf3, 0x11, 0xf6, 0x69, 0xac, 0x3a, 0xad, 0x3e,
+	0x06, 0x2c, 0xb8, 0xb0, 0xf9, 0x65, 0x0a, 0x0c, 0x0f, 0xc1, 0xff, 0xf5, 0x63, 0x80, 0xe4, 0xbe,
+	0x98, 0xdc, 0x66, 0x29, 0xfa, 0x46, 0x68, 0x3a, 0xc3, 0xeb, 0x85, 0xa9, 0xdd, 0x2c, 0x4c, 0xed,
+	0xef, 0xc2, 0xd4, 0xae, 0x96, 0x66, 0xed, 0x66, 0x69, 0xd6, 0x7e, 0x2f, 0xcd, 0xda, 0x97, 0xe7,
+	0x6b, 0xfa, 0x31, 0x09, 0x61, 0xe0, 0xd3, 0x29, 0x24, 0xb6, 0x68, 0xfe, 0x6c, 0xd5, 0x7d, 0xe1,
+	0x33, 0xda, 0x17, 0xf9, 0x5e, 0xfc, 0x1f, 0x00, 0x92, 0xeb, 0xb4, 0x3a, 0xa4, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +227,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextLockedDepositID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockedDepositID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LockedDeposits) > 0 {
+		for iNdEx := len(m.LockedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AccrualTimes) > 0 {
+		for iNdEx := len(m.AccrualTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccrualTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Deposits) > 0 {
 		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -152,6 +287,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAccrualTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccrualTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccrualTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestFactor.Size()
+		i -= size
+		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccrualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -177,6 +360,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccrualTimes) > 0 {
+		for _, e := range m.AccrualTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedDeposits) > 0 {
+		for _, e := range m.LockedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockedDepositID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockedDepositID))
+	}
+	return n
+}
+
+func (m *GenesisAccrualTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccrualTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.InterestFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -282,6 +497,242 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccrualTimes = append(m.AccrualTimes, GenesisAccrualTime{})
+			if err := m.AccrualTimes[len(m.AccrualTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedDeposits = append(m.LockedDeposits, LockedDeposit{})
+			if err := m.LockedDeposits[len(m.LockedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockedDepositID", wireType)
+			}
+			m.NextLockedDepositID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockedDepositID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccrualTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccrualTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccrualTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "savings"
@@ -20,4 +27,32 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+// DefaultNextLockedDepositID is the id of the first locked deposit
+const DefaultNextLockedDepositID uint64 = 1
+
+var (
+	DepositsKeyPrefix            = []byte{0x01}
+	InterestFactorPrefix         = []byte{0x02}
+	PreviousAccrualTimeKeyPrefix = []byte{0x03}
+	LockedDepositKeyPrefix       = []byte{0x04}
+	LockedDepositByTimeKeyPrefix = []byte{0x05}
+	NextLockedDepositIDKey       = []byte{0x06}
+	LockedRewardBonusKeyPrefix   = []byte{0x07}
+)
+
+// DepositorLockedDepositsKey returns the key prefix of the locked deposits of
+// a depositor
+func DepositorLockedDepositsKey(depositor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(depositor)
+}
+
+// LockedDepositKey returns the key of a locked deposit: depositor | id
+func LockedDepositKey(depositor sdk.AccAddress, id uint64) []byte {
+	return append(DepositorLockedDepositsKey(depositor), sdk.Uint64ToBigEndian(id)...)
+}
+
+// LockedDepositByTimeKey returns the key of a locked deposit in the index
+// ordered by unlock time: unlock time | depositor | id
+func LockedDepositByTimeKey(unlockTime time.Time, depositor sdk.AccAddress, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(unlockTime), LockedDepositKey(depositor, id)...)
+}
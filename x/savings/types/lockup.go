@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLockupTier returns a new lockup tier
+func NewLockupTier(monthsLockup int64, rewardMultiplier sdk.Dec) LockupTier {
+	return LockupTier{
+		MonthsLockup:     monthsLockup,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// Validate lockup tier validation
+func (t LockupTier) Validate() error {
+	if t.MonthsLockup <= 0 {
+		return fmt.Errorf("months lockup must be positive: %d", t.MonthsLockup)
+	}
+	if t.RewardMultiplier.IsNil() || t.RewardMultiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("reward multiplier should be ≥ 1.0, is %s for %d months", t.RewardMultiplier, t.MonthsLockup)
+	}
+
+	return nil
+}
+
+// LockupTiers is a slice of LockupTier
+type LockupTiers []LockupTier
+
+// Get returns the lockup tier of a lockup period
+func (ts LockupTiers) Get(monthsLockup int64) (LockupTier, bool) {
+	for _, t := range ts {
+		if t.MonthsLockup == monthsLockup {
+			return t, true
+		}
+	}
+	return LockupTier{}, false
+}
+
+// Validate validates LockupTiers
+func (ts LockupTiers) Validate() error {
+	seenMonths := make(map[int64]bool)
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		if seenMonths[t.MonthsLockup] {
+			return fmt.Errorf("duplicate lockup tier: %d months", t.MonthsLockup)
+		}
+		seenMonths[t.MonthsLockup] = true
+	}
+	return nil
+}
+
+// NewLockedDeposit returns a new locked deposit
+func NewLockedDeposit(
+	id uint64,
+	depositor sdk.AccAddress,
+	amount sdk.Coin,
+	monthsLockup int64,
+	unlockTime time.Time,
+	rewardMultiplier sdk.Dec,
+) LockedDeposit {
+	return LockedDeposit{
+		ID:               id,
+		Depositor:        depositor,
+		Amount:           amount,
+		MonthsLockup:     monthsLockup,
+		UnlockTime:       unlockTime,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// RewardBonus returns the savings reward shares of a locked deposit in
+// addition to the shares of the deposited amount
+func (d LockedDeposit) RewardBonus() sdk.Dec {
+	return sdk.NewDecFromInt(d.Amount.Amount).Mul(d.RewardMultiplier.Sub(sdk.OneDec()))
+}
+
+// Validate locked deposit validation
+func (d LockedDeposit) Validate() error {
+	if d.Depositor.Empty() {
+		return fmt.Errorf("depositor cannot be empty")
+	}
+	if !d.Amount.IsValid() || !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid locked deposit amount: %s", d.Amount)
+	}
+
+	return NewLockupTier(d.MonthsLockup, d.RewardMultiplier).Validate()
+}
+
+// LockedDeposits is a slice of LockedDeposit
+type LockedDeposits []LockedDeposit
+
+// Validate validates LockedDeposits
+func (ds LockedDeposits) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seenIDs[d.ID] {
+			return fmt.Errorf("duplicate locked deposit id: %d", d.ID)
+		}
+		seenIDs[d.ID] = true
+	}
+	return nil
+}
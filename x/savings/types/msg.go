@@ -10,6 +10,7 @@ import (
 var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgLockDeposit{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -95,3 +96,50 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{depositor}
 }
+
+// NewMsgLockDeposit returns a new MsgLockDeposit
+func NewMsgLockDeposit(depositor sdk.AccAddress, amount sdk.Coin, monthsLockup int64) MsgLockDeposit {
+	return MsgLockDeposit{
+		Depositor:    depositor.String(),
+		Amount:       amount,
+		MonthsLockup: monthsLockup,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLockDeposit) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLockDeposit) Type() string { return "savings_lock_deposit" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLockDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "lock amount %s", msg.Amount)
+	}
+
+	if msg.MonthsLockup <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLockupTier, "months lockup must be positive: %d", msg.MonthsLockup)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLockDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLockDeposit) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
@@ -3,25 +3,32 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys
 var (
 	KeySupportedDenoms     = []byte("SupportedDenoms")
+	KeySavingsRate         = []byte("SavingsRate")
+	KeyLockupTiers         = []byte("LockupTiers")
 	DefaultSupportedDenoms = []string{}
+	DefaultSavingsRate     = sdk.ZeroDec()
+	DefaultLockupTiers     = LockupTiers{}
 )
 
 // NewParams creates a new Params object
-func NewParams(supportedDenoms []string) Params {
+func NewParams(supportedDenoms []string, savingsRate sdk.Dec, lockupTiers LockupTiers) Params {
 	return Params{
 		SupportedDenoms: supportedDenoms,
+		SavingsRate:     savingsRate,
+		LockupTiers:     lockupTiers,
 	}
 }
 
 // DefaultParams default params for savings
 func DefaultParams() Params {
-	return NewParams(DefaultSupportedDenoms)
+	return NewParams(DefaultSupportedDenoms, DefaultSavingsRate, DefaultLockupTiers)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +41,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySupportedDenoms, &p.SupportedDenoms, validateSupportedDenoms),
+		paramtypes.NewParamSetPair(KeySavingsRate, &p.SavingsRate, validateSavingsRate),
+		paramtypes.NewParamSetPair(KeyLockupTiers, &p.LockupTiers, validateLockupTiers),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateSupportedDenoms(p.SupportedDenoms)
+	if err := validateSupportedDenoms(p.SupportedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateSavingsRate(p.SavingsRate); err != nil {
+		return err
+	}
+
+	return validateLockupTiers(p.LockupTiers)
 }
 
 func validateSupportedDenoms(i interface{}) error {
@@ -57,3 +74,29 @@ func validateSupportedDenoms(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSavingsRate(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if rate.IsNil() {
+		return fmt.Errorf("savings rate cannot be nil")
+	}
+
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("savings rate should be between 0 and 1: %s", rate)
+	}
+
+	return nil
+}
+
+func validateLockupTiers(i interface{}) error {
+	tiers, ok := i.(LockupTiers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return tiers.Validate()
+}
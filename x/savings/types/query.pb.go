@@ -42,7 +42,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{0}
+	return fileDescriptor_656b5a49cac49fcc, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -81,7 +81,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{1}
+	return fileDescriptor_656b5a49cac49fcc, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +122,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{2}
+	return fileDescriptor_656b5a49cac49fcc, []int{2}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +183,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{3}
+	return fileDescriptor_656b5a49cac49fcc, []int{3}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +234,7 @@ func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest
 func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyRequest) ProtoMessage()    {}
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{4}
+	return fileDescriptor_656b5a49cac49fcc, []int{4}
 }
 func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +275,7 @@ func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyRespon
 func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyResponse) ProtoMessage()    {}
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{5}
+	return fileDescriptor_656b5a49cac49fcc, []int{5}
 }
 func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,6 +318,122 @@ func (m *QueryTotalSupplyResponse) GetResult() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryLockedDepositsRequest defines the request type for querying x/savings
+// locked deposits.
+type QueryLockedDepositsRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Owner      string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLockedDepositsRequest) Reset()         { *m = QueryLockedDepositsRequest{} }
+func (m *QueryLockedDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDepositsRequest) ProtoMessage()    {}
+func (*QueryLockedDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_656b5a49cac49fcc, []int{6}
+}
+func (m *QueryLockedDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDepositsRequest.Merge(m, src)
+}
+func (m *QueryLockedDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryLockedDepositsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryLockedDepositsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryLockedDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLockedDepositsResponse defines the response type for querying x/savings
+// locked deposits.
+type QueryLockedDepositsResponse struct {
+	LockedDeposits LockedDeposits      `protobuf:"bytes,1,rep,name=locked_deposits,json=lockedDeposits,proto3,castrepeated=LockedDeposits" json:"locked_deposits"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLockedDepositsResponse) Reset()         { *m = QueryLockedDepositsResponse{} }
+func (m *QueryLockedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDepositsResponse) ProtoMessage()    {}
+func (*QueryLockedDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_656b5a49cac49fcc, []int{7}
+}
+func (m *QueryLockedDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDepositsResponse.Merge(m, src)
+}
+func (m *QueryLockedDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryLockedDepositsResponse) GetLockedDeposits() LockedDeposits {
+	if m != nil {
+		return m.LockedDeposits
+	}
+	return nil
+}
+
+func (m *QueryLockedDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "fury.savings.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "fury.savings.v1beta1.QueryParamsResponse")
@@ -325,51 +441,58 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "fury.savings.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "fury.savings.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "fury.savings.v1beta1.QueryTotalSupplyResponse")
-}
-
-func init() { proto.RegisterFile("fury/savings/v1beta1/query.proto", fileDescriptor_f78c91efc5db144f) }
-
-var fileDescriptor_f78c91efc5db144f = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xb1, 0x8f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x38, 0xc8, 0x39, 0x34, 0x66, 0x5c, 0x75, 0x21, 0xb8, 0x90, 0xcd, 0xe5, 0xe4,
-	0x30, 0xec, 0x7a, 0xd8, 0x5d, 0x27, 0x67, 0xb4, 0xb0, 0xd1, 0x3d, 0x13, 0x13, 0x9b, 0xcb, 0x00,
-	0x93, 0x65, 0x73, 0xb0, 0xb3, 0xb7, 0x33, 0x8b, 0xd2, 0x6a, 0x63, 0x62, 0x63, 0x62, 0xa1, 0xa5,
-	0x85, 0x95, 0x89, 0x9d, 0xf1, 0x6f, 0xb8, 0xf2, 0xa2, 0x8d, 0x95, 0x1a, 0xf0, 0x0f, 0x31, 0x3b,
-	0x33, 0xbb, 0x07, 0xc7, 0xe6, 0xa4, 0x82, 0x79, 0xf3, 0x7d, 0xdf, 0xfc, 0xe6, 0xcd, 0x03, 0xd8,
-	0x38, 0xc2, 0x13, 0x6c, 0x33, 0x3c, 0xf1, 0x7c, 0x97, 0xd9, 0x93, 0xdd, 0x1e, 0xe1, 0x78, 0xd7,
-	0x3e, 0x8e, 0x48, 0x38, 0xb5, 0x82, 0x90, 0x72, 0x8a, 0xb4, 0x58, 0x61, 0x29, 0x85, 0xa5, 0x14,
-	0xd5, 0x56, 0x9f, 0xb2, 0x31, 0x65, 0x76, 0x0f, 0x33, 0x22, 0xe5, 0xa9, 0x39, 0xc0, 0xae, 0xe7,
-	0x63, 0xee, 0x51, 0x5f, 0x26, 0x54, 0x8d, 0x45, 0x6d, 0xa2, 0xea, 0x53, 0x2f, 0xd9, 0xaf, 0xc8,
-	0xfd, 0x43, 0xb1, 0xb2, 0xe5, 0x42, 0x6d, 0x69, 0x2e, 0x75, 0xa9, 0xac, 0xc7, 0xdf, 0x54, 0xb5,
-	0xe6, 0x52, 0xea, 0x8e, 0x88, 0x8d, 0x03, 0xcf, 0xc6, 0xbe, 0x4f, 0xb9, 0x38, 0x2d, 0xf1, 0x64,
-	0x5f, 0x89, 0x71, 0x1a, 0x12, 0xa9, 0x30, 0x35, 0x88, 0x1e, 0xc7, 0xc8, 0x8f, 0x70, 0x88, 0xc7,
-	0xcc, 0x21, 0xc7, 0x11, 0x61, 0xdc, 0x7c, 0x0a, 0xaf, 0x2c, 0x55, 0x59, 0x40, 0x7d, 0x46, 0xd0,
-	0x1e, 0x2c, 0x05, 0xa2, 0xa2, 0x83, 0x06, 0x68, 0x96, 0x3b, 0x35, 0x2b, 0xab, 0x21, 0x96, 0x74,
-	0x75, 0x37, 0x4e, 0x7e, 0xd5, 0x73, 0x8e, 0x72, 0xec, 0x6d, 0xbc, 0xfe, 0x58, 0xcf, 0x99, 0x9f,
-	0x00, 0xd4, 0x44, 0xf2, 0x3d, 0x12, 0x50, 0xe6, 0xf1, 0xe4, 0x44, 0xa4, 0xc1, 0xe2, 0x80, 0xf8,
-	0x74, 0x2c, 0x92, 0x2f, 0x39, 0x72, 0x81, 0x2c, 0x58, 0xa4, 0xcf, 0x7d, 0x12, 0xea, 0xf9, 0xb8,
-	0xda, 0xd5, 0xbf, 0x7f, 0x6d, 0x6b, 0xaa, 0x29, 0x77, 0x07, 0x83, 0x90, 0x30, 0x76, 0xc0, 0x43,
-	0xcf, 0x77, 0x1d, 0x29, 0x43, 0xf7, 0x21, 0x3c, 0x6b, 0xb9, 0x5e, 0x10, 0x90, 0xdb, 0x96, 0x72,
-	0xc4, 0x3d, 0xb7, 0xe4, 0x73, 0x9e, 0x91, 0xba, 0x44, 0x11, 0x38, 0x0b, 0x4e, 0xf3, 0x0b, 0x80,
-	0x57, 0xcf, 0x61, 0xaa, 0x16, 0x3c, 0x84, 0x9b, 0x03, 0x55, 0xd3, 0x41, 0xa3, 0xd0, 0x2c, 0x77,
-	0x6e, 0x64, 0x37, 0x41, 0x39, 0xbb, 0x97, 0xe3, 0x2e, 0x7c, 0xfe, 0x5d, 0xdf, 0x4c, 0xa3, 0xd2,
-	0x00, 0xf4, 0x60, 0x09, 0x37, 0x2f, 0x70, 0x6f, 0xfe, 0x17, 0x57, 0x92, 0x2c, 0xf1, 0x56, 0xe0,
-	0x75, 0x81, 0xfb, 0x84, 0x72, 0x3c, 0x3a, 0x88, 0x82, 0x60, 0x34, 0x4d, 0x9e, 0xf2, 0x3d, 0x80,
-	0xfa, 0xea, 0x9e, 0xba, 0xcd, 0x35, 0x58, 0x1a, 0x12, 0xcf, 0x1d, 0x72, 0xd1, 0xf6, 0x82, 0xa3,
-	0x56, 0xa8, 0x0f, 0x4b, 0x21, 0x61, 0xd1, 0x88, 0xeb, 0x79, 0x71, 0xc7, 0xca, 0x12, 0x54, 0x82,
-	0xb3, 0x4f, 0x3d, 0xbf, 0x7b, 0x5b, 0xdd, 0xaf, 0xe9, 0x7a, 0x7c, 0x18, 0xf5, 0xac, 0x3e, 0x1d,
-	0xab, 0xb9, 0x55, 0x1f, 0x6d, 0x36, 0x38, 0xb2, 0xf9, 0x34, 0x20, 0x4c, 0x18, 0x98, 0xa3, 0xa2,
-	0x3b, 0xdf, 0x0a, 0xb0, 0x28, 0xc8, 0xd0, 0x2b, 0x00, 0x4b, 0x72, 0x68, 0x50, 0x33, 0xbb, 0x9b,
-	0xab, 0x33, 0x5a, 0xdd, 0x59, 0x43, 0x29, 0xaf, 0x69, 0x6e, 0xbd, 0xfc, 0xf1, 0xf7, 0x5d, 0xde,
-	0x40, 0x35, 0x3b, 0xf3, 0xf7, 0x20, 0x27, 0x14, 0xbd, 0x01, 0x30, 0x7d, 0x24, 0xd4, 0xba, 0x20,
-	0xfd, 0xdc, 0xec, 0x56, 0x6f, 0xad, 0xa5, 0x55, 0x2c, 0xdb, 0x82, 0xa5, 0x81, 0x8c, 0x6c, 0x96,
-	0x74, 0x36, 0x3e, 0x00, 0x58, 0x5e, 0x78, 0x32, 0xd4, 0xbe, 0xe0, 0x90, 0xd5, 0x67, 0xaf, 0x5a,
-	0xeb, 0xca, 0x15, 0x56, 0x4b, 0x60, 0x6d, 0x21, 0x33, 0x1b, 0x8b, 0xc7, 0x96, 0x43, 0x26, 0x3c,
-	0xdd, 0xfd, 0x93, 0x99, 0x01, 0x4e, 0x67, 0x06, 0xf8, 0x33, 0x33, 0xc0, 0xdb, 0xb9, 0x91, 0x3b,
-	0x9d, 0x1b, 0xb9, 0x9f, 0x73, 0x23, 0xf7, 0x6c, 0x67, 0x61, 0x08, 0xe2, 0x9c, 0xf6, 0x08, 0xf7,
-	0x98, 0x4c, 0x7c, 0x91, 0x66, 0x8a, 0x59, 0xe8, 0x95, 0xc4, 0xff, 0xcf, 0x9d, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x76, 0x1d, 0x1f, 0x76, 0x05, 0x00, 0x00,
+	proto.RegisterType((*QueryLockedDepositsRequest)(nil), "fury.savings.v1beta1.QueryLockedDepositsRequest")
+	proto.RegisterType((*QueryLockedDepositsResponse)(nil), "fury.savings.v1beta1.QueryLockedDepositsResponse")
+}
+
+func init() { proto.RegisterFile("fury/savings/v1beta1/query.proto", fileDescriptor_656b5a49cac49fcc) }
+
+var fileDescriptor_656b5a49cac49fcc = []byte{
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x16, 0xd8, 0xe0, 0x90, 0xa0, 0x19, 0x57, 0x2c, 0x15, 0xcb, 0xa6, 0x22, 0xac,
+	0x6b, 0xb6, 0x05, 0xbc, 0x71, 0x73, 0x25, 0x7a, 0xd0, 0x83, 0x16, 0x13, 0x13, 0x2f, 0xa4, 0xbb,
+	0x1d, 0x4b, 0x43, 0xb7, 0x53, 0x3a, 0x53, 0x74, 0xaf, 0x7a, 0x31, 0xf1, 0x62, 0xe2, 0x41, 0x8f,
+	0x1e, 0x38, 0x91, 0x78, 0xf3, 0x8f, 0xe0, 0xe0, 0x81, 0xe8, 0xc5, 0x8b, 0x3f, 0x02, 0xfe, 0x21,
+	0xa6, 0x33, 0xd3, 0xb2, 0x2d, 0x0d, 0x62, 0xe2, 0xc1, 0x13, 0xcc, 0x9b, 0xef, 0xfb, 0xce, 0x67,
+	0x5e, 0xdf, 0x9b, 0x85, 0x8d, 0x27, 0x71, 0x34, 0x30, 0xa9, 0xbd, 0xed, 0x05, 0x2e, 0x35, 0xb7,
+	0x97, 0xba, 0x98, 0xd9, 0x4b, 0xe6, 0x56, 0x8c, 0xa3, 0x81, 0x11, 0x46, 0x84, 0x11, 0x54, 0x4f,
+	0x14, 0x86, 0x54, 0x18, 0x52, 0xa1, 0xb6, 0x7a, 0x84, 0xf6, 0x09, 0x35, 0xbb, 0x36, 0xc5, 0x42,
+	0x9e, 0x25, 0x87, 0xb6, 0xeb, 0x05, 0x36, 0xf3, 0x48, 0x20, 0x1c, 0x54, 0x6d, 0x58, 0x9b, 0xaa,
+	0x7a, 0xc4, 0x4b, 0xf7, 0xa7, 0xc5, 0xfe, 0x3a, 0x5f, 0x99, 0x62, 0x21, 0xb7, 0xea, 0x2e, 0x71,
+	0x89, 0x88, 0x27, 0xff, 0xc9, 0xe8, 0x8c, 0x4b, 0x88, 0xeb, 0x63, 0xd3, 0x0e, 0x3d, 0xd3, 0x0e,
+	0x02, 0xc2, 0xf8, 0x69, 0x69, 0x4e, 0xf9, 0x95, 0x28, 0x23, 0x11, 0x16, 0x0a, 0xbd, 0x0e, 0xd1,
+	0x83, 0x04, 0xf9, 0xbe, 0x1d, 0xd9, 0x7d, 0x6a, 0xe1, 0xad, 0x18, 0x53, 0xa6, 0x3f, 0x82, 0xe7,
+	0x73, 0x51, 0x1a, 0x92, 0x80, 0x62, 0xb4, 0x02, 0x6b, 0x21, 0x8f, 0x28, 0xa0, 0x01, 0x9a, 0x13,
+	0xcb, 0x33, 0x46, 0x59, 0x41, 0x0c, 0x91, 0xd5, 0x19, 0xdd, 0xfb, 0x3e, 0x5b, 0xb1, 0x64, 0xc6,
+	0xca, 0xe8, 0xcb, 0xf7, 0xb3, 0x15, 0x7d, 0x07, 0xc0, 0x3a, 0x77, 0x5e, 0xc5, 0x21, 0xa1, 0x1e,
+	0x4b, 0x4f, 0x44, 0x75, 0x38, 0xe6, 0xe0, 0x80, 0xf4, 0xb9, 0xf3, 0x19, 0x4b, 0x2c, 0x90, 0x01,
+	0xc7, 0xc8, 0xd3, 0x00, 0x47, 0x4a, 0x35, 0x89, 0x76, 0x94, 0xcf, 0x1f, 0xdb, 0x75, 0x59, 0x94,
+	0x9b, 0x8e, 0x13, 0x61, 0x4a, 0xd7, 0x58, 0xe4, 0x05, 0xae, 0x25, 0x64, 0xe8, 0x36, 0x84, 0x47,
+	0x25, 0x57, 0x46, 0x38, 0xe4, 0xbc, 0x21, 0x33, 0x92, 0x9a, 0x1b, 0xe2, 0x73, 0x1e, 0x91, 0xba,
+	0x58, 0x12, 0x58, 0x43, 0x99, 0xfa, 0x07, 0x00, 0x2f, 0x14, 0x30, 0x65, 0x09, 0xee, 0xc2, 0x71,
+	0x47, 0xc6, 0x14, 0xd0, 0x18, 0x69, 0x4e, 0x2c, 0x5f, 0x2e, 0x2f, 0x82, 0xcc, 0xec, 0x9c, 0x4b,
+	0xaa, 0xb0, 0xfb, 0x63, 0x76, 0x3c, 0xb3, 0xca, 0x0c, 0xd0, 0x9d, 0x1c, 0x6e, 0x95, 0xe3, 0x2e,
+	0xfc, 0x11, 0x57, 0x90, 0xe4, 0x78, 0xa7, 0xe1, 0x45, 0x8e, 0xfb, 0x90, 0x30, 0xdb, 0x5f, 0x8b,
+	0xc3, 0xd0, 0x1f, 0xa4, 0x9f, 0xf2, 0x2d, 0x80, 0xca, 0xf1, 0x3d, 0x79, 0x9b, 0x29, 0x58, 0xdb,
+	0xc0, 0x9e, 0xbb, 0xc1, 0x78, 0xd9, 0x47, 0x2c, 0xb9, 0x42, 0x3d, 0x58, 0x8b, 0x30, 0x8d, 0x7d,
+	0xa6, 0x54, 0xf9, 0x1d, 0xa7, 0x73, 0x50, 0x29, 0xce, 0x2d, 0xe2, 0x05, 0x9d, 0x45, 0x79, 0xbf,
+	0xa6, 0xeb, 0xb1, 0x8d, 0xb8, 0x6b, 0xf4, 0x48, 0x5f, 0xf6, 0xad, 0xfc, 0xd3, 0xa6, 0xce, 0xa6,
+	0xc9, 0x06, 0x21, 0xa6, 0x3c, 0x81, 0x5a, 0xd2, 0x5a, 0xdf, 0x05, 0x50, 0xe5, 0x64, 0xf7, 0x48,
+	0x6f, 0x13, 0x3b, 0xff, 0x77, 0x47, 0x7c, 0x02, 0xf0, 0x52, 0x29, 0xac, 0xac, 0xa4, 0x03, 0xcf,
+	0xfa, 0x7c, 0x67, 0xbd, 0xd0, 0x1e, 0x57, 0xca, 0xdb, 0x23, 0x67, 0xd3, 0x99, 0x92, 0x45, 0x9c,
+	0x2c, 0xb8, 0x4f, 0xfa, 0xb9, 0xf5, 0x3f, 0x6b, 0x98, 0xe5, 0x6f, 0xa3, 0x70, 0x8c, 0x5f, 0x07,
+	0xbd, 0x00, 0xb0, 0x26, 0x06, 0x16, 0x35, 0xcb, 0x51, 0x8f, 0xbf, 0x0f, 0xea, 0xb5, 0x53, 0x28,
+	0xc5, 0xa9, 0xfa, 0xdc, 0xf3, 0x2f, 0xbf, 0xde, 0x54, 0x35, 0x34, 0x63, 0x96, 0xbe, 0x45, 0xe2,
+	0x75, 0x40, 0xaf, 0x00, 0xcc, 0x06, 0x04, 0xb5, 0x4e, 0x70, 0x2f, 0x74, 0x89, 0x7a, 0xfd, 0x54,
+	0x5a, 0xc9, 0x32, 0xcf, 0x59, 0x1a, 0x48, 0x2b, 0x67, 0xc9, 0xe6, 0xf2, 0x1d, 0x80, 0x13, 0x43,
+	0xe3, 0x82, 0xda, 0x27, 0x1c, 0x72, 0x7c, 0xe4, 0x54, 0xe3, 0xb4, 0x72, 0x89, 0xd5, 0xe2, 0x58,
+	0x73, 0x48, 0x2f, 0xc7, 0x62, 0x49, 0xca, 0x3a, 0x15, 0x28, 0x3b, 0x00, 0x16, 0x9a, 0x04, 0x2d,
+	0x9e, 0x70, 0x5c, 0xe9, 0x68, 0xa9, 0x4b, 0x7f, 0x91, 0x21, 0x19, 0xdb, 0x9c, 0x71, 0x01, 0x5d,
+	0x2d, 0x67, 0x2c, 0xf4, 0x7e, 0x67, 0x75, 0xef, 0x40, 0x03, 0xfb, 0x07, 0x1a, 0xf8, 0x79, 0xa0,
+	0x81, 0xd7, 0x87, 0x5a, 0x65, 0xff, 0x50, 0xab, 0x7c, 0x3d, 0xd4, 0x2a, 0x8f, 0x5b, 0x43, 0xef,
+	0x44, 0xdf, 0x76, 0x71, 0xbb, 0x47, 0xb6, 0x71, 0x20, 0x5c, 0x9f, 0x65, 0xbe, 0xfc, 0xbd, 0xe8,
+	0xd6, 0xf8, 0x6f, 0xd4, 0x8d, 0xdf, 0x03, 0x00, 0x58, 0xeb, 0xb1, 0xc1, 0x9a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -390,6 +513,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// LockedDeposits queries locked savings deposits.
+	LockedDeposits(ctx context.Context, in *QueryLockedDepositsRequest, opts ...grpc.CallOption) (*QueryLockedDepositsResponse, error)
 }
 
 type queryClient struct {
@@ -427,6 +552,15 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) LockedDeposits(ctx context.Context, in *QueryLockedDepositsRequest, opts ...grpc.CallOption) (*QueryLockedDepositsResponse, error) {
+	out := new(QueryLockedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/fury.savings.v1beta1.Query/LockedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the savings module.
@@ -435,6 +569,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// LockedDeposits queries locked savings deposits.
+	LockedDeposits(context.Context, *QueryLockedDepositsRequest) (*QueryLockedDepositsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -450,6 +586,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (*UnimplementedQueryServer) LockedDeposits(ctx context.Context, req *QueryLockedDepositsRequest) (*QueryLockedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDeposits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -509,6 +648,24 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.savings.v1beta1.Query/LockedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDeposits(ctx, req.(*QueryLockedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.savings.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -525,6 +682,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
 		},
+		{
+			MethodName: "LockedDeposits",
+			Handler:    _Query_LockedDeposits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/savings/v1beta1/query.proto",
@@ -749,6 +910,104 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLockedDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockedDeposits) > 0 {
+		for iNdEx := len(m.LockedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -847,6 +1106,46 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLockedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedDeposits) > 0 {
+		for _, e := range m.LockedDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1409,6 +1708,276 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLockedDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedDeposits = append(m.LockedDeposits, LockedDeposit{})
+			if err := m.LockedDeposits[len(m.LockedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
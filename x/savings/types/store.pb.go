@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,13 +33,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the savings module.
 type Params struct {
 	SupportedDenoms []string `protobuf:"bytes,1,rep,name=supported_denoms,json=supportedDenoms,proto3" json:"supported_denoms,omitempty"`
+	// savings_rate is the annual rate of interest paid on savings deposits of
+	// the cdp debt denom, funded from the cdp stability fee surplus.
+	SavingsRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=savings_rate,json=savingsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"savings_rate"`
+	// lockup_tiers are the lockup periods deposits can be locked for, and the
+	// multiplier on the savings rewards of locked deposits.
+	LockupTiers LockupTiers `protobuf:"bytes,3,rep,name=lockup_tiers,json=lockupTiers,proto3,castrepeated=LockupTiers" json:"lockup_tiers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7110366fa182786, []int{0}
+	return fileDescriptor_044e344806415c5a, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -64,17 +74,60 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockupTier defines a lockup period for savings deposits and the multiplier
+// on their share of savings rewards.
+type LockupTier struct {
+	MonthsLockup     int64                                  `protobuf:"varint,1,opt,name=months_lockup,json=monthsLockup,proto3" json:"months_lockup,omitempty"`
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+}
+
+func (m *LockupTier) Reset()         { *m = LockupTier{} }
+func (m *LockupTier) String() string { return proto.CompactTextString(m) }
+func (*LockupTier) ProtoMessage()    {}
+func (*LockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{1}
+}
+func (m *LockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTier.Merge(m, src)
+}
+func (m *LockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTier proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a savings module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// index is the interest factor of each deposited denom when the deposit
+	// was last synced.
+	Index InterestFactors `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=InterestFactors" json:"index"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7110366fa182786, []int{1}
+	return fileDescriptor_044e344806415c5a, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,36 +156,143 @@ func (m *Deposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
+// InterestFactor defines an individual interest factor of a denom.
+type InterestFactor struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
+func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
+func (*InterestFactor) ProtoMessage()    {}
+func (*InterestFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{3}
+}
+func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestFactor.Merge(m, src)
+}
+func (m *InterestFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestFactor proto.InternalMessageInfo
+
+// LockedDeposit defines an amount of a savings deposit that cannot be
+// withdrawn until the unlock time.
+type LockedDeposit struct {
+	ID               uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	Amount           types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	MonthsLockup     int64                                         `protobuf:"varint,4,opt,name=months_lockup,json=monthsLockup,proto3" json:"months_lockup,omitempty"`
+	UnlockTime       time.Time                                     `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+}
+
+func (m *LockedDeposit) Reset()         { *m = LockedDeposit{} }
+func (m *LockedDeposit) String() string { return proto.CompactTextString(m) }
+func (*LockedDeposit) ProtoMessage()    {}
+func (*LockedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{4}
+}
+func (m *LockedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedDeposit.Merge(m, src)
+}
+func (m *LockedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fury.savings.v1beta1.Params")
+	proto.RegisterType((*LockupTier)(nil), "fury.savings.v1beta1.LockupTier")
 	proto.RegisterType((*Deposit)(nil), "fury.savings.v1beta1.Deposit")
+	proto.RegisterType((*InterestFactor)(nil), "fury.savings.v1beta1.InterestFactor")
+	proto.RegisterType((*LockedDeposit)(nil), "fury.savings.v1beta1.LockedDeposit")
 }
 
-func init() { proto.RegisterFile("fury/savings/v1beta1/store.proto", fileDescriptor_f7110366fa182786) }
-
-var fileDescriptor_f7110366fa182786 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
-	0x14, 0xec, 0x4a, 0x82, 0xa1, 0x1e, 0x34, 0x95, 0x03, 0x70, 0x58, 0x1a, 0x4e, 0xe5, 0xd0, 0x5d,
-	0x91, 0x2f, 0xa0, 0x92, 0xe8, 0xd1, 0x70, 0xf4, 0x42, 0xb6, 0xed, 0x5a, 0x1b, 0x6c, 0x5f, 0xd3,
-	0xb7, 0x10, 0xf9, 0x0b, 0xbf, 0xc3, 0xb3, 0x1f, 0xc1, 0x91, 0x78, 0x30, 0x9e, 0x50, 0xe1, 0x2f,
-	0x3c, 0x99, 0xb6, 0x2b, 0x7a, 0xf4, 0xb4, 0x6f, 0xe7, 0xcd, 0x4c, 0x26, 0xf3, 0x4c, 0x7b, 0x26,
-	0x16, 0x82, 0xa3, 0x58, 0xc4, 0x69, 0x84, 0x7c, 0x31, 0xf0, 0xa5, 0x12, 0x03, 0x8e, 0x0a, 0x72,
-	0xc9, 0xb2, 0x1c, 0x14, 0x58, 0xcd, 0x82, 0xc1, 0x34, 0x83, 0x69, 0x46, 0x87, 0x06, 0x80, 0x09,
-	0x20, 0xf7, 0x05, 0xca, 0xbd, 0x2c, 0x80, 0x38, 0xad, 0x54, 0x9d, 0x76, 0xb5, 0x9f, 0x96, 0x3f,
-	0x5e, 0x7d, 0xf4, 0xaa, 0x19, 0x41, 0x04, 0x15, 0x5e, 0x4c, 0x15, 0xda, 0x1b, 0x9a, 0xf5, 0x6b,
-	0x91, 0x8b, 0x04, 0xad, 0xbe, 0x79, 0x82, 0xf3, 0x2c, 0x83, 0x5c, 0xc9, 0x70, 0x1a, 0xca, 0x14,
-	0x12, 0x6c, 0x11, 0xbb, 0xe6, 0x34, 0x26, 0xc7, 0x7b, 0x7c, 0x5c, 0xc2, 0xbd, 0x57, 0x62, 0x1e,
-	0x8e, 0x65, 0x06, 0x18, 0x2b, 0xeb, 0xd6, 0x6c, 0x84, 0xd5, 0x08, 0x79, 0x8b, 0xd8, 0xc4, 0x69,
-	0x78, 0x57, 0x5f, 0x9b, 0xae, 0x1b, 0xc5, 0xea, 0x6e, 0xee, 0xb3, 0x00, 0x12, 0x1d, 0x43, 0x3f,
-	0x2e, 0x86, 0x33, 0xae, 0x96, 0x99, 0x44, 0x36, 0x0a, 0x82, 0x51, 0x18, 0xe6, 0x12, 0xf1, 0xe5,
-	0xd9, 0x3d, 0xd5, 0x61, 0x35, 0xe2, 0x2d, 0x95, 0xc4, 0xc9, 0xaf, 0xb5, 0x15, 0x98, 0x75, 0x91,
-	0xc0, 0x3c, 0x55, 0xad, 0x03, 0xbb, 0xe6, 0x1c, 0x9d, 0xb7, 0x99, 0x16, 0x14, 0x55, 0xfc, 0xf4,
-	0xc3, 0x2e, 0x20, 0x4e, 0xbd, 0xb3, 0xd5, 0xa6, 0x6b, 0x3c, 0xbd, 0x77, 0x9d, 0x7f, 0x64, 0x28,
-	0x04, 0x38, 0xd1, 0xd6, 0xde, 0xe5, 0xea, 0x93, 0x1a, 0xab, 0x2d, 0x25, 0xeb, 0x2d, 0x25, 0x1f,
-	0x5b, 0x4a, 0x1e, 0x77, 0xd4, 0x58, 0xef, 0xa8, 0xf1, 0xb6, 0xa3, 0xc6, 0x4d, 0xff, 0x8f, 0x5f,
-	0x71, 0x1d, 0xf7, 0x5e, 0xf8, 0x58, 0x4e, 0xfc, 0x61, 0x7f, 0xcb, 0xd2, 0xd6, 0xaf, 0x97, 0xed,
-	0x0e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x42, 0x46, 0x9e, 0xe8, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/savings/v1beta1/store.proto", fileDescriptor_044e344806415c5a) }
+
+var fileDescriptor_044e344806415c5a = []byte{
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8f, 0x9d, 0x26, 0xdf, 0x97, 0x4d, 0x4b, 0x8b, 0x5b, 0x41, 0xda, 0x83, 0x6d, 0x05, 0x84,
+	0x02, 0x52, 0x6c, 0x0a, 0x07, 0x2e, 0x5c, 0x6a, 0x02, 0x6a, 0x25, 0x90, 0x90, 0xe9, 0x01, 0x71,
+	0xb1, 0x36, 0xf6, 0xd6, 0x5d, 0x35, 0xf6, 0x5a, 0xbb, 0xeb, 0xd0, 0xf2, 0x14, 0x7d, 0x00, 0x24,
+	0xee, 0x70, 0xed, 0x43, 0xf4, 0x58, 0xf5, 0x84, 0x38, 0xa4, 0x90, 0xde, 0x79, 0x00, 0x4e, 0xc8,
+	0xbb, 0x5b, 0x87, 0x88, 0x08, 0xf5, 0xd0, 0x9e, 0xec, 0xf9, 0xfb, 0x9b, 0x9d, 0xdf, 0xcc, 0x00,
+	0x7b, 0x27, 0xa7, 0x07, 0x2e, 0x83, 0x43, 0x9c, 0xc6, 0xcc, 0x1d, 0xae, 0xf7, 0x11, 0x87, 0xeb,
+	0x2e, 0xe3, 0x84, 0x22, 0x27, 0xa3, 0x84, 0x13, 0x63, 0xa5, 0xf0, 0x70, 0x94, 0x87, 0xa3, 0x3c,
+	0xd6, 0xcc, 0x90, 0xb0, 0x84, 0x30, 0xb7, 0x0f, 0x19, 0x2a, 0xc3, 0x42, 0x82, 0x53, 0x19, 0xb5,
+	0xb6, 0x2a, 0xed, 0x81, 0x90, 0x5c, 0x29, 0x28, 0xd3, 0x4a, 0x4c, 0x62, 0x22, 0xf5, 0xc5, 0x9f,
+	0xd2, 0x5a, 0x31, 0x21, 0xf1, 0x00, 0xb9, 0x42, 0xea, 0xe7, 0x3b, 0x2e, 0xc7, 0x09, 0x62, 0x1c,
+	0x26, 0x99, 0x74, 0x68, 0xff, 0xd4, 0x40, 0xfd, 0x35, 0xa4, 0x30, 0x61, 0xc6, 0x7d, 0xb0, 0xc4,
+	0xf2, 0x2c, 0x23, 0x94, 0xa3, 0x28, 0x88, 0x50, 0x4a, 0x12, 0xd6, 0xd2, 0xec, 0x6a, 0xa7, 0xe1,
+	0x2f, 0x96, 0xfa, 0x9e, 0x50, 0x1b, 0x01, 0x98, 0x57, 0xa5, 0x07, 0x14, 0x72, 0xd4, 0xd2, 0x6d,
+	0xad, 0xd3, 0xf0, 0x9e, 0x1e, 0x8f, 0xac, 0xca, 0xb7, 0x91, 0x75, 0x2f, 0xc6, 0x7c, 0x37, 0xef,
+	0x3b, 0x21, 0x49, 0x54, 0x8d, 0xea, 0xd3, 0x65, 0xd1, 0x9e, 0xcb, 0x0f, 0x32, 0xc4, 0x9c, 0x1e,
+	0x0a, 0x4f, 0x8f, 0xba, 0x40, 0x3d, 0xa1, 0x87, 0x42, 0xbf, 0xa9, 0x32, 0xfa, 0x90, 0x23, 0xe3,
+	0x2d, 0x98, 0x1f, 0x90, 0x70, 0x2f, 0xcf, 0x02, 0x8e, 0x11, 0x65, 0xad, 0xaa, 0x5d, 0xed, 0x34,
+	0x1f, 0xd9, 0xce, 0xac, 0xae, 0x39, 0x2f, 0x85, 0xe7, 0x36, 0x46, 0xd4, 0x5b, 0x2e, 0x4a, 0xf8,
+	0x7c, 0x66, 0x35, 0x27, 0x3a, 0xe6, 0x37, 0x07, 0x13, 0xa1, 0xfd, 0x51, 0x03, 0x60, 0x62, 0x34,
+	0xee, 0x80, 0x85, 0x84, 0xa4, 0x7c, 0x97, 0x05, 0xd2, 0xa9, 0xa5, 0xd9, 0x5a, 0xa7, 0xea, 0xcf,
+	0x4b, 0xa5, 0x74, 0x34, 0x30, 0xb8, 0x49, 0xd1, 0x7b, 0x48, 0xa3, 0x20, 0xc9, 0x07, 0x1c, 0x67,
+	0x03, 0x8c, 0xe8, 0x95, 0xbc, 0x79, 0x49, 0xa6, 0x7d, 0x55, 0x66, 0x6d, 0x7f, 0xd1, 0xc1, 0x7f,
+	0x3d, 0x94, 0x11, 0x86, 0xb9, 0xb1, 0x03, 0x1a, 0x91, 0xfc, 0x25, 0x54, 0xd4, 0xd5, 0xf0, 0x36,
+	0x7f, 0x8d, 0xac, 0xee, 0x25, 0xa0, 0x36, 0xc2, 0x70, 0x23, 0x8a, 0x28, 0x62, 0xec, 0xf4, 0xa8,
+	0xbb, 0xac, 0x10, 0x95, 0xc6, 0x3b, 0xe0, 0x88, 0xf9, 0x93, 0xd4, 0x46, 0x08, 0xea, 0x30, 0x21,
+	0x79, 0xca, 0x5b, 0xba, 0x68, 0xf3, 0xaa, 0xa3, 0x02, 0x8a, 0x31, 0x2c, 0xbb, 0xfc, 0x8c, 0xe0,
+	0xd4, 0x7b, 0xa8, 0xfa, 0xdb, 0xb9, 0x44, 0x0d, 0x45, 0x00, 0xf3, 0x55, 0x6a, 0xe3, 0x0d, 0xa8,
+	0xe1, 0x34, 0x42, 0xfb, 0x8a, 0xca, 0xbb, 0xb3, 0xa9, 0xdc, 0x4a, 0x39, 0xa2, 0x88, 0xf1, 0x17,
+	0x30, 0xe4, 0x84, 0x7a, 0xb7, 0x15, 0xdc, 0xe2, 0xb4, 0x9e, 0xf9, 0x32, 0x57, 0xfb, 0x03, 0xb8,
+	0x31, 0x6d, 0x31, 0x56, 0x40, 0x4d, 0x8c, 0xae, 0xec, 0x97, 0x2f, 0x05, 0xc3, 0x07, 0xb5, 0x21,
+	0x1c, 0xe4, 0x57, 0x33, 0xa8, 0x32, 0x55, 0xfb, 0x53, 0x15, 0x2c, 0x14, 0xf3, 0x51, 0x2c, 0x85,
+	0xe4, 0xeb, 0x16, 0xd0, 0x71, 0x24, 0x80, 0xe7, 0xbc, 0xfa, 0x78, 0x64, 0xe9, 0x5b, 0x3d, 0x5f,
+	0xc7, 0xd1, 0x34, 0x8f, 0xfa, 0xf5, 0xf1, 0xf8, 0xa4, 0xe4, 0xb1, 0x6a, 0x6b, 0xff, 0xe6, 0x71,
+	0xae, 0xe8, 0x40, 0xc9, 0xcd, 0x5f, 0x4b, 0x30, 0x37, 0x63, 0x09, 0x9e, 0x83, 0x66, 0x9e, 0x16,
+	0xf6, 0xa0, 0xb8, 0x21, 0xad, 0x9a, 0x80, 0x58, 0x73, 0xe4, 0x81, 0x71, 0x2e, 0x0e, 0x8c, 0xb3,
+	0x7d, 0x71, 0x60, 0xbc, 0xff, 0x0b, 0x8c, 0xc3, 0x33, 0x4b, 0xf3, 0x81, 0x0c, 0x2c, 0x4c, 0xb3,
+	0x77, 0xa9, 0x7e, 0x1d, 0xbb, 0xe4, 0x6d, 0x1e, 0xff, 0x30, 0x2b, 0xc7, 0x63, 0x53, 0x3b, 0x19,
+	0x9b, 0xda, 0xf7, 0xb1, 0xa9, 0x1d, 0x9e, 0x9b, 0x95, 0x93, 0x73, 0xb3, 0xf2, 0xf5, 0xdc, 0xac,
+	0xbc, 0x7b, 0xf0, 0x07, 0x4a, 0x02, 0x63, 0xd4, 0x0d, 0xc9, 0x10, 0xa5, 0xae, 0xb8, 0xdc, 0xfb,
+	0xe5, 0xed, 0x16, 0x68, 0xfd, 0xba, 0x78, 0xde, 0xe3, 0xdf, 0x03, 0x00, 0xed, 0xf0, 0xa4, 0x52,
+	0xd8, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -155,6 +315,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockupTiers) > 0 {
+		for iNdEx := len(m.LockupTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SavingsRate.Size()
+		i -= size
+		if _, err := m.SavingsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.SupportedDenoms) > 0 {
 		for iNdEx := len(m.SupportedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SupportedDenoms[iNdEx])
@@ -167,6 +351,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MonthsLockup != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MonthsLockup))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -187,6 +409,20 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -211,6 +447,114 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MonthsLockup != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MonthsLockup))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStore(v)
 	base := offset
@@ -234,6 +578,28 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	l = m.SavingsRate.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if len(m.LockupTiers) > 0 {
+		for _, e := range m.LockupTiers {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockupTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MonthsLockup != 0 {
+		n += 1 + sovStore(uint64(m.MonthsLockup))
+	}
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStore(uint64(l))
 	return n
 }
 
@@ -253,6 +619,52 @@ func (m *Deposit) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *LockedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovStore(uint64(m.ID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MonthsLockup != 0 {
+		n += 1 + sovStore(uint64(m.MonthsLockup))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStore(uint64(l))
 	return n
 }
 
@@ -323,30 +735,201 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SupportedDenoms = append(m.SupportedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStore(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+			if err := m.SavingsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTiers = append(m.LockupTiers, LockupTier{})
+			if err := m.LockupTiers[len(m.LockupTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockupTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLockup", wireType)
+			}
+			m.MonthsLockup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsLockup |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -439,6 +1022,376 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, InterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLockup", wireType)
+			}
+			m.MonthsLockup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsLockup |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -41,7 +41,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{0}
+	return fileDescriptor_d460262261c3faa1, []int{0}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,7 +92,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{1}
+	return fileDescriptor_d460262261c3faa1, []int{1}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +131,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{2}
+	return fileDescriptor_d460262261c3faa1, []int{2}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +182,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{3}
+	return fileDescriptor_d460262261c3faa1, []int{3}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,40 +211,155 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+// MsgLockDeposit defines the Msg/LockDeposit request type.
+type MsgLockDeposit struct {
+	Depositor    string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	MonthsLockup int64      `protobuf:"varint,3,opt,name=months_lockup,json=monthsLockup,proto3" json:"months_lockup,omitempty"`
+}
+
+func (m *MsgLockDeposit) Reset()         { *m = MsgLockDeposit{} }
+func (m *MsgLockDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDeposit) ProtoMessage()    {}
+func (*MsgLockDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d460262261c3faa1, []int{4}
+}
+func (m *MsgLockDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDeposit.Merge(m, src)
+}
+func (m *MsgLockDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDeposit proto.InternalMessageInfo
+
+func (m *MsgLockDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgLockDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgLockDeposit) GetMonthsLockup() int64 {
+	if m != nil {
+		return m.MonthsLockup
+	}
+	return 0
+}
+
+// MsgLockDepositResponse defines the Msg/LockDeposit response type.
+type MsgLockDepositResponse struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgLockDepositResponse) Reset()         { *m = MsgLockDepositResponse{} }
+func (m *MsgLockDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDepositResponse) ProtoMessage()    {}
+func (*MsgLockDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d460262261c3faa1, []int{5}
+}
+func (m *MsgLockDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDepositResponse.Merge(m, src)
+}
+func (m *MsgLockDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDepositResponse proto.InternalMessageInfo
+
+func (m *MsgLockDepositResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "fury.savings.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "fury.savings.v1beta1.MsgDepositResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "fury.savings.v1beta1.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "fury.savings.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgLockDeposit)(nil), "fury.savings.v1beta1.MsgLockDeposit")
+	proto.RegisterType((*MsgLockDepositResponse)(nil), "fury.savings.v1beta1.MsgLockDepositResponse")
 }
 
-func init() { proto.RegisterFile("fury/savings/v1beta1/tx.proto", fileDescriptor_c0bf8679b144267a) }
-
-var fileDescriptor_c0bf8679b144267a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x5c, 0x12, 0xee, 0x65, 0xd8, 0xf5, 0xd6, 0x04, 0x9a, 0x58, 0x90, 0x55, 0x59, 0x30,
-	0x15, 0x4c, 0xdc, 0x0b, 0x6e, 0xd9, 0x60, 0x8c, 0xc6, 0x8d, 0x99, 0xfe, 0x64, 0x98, 0x20, 0x9d,
-	0xa6, 0x67, 0x40, 0x7c, 0x0b, 0x5f, 0x43, 0xd6, 0xc6, 0x67, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03,
-	0x2f, 0x62, 0xda, 0x4e, 0x81, 0x85, 0x86, 0xad, 0xab, 0x9e, 0x99, 0xef, 0x27, 0xdf, 0x7c, 0x3d,
-	0xf8, 0x70, 0x44, 0xa7, 0xd4, 0x01, 0x3a, 0xe5, 0x21, 0x03, 0x67, 0xda, 0x76, 0x03, 0x49, 0xdb,
-	0x8e, 0x9c, 0x91, 0x28, 0x16, 0x52, 0xe8, 0x46, 0x02, 0x13, 0x05, 0x13, 0x05, 0x9b, 0x96, 0x27,
-	0x60, 0x2c, 0xc0, 0x71, 0x29, 0x04, 0x1b, 0x8d, 0x27, 0x78, 0x98, 0xa9, 0xcc, 0x6a, 0x86, 0xdf,
-	0xa6, 0x27, 0x27, 0x3b, 0x28, 0xc8, 0x60, 0x82, 0x89, 0xec, 0x3e, 0x99, 0xb2, 0xdb, 0xc6, 0x13,
-	0xc2, 0xb8, 0x0f, 0xec, 0x3c, 0x88, 0x04, 0x70, 0xa9, 0x9f, 0xe2, 0x92, 0x9f, 0x8d, 0x22, 0xae,
-	0xa0, 0x3a, 0xb2, 0x4b, 0xdd, 0xca, 0xeb, 0x73, 0xcb, 0x50, 0x4e, 0x67, 0xbe, 0x1f, 0x07, 0x00,
-	0x17, 0x32, 0xe6, 0x21, 0x1b, 0x6c, 0xa9, 0xba, 0x87, 0x8b, 0x74, 0x2c, 0x26, 0xa1, 0xac, 0xfc,
-	0xa9, 0x17, 0xec, 0x72, 0xa7, 0x4a, 0x94, 0x22, 0x09, 0x9a, 0xa7, 0x27, 0x3d, 0xc1, 0xc3, 0xee,
-	0xf1, 0xe2, 0xbd, 0xa6, 0xcd, 0x3f, 0x6a, 0x36, 0xe3, 0x72, 0x38, 0x71, 0x89, 0x27, 0xc6, 0x2a,
-	0xa8, 0xfa, 0xb4, 0xc0, 0x1f, 0x39, 0xf2, 0x21, 0x0a, 0x20, 0x15, 0xc0, 0x40, 0x59, 0x37, 0x0c,
-	0xac, 0x6f, 0xa3, 0x0e, 0x02, 0x88, 0x44, 0x08, 0x41, 0x63, 0x8e, 0x70, 0xb9, 0x0f, 0xec, 0x8a,
-	0xcb, 0xa1, 0x1f, 0xd3, 0xfb, 0xdf, 0xfd, 0x84, 0x03, 0xfc, 0x7f, 0x27, 0x6b, 0xfe, 0x86, 0xce,
-	0x0b, 0xc2, 0x85, 0x3e, 0x30, 0xfd, 0x12, 0xff, 0xcd, 0xff, 0x44, 0x9d, 0x7c, 0xb7, 0x00, 0x64,
-	0x5b, 0x80, 0x69, 0xef, 0x63, 0xe4, 0xf6, 0xfa, 0x35, 0xfe, 0xb7, 0xa9, 0xe7, 0xe8, 0x47, 0x55,
-	0x4e, 0x31, 0x9b, 0x7b, 0x29, 0xb9, 0x73, 0xb7, 0xb7, 0x58, 0x59, 0x68, 0xb9, 0xb2, 0xd0, 0xe7,
-	0xca, 0x42, 0x8f, 0x6b, 0x4b, 0x5b, 0xae, 0x2d, 0xed, 0x6d, 0x6d, 0x69, 0x37, 0xcd, 0x9d, 0x6e,
-	0x12, 0xbb, 0xd6, 0x1d, 0x75, 0x21, 0x9d, 0x9c, 0xd9, 0x66, 0xeb, 0xd3, 0x8a, 0xdc, 0x62, 0xba,
-	0x8a, 0x27, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x17, 0x24, 0x24, 0x12, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/savings/v1beta1/tx.proto", fileDescriptor_d460262261c3faa1) }
+
+var fileDescriptor_d460262261c3faa1 = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x3a, 0x55, 0xa0, 0x13, 0xe0, 0x60, 0x42, 0x95, 0x5a, 0xc2, 0x09, 0x81, 0x83, 0x41,
+	0xc4, 0x6e, 0x8b, 0x04, 0x67, 0x42, 0x2e, 0x48, 0xcd, 0xc5, 0x08, 0x81, 0xb8, 0x54, 0x8e, 0xbd,
+	0x6c, 0x56, 0xc1, 0x1e, 0xcb, 0xb3, 0x09, 0xed, 0xbf, 0xe0, 0xca, 0x1f, 0x40, 0xa2, 0x67, 0x7e,
+	0x44, 0x8f, 0x15, 0x27, 0x4e, 0x05, 0x25, 0x7f, 0x04, 0xc5, 0x5f, 0x31, 0x12, 0x25, 0x48, 0x5c,
+	0x38, 0x79, 0x77, 0xde, 0x7b, 0xbb, 0xef, 0x69, 0xc6, 0x0b, 0xb7, 0xdf, 0xce, 0x92, 0x13, 0x87,
+	0xbc, 0xb9, 0x8c, 0x04, 0x39, 0xf3, 0xfd, 0x31, 0x57, 0xde, 0xbe, 0xa3, 0x8e, 0xed, 0x38, 0x41,
+	0x85, 0x7a, 0x6b, 0x05, 0xdb, 0x39, 0x6c, 0xe7, 0xb0, 0x61, 0xfa, 0x48, 0x21, 0x92, 0x33, 0xf6,
+	0x88, 0x97, 0x1a, 0x1f, 0x65, 0x94, 0xa9, 0x8c, 0xdd, 0x0c, 0x3f, 0x4a, 0x77, 0x4e, 0xb6, 0xc9,
+	0xa1, 0x96, 0x40, 0x81, 0x59, 0x7d, 0xb5, 0xca, 0xaa, 0xbd, 0xcf, 0x0c, 0x60, 0x44, 0x62, 0xc8,
+	0x63, 0x24, 0xa9, 0xf4, 0xc7, 0xb0, 0x1d, 0x64, 0x4b, 0x4c, 0xda, 0xac, 0xcb, 0xac, 0xed, 0x41,
+	0xfb, 0xeb, 0x97, 0x7e, 0x2b, 0x3f, 0xe9, 0x69, 0x10, 0x24, 0x9c, 0xe8, 0x85, 0x4a, 0x64, 0x24,
+	0xdc, 0x35, 0x55, 0xf7, 0xa1, 0xe1, 0x85, 0x38, 0x8b, 0x54, 0x5b, 0xeb, 0xd6, 0xad, 0xe6, 0xc1,
+	0xae, 0x9d, 0x2b, 0x56, 0x46, 0x0b, 0xf7, 0xf6, 0x33, 0x94, 0xd1, 0x60, 0xef, 0xec, 0xa2, 0x53,
+	0x3b, 0xfd, 0xde, 0xb1, 0x84, 0x54, 0x93, 0xd9, 0xd8, 0xf6, 0x31, 0xcc, 0x8d, 0xe6, 0x9f, 0x3e,
+	0x05, 0x53, 0x47, 0x9d, 0xc4, 0x9c, 0x52, 0x01, 0xb9, 0xf9, 0xd1, 0xbd, 0x16, 0xe8, 0x6b, 0xab,
+	0x2e, 0xa7, 0x18, 0x23, 0xe2, 0xbd, 0x53, 0x06, 0xcd, 0x11, 0x89, 0x57, 0x52, 0x4d, 0x82, 0xc4,
+	0x7b, 0xff, 0x7f, 0x47, 0xb8, 0x05, 0x37, 0x2b, 0x5e, 0xcb, 0x0c, 0x9f, 0x18, 0xdc, 0x18, 0x91,
+	0x38, 0x44, 0x7f, 0xfa, 0xaf, 0x9d, 0x78, 0x52, 0x89, 0xc1, 0xfe, 0x1c, 0x63, 0x6b, 0x15, 0xa3,
+	0xb0, 0xa6, 0xdf, 0x85, 0xeb, 0x21, 0x46, 0x6a, 0x42, 0x47, 0xef, 0xd0, 0x9f, 0xce, 0xe2, 0x76,
+	0xbd, 0xcb, 0xac, 0xba, 0x7b, 0x2d, 0x2b, 0x1e, 0xa6, 0xb5, 0xde, 0x1e, 0xec, 0xfc, 0xea, 0xb3,
+	0x88, 0xa0, 0xef, 0x80, 0x26, 0x83, 0xd4, 0xe8, 0xd6, 0xa0, 0xb1, 0xb8, 0xe8, 0x68, 0xcf, 0x87,
+	0xae, 0x26, 0x83, 0x83, 0x8f, 0x1a, 0xd4, 0x47, 0x24, 0xf4, 0x97, 0x70, 0xa5, 0x88, 0xd6, 0xb5,
+	0x7f, 0x37, 0xdb, 0xf6, 0xba, 0xb7, 0x86, 0xb5, 0x89, 0x51, 0x5e, 0xfb, 0x1a, 0xae, 0x96, 0x9d,
+	0xbf, 0x73, 0xa9, 0xaa, 0xa0, 0x18, 0xf7, 0x37, 0x52, 0xca, 0x93, 0x3d, 0x68, 0x56, 0xfb, 0x71,
+	0xef, 0x52, 0x65, 0x85, 0x65, 0x3c, 0xfc, 0x1b, 0x56, 0x71, 0xc5, 0x60, 0x78, 0xb6, 0x30, 0xd9,
+	0xf9, 0xc2, 0x64, 0x3f, 0x16, 0x26, 0xfb, 0xb0, 0x34, 0x6b, 0xe7, 0x4b, 0xb3, 0xf6, 0x6d, 0x69,
+	0xd6, 0xde, 0x3c, 0xa8, 0x4c, 0x56, 0xe8, 0x09, 0xde, 0xf7, 0x71, 0xce, 0x23, 0x27, 0x7d, 0x32,
+	0x8e, 0xcb, 0x47, 0x23, 0x9d, 0xb0, 0x71, 0x23, 0xfd, 0x93, 0x1f, 0xfd, 0x1c, 0x00, 0x88, 0xd2,
+	0x35, 0x55, 0x51, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +378,8 @@ type MsgClient interface {
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// LockDeposit defines a method for locking deposited funds for a lockup period
+	LockDeposit(ctx context.Context, in *MsgLockDeposit, opts ...grpc.CallOption) (*MsgLockDepositResponse, error)
 }
 
 type msgClient struct {
@@ -291,12 +408,23 @@ func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) LockDeposit(ctx context.Context, in *MsgLockDeposit, opts ...grpc.CallOption) (*MsgLockDepositResponse, error) {
+	out := new(MsgLockDepositResponse)
+	err := c.cc.Invoke(ctx, "/fury.savings.v1beta1.Msg/LockDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to the savings module account
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// LockDeposit defines a method for locking deposited funds for a lockup period
+	LockDeposit(context.Context, *MsgLockDeposit) (*MsgLockDepositResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -309,6 +437,9 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (*UnimplementedMsgServer) LockDeposit(ctx context.Context, req *MsgLockDeposit) (*MsgLockDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockDeposit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -350,6 +481,24 @@ func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.savings.v1beta1.Msg/LockDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockDeposit(ctx, req.(*MsgLockDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.savings.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -362,6 +511,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _Msg_Withdraw_Handler,
 		},
+		{
+			MethodName: "LockDeposit",
+			Handler:    _Msg_LockDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/savings/v1beta1/tx.proto",
@@ -501,6 +654,79 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLockDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MonthsLockup != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MonthsLockup))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -568,6 +794,36 @@ func (m *MsgWithdrawResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLockDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MonthsLockup != 0 {
+		n += 1 + sovTx(uint64(m.MonthsLockup))
+	}
+	return n
+}
+
+func (m *MsgLockDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -906,6 +1162,209 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLockDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLockup", wireType)
+			}
+			m.MonthsLockup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsLockup |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0